@@ -1697,6 +1697,35 @@ func (m *ImportCsvRequest) validate(all bool) error {
 
 	// no validation rules for WriteMode
 
+	if all {
+		switch v := interface{}(m.GetDeduplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportCsvRequestValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportCsvRequestValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeduplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportCsvRequestValidationError{
+				field:  "Deduplication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ImportCsvRequestMultiError(errors)
 	}
@@ -2822,6 +2851,73 @@ func (m *GetDataTableResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetQualityRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDataTableResponseValidationError{
+						field:  fmt.Sprintf("QualityRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDataTableResponseValidationError{
+						field:  fmt.Sprintf("QualityRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDataTableResponseValidationError{
+					field:  fmt.Sprintf("QualityRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ErrorThreshold
+
+	// no validation rules for SchemaPolicy
+
+	if all {
+		switch v := interface{}(m.GetRetentionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDataTableResponseValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDataTableResponseValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDataTableResponseValidationError{
+				field:  "RetentionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetDataTableResponseMultiError(errors)
 	}
@@ -2937,6 +3033,66 @@ func (m *GetQueryDataTableRequest) validate(all bool) error {
 
 	// no validation rules for Limit
 
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQueryDataTableRequestValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQueryDataTableRequestValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQueryDataTableRequestValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timestamp
+
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQueryDataTableRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQueryDataTableRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQueryDataTableRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetQueryDataTableRequestMultiError(errors)
 	}
@@ -3043,6 +3199,8 @@ func (m *GetQueryDataTableResponse) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return GetQueryDataTableResponseMultiError(errors)
 	}
@@ -4314,6 +4472,28 @@ func (m *ExportDataToFileRequest) validate(all bool) error {
 
 	// no validation rules for MasterSegmentId
 
+	if _, ok := _ExportDataToFileRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := ExportDataToFileRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [email sms push ads]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExportDataToFileRequest_MembershipChange_InLookup[m.GetMembershipChange()]; !ok {
+		err := ExportDataToFileRequestValidationError{
+			field:  "MembershipChange",
+			reason: "value must be in list [ entered exited]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ExportDataToFileRequestMultiError(errors)
 	}
@@ -4394,6 +4574,19 @@ var _ interface {
 	ErrorName() string
 } = ExportDataToFileRequestValidationError{}
 
+var _ExportDataToFileRequest_Channel_InLookup = map[string]struct{}{
+	"email": {},
+	"sms":   {},
+	"push":  {},
+	"ads":   {},
+}
+
+var _ExportDataToFileRequest_MembershipChange_InLookup = map[string]struct{}{
+	"":        {},
+	"entered": {},
+	"exited":  {},
+}
+
 // Validate checks the field values on ExportDataToFileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -4885,6 +5078,35 @@ func (m *ImportCsvFromS3Request) validate(all bool) error {
 
 	// no validation rules for Description
 
+	if all {
+		switch v := interface{}(m.GetDeduplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportCsvFromS3RequestValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportCsvFromS3RequestValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeduplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportCsvFromS3RequestValidationError{
+				field:  "Deduplication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ImportCsvFromS3RequestMultiError(errors)
 	}
@@ -5219,6 +5441,74 @@ func (m *CreateMasterSegmentRequest) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetComputedAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMasterSegmentRequestValidationError{
+						field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMasterSegmentRequestValidationError{
+						field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMasterSegmentRequestValidationError{
+					field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetConsentColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMasterSegmentRequestValidationError{
+						field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMasterSegmentRequestValidationError{
+						field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMasterSegmentRequestValidationError{
+					field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateMasterSegmentRequestMultiError(errors)
 	}
@@ -5918,6 +6208,40 @@ func (m *GetMasterSegmentDetailResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetSchemaIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterSegmentDetailResponseValidationError{
+						field:  fmt.Sprintf("SchemaIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterSegmentDetailResponseValidationError{
+						field:  fmt.Sprintf("SchemaIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterSegmentDetailResponseValidationError{
+					field:  fmt.Sprintf("SchemaIssues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return GetMasterSegmentDetailResponseMultiError(errors)
 	}
@@ -6110,9 +6434,43 @@ func (m *CreateSegmentRequest) validate(all bool) error {
 
 	}
 
-	if len(errors) > 0 {
-		return CreateSegmentRequestMultiError(errors)
-	}
+	for idx, item := range m.GetSequenceConditions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSegmentRequestValidationError{
+						field:  fmt.Sprintf("SequenceConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSegmentRequestValidationError{
+						field:  fmt.Sprintf("SequenceConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSegmentRequestValidationError{
+					field:  fmt.Sprintf("SequenceConditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSegmentRequestMultiError(errors)
+	}
 
 	return nil
 }
@@ -6913,6 +7271,28 @@ func (m *CreateGophishUserGroupFromSegmentRequest) validate(all bool) error {
 		}
 	}
 
+	if _, ok := _CreateGophishUserGroupFromSegmentRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := CreateGophishUserGroupFromSegmentRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [email sms push ads]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateGophishUserGroupFromSegmentRequest_MembershipChange_InLookup[m.GetMembershipChange()]; !ok {
+		err := CreateGophishUserGroupFromSegmentRequestValidationError{
+			field:  "MembershipChange",
+			reason: "value must be in list [ entered exited]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateGophishUserGroupFromSegmentRequestMultiError(errors)
 	}
@@ -6995,6 +7375,19 @@ var _ interface {
 	ErrorName() string
 } = CreateGophishUserGroupFromSegmentRequestValidationError{}
 
+var _CreateGophishUserGroupFromSegmentRequest_Channel_InLookup = map[string]struct{}{
+	"email": {},
+	"sms":   {},
+	"push":  {},
+	"ads":   {},
+}
+
+var _CreateGophishUserGroupFromSegmentRequest_MembershipChange_InLookup = map[string]struct{}{
+	"":        {},
+	"entered": {},
+	"exited":  {},
+}
+
 // Validate checks the field values on
 // CreateGophishUserGroupFromSegmentResponse with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -7222,6 +7615,35 @@ func (m *ImportFromMySQLSourceRequest) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetDeduplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportFromMySQLSourceRequestValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportFromMySQLSourceRequestValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeduplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportFromMySQLSourceRequestValidationError{
+				field:  "Deduplication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ImportFromMySQLSourceRequestMultiError(errors)
 	}
@@ -7460,6 +7882,28 @@ func (m *ExportToMySQLDestinationRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _ExportToMySQLDestinationRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := ExportToMySQLDestinationRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [email sms push ads]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExportToMySQLDestinationRequest_MembershipChange_InLookup[m.GetMembershipChange()]; !ok {
+		err := ExportToMySQLDestinationRequestValidationError{
+			field:  "MembershipChange",
+			reason: "value must be in list [ entered exited]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ExportToMySQLDestinationRequestMultiError(errors)
 	}
@@ -7541,6 +7985,19 @@ var _ interface {
 	ErrorName() string
 } = ExportToMySQLDestinationRequestValidationError{}
 
+var _ExportToMySQLDestinationRequest_Channel_InLookup = map[string]struct{}{
+	"email": {},
+	"sms":   {},
+	"push":  {},
+	"ads":   {},
+}
+
+var _ExportToMySQLDestinationRequest_MembershipChange_InLookup = map[string]struct{}{
+	"":        {},
+	"entered": {},
+	"exited":  {},
+}
+
 // Validate checks the field values on ExportToMySQLDestinationResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -9068,6 +9525,8 @@ func (m *GetDataDestinationDetailResponse) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for Channel
+
 	if len(errors) > 0 {
 		return GetDataDestinationDetailResponseMultiError(errors)
 	}
@@ -9724,6 +10183,35 @@ func (m *GetListMasterSegmentProfilesRequest) validate(all bool) error {
 
 	// no validation rules for SearchValue
 
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListMasterSegmentProfilesRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListMasterSegmentProfilesRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListMasterSegmentProfilesRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetListMasterSegmentProfilesRequestMultiError(errors)
 	}
@@ -9835,6 +10323,8 @@ func (m *GetListMasterSegmentProfilesResponse) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return GetListMasterSegmentProfilesResponseMultiError(errors)
 	}
@@ -11567,6 +12057,35 @@ func (m *GetBehaviorProfileRequest) validate(all bool) error {
 
 	// no validation rules for ForeignKeyValue
 
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBehaviorProfileRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBehaviorProfileRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBehaviorProfileRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetBehaviorProfileRequestMultiError(errors)
 	}
@@ -11675,6 +12194,8 @@ func (m *GetBehaviorProfileResponse) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return GetBehaviorProfileResponseMultiError(errors)
 	}
@@ -12239,6 +12760,15573 @@ var _ interface {
 	ErrorName() string
 } = TotalProfilesMasterSegmentResponseValidationError{}
 
+// Validate checks the field values on PreviewFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewFileRequestMultiError, or nil if none found.
+func (m *PreviewFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFileName()) < 1 {
+		err := PreviewFileRequestValidationError{
+			field:  "FileName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FileContent
+
+	if m.GetConnectionId() < 0 {
+		err := PreviewFileRequestValidationError{
+			field:  "ConnectionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetConfigurations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewFileRequestValidationError{
+					field:  "Configurations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewFileRequestValidationError{
+					field:  "Configurations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewFileRequestValidationError{
+				field:  "Configurations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetSampleSize(); val < 0 || val > 1000 {
+		err := PreviewFileRequestValidationError{
+			field:  "SampleSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PreviewFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewFileRequestMultiError is an error wrapping multiple validation errors
+// returned by PreviewFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PreviewFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewFileRequestMultiError) AllErrors() []error { return m }
+
+// PreviewFileRequestValidationError is the validation error returned by
+// PreviewFileRequest.Validate if the designated constraints aren't met.
+type PreviewFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewFileRequestValidationError) ErrorName() string {
+	return "PreviewFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewFileRequestValidationError{}
+
+// Validate checks the field values on PreviewFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewFileResponseMultiError, or nil if none found.
+func (m *PreviewFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for SampledRows
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewFileResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewFileResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewFileResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PreviewFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewFileResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewFileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewFileResponseMultiError) AllErrors() []error { return m }
+
+// PreviewFileResponseValidationError is the validation error returned by
+// PreviewFileResponse.Validate if the designated constraints aren't met.
+type PreviewFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewFileResponseValidationError) ErrorName() string {
+	return "PreviewFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewFileResponseValidationError{}
+
+// Validate checks the field values on UpdateDataTableQualityRulesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateDataTableQualityRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDataTableQualityRulesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateDataTableQualityRulesRequestMultiError, or nil if none found.
+func (m *UpdateDataTableQualityRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDataTableQualityRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateDataTableQualityRulesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateDataTableQualityRulesRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateDataTableQualityRulesRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateDataTableQualityRulesRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if val := m.GetErrorThreshold(); val < 0 || val > 1 {
+		err := UpdateDataTableQualityRulesRequestValidationError{
+			field:  "ErrorThreshold",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateDataTableQualityRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDataTableQualityRulesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateDataTableQualityRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDataTableQualityRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDataTableQualityRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDataTableQualityRulesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDataTableQualityRulesRequestValidationError is the validation error
+// returned by UpdateDataTableQualityRulesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateDataTableQualityRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDataTableQualityRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDataTableQualityRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDataTableQualityRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDataTableQualityRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDataTableQualityRulesRequestValidationError) ErrorName() string {
+	return "UpdateDataTableQualityRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDataTableQualityRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDataTableQualityRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDataTableQualityRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDataTableQualityRulesRequestValidationError{}
+
+// Validate checks the field values on UpdateDataTableQualityRulesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateDataTableQualityRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDataTableQualityRulesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateDataTableQualityRulesResponseMultiError, or nil if none found.
+func (m *UpdateDataTableQualityRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDataTableQualityRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateDataTableQualityRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDataTableQualityRulesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateDataTableQualityRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDataTableQualityRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDataTableQualityRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDataTableQualityRulesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDataTableQualityRulesResponseValidationError is the validation error
+// returned by UpdateDataTableQualityRulesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateDataTableQualityRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDataTableQualityRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDataTableQualityRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDataTableQualityRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDataTableQualityRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDataTableQualityRulesResponseValidationError) ErrorName() string {
+	return "UpdateDataTableQualityRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDataTableQualityRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDataTableQualityRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDataTableQualityRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDataTableQualityRulesResponseValidationError{}
+
+// Validate checks the field values on CreateIdentityResolutionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateIdentityResolutionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateIdentityResolutionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateIdentityResolutionRequestMultiError, or nil if none found.
+func (m *CreateIdentityResolutionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateIdentityResolutionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateIdentityResolutionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(m.GetSources()) < 1 {
+		err := CreateIdentityResolutionRequestValidationError{
+			field:  "Sources",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateIdentityResolutionRequestValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateIdentityResolutionRequestValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateIdentityResolutionRequestValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetRules()) < 1 {
+		err := CreateIdentityResolutionRequestValidationError{
+			field:  "Rules",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateIdentityResolutionRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateIdentityResolutionRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateIdentityResolutionRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetOutputTableName()) < 1 {
+		err := CreateIdentityResolutionRequestValidationError{
+			field:  "OutputTableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateIdentityResolutionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateIdentityResolutionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateIdentityResolutionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateIdentityResolutionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateIdentityResolutionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateIdentityResolutionRequestMultiError) AllErrors() []error { return m }
+
+// CreateIdentityResolutionRequestValidationError is the validation error
+// returned by CreateIdentityResolutionRequest.Validate if the designated
+// constraints aren't met.
+type CreateIdentityResolutionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateIdentityResolutionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateIdentityResolutionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateIdentityResolutionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateIdentityResolutionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateIdentityResolutionRequestValidationError) ErrorName() string {
+	return "CreateIdentityResolutionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateIdentityResolutionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateIdentityResolutionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateIdentityResolutionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateIdentityResolutionRequestValidationError{}
+
+// Validate checks the field values on CreateIdentityResolutionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateIdentityResolutionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateIdentityResolutionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateIdentityResolutionResponseMultiError, or nil if none found.
+func (m *CreateIdentityResolutionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateIdentityResolutionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateIdentityResolutionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateIdentityResolutionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateIdentityResolutionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateIdentityResolutionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateIdentityResolutionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateIdentityResolutionResponseMultiError) AllErrors() []error { return m }
+
+// CreateIdentityResolutionResponseValidationError is the validation error
+// returned by CreateIdentityResolutionResponse.Validate if the designated
+// constraints aren't met.
+type CreateIdentityResolutionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateIdentityResolutionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateIdentityResolutionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateIdentityResolutionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateIdentityResolutionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateIdentityResolutionResponseValidationError) ErrorName() string {
+	return "CreateIdentityResolutionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateIdentityResolutionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateIdentityResolutionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateIdentityResolutionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateIdentityResolutionResponseValidationError{}
+
+// Validate checks the field values on GetListIdentityResolutionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetListIdentityResolutionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListIdentityResolutionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetListIdentityResolutionsRequestMultiError, or nil if none found.
+func (m *GetListIdentityResolutionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListIdentityResolutionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetListIdentityResolutionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListIdentityResolutionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetListIdentityResolutionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListIdentityResolutionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListIdentityResolutionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListIdentityResolutionsRequestMultiError) AllErrors() []error { return m }
+
+// GetListIdentityResolutionsRequestValidationError is the validation error
+// returned by GetListIdentityResolutionsRequest.Validate if the designated
+// constraints aren't met.
+type GetListIdentityResolutionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListIdentityResolutionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListIdentityResolutionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListIdentityResolutionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListIdentityResolutionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListIdentityResolutionsRequestValidationError) ErrorName() string {
+	return "GetListIdentityResolutionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListIdentityResolutionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListIdentityResolutionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListIdentityResolutionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListIdentityResolutionsRequestValidationError{}
+
+// Validate checks the field values on GetListIdentityResolutionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetListIdentityResolutionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListIdentityResolutionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetListIdentityResolutionsResponseMultiError, or nil if none found.
+func (m *GetListIdentityResolutionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListIdentityResolutionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListIdentityResolutionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListIdentityResolutionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListIdentityResolutionsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListIdentityResolutionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListIdentityResolutionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetListIdentityResolutionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListIdentityResolutionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListIdentityResolutionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListIdentityResolutionsResponseMultiError) AllErrors() []error { return m }
+
+// GetListIdentityResolutionsResponseValidationError is the validation error
+// returned by GetListIdentityResolutionsResponse.Validate if the designated
+// constraints aren't met.
+type GetListIdentityResolutionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListIdentityResolutionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListIdentityResolutionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListIdentityResolutionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListIdentityResolutionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListIdentityResolutionsResponseValidationError) ErrorName() string {
+	return "GetListIdentityResolutionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListIdentityResolutionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListIdentityResolutionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListIdentityResolutionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListIdentityResolutionsResponseValidationError{}
+
+// Validate checks the field values on GetIdentityResolutionDetailRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIdentityResolutionDetailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIdentityResolutionDetailRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetIdentityResolutionDetailRequestMultiError, or nil if none found.
+func (m *GetIdentityResolutionDetailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIdentityResolutionDetailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetIdentityResolutionDetailRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetIdentityResolutionDetailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIdentityResolutionDetailRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIdentityResolutionDetailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetIdentityResolutionDetailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIdentityResolutionDetailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIdentityResolutionDetailRequestMultiError) AllErrors() []error { return m }
+
+// GetIdentityResolutionDetailRequestValidationError is the validation error
+// returned by GetIdentityResolutionDetailRequest.Validate if the designated
+// constraints aren't met.
+type GetIdentityResolutionDetailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIdentityResolutionDetailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIdentityResolutionDetailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIdentityResolutionDetailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIdentityResolutionDetailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIdentityResolutionDetailRequestValidationError) ErrorName() string {
+	return "GetIdentityResolutionDetailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIdentityResolutionDetailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIdentityResolutionDetailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIdentityResolutionDetailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIdentityResolutionDetailRequestValidationError{}
+
+// Validate checks the field values on GetIdentityResolutionDetailResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIdentityResolutionDetailResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIdentityResolutionDetailResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetIdentityResolutionDetailResponseMultiError, or nil if none found.
+func (m *GetIdentityResolutionDetailResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIdentityResolutionDetailResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetIdentityResolution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIdentityResolutionDetailResponseValidationError{
+					field:  "IdentityResolution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIdentityResolutionDetailResponseValidationError{
+					field:  "IdentityResolution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentityResolution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIdentityResolutionDetailResponseValidationError{
+				field:  "IdentityResolution",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetIdentityResolutionDetailResponseValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetIdentityResolutionDetailResponseValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetIdentityResolutionDetailResponseValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetIdentityResolutionDetailResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetIdentityResolutionDetailResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetIdentityResolutionDetailResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetIdentityResolutionDetailResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIdentityResolutionDetailResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIdentityResolutionDetailResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetIdentityResolutionDetailResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIdentityResolutionDetailResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIdentityResolutionDetailResponseMultiError) AllErrors() []error { return m }
+
+// GetIdentityResolutionDetailResponseValidationError is the validation error
+// returned by GetIdentityResolutionDetailResponse.Validate if the designated
+// constraints aren't met.
+type GetIdentityResolutionDetailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIdentityResolutionDetailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIdentityResolutionDetailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIdentityResolutionDetailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIdentityResolutionDetailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIdentityResolutionDetailResponseValidationError) ErrorName() string {
+	return "GetIdentityResolutionDetailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIdentityResolutionDetailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIdentityResolutionDetailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIdentityResolutionDetailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIdentityResolutionDetailResponseValidationError{}
+
+// Validate checks the field values on GetIdentityProfileHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIdentityProfileHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIdentityProfileHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetIdentityProfileHistoryRequestMultiError, or nil if none found.
+func (m *GetIdentityProfileHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIdentityProfileHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetIdentityProfileHistoryRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UnifiedProfileId
+
+	if val := m.GetPage(); val < 0 || val > 1000 {
+		err := GetIdentityProfileHistoryRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := GetIdentityProfileHistoryRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetIdentityProfileHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIdentityProfileHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIdentityProfileHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetIdentityProfileHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIdentityProfileHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIdentityProfileHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetIdentityProfileHistoryRequestValidationError is the validation error
+// returned by GetIdentityProfileHistoryRequest.Validate if the designated
+// constraints aren't met.
+type GetIdentityProfileHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIdentityProfileHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIdentityProfileHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIdentityProfileHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIdentityProfileHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIdentityProfileHistoryRequestValidationError) ErrorName() string {
+	return "GetIdentityProfileHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIdentityProfileHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIdentityProfileHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIdentityProfileHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIdentityProfileHistoryRequestValidationError{}
+
+// Validate checks the field values on GetIdentityProfileHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetIdentityProfileHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIdentityProfileHistoryResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetIdentityProfileHistoryResponseMultiError, or nil if none found.
+func (m *GetIdentityProfileHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIdentityProfileHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetIdentityProfileHistoryResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetIdentityProfileHistoryResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetIdentityProfileHistoryResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetIdentityProfileHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIdentityProfileHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetIdentityProfileHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetIdentityProfileHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIdentityProfileHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIdentityProfileHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetIdentityProfileHistoryResponseValidationError is the validation error
+// returned by GetIdentityProfileHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetIdentityProfileHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIdentityProfileHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIdentityProfileHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIdentityProfileHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIdentityProfileHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIdentityProfileHistoryResponseValidationError) ErrorName() string {
+	return "GetIdentityProfileHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIdentityProfileHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIdentityProfileHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIdentityProfileHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIdentityProfileHistoryResponseValidationError{}
+
+// Validate checks the field values on DeduplicateDataTableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeduplicateDataTableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeduplicateDataTableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeduplicateDataTableRequestMultiError, or nil if none found.
+func (m *DeduplicateDataTableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeduplicateDataTableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeduplicateDataTableRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetConfiguration() == nil {
+		err := DeduplicateDataTableRequestValidationError{
+			field:  "Configuration",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfiguration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeduplicateDataTableRequestValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeduplicateDataTableRequestValidationError{
+					field:  "Configuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfiguration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeduplicateDataTableRequestValidationError{
+				field:  "Configuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeduplicateDataTableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeduplicateDataTableRequestMultiError is an error wrapping multiple
+// validation errors returned by DeduplicateDataTableRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeduplicateDataTableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeduplicateDataTableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeduplicateDataTableRequestMultiError) AllErrors() []error { return m }
+
+// DeduplicateDataTableRequestValidationError is the validation error returned
+// by DeduplicateDataTableRequest.Validate if the designated constraints
+// aren't met.
+type DeduplicateDataTableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicateDataTableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicateDataTableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicateDataTableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicateDataTableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicateDataTableRequestValidationError) ErrorName() string {
+	return "DeduplicateDataTableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicateDataTableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicateDataTableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicateDataTableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicateDataTableRequestValidationError{}
+
+// Validate checks the field values on DeduplicateDataTableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeduplicateDataTableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeduplicateDataTableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeduplicateDataTableResponseMultiError, or nil if none found.
+func (m *DeduplicateDataTableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeduplicateDataTableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for DataActionId
+
+	if len(errors) > 0 {
+		return DeduplicateDataTableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeduplicateDataTableResponseMultiError is an error wrapping multiple
+// validation errors returned by DeduplicateDataTableResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeduplicateDataTableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeduplicateDataTableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeduplicateDataTableResponseMultiError) AllErrors() []error { return m }
+
+// DeduplicateDataTableResponseValidationError is the validation error returned
+// by DeduplicateDataTableResponse.Validate if the designated constraints
+// aren't met.
+type DeduplicateDataTableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicateDataTableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicateDataTableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicateDataTableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicateDataTableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicateDataTableResponseValidationError) ErrorName() string {
+	return "DeduplicateDataTableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicateDataTableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicateDataTableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicateDataTableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicateDataTableResponseValidationError{}
+
+// Validate checks the field values on CreateEventSourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEventSourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEventSourceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEventSourceRequestMultiError, or nil if none found.
+func (m *CreateEventSourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEventSourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateEventSourceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := CreateEventSourceRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetProperties() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateEventSourceRequestValidationError{
+						field:  fmt.Sprintf("Properties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateEventSourceRequestValidationError{
+						field:  fmt.Sprintf("Properties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateEventSourceRequestValidationError{
+					field:  fmt.Sprintf("Properties[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTraits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateEventSourceRequestValidationError{
+						field:  fmt.Sprintf("Traits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateEventSourceRequestValidationError{
+						field:  fmt.Sprintf("Traits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateEventSourceRequestValidationError{
+					field:  fmt.Sprintf("Traits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateEventSourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEventSourceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateEventSourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateEventSourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEventSourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEventSourceRequestMultiError) AllErrors() []error { return m }
+
+// CreateEventSourceRequestValidationError is the validation error returned by
+// CreateEventSourceRequest.Validate if the designated constraints aren't met.
+type CreateEventSourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventSourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventSourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventSourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventSourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventSourceRequestValidationError) ErrorName() string {
+	return "CreateEventSourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventSourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventSourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventSourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventSourceRequestValidationError{}
+
+// Validate checks the field values on CreateEventSourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEventSourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEventSourceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEventSourceResponseMultiError, or nil if none found.
+func (m *CreateEventSourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEventSourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Id
+
+	// no validation rules for WriteKey
+
+	// no validation rules for TableId
+
+	if len(errors) > 0 {
+		return CreateEventSourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEventSourceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateEventSourceResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateEventSourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEventSourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEventSourceResponseMultiError) AllErrors() []error { return m }
+
+// CreateEventSourceResponseValidationError is the validation error returned by
+// CreateEventSourceResponse.Validate if the designated constraints aren't met.
+type CreateEventSourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventSourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventSourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventSourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventSourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventSourceResponseValidationError) ErrorName() string {
+	return "CreateEventSourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventSourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventSourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventSourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventSourceResponseValidationError{}
+
+// Validate checks the field values on GetListEventSourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListEventSourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListEventSourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListEventSourcesRequestMultiError, or nil if none found.
+func (m *GetListEventSourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListEventSourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetListEventSourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListEventSourcesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetListEventSourcesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetListEventSourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListEventSourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListEventSourcesRequestMultiError) AllErrors() []error { return m }
+
+// GetListEventSourcesRequestValidationError is the validation error returned
+// by GetListEventSourcesRequest.Validate if the designated constraints aren't met.
+type GetListEventSourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListEventSourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListEventSourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListEventSourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListEventSourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListEventSourcesRequestValidationError) ErrorName() string {
+	return "GetListEventSourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListEventSourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListEventSourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListEventSourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListEventSourcesRequestValidationError{}
+
+// Validate checks the field values on GetListEventSourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListEventSourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListEventSourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListEventSourcesResponseMultiError, or nil if none found.
+func (m *GetListEventSourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListEventSourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListEventSourcesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListEventSourcesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListEventSourcesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListEventSourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListEventSourcesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListEventSourcesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetListEventSourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListEventSourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListEventSourcesResponseMultiError) AllErrors() []error { return m }
+
+// GetListEventSourcesResponseValidationError is the validation error returned
+// by GetListEventSourcesResponse.Validate if the designated constraints
+// aren't met.
+type GetListEventSourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListEventSourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListEventSourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListEventSourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListEventSourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListEventSourcesResponseValidationError) ErrorName() string {
+	return "GetListEventSourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListEventSourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListEventSourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListEventSourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListEventSourcesResponseValidationError{}
+
+// Validate checks the field values on TrackRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrackRequestMultiError, or
+// nil if none found.
+func (m *TrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEvent()) < 1 {
+		err := TrackRequestValidationError{
+			field:  "Event",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for AnonymousId
+
+	if all {
+		switch v := interface{}(m.GetProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrackRequestValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrackRequestValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrackRequestValidationError{
+				field:  "Properties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timestamp
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return TrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrackRequestMultiError is an error wrapping multiple validation errors
+// returned by TrackRequest.ValidateAll() if the designated constraints aren't met.
+type TrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrackRequestMultiError) AllErrors() []error { return m }
+
+// TrackRequestValidationError is the validation error returned by
+// TrackRequest.Validate if the designated constraints aren't met.
+type TrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrackRequestValidationError) ErrorName() string { return "TrackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrackRequestValidationError{}
+
+// Validate checks the field values on IdentifyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IdentifyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentifyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentifyRequestMultiError, or nil if none found.
+func (m *IdentifyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentifyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for AnonymousId
+
+	if all {
+		switch v := interface{}(m.GetTraits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentifyRequestValidationError{
+					field:  "Traits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentifyRequestValidationError{
+					field:  "Traits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTraits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentifyRequestValidationError{
+				field:  "Traits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timestamp
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return IdentifyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentifyRequestMultiError is an error wrapping multiple validation errors
+// returned by IdentifyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IdentifyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentifyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentifyRequestMultiError) AllErrors() []error { return m }
+
+// IdentifyRequestValidationError is the validation error returned by
+// IdentifyRequest.Validate if the designated constraints aren't met.
+type IdentifyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentifyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentifyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentifyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentifyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentifyRequestValidationError) ErrorName() string { return "IdentifyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdentifyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentifyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentifyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentifyRequestValidationError{}
+
+// Validate checks the field values on BatchTrackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchTrackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchTrackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchTrackRequestMultiError, or nil if none found.
+func (m *BatchTrackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchTrackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetBatch()); l < 1 || l > 500 {
+		err := BatchTrackRequestValidationError{
+			field:  "Batch",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBatch() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchTrackRequestValidationError{
+						field:  fmt.Sprintf("Batch[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchTrackRequestValidationError{
+						field:  fmt.Sprintf("Batch[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchTrackRequestValidationError{
+					field:  fmt.Sprintf("Batch[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchTrackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchTrackRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchTrackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchTrackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchTrackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchTrackRequestMultiError) AllErrors() []error { return m }
+
+// BatchTrackRequestValidationError is the validation error returned by
+// BatchTrackRequest.Validate if the designated constraints aren't met.
+type BatchTrackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchTrackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchTrackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchTrackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchTrackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchTrackRequestValidationError) ErrorName() string {
+	return "BatchTrackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchTrackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchTrackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchTrackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchTrackRequestValidationError{}
+
+// Validate checks the field values on IngestEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IngestEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngestEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IngestEventResponseMultiError, or nil if none found.
+func (m *IngestEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngestEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Accepted
+
+	if len(errors) > 0 {
+		return IngestEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IngestEventResponseMultiError is an error wrapping multiple validation
+// errors returned by IngestEventResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IngestEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngestEventResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngestEventResponseMultiError) AllErrors() []error { return m }
+
+// IngestEventResponseValidationError is the validation error returned by
+// IngestEventResponse.Validate if the designated constraints aren't met.
+type IngestEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngestEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngestEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngestEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngestEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngestEventResponseValidationError) ErrorName() string {
+	return "IngestEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IngestEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngestEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngestEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngestEventResponseValidationError{}
+
+// Validate checks the field values on UpdateDataTableSchemaPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateDataTableSchemaPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDataTableSchemaPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateDataTableSchemaPolicyRequestMultiError, or nil if none found.
+func (m *UpdateDataTableSchemaPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDataTableSchemaPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateDataTableSchemaPolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateDataTableSchemaPolicyRequest_SchemaPolicy_InLookup[m.GetSchemaPolicy()]; !ok {
+		err := UpdateDataTableSchemaPolicyRequestValidationError{
+			field:  "SchemaPolicy",
+			reason: "value must be in list [allow_additive block_breaking auto_evolve]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateDataTableSchemaPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDataTableSchemaPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateDataTableSchemaPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDataTableSchemaPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDataTableSchemaPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDataTableSchemaPolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDataTableSchemaPolicyRequestValidationError is the validation error
+// returned by UpdateDataTableSchemaPolicyRequest.Validate if the designated
+// constraints aren't met.
+type UpdateDataTableSchemaPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDataTableSchemaPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDataTableSchemaPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDataTableSchemaPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDataTableSchemaPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDataTableSchemaPolicyRequestValidationError) ErrorName() string {
+	return "UpdateDataTableSchemaPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDataTableSchemaPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDataTableSchemaPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDataTableSchemaPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDataTableSchemaPolicyRequestValidationError{}
+
+var _UpdateDataTableSchemaPolicyRequest_SchemaPolicy_InLookup = map[string]struct{}{
+	"allow_additive": {},
+	"block_breaking": {},
+	"auto_evolve":    {},
+}
+
+// Validate checks the field values on UpdateDataTableSchemaPolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateDataTableSchemaPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDataTableSchemaPolicyResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateDataTableSchemaPolicyResponseMultiError, or nil if none found.
+func (m *UpdateDataTableSchemaPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDataTableSchemaPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateDataTableSchemaPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDataTableSchemaPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateDataTableSchemaPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDataTableSchemaPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDataTableSchemaPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDataTableSchemaPolicyResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDataTableSchemaPolicyResponseValidationError is the validation error
+// returned by UpdateDataTableSchemaPolicyResponse.Validate if the designated
+// constraints aren't met.
+type UpdateDataTableSchemaPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDataTableSchemaPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDataTableSchemaPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDataTableSchemaPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDataTableSchemaPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDataTableSchemaPolicyResponseValidationError) ErrorName() string {
+	return "UpdateDataTableSchemaPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDataTableSchemaPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDataTableSchemaPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDataTableSchemaPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDataTableSchemaPolicyResponseValidationError{}
+
+// Validate checks the field values on GetListDataTableSchemaVersionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetListDataTableSchemaVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListDataTableSchemaVersionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetListDataTableSchemaVersionsRequestMultiError, or nil if none found.
+func (m *GetListDataTableSchemaVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListDataTableSchemaVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetListDataTableSchemaVersionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetListDataTableSchemaVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListDataTableSchemaVersionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetListDataTableSchemaVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListDataTableSchemaVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListDataTableSchemaVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListDataTableSchemaVersionsRequestMultiError) AllErrors() []error { return m }
+
+// GetListDataTableSchemaVersionsRequestValidationError is the validation error
+// returned by GetListDataTableSchemaVersionsRequest.Validate if the
+// designated constraints aren't met.
+type GetListDataTableSchemaVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListDataTableSchemaVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListDataTableSchemaVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListDataTableSchemaVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListDataTableSchemaVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListDataTableSchemaVersionsRequestValidationError) ErrorName() string {
+	return "GetListDataTableSchemaVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListDataTableSchemaVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListDataTableSchemaVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListDataTableSchemaVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListDataTableSchemaVersionsRequestValidationError{}
+
+// Validate checks the field values on GetListDataTableSchemaVersionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetListDataTableSchemaVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetListDataTableSchemaVersionsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetListDataTableSchemaVersionsResponseMultiError, or nil if none found.
+func (m *GetListDataTableSchemaVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListDataTableSchemaVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListDataTableSchemaVersionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListDataTableSchemaVersionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListDataTableSchemaVersionsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListDataTableSchemaVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListDataTableSchemaVersionsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetListDataTableSchemaVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListDataTableSchemaVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListDataTableSchemaVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListDataTableSchemaVersionsResponseMultiError) AllErrors() []error { return m }
+
+// GetListDataTableSchemaVersionsResponseValidationError is the validation
+// error returned by GetListDataTableSchemaVersionsResponse.Validate if the
+// designated constraints aren't met.
+type GetListDataTableSchemaVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListDataTableSchemaVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListDataTableSchemaVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListDataTableSchemaVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListDataTableSchemaVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListDataTableSchemaVersionsResponseValidationError) ErrorName() string {
+	return "GetListDataTableSchemaVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListDataTableSchemaVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListDataTableSchemaVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListDataTableSchemaVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListDataTableSchemaVersionsResponseValidationError{}
+
+// Validate checks the field values on GetListDataTableVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListDataTableVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListDataTableVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListDataTableVersionsRequestMultiError, or nil if none found.
+func (m *GetListDataTableVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListDataTableVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetListDataTableVersionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := GetListDataTableVersionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListDataTableVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListDataTableVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetListDataTableVersionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetListDataTableVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListDataTableVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListDataTableVersionsRequestMultiError) AllErrors() []error { return m }
+
+// GetListDataTableVersionsRequestValidationError is the validation error
+// returned by GetListDataTableVersionsRequest.Validate if the designated
+// constraints aren't met.
+type GetListDataTableVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListDataTableVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListDataTableVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListDataTableVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListDataTableVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListDataTableVersionsRequestValidationError) ErrorName() string {
+	return "GetListDataTableVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListDataTableVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListDataTableVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListDataTableVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListDataTableVersionsRequestValidationError{}
+
+// Validate checks the field values on GetListDataTableVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetListDataTableVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListDataTableVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListDataTableVersionsResponseMultiError, or nil if none found.
+func (m *GetListDataTableVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListDataTableVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListDataTableVersionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListDataTableVersionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListDataTableVersionsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListDataTableVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListDataTableVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetListDataTableVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListDataTableVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListDataTableVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListDataTableVersionsResponseMultiError) AllErrors() []error { return m }
+
+// GetListDataTableVersionsResponseValidationError is the validation error
+// returned by GetListDataTableVersionsResponse.Validate if the designated
+// constraints aren't met.
+type GetListDataTableVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListDataTableVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListDataTableVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListDataTableVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListDataTableVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListDataTableVersionsResponseValidationError) ErrorName() string {
+	return "GetListDataTableVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListDataTableVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListDataTableVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListDataTableVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListDataTableVersionsResponseValidationError{}
+
+// Validate checks the field values on RestoreDataTableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreDataTableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreDataTableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreDataTableRequestMultiError, or nil if none found.
+func (m *RestoreDataTableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreDataTableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RestoreDataTableRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreDataTableRequestValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreDataTableRequestValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreDataTableRequestValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return RestoreDataTableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreDataTableRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreDataTableRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreDataTableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreDataTableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreDataTableRequestMultiError) AllErrors() []error { return m }
+
+// RestoreDataTableRequestValidationError is the validation error returned by
+// RestoreDataTableRequest.Validate if the designated constraints aren't met.
+type RestoreDataTableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreDataTableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreDataTableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreDataTableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreDataTableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreDataTableRequestValidationError) ErrorName() string {
+	return "RestoreDataTableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreDataTableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreDataTableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreDataTableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreDataTableRequestValidationError{}
+
+// Validate checks the field values on RestoreDataTableResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreDataTableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreDataTableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreDataTableResponseMultiError, or nil if none found.
+func (m *RestoreDataTableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreDataTableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for DataActionId
+
+	if len(errors) > 0 {
+		return RestoreDataTableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreDataTableResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreDataTableResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreDataTableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreDataTableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreDataTableResponseMultiError) AllErrors() []error { return m }
+
+// RestoreDataTableResponseValidationError is the validation error returned by
+// RestoreDataTableResponse.Validate if the designated constraints aren't met.
+type RestoreDataTableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreDataTableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreDataTableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreDataTableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreDataTableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreDataTableResponseValidationError) ErrorName() string {
+	return "RestoreDataTableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreDataTableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreDataTableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreDataTableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreDataTableResponseValidationError{}
+
+// Validate checks the field values on DiffDataTableVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffDataTableVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffDataTableVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffDataTableVersionsRequestMultiError, or nil if none found.
+func (m *DiffDataTableVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffDataTableVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DiffDataTableVersionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromVersion() < 0 {
+		err := DiffDataTableVersionsRequestValidationError{
+			field:  "FromVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetToVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffDataTableVersionsRequestValidationError{
+					field:  "ToVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffDataTableVersionsRequestValidationError{
+					field:  "ToVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffDataTableVersionsRequestValidationError{
+				field:  "ToVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := DiffDataTableVersionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiffDataTableVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffDataTableVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiffDataTableVersionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiffDataTableVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffDataTableVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffDataTableVersionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffDataTableVersionsRequestValidationError is the validation error returned
+// by DiffDataTableVersionsRequest.Validate if the designated constraints
+// aren't met.
+type DiffDataTableVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffDataTableVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffDataTableVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffDataTableVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffDataTableVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffDataTableVersionsRequestValidationError) ErrorName() string {
+	return "DiffDataTableVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffDataTableVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffDataTableVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffDataTableVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffDataTableVersionsRequestValidationError{}
+
+// Validate checks the field values on DiffDataTableVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffDataTableVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffDataTableVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DiffDataTableVersionsResponseMultiError, or nil if none found.
+func (m *DiffDataTableVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffDataTableVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for AddedCount
+
+	// no validation rules for RemovedCount
+
+	if len(errors) > 0 {
+		return DiffDataTableVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffDataTableVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffDataTableVersionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DiffDataTableVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffDataTableVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffDataTableVersionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffDataTableVersionsResponseValidationError is the validation error
+// returned by DiffDataTableVersionsResponse.Validate if the designated
+// constraints aren't met.
+type DiffDataTableVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffDataTableVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffDataTableVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffDataTableVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffDataTableVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffDataTableVersionsResponseValidationError) ErrorName() string {
+	return "DiffDataTableVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffDataTableVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffDataTableVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffDataTableVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffDataTableVersionsResponseValidationError{}
+
+// Validate checks the field values on ProfileDataTableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileDataTableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileDataTableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileDataTableRequestMultiError, or nil if none found.
+func (m *ProfileDataTableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileDataTableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ProfileDataTableRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProfileDataTableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileDataTableRequestMultiError is an error wrapping multiple validation
+// errors returned by ProfileDataTableRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ProfileDataTableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileDataTableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileDataTableRequestMultiError) AllErrors() []error { return m }
+
+// ProfileDataTableRequestValidationError is the validation error returned by
+// ProfileDataTableRequest.Validate if the designated constraints aren't met.
+type ProfileDataTableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileDataTableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileDataTableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileDataTableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileDataTableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileDataTableRequestValidationError) ErrorName() string {
+	return "ProfileDataTableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfileDataTableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileDataTableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileDataTableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileDataTableRequestValidationError{}
+
+// Validate checks the field values on ProfileDataTableResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileDataTableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileDataTableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileDataTableResponseMultiError, or nil if none found.
+func (m *ProfileDataTableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileDataTableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProfileDataTableResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProfileDataTableResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProfileDataTableResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProfileDataTableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileDataTableResponseMultiError is an error wrapping multiple validation
+// errors returned by ProfileDataTableResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ProfileDataTableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileDataTableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileDataTableResponseMultiError) AllErrors() []error { return m }
+
+// ProfileDataTableResponseValidationError is the validation error returned by
+// ProfileDataTableResponse.Validate if the designated constraints aren't met.
+type ProfileDataTableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileDataTableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileDataTableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileDataTableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileDataTableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileDataTableResponseValidationError) ErrorName() string {
+	return "ProfileDataTableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfileDataTableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileDataTableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileDataTableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileDataTableResponseValidationError{}
+
+// Validate checks the field values on GetDataTableStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDataTableStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDataTableStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDataTableStatisticsRequestMultiError, or nil if none found.
+func (m *GetDataTableStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDataTableStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetDataTableStatisticsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDataTableStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDataTableStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDataTableStatisticsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetDataTableStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDataTableStatisticsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDataTableStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetDataTableStatisticsRequestValidationError is the validation error
+// returned by GetDataTableStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type GetDataTableStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDataTableStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDataTableStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDataTableStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDataTableStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDataTableStatisticsRequestValidationError) ErrorName() string {
+	return "GetDataTableStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDataTableStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDataTableStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDataTableStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDataTableStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetDataTableStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDataTableStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDataTableStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDataTableStatisticsResponseMultiError, or nil if none found.
+func (m *GetDataTableStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDataTableStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDataTableStatisticsResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDataTableStatisticsResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDataTableStatisticsResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDataTableStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDataTableStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDataTableStatisticsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetDataTableStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDataTableStatisticsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDataTableStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetDataTableStatisticsResponseValidationError is the validation error
+// returned by GetDataTableStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type GetDataTableStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDataTableStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDataTableStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDataTableStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDataTableStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDataTableStatisticsResponseValidationError) ErrorName() string {
+	return "GetDataTableStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDataTableStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDataTableStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDataTableStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDataTableStatisticsResponseValidationError{}
+
+// Validate checks the field values on ProfileMasterSegmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileMasterSegmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileMasterSegmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileMasterSegmentRequestMultiError, or nil if none found.
+func (m *ProfileMasterSegmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileMasterSegmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ProfileMasterSegmentRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProfileMasterSegmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileMasterSegmentRequestMultiError is an error wrapping multiple
+// validation errors returned by ProfileMasterSegmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ProfileMasterSegmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileMasterSegmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileMasterSegmentRequestMultiError) AllErrors() []error { return m }
+
+// ProfileMasterSegmentRequestValidationError is the validation error returned
+// by ProfileMasterSegmentRequest.Validate if the designated constraints
+// aren't met.
+type ProfileMasterSegmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileMasterSegmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileMasterSegmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileMasterSegmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileMasterSegmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileMasterSegmentRequestValidationError) ErrorName() string {
+	return "ProfileMasterSegmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfileMasterSegmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileMasterSegmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileMasterSegmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileMasterSegmentRequestValidationError{}
+
+// Validate checks the field values on ProfileMasterSegmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileMasterSegmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileMasterSegmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileMasterSegmentResponseMultiError, or nil if none found.
+func (m *ProfileMasterSegmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileMasterSegmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetAudience()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProfileMasterSegmentResponseValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProfileMasterSegmentResponseValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudience()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProfileMasterSegmentResponseValidationError{
+				field:  "Audience",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBehaviorTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProfileMasterSegmentResponseValidationError{
+						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProfileMasterSegmentResponseValidationError{
+						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProfileMasterSegmentResponseValidationError{
+					field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProfileMasterSegmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileMasterSegmentResponseMultiError is an error wrapping multiple
+// validation errors returned by ProfileMasterSegmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ProfileMasterSegmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileMasterSegmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileMasterSegmentResponseMultiError) AllErrors() []error { return m }
+
+// ProfileMasterSegmentResponseValidationError is the validation error returned
+// by ProfileMasterSegmentResponse.Validate if the designated constraints
+// aren't met.
+type ProfileMasterSegmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileMasterSegmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileMasterSegmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileMasterSegmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileMasterSegmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileMasterSegmentResponseValidationError) ErrorName() string {
+	return "ProfileMasterSegmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfileMasterSegmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileMasterSegmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileMasterSegmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileMasterSegmentResponseValidationError{}
+
+// Validate checks the field values on GetMasterSegmentStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetMasterSegmentStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMasterSegmentStatisticsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetMasterSegmentStatisticsRequestMultiError, or nil if none found.
+func (m *GetMasterSegmentStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMasterSegmentStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetMasterSegmentStatisticsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMasterSegmentStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMasterSegmentStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetMasterSegmentStatisticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMasterSegmentStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMasterSegmentStatisticsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMasterSegmentStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// GetMasterSegmentStatisticsRequestValidationError is the validation error
+// returned by GetMasterSegmentStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type GetMasterSegmentStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMasterSegmentStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMasterSegmentStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMasterSegmentStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMasterSegmentStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMasterSegmentStatisticsRequestValidationError) ErrorName() string {
+	return "GetMasterSegmentStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMasterSegmentStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMasterSegmentStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMasterSegmentStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMasterSegmentStatisticsRequestValidationError{}
+
+// Validate checks the field values on GetMasterSegmentStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetMasterSegmentStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMasterSegmentStatisticsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetMasterSegmentStatisticsResponseMultiError, or nil if none found.
+func (m *GetMasterSegmentStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMasterSegmentStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetAudience()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMasterSegmentStatisticsResponseValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMasterSegmentStatisticsResponseValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudience()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMasterSegmentStatisticsResponseValidationError{
+				field:  "Audience",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBehaviorTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterSegmentStatisticsResponseValidationError{
+						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterSegmentStatisticsResponseValidationError{
+						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterSegmentStatisticsResponseValidationError{
+					field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMasterSegmentStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMasterSegmentStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetMasterSegmentStatisticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMasterSegmentStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMasterSegmentStatisticsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMasterSegmentStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// GetMasterSegmentStatisticsResponseValidationError is the validation error
+// returned by GetMasterSegmentStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type GetMasterSegmentStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMasterSegmentStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMasterSegmentStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMasterSegmentStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMasterSegmentStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMasterSegmentStatisticsResponseValidationError) ErrorName() string {
+	return "GetMasterSegmentStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMasterSegmentStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMasterSegmentStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMasterSegmentStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMasterSegmentStatisticsResponseValidationError{}
+
+// Validate checks the field values on
+// UpdateMasterSegmentComputedAttributesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateMasterSegmentComputedAttributesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateMasterSegmentComputedAttributesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateMasterSegmentComputedAttributesRequestMultiError, or nil if none found.
+func (m *UpdateMasterSegmentComputedAttributesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMasterSegmentComputedAttributesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateMasterSegmentComputedAttributesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetComputedAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateMasterSegmentComputedAttributesRequestValidationError{
+						field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateMasterSegmentComputedAttributesRequestValidationError{
+						field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateMasterSegmentComputedAttributesRequestValidationError{
+					field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateMasterSegmentComputedAttributesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMasterSegmentComputedAttributesRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateMasterSegmentComputedAttributesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateMasterSegmentComputedAttributesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMasterSegmentComputedAttributesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMasterSegmentComputedAttributesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMasterSegmentComputedAttributesRequestValidationError is the
+// validation error returned by
+// UpdateMasterSegmentComputedAttributesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateMasterSegmentComputedAttributesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMasterSegmentComputedAttributesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMasterSegmentComputedAttributesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMasterSegmentComputedAttributesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMasterSegmentComputedAttributesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMasterSegmentComputedAttributesRequestValidationError) ErrorName() string {
+	return "UpdateMasterSegmentComputedAttributesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMasterSegmentComputedAttributesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMasterSegmentComputedAttributesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMasterSegmentComputedAttributesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMasterSegmentComputedAttributesRequestValidationError{}
+
+// Validate checks the field values on
+// UpdateMasterSegmentComputedAttributesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateMasterSegmentComputedAttributesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateMasterSegmentComputedAttributesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateMasterSegmentComputedAttributesResponseMultiError, or nil if none found.
+func (m *UpdateMasterSegmentComputedAttributesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMasterSegmentComputedAttributesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateMasterSegmentComputedAttributesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMasterSegmentComputedAttributesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateMasterSegmentComputedAttributesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateMasterSegmentComputedAttributesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMasterSegmentComputedAttributesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMasterSegmentComputedAttributesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMasterSegmentComputedAttributesResponseValidationError is the
+// validation error returned by
+// UpdateMasterSegmentComputedAttributesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateMasterSegmentComputedAttributesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMasterSegmentComputedAttributesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMasterSegmentComputedAttributesResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e UpdateMasterSegmentComputedAttributesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMasterSegmentComputedAttributesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMasterSegmentComputedAttributesResponseValidationError) ErrorName() string {
+	return "UpdateMasterSegmentComputedAttributesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMasterSegmentComputedAttributesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMasterSegmentComputedAttributesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMasterSegmentComputedAttributesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMasterSegmentComputedAttributesResponseValidationError{}
+
+// Validate checks the field values on RefreshMasterSegmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshMasterSegmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshMasterSegmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshMasterSegmentRequestMultiError, or nil if none found.
+func (m *RefreshMasterSegmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshMasterSegmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RefreshMasterSegmentRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshMasterSegmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshMasterSegmentRequestMultiError is an error wrapping multiple
+// validation errors returned by RefreshMasterSegmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RefreshMasterSegmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshMasterSegmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshMasterSegmentRequestMultiError) AllErrors() []error { return m }
+
+// RefreshMasterSegmentRequestValidationError is the validation error returned
+// by RefreshMasterSegmentRequest.Validate if the designated constraints
+// aren't met.
+type RefreshMasterSegmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshMasterSegmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshMasterSegmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshMasterSegmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshMasterSegmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshMasterSegmentRequestValidationError) ErrorName() string {
+	return "RefreshMasterSegmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshMasterSegmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshMasterSegmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshMasterSegmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshMasterSegmentRequestValidationError{}
+
+// Validate checks the field values on RefreshMasterSegmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshMasterSegmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshMasterSegmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshMasterSegmentResponseMultiError, or nil if none found.
+func (m *RefreshMasterSegmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshMasterSegmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return RefreshMasterSegmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshMasterSegmentResponseMultiError is an error wrapping multiple
+// validation errors returned by RefreshMasterSegmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RefreshMasterSegmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshMasterSegmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshMasterSegmentResponseMultiError) AllErrors() []error { return m }
+
+// RefreshMasterSegmentResponseValidationError is the validation error returned
+// by RefreshMasterSegmentResponse.Validate if the designated constraints
+// aren't met.
+type RefreshMasterSegmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshMasterSegmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshMasterSegmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshMasterSegmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshMasterSegmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshMasterSegmentResponseValidationError) ErrorName() string {
+	return "RefreshMasterSegmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshMasterSegmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshMasterSegmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshMasterSegmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshMasterSegmentResponseValidationError{}
+
+// Validate checks the field values on GetCustomerProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCustomerProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCustomerProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCustomerProfileRequestMultiError, or nil if none found.
+func (m *GetCustomerProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCustomerProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetCustomerProfileRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCdpSystemUuid()) < 1 {
+		err := GetCustomerProfileRequestValidationError{
+			field:  "CdpSystemUuid",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTimelineLimit(); val < 0 || val > 1000 {
+		err := GetCustomerProfileRequestValidationError{
+			field:  "TimelineLimit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCustomerProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCustomerProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCustomerProfileRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCustomerProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCustomerProfileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCustomerProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetCustomerProfileRequestValidationError is the validation error returned by
+// GetCustomerProfileRequest.Validate if the designated constraints aren't met.
+type GetCustomerProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCustomerProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCustomerProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCustomerProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCustomerProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCustomerProfileRequestValidationError) ErrorName() string {
+	return "GetCustomerProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCustomerProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCustomerProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCustomerProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCustomerProfileRequestValidationError{}
+
+// Validate checks the field values on GetCustomerProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCustomerProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCustomerProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCustomerProfileResponseMultiError, or nil if none found.
+func (m *GetCustomerProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCustomerProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCustomerProfileResponseValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTimeline() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Timeline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Timeline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCustomerProfileResponseValidationError{
+					field:  fmt.Sprintf("Timeline[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSegments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCustomerProfileResponseValidationError{
+					field:  fmt.Sprintf("Segments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPredictions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Predictions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("Predictions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCustomerProfileResponseValidationError{
+					field:  fmt.Sprintf("Predictions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PredictionResult
+
+	for idx, item := range m.GetExportDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("ExportDestinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCustomerProfileResponseValidationError{
+						field:  fmt.Sprintf("ExportDestinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCustomerProfileResponseValidationError{
+					field:  fmt.Sprintf("ExportDestinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCustomerProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCustomerProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCustomerProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCustomerProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCustomerProfileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCustomerProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetCustomerProfileResponseValidationError is the validation error returned
+// by GetCustomerProfileResponse.Validate if the designated constraints aren't met.
+type GetCustomerProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCustomerProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCustomerProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCustomerProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCustomerProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCustomerProfileResponseValidationError) ErrorName() string {
+	return "GetCustomerProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCustomerProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCustomerProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCustomerProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCustomerProfileResponseValidationError{}
+
+// Validate checks the field values on
+// UpdateMasterSegmentSearchableColumnsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateMasterSegmentSearchableColumnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateMasterSegmentSearchableColumnsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateMasterSegmentSearchableColumnsRequestMultiError, or nil if none found.
+func (m *UpdateMasterSegmentSearchableColumnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMasterSegmentSearchableColumnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateMasterSegmentSearchableColumnsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateMasterSegmentSearchableColumnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMasterSegmentSearchableColumnsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateMasterSegmentSearchableColumnsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMasterSegmentSearchableColumnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMasterSegmentSearchableColumnsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMasterSegmentSearchableColumnsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMasterSegmentSearchableColumnsRequestValidationError is the validation
+// error returned by UpdateMasterSegmentSearchableColumnsRequest.Validate if
+// the designated constraints aren't met.
+type UpdateMasterSegmentSearchableColumnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMasterSegmentSearchableColumnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMasterSegmentSearchableColumnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMasterSegmentSearchableColumnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMasterSegmentSearchableColumnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMasterSegmentSearchableColumnsRequestValidationError) ErrorName() string {
+	return "UpdateMasterSegmentSearchableColumnsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMasterSegmentSearchableColumnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMasterSegmentSearchableColumnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMasterSegmentSearchableColumnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMasterSegmentSearchableColumnsRequestValidationError{}
+
+// Validate checks the field values on
+// UpdateMasterSegmentSearchableColumnsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateMasterSegmentSearchableColumnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateMasterSegmentSearchableColumnsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateMasterSegmentSearchableColumnsResponseMultiError, or nil if none found.
+func (m *UpdateMasterSegmentSearchableColumnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMasterSegmentSearchableColumnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateMasterSegmentSearchableColumnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMasterSegmentSearchableColumnsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateMasterSegmentSearchableColumnsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateMasterSegmentSearchableColumnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMasterSegmentSearchableColumnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMasterSegmentSearchableColumnsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMasterSegmentSearchableColumnsResponseValidationError is the
+// validation error returned by
+// UpdateMasterSegmentSearchableColumnsResponse.Validate if the designated
+// constraints aren't met.
+type UpdateMasterSegmentSearchableColumnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMasterSegmentSearchableColumnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMasterSegmentSearchableColumnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMasterSegmentSearchableColumnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMasterSegmentSearchableColumnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMasterSegmentSearchableColumnsResponseValidationError) ErrorName() string {
+	return "UpdateMasterSegmentSearchableColumnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMasterSegmentSearchableColumnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMasterSegmentSearchableColumnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMasterSegmentSearchableColumnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMasterSegmentSearchableColumnsResponseValidationError{}
+
+// Validate checks the field values on SearchProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchProfilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchProfilesRequestMultiError, or nil if none found.
+func (m *SearchProfilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchProfilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SearchProfilesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchProfilesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Fuzzy
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchProfilesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchProfilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchProfilesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchProfilesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchProfilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchProfilesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchProfilesRequestMultiError) AllErrors() []error { return m }
+
+// SearchProfilesRequestValidationError is the validation error returned by
+// SearchProfilesRequest.Validate if the designated constraints aren't met.
+type SearchProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchProfilesRequestValidationError) ErrorName() string {
+	return "SearchProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchProfilesRequestValidationError{}
+
+// Validate checks the field values on SearchProfilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchProfilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchProfilesResponseMultiError, or nil if none found.
+func (m *SearchProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchProfilesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchProfilesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchProfilesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchProfilesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchProfilesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchProfilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchProfilesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchProfilesResponseMultiError) AllErrors() []error { return m }
+
+// SearchProfilesResponseValidationError is the validation error returned by
+// SearchProfilesResponse.Validate if the designated constraints aren't met.
+type SearchProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchProfilesResponseValidationError) ErrorName() string {
+	return "SearchProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchProfilesResponseValidationError{}
+
+// Validate checks the field values on SuggestColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestColumnTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestColumnTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestColumnTagsRequestMultiError, or nil if none found.
+func (m *SuggestColumnTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestColumnTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SuggestColumnTagsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuggestColumnTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestColumnTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestColumnTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestColumnTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestColumnTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestColumnTagsRequestMultiError) AllErrors() []error { return m }
+
+// SuggestColumnTagsRequestValidationError is the validation error returned by
+// SuggestColumnTagsRequest.Validate if the designated constraints aren't met.
+type SuggestColumnTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestColumnTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestColumnTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestColumnTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestColumnTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestColumnTagsRequestValidationError) ErrorName() string {
+	return "SuggestColumnTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestColumnTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestColumnTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestColumnTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestColumnTagsRequestValidationError{}
+
+// Validate checks the field values on SuggestColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestColumnTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestColumnTagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestColumnTagsResponseMultiError, or nil if none found.
+func (m *SuggestColumnTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestColumnTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestColumnTagsResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestColumnTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestColumnTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by SuggestColumnTagsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SuggestColumnTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestColumnTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestColumnTagsResponseMultiError) AllErrors() []error { return m }
+
+// SuggestColumnTagsResponseValidationError is the validation error returned by
+// SuggestColumnTagsResponse.Validate if the designated constraints aren't met.
+type SuggestColumnTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestColumnTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestColumnTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestColumnTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestColumnTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestColumnTagsResponseValidationError) ErrorName() string {
+	return "SuggestColumnTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestColumnTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestColumnTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestColumnTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestColumnTagsResponseValidationError{}
+
+// Validate checks the field values on UpdateColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateColumnTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateColumnTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateColumnTagsRequestMultiError, or nil if none found.
+func (m *UpdateColumnTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateColumnTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateColumnTagsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateColumnTagsRequestValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateColumnTagsRequestValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateColumnTagsRequestValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateColumnTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateColumnTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateColumnTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateColumnTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateColumnTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateColumnTagsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateColumnTagsRequestValidationError is the validation error returned by
+// UpdateColumnTagsRequest.Validate if the designated constraints aren't met.
+type UpdateColumnTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateColumnTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateColumnTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateColumnTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateColumnTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateColumnTagsRequestValidationError) ErrorName() string {
+	return "UpdateColumnTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateColumnTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateColumnTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateColumnTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateColumnTagsRequestValidationError{}
+
+// Validate checks the field values on UpdateColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateColumnTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateColumnTagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateColumnTagsResponseMultiError, or nil if none found.
+func (m *UpdateColumnTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateColumnTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateColumnTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateColumnTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateColumnTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateColumnTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateColumnTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateColumnTagsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateColumnTagsResponseValidationError is the validation error returned by
+// UpdateColumnTagsResponse.Validate if the designated constraints aren't met.
+type UpdateColumnTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateColumnTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateColumnTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateColumnTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateColumnTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateColumnTagsResponseValidationError) ErrorName() string {
+	return "UpdateColumnTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateColumnTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateColumnTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateColumnTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateColumnTagsResponseValidationError{}
+
+// Validate checks the field values on GetColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnTagsRequestMultiError, or nil if none found.
+func (m *GetColumnTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DataTableId
+
+	// no validation rules for MasterSegmentId
+
+	if len(errors) > 0 {
+		return GetColumnTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetColumnTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnTagsRequestMultiError) AllErrors() []error { return m }
+
+// GetColumnTagsRequestValidationError is the validation error returned by
+// GetColumnTagsRequest.Validate if the designated constraints aren't met.
+type GetColumnTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnTagsRequestValidationError) ErrorName() string {
+	return "GetColumnTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnTagsRequestValidationError{}
+
+// Validate checks the field values on GetColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnTagsResponseMultiError, or nil if none found.
+func (m *GetColumnTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetColumnTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetColumnTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetColumnTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnTagsResponseMultiError) AllErrors() []error { return m }
+
+// GetColumnTagsResponseValidationError is the validation error returned by
+// GetColumnTagsResponse.Validate if the designated constraints aren't met.
+type GetColumnTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnTagsResponseValidationError) ErrorName() string {
+	return "GetColumnTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnTagsResponseValidationError{}
+
+// Validate checks the field values on UpdateMaskingPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMaskingPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMaskingPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMaskingPoliciesRequestMultiError, or nil if none found.
+func (m *UpdateMaskingPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMaskingPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateMaskingPoliciesRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateMaskingPoliciesRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateMaskingPoliciesRequestValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateMaskingPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMaskingPoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMaskingPoliciesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateMaskingPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMaskingPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMaskingPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMaskingPoliciesRequestValidationError is the validation error returned
+// by UpdateMaskingPoliciesRequest.Validate if the designated constraints
+// aren't met.
+type UpdateMaskingPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMaskingPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMaskingPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMaskingPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMaskingPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMaskingPoliciesRequestValidationError) ErrorName() string {
+	return "UpdateMaskingPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMaskingPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMaskingPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMaskingPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMaskingPoliciesRequestValidationError{}
+
+// Validate checks the field values on UpdateMaskingPoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMaskingPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMaskingPoliciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateMaskingPoliciesResponseMultiError, or nil if none found.
+func (m *UpdateMaskingPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMaskingPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateMaskingPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMaskingPoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateMaskingPoliciesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateMaskingPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMaskingPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMaskingPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMaskingPoliciesResponseValidationError is the validation error
+// returned by UpdateMaskingPoliciesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateMaskingPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMaskingPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMaskingPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMaskingPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMaskingPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMaskingPoliciesResponseValidationError) ErrorName() string {
+	return "UpdateMaskingPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMaskingPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMaskingPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMaskingPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMaskingPoliciesResponseValidationError{}
+
+// Validate checks the field values on GetMaskingPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMaskingPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMaskingPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMaskingPoliciesRequestMultiError, or nil if none found.
+func (m *GetMaskingPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMaskingPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMaskingPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMaskingPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMaskingPoliciesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetMaskingPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMaskingPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMaskingPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// GetMaskingPoliciesRequestValidationError is the validation error returned by
+// GetMaskingPoliciesRequest.Validate if the designated constraints aren't met.
+type GetMaskingPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaskingPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaskingPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaskingPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaskingPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaskingPoliciesRequestValidationError) ErrorName() string {
+	return "GetMaskingPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaskingPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaskingPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaskingPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaskingPoliciesRequestValidationError{}
+
+// Validate checks the field values on GetMaskingPoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMaskingPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMaskingPoliciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMaskingPoliciesResponseMultiError, or nil if none found.
+func (m *GetMaskingPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMaskingPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMaskingPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMaskingPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMaskingPoliciesResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMaskingPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMaskingPoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMaskingPoliciesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetMaskingPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMaskingPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMaskingPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// GetMaskingPoliciesResponseValidationError is the validation error returned
+// by GetMaskingPoliciesResponse.Validate if the designated constraints aren't met.
+type GetMaskingPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMaskingPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMaskingPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMaskingPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMaskingPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMaskingPoliciesResponseValidationError) ErrorName() string {
+	return "GetMaskingPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMaskingPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMaskingPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMaskingPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMaskingPoliciesResponseValidationError{}
+
+// Validate checks the field values on ErasePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ErasePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasePersonRequestMultiError, or nil if none found.
+func (m *ErasePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdentifier()) < 1 {
+		err := ErasePersonRequestValidationError{
+			field:  "Identifier",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetIdentifierColumns()) < 1 {
+		err := ErasePersonRequestValidationError{
+			field:  "IdentifierColumns",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ErasePersonRequest_IdentifierColumns_Unique := make(map[string]struct{}, len(m.GetIdentifierColumns()))
+
+	for idx, item := range m.GetIdentifierColumns() {
+		_, _ = idx, item
+
+		if _, exists := _ErasePersonRequest_IdentifierColumns_Unique[item]; exists {
+			err := ErasePersonRequestValidationError{
+				field:  fmt.Sprintf("IdentifierColumns[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ErasePersonRequest_IdentifierColumns_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ErasePersonRequestValidationError{
+				field:  fmt.Sprintf("IdentifierColumns[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ErasePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasePersonRequestMultiError is an error wrapping multiple validation errors
+// returned by ErasePersonRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ErasePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasePersonRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasePersonRequestMultiError) AllErrors() []error { return m }
+
+// ErasePersonRequestValidationError is the validation error returned by
+// ErasePersonRequest.Validate if the designated constraints aren't met.
+type ErasePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasePersonRequestValidationError) ErrorName() string {
+	return "ErasePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ErasePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasePersonRequestValidationError{}
+
+// Validate checks the field values on ErasePersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ErasePersonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasePersonResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasePersonResponseMultiError, or nil if none found.
+func (m *ErasePersonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasePersonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCertificate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ErasePersonResponseValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ErasePersonResponseValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCertificate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ErasePersonResponseValidationError{
+				field:  "Certificate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ErasePersonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasePersonResponseMultiError is an error wrapping multiple validation
+// errors returned by ErasePersonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ErasePersonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasePersonResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasePersonResponseMultiError) AllErrors() []error { return m }
+
+// ErasePersonResponseValidationError is the validation error returned by
+// ErasePersonResponse.Validate if the designated constraints aren't met.
+type ErasePersonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasePersonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasePersonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasePersonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasePersonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasePersonResponseValidationError) ErrorName() string {
+	return "ErasePersonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ErasePersonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasePersonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasePersonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasePersonResponseValidationError{}
+
+// Validate checks the field values on GetListErasureCertificatesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetListErasureCertificatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListErasureCertificatesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetListErasureCertificatesRequestMultiError, or nil if none found.
+func (m *GetListErasureCertificatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListErasureCertificatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetListErasureCertificatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListErasureCertificatesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetListErasureCertificatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListErasureCertificatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListErasureCertificatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListErasureCertificatesRequestMultiError) AllErrors() []error { return m }
+
+// GetListErasureCertificatesRequestValidationError is the validation error
+// returned by GetListErasureCertificatesRequest.Validate if the designated
+// constraints aren't met.
+type GetListErasureCertificatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListErasureCertificatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListErasureCertificatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListErasureCertificatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListErasureCertificatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListErasureCertificatesRequestValidationError) ErrorName() string {
+	return "GetListErasureCertificatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListErasureCertificatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListErasureCertificatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListErasureCertificatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListErasureCertificatesRequestValidationError{}
+
+// Validate checks the field values on GetListErasureCertificatesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetListErasureCertificatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListErasureCertificatesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetListErasureCertificatesResponseMultiError, or nil if none found.
+func (m *GetListErasureCertificatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListErasureCertificatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetCertificates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListErasureCertificatesResponseValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListErasureCertificatesResponseValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListErasureCertificatesResponseValidationError{
+					field:  fmt.Sprintf("Certificates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListErasureCertificatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListErasureCertificatesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetListErasureCertificatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListErasureCertificatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListErasureCertificatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListErasureCertificatesResponseMultiError) AllErrors() []error { return m }
+
+// GetListErasureCertificatesResponseValidationError is the validation error
+// returned by GetListErasureCertificatesResponse.Validate if the designated
+// constraints aren't met.
+type GetListErasureCertificatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListErasureCertificatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListErasureCertificatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListErasureCertificatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListErasureCertificatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListErasureCertificatesResponseValidationError) ErrorName() string {
+	return "GetListErasureCertificatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListErasureCertificatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListErasureCertificatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListErasureCertificatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListErasureCertificatesResponseValidationError{}
+
+// Validate checks the field values on GetErasureCertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetErasureCertificateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetErasureCertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetErasureCertificateRequestMultiError, or nil if none found.
+func (m *GetErasureCertificateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetErasureCertificateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetErasureCertificateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetErasureCertificateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetErasureCertificateRequestMultiError is an error wrapping multiple
+// validation errors returned by GetErasureCertificateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetErasureCertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetErasureCertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetErasureCertificateRequestMultiError) AllErrors() []error { return m }
+
+// GetErasureCertificateRequestValidationError is the validation error returned
+// by GetErasureCertificateRequest.Validate if the designated constraints
+// aren't met.
+type GetErasureCertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetErasureCertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetErasureCertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetErasureCertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetErasureCertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetErasureCertificateRequestValidationError) ErrorName() string {
+	return "GetErasureCertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetErasureCertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetErasureCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetErasureCertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetErasureCertificateRequestValidationError{}
+
+// Validate checks the field values on GetErasureCertificateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetErasureCertificateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetErasureCertificateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetErasureCertificateResponseMultiError, or nil if none found.
+func (m *GetErasureCertificateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetErasureCertificateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCertificate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetErasureCertificateResponseValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetErasureCertificateResponseValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCertificate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetErasureCertificateResponseValidationError{
+				field:  "Certificate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Verified
+
+	if len(errors) > 0 {
+		return GetErasureCertificateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetErasureCertificateResponseMultiError is an error wrapping multiple
+// validation errors returned by GetErasureCertificateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetErasureCertificateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetErasureCertificateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetErasureCertificateResponseMultiError) AllErrors() []error { return m }
+
+// GetErasureCertificateResponseValidationError is the validation error
+// returned by GetErasureCertificateResponse.Validate if the designated
+// constraints aren't met.
+type GetErasureCertificateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetErasureCertificateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetErasureCertificateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetErasureCertificateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetErasureCertificateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetErasureCertificateResponseValidationError) ErrorName() string {
+	return "GetErasureCertificateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetErasureCertificateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetErasureCertificateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetErasureCertificateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetErasureCertificateResponseValidationError{}
+
+// Validate checks the field values on UpdateMasterSegmentConsentColumnsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateMasterSegmentConsentColumnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateMasterSegmentConsentColumnsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateMasterSegmentConsentColumnsRequestMultiError, or nil if none found.
+func (m *UpdateMasterSegmentConsentColumnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMasterSegmentConsentColumnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateMasterSegmentConsentColumnsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetConsentColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateMasterSegmentConsentColumnsRequestValidationError{
+						field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateMasterSegmentConsentColumnsRequestValidationError{
+						field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateMasterSegmentConsentColumnsRequestValidationError{
+					field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateMasterSegmentConsentColumnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMasterSegmentConsentColumnsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateMasterSegmentConsentColumnsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMasterSegmentConsentColumnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMasterSegmentConsentColumnsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMasterSegmentConsentColumnsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMasterSegmentConsentColumnsRequestValidationError is the validation
+// error returned by UpdateMasterSegmentConsentColumnsRequest.Validate if the
+// designated constraints aren't met.
+type UpdateMasterSegmentConsentColumnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMasterSegmentConsentColumnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMasterSegmentConsentColumnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMasterSegmentConsentColumnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMasterSegmentConsentColumnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMasterSegmentConsentColumnsRequestValidationError) ErrorName() string {
+	return "UpdateMasterSegmentConsentColumnsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMasterSegmentConsentColumnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMasterSegmentConsentColumnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMasterSegmentConsentColumnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMasterSegmentConsentColumnsRequestValidationError{}
+
+// Validate checks the field values on
+// UpdateMasterSegmentConsentColumnsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateMasterSegmentConsentColumnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateMasterSegmentConsentColumnsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateMasterSegmentConsentColumnsResponseMultiError, or nil if none found.
+func (m *UpdateMasterSegmentConsentColumnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMasterSegmentConsentColumnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UpdateMasterSegmentConsentColumnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMasterSegmentConsentColumnsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateMasterSegmentConsentColumnsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMasterSegmentConsentColumnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMasterSegmentConsentColumnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMasterSegmentConsentColumnsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateMasterSegmentConsentColumnsResponseValidationError is the validation
+// error returned by UpdateMasterSegmentConsentColumnsResponse.Validate if the
+// designated constraints aren't met.
+type UpdateMasterSegmentConsentColumnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMasterSegmentConsentColumnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMasterSegmentConsentColumnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMasterSegmentConsentColumnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMasterSegmentConsentColumnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMasterSegmentConsentColumnsResponseValidationError) ErrorName() string {
+	return "UpdateMasterSegmentConsentColumnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMasterSegmentConsentColumnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMasterSegmentConsentColumnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMasterSegmentConsentColumnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMasterSegmentConsentColumnsResponseValidationError{}
+
+// Validate checks the field values on CreateSuppressionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSuppressionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSuppressionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSuppressionListRequestMultiError, or nil if none found.
+func (m *CreateSuppressionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSuppressionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateSuppressionListRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateSuppressionListRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := CreateSuppressionListRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [ email sms push ads]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIdentifierColumn()) < 1 {
+		err := CreateSuppressionListRequestValidationError{
+			field:  "IdentifierColumn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SourceDataTableId
+
+	// no validation rules for SourceColumn
+
+	if len(errors) > 0 {
+		return CreateSuppressionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSuppressionListRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateSuppressionListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSuppressionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSuppressionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSuppressionListRequestMultiError) AllErrors() []error { return m }
+
+// CreateSuppressionListRequestValidationError is the validation error returned
+// by CreateSuppressionListRequest.Validate if the designated constraints
+// aren't met.
+type CreateSuppressionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSuppressionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSuppressionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSuppressionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSuppressionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSuppressionListRequestValidationError) ErrorName() string {
+	return "CreateSuppressionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSuppressionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSuppressionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSuppressionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSuppressionListRequestValidationError{}
+
+var _CreateSuppressionListRequest_Channel_InLookup = map[string]struct{}{
+	"":      {},
+	"email": {},
+	"sms":   {},
+	"push":  {},
+	"ads":   {},
+}
+
+// Validate checks the field values on CreateSuppressionListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSuppressionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSuppressionListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateSuppressionListResponseMultiError, or nil if none found.
+func (m *CreateSuppressionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSuppressionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateSuppressionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSuppressionListResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateSuppressionListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateSuppressionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSuppressionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSuppressionListResponseMultiError) AllErrors() []error { return m }
+
+// CreateSuppressionListResponseValidationError is the validation error
+// returned by CreateSuppressionListResponse.Validate if the designated
+// constraints aren't met.
+type CreateSuppressionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSuppressionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSuppressionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSuppressionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSuppressionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSuppressionListResponseValidationError) ErrorName() string {
+	return "CreateSuppressionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSuppressionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSuppressionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSuppressionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSuppressionListResponseValidationError{}
+
+// Validate checks the field values on GetListSuppressionListsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListSuppressionListsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListSuppressionListsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListSuppressionListsRequestMultiError, or nil if none found.
+func (m *GetListSuppressionListsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListSuppressionListsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetListSuppressionListsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListSuppressionListsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetListSuppressionListsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetListSuppressionListsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListSuppressionListsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListSuppressionListsRequestMultiError) AllErrors() []error { return m }
+
+// GetListSuppressionListsRequestValidationError is the validation error
+// returned by GetListSuppressionListsRequest.Validate if the designated
+// constraints aren't met.
+type GetListSuppressionListsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListSuppressionListsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListSuppressionListsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListSuppressionListsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListSuppressionListsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListSuppressionListsRequestValidationError) ErrorName() string {
+	return "GetListSuppressionListsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListSuppressionListsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListSuppressionListsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListSuppressionListsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListSuppressionListsRequestValidationError{}
+
+// Validate checks the field values on GetListSuppressionListsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListSuppressionListsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListSuppressionListsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListSuppressionListsResponseMultiError, or nil if none found.
+func (m *GetListSuppressionListsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListSuppressionListsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetSuppressionLists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListSuppressionListsResponseValidationError{
+						field:  fmt.Sprintf("SuppressionLists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListSuppressionListsResponseValidationError{
+						field:  fmt.Sprintf("SuppressionLists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListSuppressionListsResponseValidationError{
+					field:  fmt.Sprintf("SuppressionLists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListSuppressionListsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListSuppressionListsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListSuppressionListsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetListSuppressionListsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListSuppressionListsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListSuppressionListsResponseMultiError) AllErrors() []error { return m }
+
+// GetListSuppressionListsResponseValidationError is the validation error
+// returned by GetListSuppressionListsResponse.Validate if the designated
+// constraints aren't met.
+type GetListSuppressionListsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListSuppressionListsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListSuppressionListsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListSuppressionListsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListSuppressionListsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListSuppressionListsResponseValidationError) ErrorName() string {
+	return "GetListSuppressionListsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListSuppressionListsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListSuppressionListsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListSuppressionListsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListSuppressionListsResponseValidationError{}
+
+// Validate checks the field values on UploadSuppressionEntriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadSuppressionEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSuppressionEntriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UploadSuppressionEntriesRequestMultiError, or nil if none found.
+func (m *UploadSuppressionEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSuppressionEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UploadSuppressionEntriesRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Replace
+
+	if len(errors) > 0 {
+		return UploadSuppressionEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSuppressionEntriesRequestMultiError is an error wrapping multiple
+// validation errors returned by UploadSuppressionEntriesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UploadSuppressionEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSuppressionEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSuppressionEntriesRequestMultiError) AllErrors() []error { return m }
+
+// UploadSuppressionEntriesRequestValidationError is the validation error
+// returned by UploadSuppressionEntriesRequest.Validate if the designated
+// constraints aren't met.
+type UploadSuppressionEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSuppressionEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSuppressionEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSuppressionEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSuppressionEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSuppressionEntriesRequestValidationError) ErrorName() string {
+	return "UploadSuppressionEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadSuppressionEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSuppressionEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSuppressionEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSuppressionEntriesRequestValidationError{}
+
+// Validate checks the field values on UploadSuppressionEntriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UploadSuppressionEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSuppressionEntriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UploadSuppressionEntriesResponseMultiError, or nil if none found.
+func (m *UploadSuppressionEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSuppressionEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for EntryCount
+
+	if len(errors) > 0 {
+		return UploadSuppressionEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSuppressionEntriesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UploadSuppressionEntriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadSuppressionEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSuppressionEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSuppressionEntriesResponseMultiError) AllErrors() []error { return m }
+
+// UploadSuppressionEntriesResponseValidationError is the validation error
+// returned by UploadSuppressionEntriesResponse.Validate if the designated
+// constraints aren't met.
+type UploadSuppressionEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSuppressionEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSuppressionEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSuppressionEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSuppressionEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSuppressionEntriesResponseValidationError) ErrorName() string {
+	return "UploadSuppressionEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadSuppressionEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSuppressionEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSuppressionEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSuppressionEntriesResponseValidationError{}
+
+// Validate checks the field values on DeleteSuppressionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSuppressionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSuppressionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSuppressionListRequestMultiError, or nil if none found.
+func (m *DeleteSuppressionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSuppressionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteSuppressionListRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSuppressionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSuppressionListRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteSuppressionListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSuppressionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSuppressionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSuppressionListRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSuppressionListRequestValidationError is the validation error returned
+// by DeleteSuppressionListRequest.Validate if the designated constraints
+// aren't met.
+type DeleteSuppressionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSuppressionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSuppressionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSuppressionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSuppressionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSuppressionListRequestValidationError) ErrorName() string {
+	return "DeleteSuppressionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSuppressionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSuppressionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSuppressionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSuppressionListRequestValidationError{}
+
+// Validate checks the field values on DeleteSuppressionListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSuppressionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSuppressionListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteSuppressionListResponseMultiError, or nil if none found.
+func (m *DeleteSuppressionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSuppressionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteSuppressionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSuppressionListResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteSuppressionListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteSuppressionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSuppressionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSuppressionListResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSuppressionListResponseValidationError is the validation error
+// returned by DeleteSuppressionListResponse.Validate if the designated
+// constraints aren't met.
+type DeleteSuppressionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSuppressionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSuppressionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSuppressionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSuppressionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSuppressionListResponseValidationError) ErrorName() string {
+	return "DeleteSuppressionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSuppressionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSuppressionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSuppressionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSuppressionListResponseValidationError{}
+
+// Validate checks the field values on SetRetentionPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRetentionPolicyRequestMultiError, or nil if none found.
+func (m *SetRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _SetRetentionPolicyRequest_ObjectType_InLookup[m.GetObjectType()]; !ok {
+		err := SetRetentionPolicyRequestValidationError{
+			field:  "ObjectType",
+			reason: "value must be in list [data_table behavior_table]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetObjectId() <= 0 {
+		err := SetRetentionPolicyRequestValidationError{
+			field:  "ObjectId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimestampColumn
+
+	if val := m.GetMaxAgeDays(); val < 0 || val > 36500 {
+		err := SetRetentionPolicyRequestValidationError{
+			field:  "MaxAgeDays",
+			reason: "value must be inside range [0, 36500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxVersions() < 0 {
+		err := SetRetentionPolicyRequestValidationError{
+			field:  "MaxVersions",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRetentionPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetRetentionPolicyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRetentionPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetRetentionPolicyRequestValidationError is the validation error returned by
+// SetRetentionPolicyRequest.Validate if the designated constraints aren't met.
+type SetRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRetentionPolicyRequestValidationError) ErrorName() string {
+	return "SetRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRetentionPolicyRequestValidationError{}
+
+var _SetRetentionPolicyRequest_ObjectType_InLookup = map[string]struct{}{
+	"data_table":     {},
+	"behavior_table": {},
+}
+
+// Validate checks the field values on SetRetentionPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRetentionPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRetentionPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRetentionPolicyResponseMultiError, or nil if none found.
+func (m *SetRetentionPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRetentionPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetRetentionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetRetentionPolicyResponseValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetRetentionPolicyResponseValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetRetentionPolicyResponseValidationError{
+				field:  "RetentionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetRetentionPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRetentionPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by SetRetentionPolicyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetRetentionPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRetentionPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRetentionPolicyResponseMultiError) AllErrors() []error { return m }
+
+// SetRetentionPolicyResponseValidationError is the validation error returned
+// by SetRetentionPolicyResponse.Validate if the designated constraints aren't met.
+type SetRetentionPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRetentionPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRetentionPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRetentionPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRetentionPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRetentionPolicyResponseValidationError) ErrorName() string {
+	return "SetRetentionPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRetentionPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRetentionPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRetentionPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRetentionPolicyResponseValidationError{}
+
+// Validate checks the field values on GetListRetentionPoliciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListRetentionPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRetentionPoliciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListRetentionPoliciesRequestMultiError, or nil if none found.
+func (m *GetListRetentionPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRetentionPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetListRetentionPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRetentionPoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetListRetentionPoliciesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetListRetentionPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRetentionPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRetentionPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// GetListRetentionPoliciesRequestValidationError is the validation error
+// returned by GetListRetentionPoliciesRequest.Validate if the designated
+// constraints aren't met.
+type GetListRetentionPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRetentionPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRetentionPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRetentionPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRetentionPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRetentionPoliciesRequestValidationError) ErrorName() string {
+	return "GetListRetentionPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListRetentionPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRetentionPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRetentionPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRetentionPoliciesRequestValidationError{}
+
+// Validate checks the field values on GetListRetentionPoliciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetListRetentionPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRetentionPoliciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListRetentionPoliciesResponseMultiError, or nil if none found.
+func (m *GetListRetentionPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRetentionPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetRetentionPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListRetentionPoliciesResponseValidationError{
+						field:  fmt.Sprintf("RetentionPolicies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListRetentionPoliciesResponseValidationError{
+						field:  fmt.Sprintf("RetentionPolicies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListRetentionPoliciesResponseValidationError{
+					field:  fmt.Sprintf("RetentionPolicies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListRetentionPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRetentionPoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetListRetentionPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListRetentionPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRetentionPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRetentionPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// GetListRetentionPoliciesResponseValidationError is the validation error
+// returned by GetListRetentionPoliciesResponse.Validate if the designated
+// constraints aren't met.
+type GetListRetentionPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRetentionPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRetentionPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRetentionPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRetentionPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRetentionPoliciesResponseValidationError) ErrorName() string {
+	return "GetListRetentionPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListRetentionPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRetentionPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRetentionPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRetentionPoliciesResponseValidationError{}
+
+// Validate checks the field values on DeleteRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRetentionPolicyRequestMultiError, or nil if none found.
+func (m *DeleteRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteRetentionPolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRetentionPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteRetentionPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRetentionPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRetentionPolicyRequestValidationError is the validation error returned
+// by DeleteRetentionPolicyRequest.Validate if the designated constraints
+// aren't met.
+type DeleteRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRetentionPolicyRequestValidationError) ErrorName() string {
+	return "DeleteRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on DeleteRetentionPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRetentionPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRetentionPolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteRetentionPolicyResponseMultiError, or nil if none found.
+func (m *DeleteRetentionPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRetentionPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteRetentionPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRetentionPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteRetentionPolicyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteRetentionPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRetentionPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRetentionPolicyResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRetentionPolicyResponseValidationError is the validation error
+// returned by DeleteRetentionPolicyResponse.Validate if the designated
+// constraints aren't met.
+type DeleteRetentionPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRetentionPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRetentionPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRetentionPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRetentionPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRetentionPolicyResponseValidationError) ErrorName() string {
+	return "DeleteRetentionPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRetentionPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRetentionPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRetentionPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRetentionPolicyResponseValidationError{}
+
+// Validate checks the field values on GetListSegmentSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListSegmentSnapshotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListSegmentSnapshotsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListSegmentSnapshotsRequestMultiError, or nil if none found.
+func (m *GetListSegmentSnapshotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListSegmentSnapshotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSegmentId() <= 0 {
+		err := GetListSegmentSnapshotsRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListSegmentSnapshotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListSegmentSnapshotsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetListSegmentSnapshotsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetListSegmentSnapshotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListSegmentSnapshotsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListSegmentSnapshotsRequestMultiError) AllErrors() []error { return m }
+
+// GetListSegmentSnapshotsRequestValidationError is the validation error
+// returned by GetListSegmentSnapshotsRequest.Validate if the designated
+// constraints aren't met.
+type GetListSegmentSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListSegmentSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListSegmentSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListSegmentSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListSegmentSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListSegmentSnapshotsRequestValidationError) ErrorName() string {
+	return "GetListSegmentSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListSegmentSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListSegmentSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListSegmentSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListSegmentSnapshotsRequestValidationError{}
+
+// Validate checks the field values on GetListSegmentSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListSegmentSnapshotsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListSegmentSnapshotsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetListSegmentSnapshotsResponseMultiError, or nil if none found.
+func (m *GetListSegmentSnapshotsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListSegmentSnapshotsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListSegmentSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListSegmentSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListSegmentSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListSegmentSnapshotsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListSegmentSnapshotsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListSegmentSnapshotsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetListSegmentSnapshotsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListSegmentSnapshotsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListSegmentSnapshotsResponseMultiError) AllErrors() []error { return m }
+
+// GetListSegmentSnapshotsResponseValidationError is the validation error
+// returned by GetListSegmentSnapshotsResponse.Validate if the designated
+// constraints aren't met.
+type GetListSegmentSnapshotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListSegmentSnapshotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListSegmentSnapshotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListSegmentSnapshotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListSegmentSnapshotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListSegmentSnapshotsResponseValidationError) ErrorName() string {
+	return "GetListSegmentSnapshotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListSegmentSnapshotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListSegmentSnapshotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListSegmentSnapshotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListSegmentSnapshotsResponseValidationError{}
+
+// Validate checks the field values on GetSegmentMembershipChangesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSegmentMembershipChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSegmentMembershipChangesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetSegmentMembershipChangesRequestMultiError, or nil if none found.
+func (m *GetSegmentMembershipChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSegmentMembershipChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSegmentId() <= 0 {
+		err := GetSegmentMembershipChangesRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SnapshotId
+
+	if _, ok := _GetSegmentMembershipChangesRequest_Change_InLookup[m.GetChange()]; !ok {
+		err := GetSegmentMembershipChangesRequestValidationError{
+			field:  "Change",
+			reason: "value must be in list [entered exited]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return GetSegmentMembershipChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSegmentMembershipChangesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSegmentMembershipChangesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSegmentMembershipChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSegmentMembershipChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSegmentMembershipChangesRequestMultiError) AllErrors() []error { return m }
+
+// GetSegmentMembershipChangesRequestValidationError is the validation error
+// returned by GetSegmentMembershipChangesRequest.Validate if the designated
+// constraints aren't met.
+type GetSegmentMembershipChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSegmentMembershipChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSegmentMembershipChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSegmentMembershipChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSegmentMembershipChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSegmentMembershipChangesRequestValidationError) ErrorName() string {
+	return "GetSegmentMembershipChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSegmentMembershipChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSegmentMembershipChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSegmentMembershipChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSegmentMembershipChangesRequestValidationError{}
+
+var _GetSegmentMembershipChangesRequest_Change_InLookup = map[string]struct{}{
+	"entered": {},
+	"exited":  {},
+}
+
+// Validate checks the field values on GetSegmentMembershipChangesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSegmentMembershipChangesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSegmentMembershipChangesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetSegmentMembershipChangesResponseMultiError, or nil if none found.
+func (m *GetSegmentMembershipChangesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSegmentMembershipChangesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for SnapshotId
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetSegmentMembershipChangesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSegmentMembershipChangesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSegmentMembershipChangesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSegmentMembershipChangesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSegmentMembershipChangesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSegmentMembershipChangesResponseMultiError) AllErrors() []error { return m }
+
+// GetSegmentMembershipChangesResponseValidationError is the validation error
+// returned by GetSegmentMembershipChangesResponse.Validate if the designated
+// constraints aren't met.
+type GetSegmentMembershipChangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSegmentMembershipChangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSegmentMembershipChangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSegmentMembershipChangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSegmentMembershipChangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSegmentMembershipChangesResponseValidationError) ErrorName() string {
+	return "GetSegmentMembershipChangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSegmentMembershipChangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSegmentMembershipChangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSegmentMembershipChangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSegmentMembershipChangesResponseValidationError{}
+
+// Validate checks the field values on GetSegmentSizeHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSegmentSizeHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSegmentSizeHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSegmentSizeHistoryRequestMultiError, or nil if none found.
+func (m *GetSegmentSizeHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSegmentSizeHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSegmentId() <= 0 {
+		err := GetSegmentSizeHistoryRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetSegmentSizeHistoryRequest_Interval_InLookup[m.GetInterval()]; !ok {
+		err := GetSegmentSizeHistoryRequestValidationError{
+			field:  "Interval",
+			reason: "value must be in list [ day week month]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSegmentSizeHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSegmentSizeHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSegmentSizeHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSegmentSizeHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSegmentSizeHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSegmentSizeHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetSegmentSizeHistoryRequestValidationError is the validation error returned
+// by GetSegmentSizeHistoryRequest.Validate if the designated constraints
+// aren't met.
+type GetSegmentSizeHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSegmentSizeHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSegmentSizeHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSegmentSizeHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSegmentSizeHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSegmentSizeHistoryRequestValidationError) ErrorName() string {
+	return "GetSegmentSizeHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSegmentSizeHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSegmentSizeHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSegmentSizeHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSegmentSizeHistoryRequestValidationError{}
+
+var _GetSegmentSizeHistoryRequest_Interval_InLookup = map[string]struct{}{
+	"":      {},
+	"day":   {},
+	"week":  {},
+	"month": {},
+}
+
+// Validate checks the field values on GetSegmentSizeHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSegmentSizeHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSegmentSizeHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSegmentSizeHistoryResponseMultiError, or nil if none found.
+func (m *GetSegmentSizeHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSegmentSizeHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSegmentSizeHistoryResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSegmentSizeHistoryResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSegmentSizeHistoryResponseValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSegmentSizeHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSegmentSizeHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSegmentSizeHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetSegmentSizeHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSegmentSizeHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSegmentSizeHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetSegmentSizeHistoryResponseValidationError is the validation error
+// returned by GetSegmentSizeHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetSegmentSizeHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSegmentSizeHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSegmentSizeHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSegmentSizeHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSegmentSizeHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSegmentSizeHistoryResponseValidationError) ErrorName() string {
+	return "GetSegmentSizeHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSegmentSizeHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSegmentSizeHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSegmentSizeHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSegmentSizeHistoryResponseValidationError{}
+
+// Validate checks the field values on AnalyzeSegmentOverlapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeSegmentOverlapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeSegmentOverlapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzeSegmentOverlapRequestMultiError, or nil if none found.
+func (m *AnalyzeSegmentOverlapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeSegmentOverlapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetSegmentIds()); l < 2 || l > 5 {
+		err := AnalyzeSegmentOverlapRequestValidationError{
+			field:  "SegmentIds",
+			reason: "value must contain between 2 and 5 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AnalyzeSegmentOverlapRequest_SegmentIds_Unique := make(map[int64]struct{}, len(m.GetSegmentIds()))
+
+	for idx, item := range m.GetSegmentIds() {
+		_, _ = idx, item
+
+		if _, exists := _AnalyzeSegmentOverlapRequest_SegmentIds_Unique[item]; exists {
+			err := AnalyzeSegmentOverlapRequestValidationError{
+				field:  fmt.Sprintf("SegmentIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AnalyzeSegmentOverlapRequest_SegmentIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for SegmentIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return AnalyzeSegmentOverlapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeSegmentOverlapRequestMultiError is an error wrapping multiple
+// validation errors returned by AnalyzeSegmentOverlapRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AnalyzeSegmentOverlapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeSegmentOverlapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeSegmentOverlapRequestMultiError) AllErrors() []error { return m }
+
+// AnalyzeSegmentOverlapRequestValidationError is the validation error returned
+// by AnalyzeSegmentOverlapRequest.Validate if the designated constraints
+// aren't met.
+type AnalyzeSegmentOverlapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeSegmentOverlapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeSegmentOverlapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeSegmentOverlapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeSegmentOverlapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeSegmentOverlapRequestValidationError) ErrorName() string {
+	return "AnalyzeSegmentOverlapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzeSegmentOverlapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeSegmentOverlapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeSegmentOverlapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeSegmentOverlapRequestValidationError{}
+
+// Validate checks the field values on AnalyzeSegmentOverlapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeSegmentOverlapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeSegmentOverlapResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AnalyzeSegmentOverlapResponseMultiError, or nil if none found.
+func (m *AnalyzeSegmentOverlapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeSegmentOverlapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for MasterSegmentId
+
+	for idx, item := range m.GetSegments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeSegmentOverlapResponseValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeSegmentOverlapResponseValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeSegmentOverlapResponseValidationError{
+					field:  fmt.Sprintf("Segments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetIntersections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeSegmentOverlapResponseValidationError{
+						field:  fmt.Sprintf("Intersections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeSegmentOverlapResponseValidationError{
+						field:  fmt.Sprintf("Intersections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeSegmentOverlapResponseValidationError{
+					field:  fmt.Sprintf("Intersections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPairs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeSegmentOverlapResponseValidationError{
+						field:  fmt.Sprintf("Pairs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeSegmentOverlapResponseValidationError{
+						field:  fmt.Sprintf("Pairs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeSegmentOverlapResponseValidationError{
+					field:  fmt.Sprintf("Pairs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AllCount
+
+	// no validation rules for UnionCount
+
+	if len(errors) > 0 {
+		return AnalyzeSegmentOverlapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeSegmentOverlapResponseMultiError is an error wrapping multiple
+// validation errors returned by AnalyzeSegmentOverlapResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AnalyzeSegmentOverlapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeSegmentOverlapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeSegmentOverlapResponseMultiError) AllErrors() []error { return m }
+
+// AnalyzeSegmentOverlapResponseValidationError is the validation error
+// returned by AnalyzeSegmentOverlapResponse.Validate if the designated
+// constraints aren't met.
+type AnalyzeSegmentOverlapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeSegmentOverlapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeSegmentOverlapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeSegmentOverlapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeSegmentOverlapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeSegmentOverlapResponseValidationError) ErrorName() string {
+	return "AnalyzeSegmentOverlapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzeSegmentOverlapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeSegmentOverlapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeSegmentOverlapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeSegmentOverlapResponseValidationError{}
+
+// Validate checks the field values on GetSegmentInsightsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSegmentInsightsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSegmentInsightsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSegmentInsightsRequestMultiError, or nil if none found.
+func (m *GetSegmentInsightsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSegmentInsightsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSegmentId() <= 0 {
+		err := GetSegmentInsightsRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetColumns()); l < 1 || l > 20 {
+		err := GetSegmentInsightsRequestValidationError{
+			field:  "Columns",
+			reason: "value must contain between 1 and 20 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GetSegmentInsightsRequest_Columns_Unique := make(map[string]struct{}, len(m.GetColumns()))
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if _, exists := _GetSegmentInsightsRequest_Columns_Unique[item]; exists {
+			err := GetSegmentInsightsRequestValidationError{
+				field:  fmt.Sprintf("Columns[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetSegmentInsightsRequest_Columns_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Columns[idx]
+	}
+
+	if val := m.GetTopN(); val < 0 || val > 50 {
+		err := GetSegmentInsightsRequestValidationError{
+			field:  "TopN",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBucketCount(); val < 0 || val > 50 {
+		err := GetSegmentInsightsRequestValidationError{
+			field:  "BucketCount",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSegmentInsightsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSegmentInsightsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSegmentInsightsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetSegmentInsightsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSegmentInsightsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSegmentInsightsRequestMultiError) AllErrors() []error { return m }
+
+// GetSegmentInsightsRequestValidationError is the validation error returned by
+// GetSegmentInsightsRequest.Validate if the designated constraints aren't met.
+type GetSegmentInsightsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSegmentInsightsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSegmentInsightsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSegmentInsightsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSegmentInsightsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSegmentInsightsRequestValidationError) ErrorName() string {
+	return "GetSegmentInsightsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSegmentInsightsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSegmentInsightsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSegmentInsightsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSegmentInsightsRequestValidationError{}
+
+// Validate checks the field values on GetSegmentInsightsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSegmentInsightsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSegmentInsightsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSegmentInsightsResponseMultiError, or nil if none found.
+func (m *GetSegmentInsightsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSegmentInsightsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for SegmentSize
+
+	// no validation rules for AudienceSize
+
+	for idx, item := range m.GetInsights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSegmentInsightsResponseValidationError{
+						field:  fmt.Sprintf("Insights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSegmentInsightsResponseValidationError{
+						field:  fmt.Sprintf("Insights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSegmentInsightsResponseValidationError{
+					field:  fmt.Sprintf("Insights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSegmentInsightsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSegmentInsightsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSegmentInsightsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSegmentInsightsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSegmentInsightsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSegmentInsightsResponseMultiError) AllErrors() []error { return m }
+
+// GetSegmentInsightsResponseValidationError is the validation error returned
+// by GetSegmentInsightsResponse.Validate if the designated constraints aren't met.
+type GetSegmentInsightsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSegmentInsightsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSegmentInsightsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSegmentInsightsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSegmentInsightsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSegmentInsightsResponseValidationError) ErrorName() string {
+	return "GetSegmentInsightsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSegmentInsightsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSegmentInsightsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSegmentInsightsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSegmentInsightsResponseValidationError{}
+
+// Validate checks the field values on CreateRFMAnalysisRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRFMAnalysisRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRFMAnalysisRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRFMAnalysisRequestMultiError, or nil if none found.
+func (m *CreateRFMAnalysisRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRFMAnalysisRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMasterSegmentId() <= 0 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "MasterSegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBehaviorTableId() <= 0 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "BehaviorTableId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CustomerKey
+
+	if utf8.RuneCountInString(m.GetDateColumn()) < 1 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "DateColumn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAmountColumn()) < 1 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "AmountColumn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBins(); val < 0 || val > 10 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "Bins",
+			reason: "value must be inside range [0, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAttributePrefix()) > 32 {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "AttributePrefix",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRFMAnalysisRequest_AttributePrefix_Pattern.MatchString(m.GetAttributePrefix()) {
+		err := CreateRFMAnalysisRequestValidationError{
+			field:  "AttributePrefix",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRFMAnalysisRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRFMAnalysisRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRFMAnalysisRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRFMAnalysisRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRFMAnalysisRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRFMAnalysisRequestMultiError) AllErrors() []error { return m }
+
+// CreateRFMAnalysisRequestValidationError is the validation error returned by
+// CreateRFMAnalysisRequest.Validate if the designated constraints aren't met.
+type CreateRFMAnalysisRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRFMAnalysisRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRFMAnalysisRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRFMAnalysisRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRFMAnalysisRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRFMAnalysisRequestValidationError) ErrorName() string {
+	return "CreateRFMAnalysisRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRFMAnalysisRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRFMAnalysisRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRFMAnalysisRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRFMAnalysisRequestValidationError{}
+
+var _CreateRFMAnalysisRequest_AttributePrefix_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on CreateRFMAnalysisResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRFMAnalysisResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRFMAnalysisResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRFMAnalysisResponseMultiError, or nil if none found.
+func (m *CreateRFMAnalysisResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRFMAnalysisResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetRfmAnalysis()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRFMAnalysisResponseValidationError{
+					field:  "RfmAnalysis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRFMAnalysisResponseValidationError{
+					field:  "RfmAnalysis",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRfmAnalysis()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRFMAnalysisResponseValidationError{
+				field:  "RfmAnalysis",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRFMAnalysisResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRFMAnalysisResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateRFMAnalysisResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateRFMAnalysisResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRFMAnalysisResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRFMAnalysisResponseMultiError) AllErrors() []error { return m }
+
+// CreateRFMAnalysisResponseValidationError is the validation error returned by
+// CreateRFMAnalysisResponse.Validate if the designated constraints aren't met.
+type CreateRFMAnalysisResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRFMAnalysisResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRFMAnalysisResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRFMAnalysisResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRFMAnalysisResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRFMAnalysisResponseValidationError) ErrorName() string {
+	return "CreateRFMAnalysisResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRFMAnalysisResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRFMAnalysisResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRFMAnalysisResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRFMAnalysisResponseValidationError{}
+
+// Validate checks the field values on GetListRFMAnalysesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListRFMAnalysesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRFMAnalysesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListRFMAnalysesRequestMultiError, or nil if none found.
+func (m *GetListRFMAnalysesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRFMAnalysesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMasterSegmentId() <= 0 {
+		err := GetListRFMAnalysesRequestValidationError{
+			field:  "MasterSegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListRFMAnalysesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRFMAnalysesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListRFMAnalysesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetListRFMAnalysesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRFMAnalysesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRFMAnalysesRequestMultiError) AllErrors() []error { return m }
+
+// GetListRFMAnalysesRequestValidationError is the validation error returned by
+// GetListRFMAnalysesRequest.Validate if the designated constraints aren't met.
+type GetListRFMAnalysesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRFMAnalysesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRFMAnalysesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRFMAnalysesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRFMAnalysesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRFMAnalysesRequestValidationError) ErrorName() string {
+	return "GetListRFMAnalysesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListRFMAnalysesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRFMAnalysesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRFMAnalysesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRFMAnalysesRequestValidationError{}
+
+// Validate checks the field values on GetListRFMAnalysesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListRFMAnalysesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRFMAnalysesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListRFMAnalysesResponseMultiError, or nil if none found.
+func (m *GetListRFMAnalysesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRFMAnalysesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetRfmAnalyses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListRFMAnalysesResponseValidationError{
+						field:  fmt.Sprintf("RfmAnalyses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListRFMAnalysesResponseValidationError{
+						field:  fmt.Sprintf("RfmAnalyses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListRFMAnalysesResponseValidationError{
+					field:  fmt.Sprintf("RfmAnalyses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListRFMAnalysesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListRFMAnalysesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListRFMAnalysesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetListRFMAnalysesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRFMAnalysesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRFMAnalysesResponseMultiError) AllErrors() []error { return m }
+
+// GetListRFMAnalysesResponseValidationError is the validation error returned
+// by GetListRFMAnalysesResponse.Validate if the designated constraints aren't met.
+type GetListRFMAnalysesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRFMAnalysesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRFMAnalysesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRFMAnalysesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRFMAnalysesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRFMAnalysesResponseValidationError) ErrorName() string {
+	return "GetListRFMAnalysesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListRFMAnalysesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRFMAnalysesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRFMAnalysesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRFMAnalysesResponseValidationError{}
+
+// Validate checks the field values on GetCohortRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCohortRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCohortRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCohortRetentionRequestMultiError, or nil if none found.
+func (m *GetCohortRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCohortRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMasterSegmentId() <= 0 {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "MasterSegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBehaviorTableId() <= 0 {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "BehaviorTableId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimeColumn
+
+	if _, ok := _GetCohortRetentionRequest_Interval_InLookup[m.GetInterval()]; !ok {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "Interval",
+			reason: "value must be in list [ day week month]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPeriods(); val < 0 || val > 90 {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "Periods",
+			reason: "value must be inside range [0, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSegmentId() < 0 {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCohortRetentionRequestValidationError{
+					field:  "EventFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCohortRetentionRequestValidationError{
+					field:  "EventFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCohortRetentionRequestValidationError{
+				field:  "EventFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if !_GetCohortRetentionRequest_From_Pattern.MatchString(m.GetFrom()) {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "From",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetCohortRetentionRequest_To_Pattern.MatchString(m.GetTo()) {
+		err := GetCohortRetentionRequestValidationError{
+			field:  "To",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCohortRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCohortRetentionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCohortRetentionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCohortRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCohortRetentionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCohortRetentionRequestMultiError) AllErrors() []error { return m }
+
+// GetCohortRetentionRequestValidationError is the validation error returned by
+// GetCohortRetentionRequest.Validate if the designated constraints aren't met.
+type GetCohortRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCohortRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCohortRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCohortRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCohortRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCohortRetentionRequestValidationError) ErrorName() string {
+	return "GetCohortRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCohortRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCohortRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCohortRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCohortRetentionRequestValidationError{}
+
+var _GetCohortRetentionRequest_Interval_InLookup = map[string]struct{}{
+	"":      {},
+	"day":   {},
+	"week":  {},
+	"month": {},
+}
+
+var _GetCohortRetentionRequest_From_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+var _GetCohortRetentionRequest_To_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+// Validate checks the field values on GetCohortRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCohortRetentionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCohortRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCohortRetentionResponseMultiError, or nil if none found.
+func (m *GetCohortRetentionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCohortRetentionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Interval
+
+	for idx, item := range m.GetCohorts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCohortRetentionResponseValidationError{
+						field:  fmt.Sprintf("Cohorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCohortRetentionResponseValidationError{
+						field:  fmt.Sprintf("Cohorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCohortRetentionResponseValidationError{
+					field:  fmt.Sprintf("Cohorts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCohortRetentionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCohortRetentionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCohortRetentionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCohortRetentionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCohortRetentionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCohortRetentionResponseMultiError) AllErrors() []error { return m }
+
+// GetCohortRetentionResponseValidationError is the validation error returned
+// by GetCohortRetentionResponse.Validate if the designated constraints aren't met.
+type GetCohortRetentionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCohortRetentionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCohortRetentionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCohortRetentionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCohortRetentionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCohortRetentionResponseValidationError) ErrorName() string {
+	return "GetCohortRetentionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCohortRetentionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCohortRetentionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCohortRetentionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCohortRetentionResponseValidationError{}
+
+// Validate checks the field values on CreateFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFunnelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFunnelRequestMultiError, or nil if none found.
+func (m *CreateFunnelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFunnelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMasterSegmentId() <= 0 {
+		err := CreateFunnelRequestValidationError{
+			field:  "MasterSegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreateFunnelRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if l := len(m.GetSteps()); l < 2 || l > 10 {
+		err := CreateFunnelRequestValidationError{
+			field:  "Steps",
+			reason: "value must contain between 2 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateFunnelRequestValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateFunnelRequestValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateFunnelRequestValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateFunnelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFunnelRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFunnelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFunnelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFunnelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFunnelRequestMultiError) AllErrors() []error { return m }
+
+// CreateFunnelRequestValidationError is the validation error returned by
+// CreateFunnelRequest.Validate if the designated constraints aren't met.
+type CreateFunnelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFunnelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFunnelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFunnelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFunnelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFunnelRequestValidationError) ErrorName() string {
+	return "CreateFunnelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFunnelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFunnelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFunnelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFunnelRequestValidationError{}
+
+// Validate checks the field values on CreateFunnelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFunnelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFunnelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFunnelResponseMultiError, or nil if none found.
+func (m *CreateFunnelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFunnelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetFunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFunnelResponseValidationError{
+					field:  "Funnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFunnelResponseValidationError{
+					field:  "Funnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFunnelResponseValidationError{
+				field:  "Funnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateFunnelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFunnelResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFunnelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFunnelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFunnelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFunnelResponseMultiError) AllErrors() []error { return m }
+
+// CreateFunnelResponseValidationError is the validation error returned by
+// CreateFunnelResponse.Validate if the designated constraints aren't met.
+type CreateFunnelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFunnelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFunnelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFunnelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFunnelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFunnelResponseValidationError) ErrorName() string {
+	return "CreateFunnelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFunnelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFunnelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFunnelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFunnelResponseValidationError{}
+
+// Validate checks the field values on GetListFunnelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListFunnelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListFunnelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListFunnelsRequestMultiError, or nil if none found.
+func (m *GetListFunnelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListFunnelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMasterSegmentId() <= 0 {
+		err := GetListFunnelsRequestValidationError{
+			field:  "MasterSegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListFunnelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListFunnelsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListFunnelsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListFunnelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListFunnelsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListFunnelsRequestMultiError) AllErrors() []error { return m }
+
+// GetListFunnelsRequestValidationError is the validation error returned by
+// GetListFunnelsRequest.Validate if the designated constraints aren't met.
+type GetListFunnelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListFunnelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListFunnelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListFunnelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListFunnelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListFunnelsRequestValidationError) ErrorName() string {
+	return "GetListFunnelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListFunnelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListFunnelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListFunnelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListFunnelsRequestValidationError{}
+
+// Validate checks the field values on GetListFunnelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListFunnelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListFunnelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListFunnelsResponseMultiError, or nil if none found.
+func (m *GetListFunnelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListFunnelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetFunnels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListFunnelsResponseValidationError{
+						field:  fmt.Sprintf("Funnels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListFunnelsResponseValidationError{
+						field:  fmt.Sprintf("Funnels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListFunnelsResponseValidationError{
+					field:  fmt.Sprintf("Funnels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListFunnelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListFunnelsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetListFunnelsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListFunnelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListFunnelsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListFunnelsResponseMultiError) AllErrors() []error { return m }
+
+// GetListFunnelsResponseValidationError is the validation error returned by
+// GetListFunnelsResponse.Validate if the designated constraints aren't met.
+type GetListFunnelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListFunnelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListFunnelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListFunnelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListFunnelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListFunnelsResponseValidationError) ErrorName() string {
+	return "GetListFunnelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListFunnelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListFunnelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListFunnelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListFunnelsResponseValidationError{}
+
+// Validate checks the field values on DeleteFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFunnelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFunnelRequestMultiError, or nil if none found.
+func (m *DeleteFunnelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFunnelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteFunnelRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFunnelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFunnelRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFunnelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFunnelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFunnelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFunnelRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFunnelRequestValidationError is the validation error returned by
+// DeleteFunnelRequest.Validate if the designated constraints aren't met.
+type DeleteFunnelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFunnelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFunnelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFunnelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFunnelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFunnelRequestValidationError) ErrorName() string {
+	return "DeleteFunnelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFunnelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFunnelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFunnelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFunnelRequestValidationError{}
+
+// Validate checks the field values on DeleteFunnelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFunnelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFunnelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFunnelResponseMultiError, or nil if none found.
+func (m *DeleteFunnelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFunnelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteFunnelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFunnelResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteFunnelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFunnelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFunnelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFunnelResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFunnelResponseValidationError is the validation error returned by
+// DeleteFunnelResponse.Validate if the designated constraints aren't met.
+type DeleteFunnelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFunnelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFunnelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFunnelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFunnelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFunnelResponseValidationError) ErrorName() string {
+	return "DeleteFunnelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFunnelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFunnelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFunnelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFunnelResponseValidationError{}
+
+// Validate checks the field values on ComputeFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ComputeFunnelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComputeFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComputeFunnelRequestMultiError, or nil if none found.
+func (m *ComputeFunnelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComputeFunnelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ComputeFunnelRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BreakdownColumn
+
+	if !_ComputeFunnelRequest_From_Pattern.MatchString(m.GetFrom()) {
+		err := ComputeFunnelRequestValidationError{
+			field:  "From",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ComputeFunnelRequest_To_Pattern.MatchString(m.GetTo()) {
+		err := ComputeFunnelRequestValidationError{
+			field:  "To",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ComputeFunnelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComputeFunnelRequestMultiError is an error wrapping multiple validation
+// errors returned by ComputeFunnelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ComputeFunnelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComputeFunnelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComputeFunnelRequestMultiError) AllErrors() []error { return m }
+
+// ComputeFunnelRequestValidationError is the validation error returned by
+// ComputeFunnelRequest.Validate if the designated constraints aren't met.
+type ComputeFunnelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComputeFunnelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComputeFunnelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComputeFunnelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComputeFunnelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComputeFunnelRequestValidationError) ErrorName() string {
+	return "ComputeFunnelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComputeFunnelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComputeFunnelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComputeFunnelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComputeFunnelRequestValidationError{}
+
+var _ComputeFunnelRequest_From_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+var _ComputeFunnelRequest_To_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+// Validate checks the field values on ComputeFunnelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ComputeFunnelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComputeFunnelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComputeFunnelResponseMultiError, or nil if none found.
+func (m *ComputeFunnelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComputeFunnelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ComputeFunnelResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ComputeFunnelResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ComputeFunnelResponseValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBreakdowns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ComputeFunnelResponseValidationError{
+						field:  fmt.Sprintf("Breakdowns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ComputeFunnelResponseValidationError{
+						field:  fmt.Sprintf("Breakdowns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ComputeFunnelResponseValidationError{
+					field:  fmt.Sprintf("Breakdowns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ComputeFunnelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComputeFunnelResponseMultiError is an error wrapping multiple validation
+// errors returned by ComputeFunnelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ComputeFunnelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComputeFunnelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComputeFunnelResponseMultiError) AllErrors() []error { return m }
+
+// ComputeFunnelResponseValidationError is the validation error returned by
+// ComputeFunnelResponse.Validate if the designated constraints aren't met.
+type ComputeFunnelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComputeFunnelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComputeFunnelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComputeFunnelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComputeFunnelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComputeFunnelResponseValidationError) ErrorName() string {
+	return "ComputeFunnelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComputeFunnelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComputeFunnelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComputeFunnelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComputeFunnelResponseValidationError{}
+
+// Validate checks the field values on CreateFunnelDropOffSegmentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateFunnelDropOffSegmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFunnelDropOffSegmentRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateFunnelDropOffSegmentRequestMultiError, or nil if none found.
+func (m *CreateFunnelDropOffSegmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFunnelDropOffSegmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CreateFunnelDropOffSegmentRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStep() <= 0 {
+		err := CreateFunnelDropOffSegmentRequestValidationError{
+			field:  "Step",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreateFunnelDropOffSegmentRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return CreateFunnelDropOffSegmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFunnelDropOffSegmentRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateFunnelDropOffSegmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFunnelDropOffSegmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFunnelDropOffSegmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFunnelDropOffSegmentRequestMultiError) AllErrors() []error { return m }
+
+// CreateFunnelDropOffSegmentRequestValidationError is the validation error
+// returned by CreateFunnelDropOffSegmentRequest.Validate if the designated
+// constraints aren't met.
+type CreateFunnelDropOffSegmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFunnelDropOffSegmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFunnelDropOffSegmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFunnelDropOffSegmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFunnelDropOffSegmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFunnelDropOffSegmentRequestValidationError) ErrorName() string {
+	return "CreateFunnelDropOffSegmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFunnelDropOffSegmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFunnelDropOffSegmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFunnelDropOffSegmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFunnelDropOffSegmentRequestValidationError{}
+
+// Validate checks the field values on CreateFunnelDropOffSegmentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateFunnelDropOffSegmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFunnelDropOffSegmentResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateFunnelDropOffSegmentResponseMultiError, or nil if none found.
+func (m *CreateFunnelDropOffSegmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFunnelDropOffSegmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for SegmentId
+
+	if len(errors) > 0 {
+		return CreateFunnelDropOffSegmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFunnelDropOffSegmentResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateFunnelDropOffSegmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFunnelDropOffSegmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFunnelDropOffSegmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFunnelDropOffSegmentResponseMultiError) AllErrors() []error { return m }
+
+// CreateFunnelDropOffSegmentResponseValidationError is the validation error
+// returned by CreateFunnelDropOffSegmentResponse.Validate if the designated
+// constraints aren't met.
+type CreateFunnelDropOffSegmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFunnelDropOffSegmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFunnelDropOffSegmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFunnelDropOffSegmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFunnelDropOffSegmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFunnelDropOffSegmentResponseValidationError) ErrorName() string {
+	return "CreateFunnelDropOffSegmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFunnelDropOffSegmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFunnelDropOffSegmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFunnelDropOffSegmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFunnelDropOffSegmentResponseValidationError{}
+
+// Validate checks the field values on CreateSegmentSplitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSegmentSplitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSegmentSplitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSegmentSplitRequestMultiError, or nil if none found.
+func (m *CreateSegmentSplitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSegmentSplitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSegmentId() <= 0 {
+		err := CreateSegmentSplitRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreateSegmentSplitRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSalt()) > 255 {
+		err := CreateSegmentSplitRequestValidationError{
+			field:  "Salt",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetArms()); l < 2 || l > 10 {
+		err := CreateSegmentSplitRequestValidationError{
+			field:  "Arms",
+			reason: "value must contain between 2 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetArms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSegmentSplitRequestValidationError{
+						field:  fmt.Sprintf("Arms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSegmentSplitRequestValidationError{
+						field:  fmt.Sprintf("Arms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSegmentSplitRequestValidationError{
+					field:  fmt.Sprintf("Arms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSegmentSplitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSegmentSplitRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSegmentSplitRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateSegmentSplitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSegmentSplitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSegmentSplitRequestMultiError) AllErrors() []error { return m }
+
+// CreateSegmentSplitRequestValidationError is the validation error returned by
+// CreateSegmentSplitRequest.Validate if the designated constraints aren't met.
+type CreateSegmentSplitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSegmentSplitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSegmentSplitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSegmentSplitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSegmentSplitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSegmentSplitRequestValidationError) ErrorName() string {
+	return "CreateSegmentSplitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSegmentSplitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSegmentSplitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSegmentSplitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSegmentSplitRequestValidationError{}
+
+// Validate checks the field values on CreateSegmentSplitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSegmentSplitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSegmentSplitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSegmentSplitResponseMultiError, or nil if none found.
+func (m *CreateSegmentSplitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSegmentSplitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetSplit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSegmentSplitResponseValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSegmentSplitResponseValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSegmentSplitResponseValidationError{
+				field:  "Split",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSegmentSplitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSegmentSplitResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateSegmentSplitResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSegmentSplitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSegmentSplitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSegmentSplitResponseMultiError) AllErrors() []error { return m }
+
+// CreateSegmentSplitResponseValidationError is the validation error returned
+// by CreateSegmentSplitResponse.Validate if the designated constraints aren't met.
+type CreateSegmentSplitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSegmentSplitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSegmentSplitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSegmentSplitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSegmentSplitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSegmentSplitResponseValidationError) ErrorName() string {
+	return "CreateSegmentSplitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSegmentSplitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSegmentSplitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSegmentSplitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSegmentSplitResponseValidationError{}
+
+// Validate checks the field values on GetListSegmentSplitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListSegmentSplitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListSegmentSplitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListSegmentSplitsRequestMultiError, or nil if none found.
+func (m *GetListSegmentSplitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListSegmentSplitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSegmentId() <= 0 {
+		err := GetListSegmentSplitsRequestValidationError{
+			field:  "SegmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListSegmentSplitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListSegmentSplitsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetListSegmentSplitsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetListSegmentSplitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListSegmentSplitsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListSegmentSplitsRequestMultiError) AllErrors() []error { return m }
+
+// GetListSegmentSplitsRequestValidationError is the validation error returned
+// by GetListSegmentSplitsRequest.Validate if the designated constraints
+// aren't met.
+type GetListSegmentSplitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListSegmentSplitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListSegmentSplitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListSegmentSplitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListSegmentSplitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListSegmentSplitsRequestValidationError) ErrorName() string {
+	return "GetListSegmentSplitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListSegmentSplitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListSegmentSplitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListSegmentSplitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListSegmentSplitsRequestValidationError{}
+
+// Validate checks the field values on GetListSegmentSplitsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListSegmentSplitsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListSegmentSplitsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListSegmentSplitsResponseMultiError, or nil if none found.
+func (m *GetListSegmentSplitsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListSegmentSplitsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetSplits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListSegmentSplitsResponseValidationError{
+						field:  fmt.Sprintf("Splits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListSegmentSplitsResponseValidationError{
+						field:  fmt.Sprintf("Splits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListSegmentSplitsResponseValidationError{
+					field:  fmt.Sprintf("Splits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListSegmentSplitsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListSegmentSplitsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetListSegmentSplitsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetListSegmentSplitsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListSegmentSplitsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListSegmentSplitsResponseMultiError) AllErrors() []error { return m }
+
+// GetListSegmentSplitsResponseValidationError is the validation error returned
+// by GetListSegmentSplitsResponse.Validate if the designated constraints
+// aren't met.
+type GetListSegmentSplitsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListSegmentSplitsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListSegmentSplitsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListSegmentSplitsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListSegmentSplitsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListSegmentSplitsResponseValidationError) ErrorName() string {
+	return "GetListSegmentSplitsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListSegmentSplitsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListSegmentSplitsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListSegmentSplitsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListSegmentSplitsResponseValidationError{}
+
+// Validate checks the field values on CompareSegmentSplitArmsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareSegmentSplitArmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareSegmentSplitArmsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CompareSegmentSplitArmsRequestMultiError, or nil if none found.
+func (m *CompareSegmentSplitArmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareSegmentSplitArmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CompareSegmentSplitArmsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBehaviorTableId() <= 0 {
+		err := CompareSegmentSplitArmsRequestValidationError{
+			field:  "BehaviorTableId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEventFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareSegmentSplitArmsRequestValidationError{
+					field:  "EventFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareSegmentSplitArmsRequestValidationError{
+					field:  "EventFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareSegmentSplitArmsRequestValidationError{
+				field:  "EventFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeColumn
+
+	if _, ok := _CompareSegmentSplitArmsRequest_Metric_InLookup[m.GetMetric()]; !ok {
+		err := CompareSegmentSplitArmsRequestValidationError{
+			field:  "Metric",
+			reason: "value must be in list [ conversion mean]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValueColumn
+
+	// no validation rules for ControlArm
+
+	if !_CompareSegmentSplitArmsRequest_From_Pattern.MatchString(m.GetFrom()) {
+		err := CompareSegmentSplitArmsRequestValidationError{
+			field:  "From",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompareSegmentSplitArmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareSegmentSplitArmsRequestMultiError is an error wrapping multiple
+// validation errors returned by CompareSegmentSplitArmsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CompareSegmentSplitArmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareSegmentSplitArmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareSegmentSplitArmsRequestMultiError) AllErrors() []error { return m }
+
+// CompareSegmentSplitArmsRequestValidationError is the validation error
+// returned by CompareSegmentSplitArmsRequest.Validate if the designated
+// constraints aren't met.
+type CompareSegmentSplitArmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareSegmentSplitArmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareSegmentSplitArmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareSegmentSplitArmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareSegmentSplitArmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareSegmentSplitArmsRequestValidationError) ErrorName() string {
+	return "CompareSegmentSplitArmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareSegmentSplitArmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareSegmentSplitArmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareSegmentSplitArmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareSegmentSplitArmsRequestValidationError{}
+
+var _CompareSegmentSplitArmsRequest_Metric_InLookup = map[string]struct{}{
+	"":           {},
+	"conversion": {},
+	"mean":       {},
+}
+
+var _CompareSegmentSplitArmsRequest_From_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2})?$")
+
+// Validate checks the field values on CompareSegmentSplitArmsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareSegmentSplitArmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareSegmentSplitArmsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CompareSegmentSplitArmsResponseMultiError, or nil if none found.
+func (m *CompareSegmentSplitArmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareSegmentSplitArmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Metric
+
+	for idx, item := range m.GetArms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompareSegmentSplitArmsResponseValidationError{
+						field:  fmt.Sprintf("Arms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompareSegmentSplitArmsResponseValidationError{
+						field:  fmt.Sprintf("Arms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompareSegmentSplitArmsResponseValidationError{
+					field:  fmt.Sprintf("Arms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetComparisons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompareSegmentSplitArmsResponseValidationError{
+						field:  fmt.Sprintf("Comparisons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompareSegmentSplitArmsResponseValidationError{
+						field:  fmt.Sprintf("Comparisons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompareSegmentSplitArmsResponseValidationError{
+					field:  fmt.Sprintf("Comparisons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CompareSegmentSplitArmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareSegmentSplitArmsResponseMultiError is an error wrapping multiple
+// validation errors returned by CompareSegmentSplitArmsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CompareSegmentSplitArmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareSegmentSplitArmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareSegmentSplitArmsResponseMultiError) AllErrors() []error { return m }
+
+// CompareSegmentSplitArmsResponseValidationError is the validation error
+// returned by CompareSegmentSplitArmsResponse.Validate if the designated
+// constraints aren't met.
+type CompareSegmentSplitArmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareSegmentSplitArmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareSegmentSplitArmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareSegmentSplitArmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareSegmentSplitArmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareSegmentSplitArmsResponseValidationError) ErrorName() string {
+	return "CompareSegmentSplitArmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareSegmentSplitArmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareSegmentSplitArmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareSegmentSplitArmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareSegmentSplitArmsResponseValidationError{}
+
 // Validate checks the field values on GetListDataSourcesResponse_DataSource
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
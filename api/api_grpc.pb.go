@@ -19,53 +19,111 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CDPService_CheckHealth_FullMethodName                       = "/api.CDPService/CheckHealth"
-	CDPService_Login_FullMethodName                             = "/api.CDPService/Login"
-	CDPService_SignUp_FullMethodName                            = "/api.CDPService/SignUp"
-	CDPService_GetAccountInfo_FullMethodName                    = "/api.CDPService/GetAccountInfo"
-	CDPService_UpdateAccountInfo_FullMethodName                 = "/api.CDPService/UpdateAccountInfo"
-	CDPService_UpdateAccountSetting_FullMethodName              = "/api.CDPService/UpdateAccountSetting"
-	CDPService_GetListDataSources_FullMethodName                = "/api.CDPService/GetListDataSources"
-	CDPService_GetDataSource_FullMethodName                     = "/api.CDPService/GetDataSource"
-	CDPService_GetListDataTables_FullMethodName                 = "/api.CDPService/GetListDataTables"
-	CDPService_GetDataTable_FullMethodName                      = "/api.CDPService/GetDataTable"
-	CDPService_GetQueryDataTable_FullMethodName                 = "/api.CDPService/GetQueryDataTable"
-	CDPService_GetConnection_FullMethodName                     = "/api.CDPService/GetConnection"
-	CDPService_GetListConnections_FullMethodName                = "/api.CDPService/GetListConnections"
-	CDPService_CreateConnection_FullMethodName                  = "/api.CDPService/CreateConnection"
-	CDPService_UpdateConnection_FullMethodName                  = "/api.CDPService/UpdateConnection"
-	CDPService_DeleteConnection_FullMethodName                  = "/api.CDPService/DeleteConnection"
-	CDPService_ExportDataToFile_FullMethodName                  = "/api.CDPService/ExportDataToFile"
-	CDPService_ImportCsvFromS3_FullMethodName                   = "/api.CDPService/ImportCsvFromS3"
-	CDPService_GetListFileExportRecords_FullMethodName          = "/api.CDPService/GetListFileExportRecords"
-	CDPService_CreateMasterSegment_FullMethodName               = "/api.CDPService/CreateMasterSegment"
-	CDPService_GetListMasterSegments_FullMethodName             = "/api.CDPService/GetListMasterSegments"
-	CDPService_GetMasterSegmentDetail_FullMethodName            = "/api.CDPService/GetMasterSegmentDetail"
-	CDPService_CreateSegment_FullMethodName                     = "/api.CDPService/CreateSegment"
-	CDPService_GetListSegments_FullMethodName                   = "/api.CDPService/GetListSegments"
-	CDPService_GetSegmentDetail_FullMethodName                  = "/api.CDPService/GetSegmentDetail"
-	CDPService_CreateGophishUserGroupFromSegment_FullMethodName = "/api.CDPService/CreateGophishUserGroupFromSegment"
-	CDPService_ImportFromMySQLSource_FullMethodName             = "/api.CDPService/ImportFromMySQLSource"
-	CDPService_ExportToMySQLDestination_FullMethodName          = "/api.CDPService/ExportToMySQLDestination"
-	CDPService_GetListDataDestinations_FullMethodName           = "/api.CDPService/GetListDataDestinations"
-	CDPService_GetListDataActionRuns_FullMethodName             = "/api.CDPService/GetListDataActionRuns"
-	CDPService_TrainPredictModel_FullMethodName                 = "/api.CDPService/TrainPredictModel"
-	CDPService_GetListPredictModels_FullMethodName              = "/api.CDPService/GetListPredictModels"
-	CDPService_GetListSourceTableMap_FullMethodName             = "/api.CDPService/GetListSourceTableMap"
-	CDPService_GetDataDestinationDetail_FullMethodName          = "/api.CDPService/GetDataDestinationDetail"
-	CDPService_GetPredictModelDetail_FullMethodName             = "/api.CDPService/GetPredictModelDetail"
-	CDPService_GetMySQLTableSchema_FullMethodName               = "/api.CDPService/GetMySQLTableSchema"
-	CDPService_GetListMasterSegmentProfiles_FullMethodName      = "/api.CDPService/GetListMasterSegmentProfiles"
-	CDPService_ApplyPredictModel_FullMethodName                 = "/api.CDPService/ApplyPredictModel"
-	CDPService_GetListPredictionActions_FullMethodName          = "/api.CDPService/GetListPredictionActions"
-	CDPService_TriggerDataActionRun_FullMethodName              = "/api.CDPService/TriggerDataActionRun"
-	CDPService_GetMasterSegmentProfile_FullMethodName           = "/api.CDPService/GetMasterSegmentProfile"
-	CDPService_GetResultPredictionActions_FullMethodName        = "/api.CDPService/GetResultPredictionActions"
-	CDPService_GetDataActionRunsPerDay_FullMethodName           = "/api.CDPService/GetDataActionRunsPerDay"
-	CDPService_GetDataRunsProportion_FullMethodName             = "/api.CDPService/GetDataRunsProportion"
-	CDPService_GetBehaviorProfile_FullMethodName                = "/api.CDPService/GetBehaviorProfile"
-	CDPService_GetListDestinationMap_FullMethodName             = "/api.CDPService/GetListDestinationMap"
-	CDPService_TotalProfilesMasterSegment_FullMethodName        = "/api.CDPService/TotalProfilesMasterSegment"
+	CDPService_CheckHealth_FullMethodName                           = "/api.CDPService/CheckHealth"
+	CDPService_Login_FullMethodName                                 = "/api.CDPService/Login"
+	CDPService_SignUp_FullMethodName                                = "/api.CDPService/SignUp"
+	CDPService_GetAccountInfo_FullMethodName                        = "/api.CDPService/GetAccountInfo"
+	CDPService_UpdateAccountInfo_FullMethodName                     = "/api.CDPService/UpdateAccountInfo"
+	CDPService_UpdateAccountSetting_FullMethodName                  = "/api.CDPService/UpdateAccountSetting"
+	CDPService_GetListDataSources_FullMethodName                    = "/api.CDPService/GetListDataSources"
+	CDPService_GetDataSource_FullMethodName                         = "/api.CDPService/GetDataSource"
+	CDPService_GetListDataTables_FullMethodName                     = "/api.CDPService/GetListDataTables"
+	CDPService_GetDataTable_FullMethodName                          = "/api.CDPService/GetDataTable"
+	CDPService_GetQueryDataTable_FullMethodName                     = "/api.CDPService/GetQueryDataTable"
+	CDPService_GetConnection_FullMethodName                         = "/api.CDPService/GetConnection"
+	CDPService_GetListConnections_FullMethodName                    = "/api.CDPService/GetListConnections"
+	CDPService_CreateConnection_FullMethodName                      = "/api.CDPService/CreateConnection"
+	CDPService_UpdateConnection_FullMethodName                      = "/api.CDPService/UpdateConnection"
+	CDPService_DeleteConnection_FullMethodName                      = "/api.CDPService/DeleteConnection"
+	CDPService_ExportDataToFile_FullMethodName                      = "/api.CDPService/ExportDataToFile"
+	CDPService_ImportCsvFromS3_FullMethodName                       = "/api.CDPService/ImportCsvFromS3"
+	CDPService_GetListFileExportRecords_FullMethodName              = "/api.CDPService/GetListFileExportRecords"
+	CDPService_CreateMasterSegment_FullMethodName                   = "/api.CDPService/CreateMasterSegment"
+	CDPService_GetListMasterSegments_FullMethodName                 = "/api.CDPService/GetListMasterSegments"
+	CDPService_GetMasterSegmentDetail_FullMethodName                = "/api.CDPService/GetMasterSegmentDetail"
+	CDPService_CreateSegment_FullMethodName                         = "/api.CDPService/CreateSegment"
+	CDPService_GetListSegments_FullMethodName                       = "/api.CDPService/GetListSegments"
+	CDPService_GetSegmentDetail_FullMethodName                      = "/api.CDPService/GetSegmentDetail"
+	CDPService_CreateGophishUserGroupFromSegment_FullMethodName     = "/api.CDPService/CreateGophishUserGroupFromSegment"
+	CDPService_ImportFromMySQLSource_FullMethodName                 = "/api.CDPService/ImportFromMySQLSource"
+	CDPService_ExportToMySQLDestination_FullMethodName              = "/api.CDPService/ExportToMySQLDestination"
+	CDPService_GetListDataDestinations_FullMethodName               = "/api.CDPService/GetListDataDestinations"
+	CDPService_GetListDataActionRuns_FullMethodName                 = "/api.CDPService/GetListDataActionRuns"
+	CDPService_TrainPredictModel_FullMethodName                     = "/api.CDPService/TrainPredictModel"
+	CDPService_GetListPredictModels_FullMethodName                  = "/api.CDPService/GetListPredictModels"
+	CDPService_GetListSourceTableMap_FullMethodName                 = "/api.CDPService/GetListSourceTableMap"
+	CDPService_GetDataDestinationDetail_FullMethodName              = "/api.CDPService/GetDataDestinationDetail"
+	CDPService_GetPredictModelDetail_FullMethodName                 = "/api.CDPService/GetPredictModelDetail"
+	CDPService_GetMySQLTableSchema_FullMethodName                   = "/api.CDPService/GetMySQLTableSchema"
+	CDPService_GetListMasterSegmentProfiles_FullMethodName          = "/api.CDPService/GetListMasterSegmentProfiles"
+	CDPService_ApplyPredictModel_FullMethodName                     = "/api.CDPService/ApplyPredictModel"
+	CDPService_GetListPredictionActions_FullMethodName              = "/api.CDPService/GetListPredictionActions"
+	CDPService_TriggerDataActionRun_FullMethodName                  = "/api.CDPService/TriggerDataActionRun"
+	CDPService_GetMasterSegmentProfile_FullMethodName               = "/api.CDPService/GetMasterSegmentProfile"
+	CDPService_GetResultPredictionActions_FullMethodName            = "/api.CDPService/GetResultPredictionActions"
+	CDPService_GetDataActionRunsPerDay_FullMethodName               = "/api.CDPService/GetDataActionRunsPerDay"
+	CDPService_GetDataRunsProportion_FullMethodName                 = "/api.CDPService/GetDataRunsProportion"
+	CDPService_GetBehaviorProfile_FullMethodName                    = "/api.CDPService/GetBehaviorProfile"
+	CDPService_GetListDestinationMap_FullMethodName                 = "/api.CDPService/GetListDestinationMap"
+	CDPService_TotalProfilesMasterSegment_FullMethodName            = "/api.CDPService/TotalProfilesMasterSegment"
+	CDPService_PreviewFile_FullMethodName                           = "/api.CDPService/PreviewFile"
+	CDPService_UpdateDataTableQualityRules_FullMethodName           = "/api.CDPService/UpdateDataTableQualityRules"
+	CDPService_CreateIdentityResolution_FullMethodName              = "/api.CDPService/CreateIdentityResolution"
+	CDPService_GetListIdentityResolutions_FullMethodName            = "/api.CDPService/GetListIdentityResolutions"
+	CDPService_GetIdentityResolutionDetail_FullMethodName           = "/api.CDPService/GetIdentityResolutionDetail"
+	CDPService_GetIdentityProfileHistory_FullMethodName             = "/api.CDPService/GetIdentityProfileHistory"
+	CDPService_DeduplicateDataTable_FullMethodName                  = "/api.CDPService/DeduplicateDataTable"
+	CDPService_CreateEventSource_FullMethodName                     = "/api.CDPService/CreateEventSource"
+	CDPService_GetListEventSources_FullMethodName                   = "/api.CDPService/GetListEventSources"
+	CDPService_Track_FullMethodName                                 = "/api.CDPService/Track"
+	CDPService_Identify_FullMethodName                              = "/api.CDPService/Identify"
+	CDPService_BatchTrack_FullMethodName                            = "/api.CDPService/BatchTrack"
+	CDPService_UpdateDataTableSchemaPolicy_FullMethodName           = "/api.CDPService/UpdateDataTableSchemaPolicy"
+	CDPService_GetListDataTableSchemaVersions_FullMethodName        = "/api.CDPService/GetListDataTableSchemaVersions"
+	CDPService_GetListDataTableVersions_FullMethodName              = "/api.CDPService/GetListDataTableVersions"
+	CDPService_RestoreDataTable_FullMethodName                      = "/api.CDPService/RestoreDataTable"
+	CDPService_DiffDataTableVersions_FullMethodName                 = "/api.CDPService/DiffDataTableVersions"
+	CDPService_ProfileDataTable_FullMethodName                      = "/api.CDPService/ProfileDataTable"
+	CDPService_GetDataTableStatistics_FullMethodName                = "/api.CDPService/GetDataTableStatistics"
+	CDPService_ProfileMasterSegment_FullMethodName                  = "/api.CDPService/ProfileMasterSegment"
+	CDPService_GetMasterSegmentStatistics_FullMethodName            = "/api.CDPService/GetMasterSegmentStatistics"
+	CDPService_UpdateMasterSegmentComputedAttributes_FullMethodName = "/api.CDPService/UpdateMasterSegmentComputedAttributes"
+	CDPService_RefreshMasterSegment_FullMethodName                  = "/api.CDPService/RefreshMasterSegment"
+	CDPService_GetCustomerProfile_FullMethodName                    = "/api.CDPService/GetCustomerProfile"
+	CDPService_UpdateMasterSegmentSearchableColumns_FullMethodName  = "/api.CDPService/UpdateMasterSegmentSearchableColumns"
+	CDPService_SearchProfiles_FullMethodName                        = "/api.CDPService/SearchProfiles"
+	CDPService_SuggestColumnTags_FullMethodName                     = "/api.CDPService/SuggestColumnTags"
+	CDPService_UpdateColumnTags_FullMethodName                      = "/api.CDPService/UpdateColumnTags"
+	CDPService_GetColumnTags_FullMethodName                         = "/api.CDPService/GetColumnTags"
+	CDPService_UpdateMaskingPolicies_FullMethodName                 = "/api.CDPService/UpdateMaskingPolicies"
+	CDPService_GetMaskingPolicies_FullMethodName                    = "/api.CDPService/GetMaskingPolicies"
+	CDPService_ErasePerson_FullMethodName                           = "/api.CDPService/ErasePerson"
+	CDPService_GetListErasureCertificates_FullMethodName            = "/api.CDPService/GetListErasureCertificates"
+	CDPService_GetErasureCertificate_FullMethodName                 = "/api.CDPService/GetErasureCertificate"
+	CDPService_UpdateMasterSegmentConsentColumns_FullMethodName     = "/api.CDPService/UpdateMasterSegmentConsentColumns"
+	CDPService_CreateSuppressionList_FullMethodName                 = "/api.CDPService/CreateSuppressionList"
+	CDPService_GetListSuppressionLists_FullMethodName               = "/api.CDPService/GetListSuppressionLists"
+	CDPService_UploadSuppressionEntries_FullMethodName              = "/api.CDPService/UploadSuppressionEntries"
+	CDPService_DeleteSuppressionList_FullMethodName                 = "/api.CDPService/DeleteSuppressionList"
+	CDPService_SetRetentionPolicy_FullMethodName                    = "/api.CDPService/SetRetentionPolicy"
+	CDPService_GetListRetentionPolicies_FullMethodName              = "/api.CDPService/GetListRetentionPolicies"
+	CDPService_DeleteRetentionPolicy_FullMethodName                 = "/api.CDPService/DeleteRetentionPolicy"
+	CDPService_GetListSegmentSnapshots_FullMethodName               = "/api.CDPService/GetListSegmentSnapshots"
+	CDPService_GetSegmentMembershipChanges_FullMethodName           = "/api.CDPService/GetSegmentMembershipChanges"
+	CDPService_GetSegmentSizeHistory_FullMethodName                 = "/api.CDPService/GetSegmentSizeHistory"
+	CDPService_AnalyzeSegmentOverlap_FullMethodName                 = "/api.CDPService/AnalyzeSegmentOverlap"
+	CDPService_GetSegmentInsights_FullMethodName                    = "/api.CDPService/GetSegmentInsights"
+	CDPService_CreateRFMAnalysis_FullMethodName                     = "/api.CDPService/CreateRFMAnalysis"
+	CDPService_GetListRFMAnalyses_FullMethodName                    = "/api.CDPService/GetListRFMAnalyses"
+	CDPService_GetCohortRetention_FullMethodName                    = "/api.CDPService/GetCohortRetention"
+	CDPService_CreateFunnel_FullMethodName                          = "/api.CDPService/CreateFunnel"
+	CDPService_GetListFunnels_FullMethodName                        = "/api.CDPService/GetListFunnels"
+	CDPService_DeleteFunnel_FullMethodName                          = "/api.CDPService/DeleteFunnel"
+	CDPService_ComputeFunnel_FullMethodName                         = "/api.CDPService/ComputeFunnel"
+	CDPService_CreateFunnelDropOffSegment_FullMethodName            = "/api.CDPService/CreateFunnelDropOffSegment"
+	CDPService_CreateSegmentSplit_FullMethodName                    = "/api.CDPService/CreateSegmentSplit"
+	CDPService_GetListSegmentSplits_FullMethodName                  = "/api.CDPService/GetListSegmentSplits"
+	CDPService_CompareSegmentSplitArms_FullMethodName               = "/api.CDPService/CompareSegmentSplitArms"
 )
 
 // CDPServiceClient is the client API for CDPService service.
@@ -119,6 +177,64 @@ type CDPServiceClient interface {
 	GetBehaviorProfile(ctx context.Context, in *GetBehaviorProfileRequest, opts ...grpc.CallOption) (*GetBehaviorProfileResponse, error)
 	GetListDestinationMap(ctx context.Context, in *GetListDestinationMapRequest, opts ...grpc.CallOption) (*GetListDestinationMapResponse, error)
 	TotalProfilesMasterSegment(ctx context.Context, in *TotalProfilesMasterSegmentRequest, opts ...grpc.CallOption) (*TotalProfilesMasterSegmentResponse, error)
+	PreviewFile(ctx context.Context, in *PreviewFileRequest, opts ...grpc.CallOption) (*PreviewFileResponse, error)
+	UpdateDataTableQualityRules(ctx context.Context, in *UpdateDataTableQualityRulesRequest, opts ...grpc.CallOption) (*UpdateDataTableQualityRulesResponse, error)
+	CreateIdentityResolution(ctx context.Context, in *CreateIdentityResolutionRequest, opts ...grpc.CallOption) (*CreateIdentityResolutionResponse, error)
+	GetListIdentityResolutions(ctx context.Context, in *GetListIdentityResolutionsRequest, opts ...grpc.CallOption) (*GetListIdentityResolutionsResponse, error)
+	GetIdentityResolutionDetail(ctx context.Context, in *GetIdentityResolutionDetailRequest, opts ...grpc.CallOption) (*GetIdentityResolutionDetailResponse, error)
+	GetIdentityProfileHistory(ctx context.Context, in *GetIdentityProfileHistoryRequest, opts ...grpc.CallOption) (*GetIdentityProfileHistoryResponse, error)
+	DeduplicateDataTable(ctx context.Context, in *DeduplicateDataTableRequest, opts ...grpc.CallOption) (*DeduplicateDataTableResponse, error)
+	CreateEventSource(ctx context.Context, in *CreateEventSourceRequest, opts ...grpc.CallOption) (*CreateEventSourceResponse, error)
+	GetListEventSources(ctx context.Context, in *GetListEventSourcesRequest, opts ...grpc.CallOption) (*GetListEventSourcesResponse, error)
+	Track(ctx context.Context, in *TrackRequest, opts ...grpc.CallOption) (*IngestEventResponse, error)
+	Identify(ctx context.Context, in *IdentifyRequest, opts ...grpc.CallOption) (*IngestEventResponse, error)
+	BatchTrack(ctx context.Context, in *BatchTrackRequest, opts ...grpc.CallOption) (*IngestEventResponse, error)
+	UpdateDataTableSchemaPolicy(ctx context.Context, in *UpdateDataTableSchemaPolicyRequest, opts ...grpc.CallOption) (*UpdateDataTableSchemaPolicyResponse, error)
+	GetListDataTableSchemaVersions(ctx context.Context, in *GetListDataTableSchemaVersionsRequest, opts ...grpc.CallOption) (*GetListDataTableSchemaVersionsResponse, error)
+	GetListDataTableVersions(ctx context.Context, in *GetListDataTableVersionsRequest, opts ...grpc.CallOption) (*GetListDataTableVersionsResponse, error)
+	RestoreDataTable(ctx context.Context, in *RestoreDataTableRequest, opts ...grpc.CallOption) (*RestoreDataTableResponse, error)
+	DiffDataTableVersions(ctx context.Context, in *DiffDataTableVersionsRequest, opts ...grpc.CallOption) (*DiffDataTableVersionsResponse, error)
+	ProfileDataTable(ctx context.Context, in *ProfileDataTableRequest, opts ...grpc.CallOption) (*ProfileDataTableResponse, error)
+	GetDataTableStatistics(ctx context.Context, in *GetDataTableStatisticsRequest, opts ...grpc.CallOption) (*GetDataTableStatisticsResponse, error)
+	ProfileMasterSegment(ctx context.Context, in *ProfileMasterSegmentRequest, opts ...grpc.CallOption) (*ProfileMasterSegmentResponse, error)
+	GetMasterSegmentStatistics(ctx context.Context, in *GetMasterSegmentStatisticsRequest, opts ...grpc.CallOption) (*GetMasterSegmentStatisticsResponse, error)
+	UpdateMasterSegmentComputedAttributes(ctx context.Context, in *UpdateMasterSegmentComputedAttributesRequest, opts ...grpc.CallOption) (*UpdateMasterSegmentComputedAttributesResponse, error)
+	RefreshMasterSegment(ctx context.Context, in *RefreshMasterSegmentRequest, opts ...grpc.CallOption) (*RefreshMasterSegmentResponse, error)
+	GetCustomerProfile(ctx context.Context, in *GetCustomerProfileRequest, opts ...grpc.CallOption) (*GetCustomerProfileResponse, error)
+	UpdateMasterSegmentSearchableColumns(ctx context.Context, in *UpdateMasterSegmentSearchableColumnsRequest, opts ...grpc.CallOption) (*UpdateMasterSegmentSearchableColumnsResponse, error)
+	SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*SearchProfilesResponse, error)
+	SuggestColumnTags(ctx context.Context, in *SuggestColumnTagsRequest, opts ...grpc.CallOption) (*SuggestColumnTagsResponse, error)
+	UpdateColumnTags(ctx context.Context, in *UpdateColumnTagsRequest, opts ...grpc.CallOption) (*UpdateColumnTagsResponse, error)
+	GetColumnTags(ctx context.Context, in *GetColumnTagsRequest, opts ...grpc.CallOption) (*GetColumnTagsResponse, error)
+	UpdateMaskingPolicies(ctx context.Context, in *UpdateMaskingPoliciesRequest, opts ...grpc.CallOption) (*UpdateMaskingPoliciesResponse, error)
+	GetMaskingPolicies(ctx context.Context, in *GetMaskingPoliciesRequest, opts ...grpc.CallOption) (*GetMaskingPoliciesResponse, error)
+	ErasePerson(ctx context.Context, in *ErasePersonRequest, opts ...grpc.CallOption) (*ErasePersonResponse, error)
+	GetListErasureCertificates(ctx context.Context, in *GetListErasureCertificatesRequest, opts ...grpc.CallOption) (*GetListErasureCertificatesResponse, error)
+	GetErasureCertificate(ctx context.Context, in *GetErasureCertificateRequest, opts ...grpc.CallOption) (*GetErasureCertificateResponse, error)
+	UpdateMasterSegmentConsentColumns(ctx context.Context, in *UpdateMasterSegmentConsentColumnsRequest, opts ...grpc.CallOption) (*UpdateMasterSegmentConsentColumnsResponse, error)
+	CreateSuppressionList(ctx context.Context, in *CreateSuppressionListRequest, opts ...grpc.CallOption) (*CreateSuppressionListResponse, error)
+	GetListSuppressionLists(ctx context.Context, in *GetListSuppressionListsRequest, opts ...grpc.CallOption) (*GetListSuppressionListsResponse, error)
+	UploadSuppressionEntries(ctx context.Context, in *UploadSuppressionEntriesRequest, opts ...grpc.CallOption) (*UploadSuppressionEntriesResponse, error)
+	DeleteSuppressionList(ctx context.Context, in *DeleteSuppressionListRequest, opts ...grpc.CallOption) (*DeleteSuppressionListResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	GetListRetentionPolicies(ctx context.Context, in *GetListRetentionPoliciesRequest, opts ...grpc.CallOption) (*GetListRetentionPoliciesResponse, error)
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error)
+	GetListSegmentSnapshots(ctx context.Context, in *GetListSegmentSnapshotsRequest, opts ...grpc.CallOption) (*GetListSegmentSnapshotsResponse, error)
+	GetSegmentMembershipChanges(ctx context.Context, in *GetSegmentMembershipChangesRequest, opts ...grpc.CallOption) (*GetSegmentMembershipChangesResponse, error)
+	GetSegmentSizeHistory(ctx context.Context, in *GetSegmentSizeHistoryRequest, opts ...grpc.CallOption) (*GetSegmentSizeHistoryResponse, error)
+	AnalyzeSegmentOverlap(ctx context.Context, in *AnalyzeSegmentOverlapRequest, opts ...grpc.CallOption) (*AnalyzeSegmentOverlapResponse, error)
+	GetSegmentInsights(ctx context.Context, in *GetSegmentInsightsRequest, opts ...grpc.CallOption) (*GetSegmentInsightsResponse, error)
+	CreateRFMAnalysis(ctx context.Context, in *CreateRFMAnalysisRequest, opts ...grpc.CallOption) (*CreateRFMAnalysisResponse, error)
+	GetListRFMAnalyses(ctx context.Context, in *GetListRFMAnalysesRequest, opts ...grpc.CallOption) (*GetListRFMAnalysesResponse, error)
+	GetCohortRetention(ctx context.Context, in *GetCohortRetentionRequest, opts ...grpc.CallOption) (*GetCohortRetentionResponse, error)
+	CreateFunnel(ctx context.Context, in *CreateFunnelRequest, opts ...grpc.CallOption) (*CreateFunnelResponse, error)
+	GetListFunnels(ctx context.Context, in *GetListFunnelsRequest, opts ...grpc.CallOption) (*GetListFunnelsResponse, error)
+	DeleteFunnel(ctx context.Context, in *DeleteFunnelRequest, opts ...grpc.CallOption) (*DeleteFunnelResponse, error)
+	ComputeFunnel(ctx context.Context, in *ComputeFunnelRequest, opts ...grpc.CallOption) (*ComputeFunnelResponse, error)
+	CreateFunnelDropOffSegment(ctx context.Context, in *CreateFunnelDropOffSegmentRequest, opts ...grpc.CallOption) (*CreateFunnelDropOffSegmentResponse, error)
+	CreateSegmentSplit(ctx context.Context, in *CreateSegmentSplitRequest, opts ...grpc.CallOption) (*CreateSegmentSplitResponse, error)
+	GetListSegmentSplits(ctx context.Context, in *GetListSegmentSplitsRequest, opts ...grpc.CallOption) (*GetListSegmentSplitsResponse, error)
+	CompareSegmentSplitArms(ctx context.Context, in *CompareSegmentSplitArmsRequest, opts ...grpc.CallOption) (*CompareSegmentSplitArmsResponse, error)
 }
 
 type cDPServiceClient struct {
@@ -552,1060 +668,2858 @@ func (c *cDPServiceClient) TotalProfilesMasterSegment(ctx context.Context, in *T
 	return out, nil
 }
 
-// CDPServiceServer is the server API for CDPService service.
-// All implementations must embed UnimplementedCDPServiceServer
-// for forward compatibility
-type CDPServiceServer interface {
-	CheckHealth(context.Context, *CheckHealthRequest) (*CommonResponse, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	SignUp(context.Context, *SignUpRequest) (*CommonResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	UpdateAccountInfo(context.Context, *UpdateAccountInfoRequest) (*UpdateAccountInfoResponse, error)
-	UpdateAccountSetting(context.Context, *UpdateAccountSettingRequest) (*UpdateAccountSettingResponse, error)
-	GetListDataSources(context.Context, *GetListDataSourcesRequest) (*GetListDataSourcesResponse, error)
-	GetDataSource(context.Context, *GetDataSourceRequest) (*GetDataSourceResponse, error)
-	GetListDataTables(context.Context, *GetListDataTablesRequest) (*GetListDataTablesResponse, error)
-	GetDataTable(context.Context, *GetDataTableRequest) (*GetDataTableResponse, error)
-	GetQueryDataTable(context.Context, *GetQueryDataTableRequest) (*GetQueryDataTableResponse, error)
-	GetConnection(context.Context, *GetConnectionRequest) (*GetConnectionResponse, error)
-	GetListConnections(context.Context, *GetListConnectionsRequest) (*GetListConnectionsResponse, error)
-	CreateConnection(context.Context, *CreateConnectionRequest) (*CreateConnectionResponse, error)
-	UpdateConnection(context.Context, *UpdateConnectionRequest) (*UpdateConnectionResponse, error)
-	DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error)
-	ExportDataToFile(context.Context, *ExportDataToFileRequest) (*ExportDataToFileResponse, error)
-	ImportCsvFromS3(context.Context, *ImportCsvFromS3Request) (*ImportCsvFromS3Response, error)
-	GetListFileExportRecords(context.Context, *GetListFileExportRecordsRequest) (*GetListFileExportRecordsResponse, error)
-	CreateMasterSegment(context.Context, *CreateMasterSegmentRequest) (*CreateMasterSegmentResponse, error)
-	GetListMasterSegments(context.Context, *GetListMasterSegmentsRequest) (*GetListMasterSegmentsResponse, error)
-	GetMasterSegmentDetail(context.Context, *GetMasterSegmentDetailRequest) (*GetMasterSegmentDetailResponse, error)
-	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
-	GetListSegments(context.Context, *GetListSegmentsRequest) (*GetListSegmentsResponse, error)
-	GetSegmentDetail(context.Context, *GetSegmentDetailRequest) (*GetSegmentDetailResponse, error)
-	CreateGophishUserGroupFromSegment(context.Context, *CreateGophishUserGroupFromSegmentRequest) (*CreateGophishUserGroupFromSegmentResponse, error)
-	ImportFromMySQLSource(context.Context, *ImportFromMySQLSourceRequest) (*ImportFromMySQLSourceResponse, error)
-	ExportToMySQLDestination(context.Context, *ExportToMySQLDestinationRequest) (*ExportToMySQLDestinationResponse, error)
-	GetListDataDestinations(context.Context, *GetListDataDestinationsRequest) (*GetListDataDestinationsResponse, error)
-	GetListDataActionRuns(context.Context, *GetListDataActionRunsRequest) (*GetListDataActionRunsResponse, error)
-	TrainPredictModel(context.Context, *TrainPredictModelRequest) (*TrainPredictModelResponse, error)
-	GetListPredictModels(context.Context, *GetListPredictModelsRequest) (*GetListPredictModelsResponse, error)
-	GetListSourceTableMap(context.Context, *GetListSourceTableMapRequest) (*GetListSourceTableMapResponse, error)
-	GetDataDestinationDetail(context.Context, *GetDataDestinationDetailRequest) (*GetDataDestinationDetailResponse, error)
-	GetPredictModelDetail(context.Context, *GetPredictModelDetailRequest) (*GetPredictModelDetailResponse, error)
-	GetMySQLTableSchema(context.Context, *GetMySQLTableSchemaRequest) (*GetMySQLTableSchemaResponse, error)
-	GetListMasterSegmentProfiles(context.Context, *GetListMasterSegmentProfilesRequest) (*GetListMasterSegmentProfilesResponse, error)
-	ApplyPredictModel(context.Context, *ApplyPredictModelRequest) (*ApplyPredictModelResponse, error)
-	GetListPredictionActions(context.Context, *GetListPredictionActionsRequest) (*GetListPredictionActionsResponse, error)
-	TriggerDataActionRun(context.Context, *TriggerDataActionRunRequest) (*TriggerDataActionRunResponse, error)
-	GetMasterSegmentProfile(context.Context, *GetMasterSegmentProfileRequest) (*GetMasterSegmentProfileResponse, error)
-	GetResultPredictionActions(context.Context, *GetResultPredictionActionsRequest) (*GetResultPredictionActionsResponse, error)
-	GetDataActionRunsPerDay(context.Context, *GetDataActionRunsPerDayRequest) (*GetDataActionRunsPerDayResponse, error)
-	GetDataRunsProportion(context.Context, *GetDataRunsProportionRequest) (*GetDataRunsProportionResponse, error)
-	GetBehaviorProfile(context.Context, *GetBehaviorProfileRequest) (*GetBehaviorProfileResponse, error)
-	GetListDestinationMap(context.Context, *GetListDestinationMapRequest) (*GetListDestinationMapResponse, error)
-	TotalProfilesMasterSegment(context.Context, *TotalProfilesMasterSegmentRequest) (*TotalProfilesMasterSegmentResponse, error)
-	mustEmbedUnimplementedCDPServiceServer()
+func (c *cDPServiceClient) PreviewFile(ctx context.Context, in *PreviewFileRequest, opts ...grpc.CallOption) (*PreviewFileResponse, error) {
+	out := new(PreviewFileResponse)
+	err := c.cc.Invoke(ctx, CDPService_PreviewFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCDPServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCDPServiceServer struct {
+func (c *cDPServiceClient) UpdateDataTableQualityRules(ctx context.Context, in *UpdateDataTableQualityRulesRequest, opts ...grpc.CallOption) (*UpdateDataTableQualityRulesResponse, error) {
+	out := new(UpdateDataTableQualityRulesResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateDataTableQualityRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedCDPServiceServer) CheckHealth(context.Context, *CheckHealthRequest) (*CommonResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
-}
-func (UnimplementedCDPServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (UnimplementedCDPServiceServer) SignUp(context.Context, *SignUpRequest) (*CommonResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
+func (c *cDPServiceClient) CreateIdentityResolution(ctx context.Context, in *CreateIdentityResolutionRequest, opts ...grpc.CallOption) (*CreateIdentityResolutionResponse, error) {
+	out := new(CreateIdentityResolutionResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateIdentityResolution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+func (c *cDPServiceClient) GetListIdentityResolutions(ctx context.Context, in *GetListIdentityResolutionsRequest, opts ...grpc.CallOption) (*GetListIdentityResolutionsResponse, error) {
+	out := new(GetListIdentityResolutionsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListIdentityResolutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) UpdateAccountInfo(context.Context, *UpdateAccountInfoRequest) (*UpdateAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+
+func (c *cDPServiceClient) GetIdentityResolutionDetail(ctx context.Context, in *GetIdentityResolutionDetailRequest, opts ...grpc.CallOption) (*GetIdentityResolutionDetailResponse, error) {
+	out := new(GetIdentityResolutionDetailResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetIdentityResolutionDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) UpdateAccountSetting(context.Context, *UpdateAccountSettingRequest) (*UpdateAccountSettingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountSetting not implemented")
+
+func (c *cDPServiceClient) GetIdentityProfileHistory(ctx context.Context, in *GetIdentityProfileHistoryRequest, opts ...grpc.CallOption) (*GetIdentityProfileHistoryResponse, error) {
+	out := new(GetIdentityProfileHistoryResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetIdentityProfileHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListDataSources(context.Context, *GetListDataSourcesRequest) (*GetListDataSourcesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListDataSources not implemented")
+
+func (c *cDPServiceClient) DeduplicateDataTable(ctx context.Context, in *DeduplicateDataTableRequest, opts ...grpc.CallOption) (*DeduplicateDataTableResponse, error) {
+	out := new(DeduplicateDataTableResponse)
+	err := c.cc.Invoke(ctx, CDPService_DeduplicateDataTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetDataSource(context.Context, *GetDataSourceRequest) (*GetDataSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataSource not implemented")
+
+func (c *cDPServiceClient) CreateEventSource(ctx context.Context, in *CreateEventSourceRequest, opts ...grpc.CallOption) (*CreateEventSourceResponse, error) {
+	out := new(CreateEventSourceResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateEventSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListDataTables(context.Context, *GetListDataTablesRequest) (*GetListDataTablesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListDataTables not implemented")
+
+func (c *cDPServiceClient) GetListEventSources(ctx context.Context, in *GetListEventSourcesRequest, opts ...grpc.CallOption) (*GetListEventSourcesResponse, error) {
+	out := new(GetListEventSourcesResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListEventSources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetDataTable(context.Context, *GetDataTableRequest) (*GetDataTableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataTable not implemented")
+
+func (c *cDPServiceClient) Track(ctx context.Context, in *TrackRequest, opts ...grpc.CallOption) (*IngestEventResponse, error) {
+	out := new(IngestEventResponse)
+	err := c.cc.Invoke(ctx, CDPService_Track_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetQueryDataTable(context.Context, *GetQueryDataTableRequest) (*GetQueryDataTableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueryDataTable not implemented")
+
+func (c *cDPServiceClient) Identify(ctx context.Context, in *IdentifyRequest, opts ...grpc.CallOption) (*IngestEventResponse, error) {
+	out := new(IngestEventResponse)
+	err := c.cc.Invoke(ctx, CDPService_Identify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetConnection(context.Context, *GetConnectionRequest) (*GetConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConnection not implemented")
+
+func (c *cDPServiceClient) BatchTrack(ctx context.Context, in *BatchTrackRequest, opts ...grpc.CallOption) (*IngestEventResponse, error) {
+	out := new(IngestEventResponse)
+	err := c.cc.Invoke(ctx, CDPService_BatchTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListConnections(context.Context, *GetListConnectionsRequest) (*GetListConnectionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListConnections not implemented")
+
+func (c *cDPServiceClient) UpdateDataTableSchemaPolicy(ctx context.Context, in *UpdateDataTableSchemaPolicyRequest, opts ...grpc.CallOption) (*UpdateDataTableSchemaPolicyResponse, error) {
+	out := new(UpdateDataTableSchemaPolicyResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateDataTableSchemaPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) CreateConnection(context.Context, *CreateConnectionRequest) (*CreateConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConnection not implemented")
+
+func (c *cDPServiceClient) GetListDataTableSchemaVersions(ctx context.Context, in *GetListDataTableSchemaVersionsRequest, opts ...grpc.CallOption) (*GetListDataTableSchemaVersionsResponse, error) {
+	out := new(GetListDataTableSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListDataTableSchemaVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) UpdateConnection(context.Context, *UpdateConnectionRequest) (*UpdateConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnection not implemented")
+
+func (c *cDPServiceClient) GetListDataTableVersions(ctx context.Context, in *GetListDataTableVersionsRequest, opts ...grpc.CallOption) (*GetListDataTableVersionsResponse, error) {
+	out := new(GetListDataTableVersionsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListDataTableVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnection not implemented")
+
+func (c *cDPServiceClient) RestoreDataTable(ctx context.Context, in *RestoreDataTableRequest, opts ...grpc.CallOption) (*RestoreDataTableResponse, error) {
+	out := new(RestoreDataTableResponse)
+	err := c.cc.Invoke(ctx, CDPService_RestoreDataTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) ExportDataToFile(context.Context, *ExportDataToFileRequest) (*ExportDataToFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExportDataToFile not implemented")
+
+func (c *cDPServiceClient) DiffDataTableVersions(ctx context.Context, in *DiffDataTableVersionsRequest, opts ...grpc.CallOption) (*DiffDataTableVersionsResponse, error) {
+	out := new(DiffDataTableVersionsResponse)
+	err := c.cc.Invoke(ctx, CDPService_DiffDataTableVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) ImportCsvFromS3(context.Context, *ImportCsvFromS3Request) (*ImportCsvFromS3Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportCsvFromS3 not implemented")
+
+func (c *cDPServiceClient) ProfileDataTable(ctx context.Context, in *ProfileDataTableRequest, opts ...grpc.CallOption) (*ProfileDataTableResponse, error) {
+	out := new(ProfileDataTableResponse)
+	err := c.cc.Invoke(ctx, CDPService_ProfileDataTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListFileExportRecords(context.Context, *GetListFileExportRecordsRequest) (*GetListFileExportRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListFileExportRecords not implemented")
+
+func (c *cDPServiceClient) GetDataTableStatistics(ctx context.Context, in *GetDataTableStatisticsRequest, opts ...grpc.CallOption) (*GetDataTableStatisticsResponse, error) {
+	out := new(GetDataTableStatisticsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetDataTableStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) CreateMasterSegment(context.Context, *CreateMasterSegmentRequest) (*CreateMasterSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMasterSegment not implemented")
+
+func (c *cDPServiceClient) ProfileMasterSegment(ctx context.Context, in *ProfileMasterSegmentRequest, opts ...grpc.CallOption) (*ProfileMasterSegmentResponse, error) {
+	out := new(ProfileMasterSegmentResponse)
+	err := c.cc.Invoke(ctx, CDPService_ProfileMasterSegment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListMasterSegments(context.Context, *GetListMasterSegmentsRequest) (*GetListMasterSegmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListMasterSegments not implemented")
+
+func (c *cDPServiceClient) GetMasterSegmentStatistics(ctx context.Context, in *GetMasterSegmentStatisticsRequest, opts ...grpc.CallOption) (*GetMasterSegmentStatisticsResponse, error) {
+	out := new(GetMasterSegmentStatisticsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetMasterSegmentStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetMasterSegmentDetail(context.Context, *GetMasterSegmentDetailRequest) (*GetMasterSegmentDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMasterSegmentDetail not implemented")
+
+func (c *cDPServiceClient) UpdateMasterSegmentComputedAttributes(ctx context.Context, in *UpdateMasterSegmentComputedAttributesRequest, opts ...grpc.CallOption) (*UpdateMasterSegmentComputedAttributesResponse, error) {
+	out := new(UpdateMasterSegmentComputedAttributesResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateMasterSegmentComputedAttributes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+
+func (c *cDPServiceClient) RefreshMasterSegment(ctx context.Context, in *RefreshMasterSegmentRequest, opts ...grpc.CallOption) (*RefreshMasterSegmentResponse, error) {
+	out := new(RefreshMasterSegmentResponse)
+	err := c.cc.Invoke(ctx, CDPService_RefreshMasterSegment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListSegments(context.Context, *GetListSegmentsRequest) (*GetListSegmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListSegments not implemented")
+
+func (c *cDPServiceClient) GetCustomerProfile(ctx context.Context, in *GetCustomerProfileRequest, opts ...grpc.CallOption) (*GetCustomerProfileResponse, error) {
+	out := new(GetCustomerProfileResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetCustomerProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetSegmentDetail(context.Context, *GetSegmentDetailRequest) (*GetSegmentDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentDetail not implemented")
+
+func (c *cDPServiceClient) UpdateMasterSegmentSearchableColumns(ctx context.Context, in *UpdateMasterSegmentSearchableColumnsRequest, opts ...grpc.CallOption) (*UpdateMasterSegmentSearchableColumnsResponse, error) {
+	out := new(UpdateMasterSegmentSearchableColumnsResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateMasterSegmentSearchableColumns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) CreateGophishUserGroupFromSegment(context.Context, *CreateGophishUserGroupFromSegmentRequest) (*CreateGophishUserGroupFromSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGophishUserGroupFromSegment not implemented")
+
+func (c *cDPServiceClient) SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*SearchProfilesResponse, error) {
+	out := new(SearchProfilesResponse)
+	err := c.cc.Invoke(ctx, CDPService_SearchProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) ImportFromMySQLSource(context.Context, *ImportFromMySQLSourceRequest) (*ImportFromMySQLSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportFromMySQLSource not implemented")
+
+func (c *cDPServiceClient) SuggestColumnTags(ctx context.Context, in *SuggestColumnTagsRequest, opts ...grpc.CallOption) (*SuggestColumnTagsResponse, error) {
+	out := new(SuggestColumnTagsResponse)
+	err := c.cc.Invoke(ctx, CDPService_SuggestColumnTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) ExportToMySQLDestination(context.Context, *ExportToMySQLDestinationRequest) (*ExportToMySQLDestinationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExportToMySQLDestination not implemented")
+
+func (c *cDPServiceClient) UpdateColumnTags(ctx context.Context, in *UpdateColumnTagsRequest, opts ...grpc.CallOption) (*UpdateColumnTagsResponse, error) {
+	out := new(UpdateColumnTagsResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateColumnTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCDPServiceServer) GetListDataDestinations(context.Context, *GetListDataDestinationsRequest) (*GetListDataDestinationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListDataDestinations not implemented")
+
+func (c *cDPServiceClient) GetColumnTags(ctx context.Context, in *GetColumnTagsRequest, opts ...grpc.CallOption) (*GetColumnTagsResponse, error) {
+	out := new(GetColumnTagsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetColumnTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) UpdateMaskingPolicies(ctx context.Context, in *UpdateMaskingPoliciesRequest, opts ...grpc.CallOption) (*UpdateMaskingPoliciesResponse, error) {
+	out := new(UpdateMaskingPoliciesResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateMaskingPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetMaskingPolicies(ctx context.Context, in *GetMaskingPoliciesRequest, opts ...grpc.CallOption) (*GetMaskingPoliciesResponse, error) {
+	out := new(GetMaskingPoliciesResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetMaskingPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) ErasePerson(ctx context.Context, in *ErasePersonRequest, opts ...grpc.CallOption) (*ErasePersonResponse, error) {
+	out := new(ErasePersonResponse)
+	err := c.cc.Invoke(ctx, CDPService_ErasePerson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListErasureCertificates(ctx context.Context, in *GetListErasureCertificatesRequest, opts ...grpc.CallOption) (*GetListErasureCertificatesResponse, error) {
+	out := new(GetListErasureCertificatesResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListErasureCertificates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetErasureCertificate(ctx context.Context, in *GetErasureCertificateRequest, opts ...grpc.CallOption) (*GetErasureCertificateResponse, error) {
+	out := new(GetErasureCertificateResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetErasureCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) UpdateMasterSegmentConsentColumns(ctx context.Context, in *UpdateMasterSegmentConsentColumnsRequest, opts ...grpc.CallOption) (*UpdateMasterSegmentConsentColumnsResponse, error) {
+	out := new(UpdateMasterSegmentConsentColumnsResponse)
+	err := c.cc.Invoke(ctx, CDPService_UpdateMasterSegmentConsentColumns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) CreateSuppressionList(ctx context.Context, in *CreateSuppressionListRequest, opts ...grpc.CallOption) (*CreateSuppressionListResponse, error) {
+	out := new(CreateSuppressionListResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateSuppressionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListSuppressionLists(ctx context.Context, in *GetListSuppressionListsRequest, opts ...grpc.CallOption) (*GetListSuppressionListsResponse, error) {
+	out := new(GetListSuppressionListsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListSuppressionLists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) UploadSuppressionEntries(ctx context.Context, in *UploadSuppressionEntriesRequest, opts ...grpc.CallOption) (*UploadSuppressionEntriesResponse, error) {
+	out := new(UploadSuppressionEntriesResponse)
+	err := c.cc.Invoke(ctx, CDPService_UploadSuppressionEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) DeleteSuppressionList(ctx context.Context, in *DeleteSuppressionListRequest, opts ...grpc.CallOption) (*DeleteSuppressionListResponse, error) {
+	out := new(DeleteSuppressionListResponse)
+	err := c.cc.Invoke(ctx, CDPService_DeleteSuppressionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, CDPService_SetRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListRetentionPolicies(ctx context.Context, in *GetListRetentionPoliciesRequest, opts ...grpc.CallOption) (*GetListRetentionPoliciesResponse, error) {
+	out := new(GetListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListRetentionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error) {
+	out := new(DeleteRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, CDPService_DeleteRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListSegmentSnapshots(ctx context.Context, in *GetListSegmentSnapshotsRequest, opts ...grpc.CallOption) (*GetListSegmentSnapshotsResponse, error) {
+	out := new(GetListSegmentSnapshotsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListSegmentSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetSegmentMembershipChanges(ctx context.Context, in *GetSegmentMembershipChangesRequest, opts ...grpc.CallOption) (*GetSegmentMembershipChangesResponse, error) {
+	out := new(GetSegmentMembershipChangesResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetSegmentMembershipChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetSegmentSizeHistory(ctx context.Context, in *GetSegmentSizeHistoryRequest, opts ...grpc.CallOption) (*GetSegmentSizeHistoryResponse, error) {
+	out := new(GetSegmentSizeHistoryResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetSegmentSizeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) AnalyzeSegmentOverlap(ctx context.Context, in *AnalyzeSegmentOverlapRequest, opts ...grpc.CallOption) (*AnalyzeSegmentOverlapResponse, error) {
+	out := new(AnalyzeSegmentOverlapResponse)
+	err := c.cc.Invoke(ctx, CDPService_AnalyzeSegmentOverlap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetSegmentInsights(ctx context.Context, in *GetSegmentInsightsRequest, opts ...grpc.CallOption) (*GetSegmentInsightsResponse, error) {
+	out := new(GetSegmentInsightsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetSegmentInsights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) CreateRFMAnalysis(ctx context.Context, in *CreateRFMAnalysisRequest, opts ...grpc.CallOption) (*CreateRFMAnalysisResponse, error) {
+	out := new(CreateRFMAnalysisResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateRFMAnalysis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListRFMAnalyses(ctx context.Context, in *GetListRFMAnalysesRequest, opts ...grpc.CallOption) (*GetListRFMAnalysesResponse, error) {
+	out := new(GetListRFMAnalysesResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListRFMAnalyses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetCohortRetention(ctx context.Context, in *GetCohortRetentionRequest, opts ...grpc.CallOption) (*GetCohortRetentionResponse, error) {
+	out := new(GetCohortRetentionResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetCohortRetention_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) CreateFunnel(ctx context.Context, in *CreateFunnelRequest, opts ...grpc.CallOption) (*CreateFunnelResponse, error) {
+	out := new(CreateFunnelResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateFunnel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListFunnels(ctx context.Context, in *GetListFunnelsRequest, opts ...grpc.CallOption) (*GetListFunnelsResponse, error) {
+	out := new(GetListFunnelsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListFunnels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) DeleteFunnel(ctx context.Context, in *DeleteFunnelRequest, opts ...grpc.CallOption) (*DeleteFunnelResponse, error) {
+	out := new(DeleteFunnelResponse)
+	err := c.cc.Invoke(ctx, CDPService_DeleteFunnel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) ComputeFunnel(ctx context.Context, in *ComputeFunnelRequest, opts ...grpc.CallOption) (*ComputeFunnelResponse, error) {
+	out := new(ComputeFunnelResponse)
+	err := c.cc.Invoke(ctx, CDPService_ComputeFunnel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) CreateFunnelDropOffSegment(ctx context.Context, in *CreateFunnelDropOffSegmentRequest, opts ...grpc.CallOption) (*CreateFunnelDropOffSegmentResponse, error) {
+	out := new(CreateFunnelDropOffSegmentResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateFunnelDropOffSegment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) CreateSegmentSplit(ctx context.Context, in *CreateSegmentSplitRequest, opts ...grpc.CallOption) (*CreateSegmentSplitResponse, error) {
+	out := new(CreateSegmentSplitResponse)
+	err := c.cc.Invoke(ctx, CDPService_CreateSegmentSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) GetListSegmentSplits(ctx context.Context, in *GetListSegmentSplitsRequest, opts ...grpc.CallOption) (*GetListSegmentSplitsResponse, error) {
+	out := new(GetListSegmentSplitsResponse)
+	err := c.cc.Invoke(ctx, CDPService_GetListSegmentSplits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDPServiceClient) CompareSegmentSplitArms(ctx context.Context, in *CompareSegmentSplitArmsRequest, opts ...grpc.CallOption) (*CompareSegmentSplitArmsResponse, error) {
+	out := new(CompareSegmentSplitArmsResponse)
+	err := c.cc.Invoke(ctx, CDPService_CompareSegmentSplitArms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CDPServiceServer is the server API for CDPService service.
+// All implementations must embed UnimplementedCDPServiceServer
+// for forward compatibility
+type CDPServiceServer interface {
+	CheckHealth(context.Context, *CheckHealthRequest) (*CommonResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	SignUp(context.Context, *SignUpRequest) (*CommonResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	UpdateAccountInfo(context.Context, *UpdateAccountInfoRequest) (*UpdateAccountInfoResponse, error)
+	UpdateAccountSetting(context.Context, *UpdateAccountSettingRequest) (*UpdateAccountSettingResponse, error)
+	GetListDataSources(context.Context, *GetListDataSourcesRequest) (*GetListDataSourcesResponse, error)
+	GetDataSource(context.Context, *GetDataSourceRequest) (*GetDataSourceResponse, error)
+	GetListDataTables(context.Context, *GetListDataTablesRequest) (*GetListDataTablesResponse, error)
+	GetDataTable(context.Context, *GetDataTableRequest) (*GetDataTableResponse, error)
+	GetQueryDataTable(context.Context, *GetQueryDataTableRequest) (*GetQueryDataTableResponse, error)
+	GetConnection(context.Context, *GetConnectionRequest) (*GetConnectionResponse, error)
+	GetListConnections(context.Context, *GetListConnectionsRequest) (*GetListConnectionsResponse, error)
+	CreateConnection(context.Context, *CreateConnectionRequest) (*CreateConnectionResponse, error)
+	UpdateConnection(context.Context, *UpdateConnectionRequest) (*UpdateConnectionResponse, error)
+	DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error)
+	ExportDataToFile(context.Context, *ExportDataToFileRequest) (*ExportDataToFileResponse, error)
+	ImportCsvFromS3(context.Context, *ImportCsvFromS3Request) (*ImportCsvFromS3Response, error)
+	GetListFileExportRecords(context.Context, *GetListFileExportRecordsRequest) (*GetListFileExportRecordsResponse, error)
+	CreateMasterSegment(context.Context, *CreateMasterSegmentRequest) (*CreateMasterSegmentResponse, error)
+	GetListMasterSegments(context.Context, *GetListMasterSegmentsRequest) (*GetListMasterSegmentsResponse, error)
+	GetMasterSegmentDetail(context.Context, *GetMasterSegmentDetailRequest) (*GetMasterSegmentDetailResponse, error)
+	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
+	GetListSegments(context.Context, *GetListSegmentsRequest) (*GetListSegmentsResponse, error)
+	GetSegmentDetail(context.Context, *GetSegmentDetailRequest) (*GetSegmentDetailResponse, error)
+	CreateGophishUserGroupFromSegment(context.Context, *CreateGophishUserGroupFromSegmentRequest) (*CreateGophishUserGroupFromSegmentResponse, error)
+	ImportFromMySQLSource(context.Context, *ImportFromMySQLSourceRequest) (*ImportFromMySQLSourceResponse, error)
+	ExportToMySQLDestination(context.Context, *ExportToMySQLDestinationRequest) (*ExportToMySQLDestinationResponse, error)
+	GetListDataDestinations(context.Context, *GetListDataDestinationsRequest) (*GetListDataDestinationsResponse, error)
+	GetListDataActionRuns(context.Context, *GetListDataActionRunsRequest) (*GetListDataActionRunsResponse, error)
+	TrainPredictModel(context.Context, *TrainPredictModelRequest) (*TrainPredictModelResponse, error)
+	GetListPredictModels(context.Context, *GetListPredictModelsRequest) (*GetListPredictModelsResponse, error)
+	GetListSourceTableMap(context.Context, *GetListSourceTableMapRequest) (*GetListSourceTableMapResponse, error)
+	GetDataDestinationDetail(context.Context, *GetDataDestinationDetailRequest) (*GetDataDestinationDetailResponse, error)
+	GetPredictModelDetail(context.Context, *GetPredictModelDetailRequest) (*GetPredictModelDetailResponse, error)
+	GetMySQLTableSchema(context.Context, *GetMySQLTableSchemaRequest) (*GetMySQLTableSchemaResponse, error)
+	GetListMasterSegmentProfiles(context.Context, *GetListMasterSegmentProfilesRequest) (*GetListMasterSegmentProfilesResponse, error)
+	ApplyPredictModel(context.Context, *ApplyPredictModelRequest) (*ApplyPredictModelResponse, error)
+	GetListPredictionActions(context.Context, *GetListPredictionActionsRequest) (*GetListPredictionActionsResponse, error)
+	TriggerDataActionRun(context.Context, *TriggerDataActionRunRequest) (*TriggerDataActionRunResponse, error)
+	GetMasterSegmentProfile(context.Context, *GetMasterSegmentProfileRequest) (*GetMasterSegmentProfileResponse, error)
+	GetResultPredictionActions(context.Context, *GetResultPredictionActionsRequest) (*GetResultPredictionActionsResponse, error)
+	GetDataActionRunsPerDay(context.Context, *GetDataActionRunsPerDayRequest) (*GetDataActionRunsPerDayResponse, error)
+	GetDataRunsProportion(context.Context, *GetDataRunsProportionRequest) (*GetDataRunsProportionResponse, error)
+	GetBehaviorProfile(context.Context, *GetBehaviorProfileRequest) (*GetBehaviorProfileResponse, error)
+	GetListDestinationMap(context.Context, *GetListDestinationMapRequest) (*GetListDestinationMapResponse, error)
+	TotalProfilesMasterSegment(context.Context, *TotalProfilesMasterSegmentRequest) (*TotalProfilesMasterSegmentResponse, error)
+	PreviewFile(context.Context, *PreviewFileRequest) (*PreviewFileResponse, error)
+	UpdateDataTableQualityRules(context.Context, *UpdateDataTableQualityRulesRequest) (*UpdateDataTableQualityRulesResponse, error)
+	CreateIdentityResolution(context.Context, *CreateIdentityResolutionRequest) (*CreateIdentityResolutionResponse, error)
+	GetListIdentityResolutions(context.Context, *GetListIdentityResolutionsRequest) (*GetListIdentityResolutionsResponse, error)
+	GetIdentityResolutionDetail(context.Context, *GetIdentityResolutionDetailRequest) (*GetIdentityResolutionDetailResponse, error)
+	GetIdentityProfileHistory(context.Context, *GetIdentityProfileHistoryRequest) (*GetIdentityProfileHistoryResponse, error)
+	DeduplicateDataTable(context.Context, *DeduplicateDataTableRequest) (*DeduplicateDataTableResponse, error)
+	CreateEventSource(context.Context, *CreateEventSourceRequest) (*CreateEventSourceResponse, error)
+	GetListEventSources(context.Context, *GetListEventSourcesRequest) (*GetListEventSourcesResponse, error)
+	Track(context.Context, *TrackRequest) (*IngestEventResponse, error)
+	Identify(context.Context, *IdentifyRequest) (*IngestEventResponse, error)
+	BatchTrack(context.Context, *BatchTrackRequest) (*IngestEventResponse, error)
+	UpdateDataTableSchemaPolicy(context.Context, *UpdateDataTableSchemaPolicyRequest) (*UpdateDataTableSchemaPolicyResponse, error)
+	GetListDataTableSchemaVersions(context.Context, *GetListDataTableSchemaVersionsRequest) (*GetListDataTableSchemaVersionsResponse, error)
+	GetListDataTableVersions(context.Context, *GetListDataTableVersionsRequest) (*GetListDataTableVersionsResponse, error)
+	RestoreDataTable(context.Context, *RestoreDataTableRequest) (*RestoreDataTableResponse, error)
+	DiffDataTableVersions(context.Context, *DiffDataTableVersionsRequest) (*DiffDataTableVersionsResponse, error)
+	ProfileDataTable(context.Context, *ProfileDataTableRequest) (*ProfileDataTableResponse, error)
+	GetDataTableStatistics(context.Context, *GetDataTableStatisticsRequest) (*GetDataTableStatisticsResponse, error)
+	ProfileMasterSegment(context.Context, *ProfileMasterSegmentRequest) (*ProfileMasterSegmentResponse, error)
+	GetMasterSegmentStatistics(context.Context, *GetMasterSegmentStatisticsRequest) (*GetMasterSegmentStatisticsResponse, error)
+	UpdateMasterSegmentComputedAttributes(context.Context, *UpdateMasterSegmentComputedAttributesRequest) (*UpdateMasterSegmentComputedAttributesResponse, error)
+	RefreshMasterSegment(context.Context, *RefreshMasterSegmentRequest) (*RefreshMasterSegmentResponse, error)
+	GetCustomerProfile(context.Context, *GetCustomerProfileRequest) (*GetCustomerProfileResponse, error)
+	UpdateMasterSegmentSearchableColumns(context.Context, *UpdateMasterSegmentSearchableColumnsRequest) (*UpdateMasterSegmentSearchableColumnsResponse, error)
+	SearchProfiles(context.Context, *SearchProfilesRequest) (*SearchProfilesResponse, error)
+	SuggestColumnTags(context.Context, *SuggestColumnTagsRequest) (*SuggestColumnTagsResponse, error)
+	UpdateColumnTags(context.Context, *UpdateColumnTagsRequest) (*UpdateColumnTagsResponse, error)
+	GetColumnTags(context.Context, *GetColumnTagsRequest) (*GetColumnTagsResponse, error)
+	UpdateMaskingPolicies(context.Context, *UpdateMaskingPoliciesRequest) (*UpdateMaskingPoliciesResponse, error)
+	GetMaskingPolicies(context.Context, *GetMaskingPoliciesRequest) (*GetMaskingPoliciesResponse, error)
+	ErasePerson(context.Context, *ErasePersonRequest) (*ErasePersonResponse, error)
+	GetListErasureCertificates(context.Context, *GetListErasureCertificatesRequest) (*GetListErasureCertificatesResponse, error)
+	GetErasureCertificate(context.Context, *GetErasureCertificateRequest) (*GetErasureCertificateResponse, error)
+	UpdateMasterSegmentConsentColumns(context.Context, *UpdateMasterSegmentConsentColumnsRequest) (*UpdateMasterSegmentConsentColumnsResponse, error)
+	CreateSuppressionList(context.Context, *CreateSuppressionListRequest) (*CreateSuppressionListResponse, error)
+	GetListSuppressionLists(context.Context, *GetListSuppressionListsRequest) (*GetListSuppressionListsResponse, error)
+	UploadSuppressionEntries(context.Context, *UploadSuppressionEntriesRequest) (*UploadSuppressionEntriesResponse, error)
+	DeleteSuppressionList(context.Context, *DeleteSuppressionListRequest) (*DeleteSuppressionListResponse, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	GetListRetentionPolicies(context.Context, *GetListRetentionPoliciesRequest) (*GetListRetentionPoliciesResponse, error)
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error)
+	GetListSegmentSnapshots(context.Context, *GetListSegmentSnapshotsRequest) (*GetListSegmentSnapshotsResponse, error)
+	GetSegmentMembershipChanges(context.Context, *GetSegmentMembershipChangesRequest) (*GetSegmentMembershipChangesResponse, error)
+	GetSegmentSizeHistory(context.Context, *GetSegmentSizeHistoryRequest) (*GetSegmentSizeHistoryResponse, error)
+	AnalyzeSegmentOverlap(context.Context, *AnalyzeSegmentOverlapRequest) (*AnalyzeSegmentOverlapResponse, error)
+	GetSegmentInsights(context.Context, *GetSegmentInsightsRequest) (*GetSegmentInsightsResponse, error)
+	CreateRFMAnalysis(context.Context, *CreateRFMAnalysisRequest) (*CreateRFMAnalysisResponse, error)
+	GetListRFMAnalyses(context.Context, *GetListRFMAnalysesRequest) (*GetListRFMAnalysesResponse, error)
+	GetCohortRetention(context.Context, *GetCohortRetentionRequest) (*GetCohortRetentionResponse, error)
+	CreateFunnel(context.Context, *CreateFunnelRequest) (*CreateFunnelResponse, error)
+	GetListFunnels(context.Context, *GetListFunnelsRequest) (*GetListFunnelsResponse, error)
+	DeleteFunnel(context.Context, *DeleteFunnelRequest) (*DeleteFunnelResponse, error)
+	ComputeFunnel(context.Context, *ComputeFunnelRequest) (*ComputeFunnelResponse, error)
+	CreateFunnelDropOffSegment(context.Context, *CreateFunnelDropOffSegmentRequest) (*CreateFunnelDropOffSegmentResponse, error)
+	CreateSegmentSplit(context.Context, *CreateSegmentSplitRequest) (*CreateSegmentSplitResponse, error)
+	GetListSegmentSplits(context.Context, *GetListSegmentSplitsRequest) (*GetListSegmentSplitsResponse, error)
+	CompareSegmentSplitArms(context.Context, *CompareSegmentSplitArmsRequest) (*CompareSegmentSplitArmsResponse, error)
+	mustEmbedUnimplementedCDPServiceServer()
+}
+
+// UnimplementedCDPServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCDPServiceServer struct {
+}
+
+func (UnimplementedCDPServiceServer) CheckHealth(context.Context, *CheckHealthRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (UnimplementedCDPServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedCDPServiceServer) SignUp(context.Context, *SignUpRequest) (*CommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
+}
+func (UnimplementedCDPServiceServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateAccountInfo(context.Context, *UpdateAccountInfoRequest) (*UpdateAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateAccountSetting(context.Context, *UpdateAccountSettingRequest) (*UpdateAccountSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountSetting not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListDataSources(context.Context, *GetListDataSourcesRequest) (*GetListDataSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListDataSources not implemented")
+}
+func (UnimplementedCDPServiceServer) GetDataSource(context.Context, *GetDataSourceRequest) (*GetDataSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataSource not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListDataTables(context.Context, *GetListDataTablesRequest) (*GetListDataTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListDataTables not implemented")
+}
+func (UnimplementedCDPServiceServer) GetDataTable(context.Context, *GetDataTableRequest) (*GetDataTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataTable not implemented")
+}
+func (UnimplementedCDPServiceServer) GetQueryDataTable(context.Context, *GetQueryDataTableRequest) (*GetQueryDataTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryDataTable not implemented")
+}
+func (UnimplementedCDPServiceServer) GetConnection(context.Context, *GetConnectionRequest) (*GetConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnection not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListConnections(context.Context, *GetListConnectionsRequest) (*GetListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListConnections not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateConnection(context.Context, *CreateConnectionRequest) (*CreateConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConnection not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateConnection(context.Context, *UpdateConnectionRequest) (*UpdateConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnection not implemented")
+}
+func (UnimplementedCDPServiceServer) DeleteConnection(context.Context, *DeleteConnectionRequest) (*DeleteConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnection not implemented")
+}
+func (UnimplementedCDPServiceServer) ExportDataToFile(context.Context, *ExportDataToFileRequest) (*ExportDataToFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDataToFile not implemented")
+}
+func (UnimplementedCDPServiceServer) ImportCsvFromS3(context.Context, *ImportCsvFromS3Request) (*ImportCsvFromS3Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCsvFromS3 not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListFileExportRecords(context.Context, *GetListFileExportRecordsRequest) (*GetListFileExportRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListFileExportRecords not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateMasterSegment(context.Context, *CreateMasterSegmentRequest) (*CreateMasterSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMasterSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListMasterSegments(context.Context, *GetListMasterSegmentsRequest) (*GetListMasterSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListMasterSegments not implemented")
+}
+func (UnimplementedCDPServiceServer) GetMasterSegmentDetail(context.Context, *GetMasterSegmentDetailRequest) (*GetMasterSegmentDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterSegmentDetail not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListSegments(context.Context, *GetListSegmentsRequest) (*GetListSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSegments not implemented")
+}
+func (UnimplementedCDPServiceServer) GetSegmentDetail(context.Context, *GetSegmentDetailRequest) (*GetSegmentDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentDetail not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateGophishUserGroupFromSegment(context.Context, *CreateGophishUserGroupFromSegmentRequest) (*CreateGophishUserGroupFromSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGophishUserGroupFromSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) ImportFromMySQLSource(context.Context, *ImportFromMySQLSourceRequest) (*ImportFromMySQLSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromMySQLSource not implemented")
+}
+func (UnimplementedCDPServiceServer) ExportToMySQLDestination(context.Context, *ExportToMySQLDestinationRequest) (*ExportToMySQLDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportToMySQLDestination not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListDataDestinations(context.Context, *GetListDataDestinationsRequest) (*GetListDataDestinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListDataDestinations not implemented")
 }
 func (UnimplementedCDPServiceServer) GetListDataActionRuns(context.Context, *GetListDataActionRunsRequest) (*GetListDataActionRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetListDataActionRuns not implemented")
 }
-func (UnimplementedCDPServiceServer) TrainPredictModel(context.Context, *TrainPredictModelRequest) (*TrainPredictModelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TrainPredictModel not implemented")
+func (UnimplementedCDPServiceServer) TrainPredictModel(context.Context, *TrainPredictModelRequest) (*TrainPredictModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainPredictModel not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListPredictModels(context.Context, *GetListPredictModelsRequest) (*GetListPredictModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPredictModels not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListSourceTableMap(context.Context, *GetListSourceTableMapRequest) (*GetListSourceTableMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSourceTableMap not implemented")
+}
+func (UnimplementedCDPServiceServer) GetDataDestinationDetail(context.Context, *GetDataDestinationDetailRequest) (*GetDataDestinationDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataDestinationDetail not implemented")
+}
+func (UnimplementedCDPServiceServer) GetPredictModelDetail(context.Context, *GetPredictModelDetailRequest) (*GetPredictModelDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPredictModelDetail not implemented")
+}
+func (UnimplementedCDPServiceServer) GetMySQLTableSchema(context.Context, *GetMySQLTableSchemaRequest) (*GetMySQLTableSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMySQLTableSchema not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListMasterSegmentProfiles(context.Context, *GetListMasterSegmentProfilesRequest) (*GetListMasterSegmentProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListMasterSegmentProfiles not implemented")
+}
+func (UnimplementedCDPServiceServer) ApplyPredictModel(context.Context, *ApplyPredictModelRequest) (*ApplyPredictModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPredictModel not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListPredictionActions(context.Context, *GetListPredictionActionsRequest) (*GetListPredictionActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPredictionActions not implemented")
+}
+func (UnimplementedCDPServiceServer) TriggerDataActionRun(context.Context, *TriggerDataActionRunRequest) (*TriggerDataActionRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerDataActionRun not implemented")
+}
+func (UnimplementedCDPServiceServer) GetMasterSegmentProfile(context.Context, *GetMasterSegmentProfileRequest) (*GetMasterSegmentProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterSegmentProfile not implemented")
+}
+func (UnimplementedCDPServiceServer) GetResultPredictionActions(context.Context, *GetResultPredictionActionsRequest) (*GetResultPredictionActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResultPredictionActions not implemented")
+}
+func (UnimplementedCDPServiceServer) GetDataActionRunsPerDay(context.Context, *GetDataActionRunsPerDayRequest) (*GetDataActionRunsPerDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataActionRunsPerDay not implemented")
+}
+func (UnimplementedCDPServiceServer) GetDataRunsProportion(context.Context, *GetDataRunsProportionRequest) (*GetDataRunsProportionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataRunsProportion not implemented")
+}
+func (UnimplementedCDPServiceServer) GetBehaviorProfile(context.Context, *GetBehaviorProfileRequest) (*GetBehaviorProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBehaviorProfile not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListDestinationMap(context.Context, *GetListDestinationMapRequest) (*GetListDestinationMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListDestinationMap not implemented")
+}
+func (UnimplementedCDPServiceServer) TotalProfilesMasterSegment(context.Context, *TotalProfilesMasterSegmentRequest) (*TotalProfilesMasterSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalProfilesMasterSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) PreviewFile(context.Context, *PreviewFileRequest) (*PreviewFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewFile not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateDataTableQualityRules(context.Context, *UpdateDataTableQualityRulesRequest) (*UpdateDataTableQualityRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataTableQualityRules not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateIdentityResolution(context.Context, *CreateIdentityResolutionRequest) (*CreateIdentityResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIdentityResolution not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListIdentityResolutions(context.Context, *GetListIdentityResolutionsRequest) (*GetListIdentityResolutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListIdentityResolutions not implemented")
+}
+func (UnimplementedCDPServiceServer) GetIdentityResolutionDetail(context.Context, *GetIdentityResolutionDetailRequest) (*GetIdentityResolutionDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityResolutionDetail not implemented")
+}
+func (UnimplementedCDPServiceServer) GetIdentityProfileHistory(context.Context, *GetIdentityProfileHistoryRequest) (*GetIdentityProfileHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityProfileHistory not implemented")
+}
+func (UnimplementedCDPServiceServer) DeduplicateDataTable(context.Context, *DeduplicateDataTableRequest) (*DeduplicateDataTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeduplicateDataTable not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateEventSource(context.Context, *CreateEventSourceRequest) (*CreateEventSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventSource not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListEventSources(context.Context, *GetListEventSourcesRequest) (*GetListEventSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListEventSources not implemented")
+}
+func (UnimplementedCDPServiceServer) Track(context.Context, *TrackRequest) (*IngestEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Track not implemented")
+}
+func (UnimplementedCDPServiceServer) Identify(context.Context, *IdentifyRequest) (*IngestEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identify not implemented")
+}
+func (UnimplementedCDPServiceServer) BatchTrack(context.Context, *BatchTrackRequest) (*IngestEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTrack not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateDataTableSchemaPolicy(context.Context, *UpdateDataTableSchemaPolicyRequest) (*UpdateDataTableSchemaPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataTableSchemaPolicy not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListDataTableSchemaVersions(context.Context, *GetListDataTableSchemaVersionsRequest) (*GetListDataTableSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListDataTableSchemaVersions not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListDataTableVersions(context.Context, *GetListDataTableVersionsRequest) (*GetListDataTableVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListDataTableVersions not implemented")
+}
+func (UnimplementedCDPServiceServer) RestoreDataTable(context.Context, *RestoreDataTableRequest) (*RestoreDataTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDataTable not implemented")
+}
+func (UnimplementedCDPServiceServer) DiffDataTableVersions(context.Context, *DiffDataTableVersionsRequest) (*DiffDataTableVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffDataTableVersions not implemented")
+}
+func (UnimplementedCDPServiceServer) ProfileDataTable(context.Context, *ProfileDataTableRequest) (*ProfileDataTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileDataTable not implemented")
+}
+func (UnimplementedCDPServiceServer) GetDataTableStatistics(context.Context, *GetDataTableStatisticsRequest) (*GetDataTableStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataTableStatistics not implemented")
+}
+func (UnimplementedCDPServiceServer) ProfileMasterSegment(context.Context, *ProfileMasterSegmentRequest) (*ProfileMasterSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileMasterSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) GetMasterSegmentStatistics(context.Context, *GetMasterSegmentStatisticsRequest) (*GetMasterSegmentStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterSegmentStatistics not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateMasterSegmentComputedAttributes(context.Context, *UpdateMasterSegmentComputedAttributesRequest) (*UpdateMasterSegmentComputedAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMasterSegmentComputedAttributes not implemented")
+}
+func (UnimplementedCDPServiceServer) RefreshMasterSegment(context.Context, *RefreshMasterSegmentRequest) (*RefreshMasterSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshMasterSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) GetCustomerProfile(context.Context, *GetCustomerProfileRequest) (*GetCustomerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerProfile not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateMasterSegmentSearchableColumns(context.Context, *UpdateMasterSegmentSearchableColumnsRequest) (*UpdateMasterSegmentSearchableColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMasterSegmentSearchableColumns not implemented")
+}
+func (UnimplementedCDPServiceServer) SearchProfiles(context.Context, *SearchProfilesRequest) (*SearchProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProfiles not implemented")
+}
+func (UnimplementedCDPServiceServer) SuggestColumnTags(context.Context, *SuggestColumnTagsRequest) (*SuggestColumnTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestColumnTags not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateColumnTags(context.Context, *UpdateColumnTagsRequest) (*UpdateColumnTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateColumnTags not implemented")
+}
+func (UnimplementedCDPServiceServer) GetColumnTags(context.Context, *GetColumnTagsRequest) (*GetColumnTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetColumnTags not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateMaskingPolicies(context.Context, *UpdateMaskingPoliciesRequest) (*UpdateMaskingPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaskingPolicies not implemented")
+}
+func (UnimplementedCDPServiceServer) GetMaskingPolicies(context.Context, *GetMaskingPoliciesRequest) (*GetMaskingPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaskingPolicies not implemented")
+}
+func (UnimplementedCDPServiceServer) ErasePerson(context.Context, *ErasePersonRequest) (*ErasePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ErasePerson not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListErasureCertificates(context.Context, *GetListErasureCertificatesRequest) (*GetListErasureCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListErasureCertificates not implemented")
+}
+func (UnimplementedCDPServiceServer) GetErasureCertificate(context.Context, *GetErasureCertificateRequest) (*GetErasureCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErasureCertificate not implemented")
+}
+func (UnimplementedCDPServiceServer) UpdateMasterSegmentConsentColumns(context.Context, *UpdateMasterSegmentConsentColumnsRequest) (*UpdateMasterSegmentConsentColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMasterSegmentConsentColumns not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateSuppressionList(context.Context, *CreateSuppressionListRequest) (*CreateSuppressionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSuppressionList not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListSuppressionLists(context.Context, *GetListSuppressionListsRequest) (*GetListSuppressionListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSuppressionLists not implemented")
+}
+func (UnimplementedCDPServiceServer) UploadSuppressionEntries(context.Context, *UploadSuppressionEntriesRequest) (*UploadSuppressionEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadSuppressionEntries not implemented")
+}
+func (UnimplementedCDPServiceServer) DeleteSuppressionList(context.Context, *DeleteSuppressionListRequest) (*DeleteSuppressionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSuppressionList not implemented")
+}
+func (UnimplementedCDPServiceServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListRetentionPolicies(context.Context, *GetListRetentionPoliciesRequest) (*GetListRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListRetentionPolicies not implemented")
+}
+func (UnimplementedCDPServiceServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListSegmentSnapshots(context.Context, *GetListSegmentSnapshotsRequest) (*GetListSegmentSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSegmentSnapshots not implemented")
+}
+func (UnimplementedCDPServiceServer) GetSegmentMembershipChanges(context.Context, *GetSegmentMembershipChangesRequest) (*GetSegmentMembershipChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentMembershipChanges not implemented")
+}
+func (UnimplementedCDPServiceServer) GetSegmentSizeHistory(context.Context, *GetSegmentSizeHistoryRequest) (*GetSegmentSizeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentSizeHistory not implemented")
+}
+func (UnimplementedCDPServiceServer) AnalyzeSegmentOverlap(context.Context, *AnalyzeSegmentOverlapRequest) (*AnalyzeSegmentOverlapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeSegmentOverlap not implemented")
+}
+func (UnimplementedCDPServiceServer) GetSegmentInsights(context.Context, *GetSegmentInsightsRequest) (*GetSegmentInsightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInsights not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateRFMAnalysis(context.Context, *CreateRFMAnalysisRequest) (*CreateRFMAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRFMAnalysis not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListRFMAnalyses(context.Context, *GetListRFMAnalysesRequest) (*GetListRFMAnalysesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListRFMAnalyses not implemented")
+}
+func (UnimplementedCDPServiceServer) GetCohortRetention(context.Context, *GetCohortRetentionRequest) (*GetCohortRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCohortRetention not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateFunnel(context.Context, *CreateFunnelRequest) (*CreateFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunnel not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListFunnels(context.Context, *GetListFunnelsRequest) (*GetListFunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListFunnels not implemented")
+}
+func (UnimplementedCDPServiceServer) DeleteFunnel(context.Context, *DeleteFunnelRequest) (*DeleteFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFunnel not implemented")
+}
+func (UnimplementedCDPServiceServer) ComputeFunnel(context.Context, *ComputeFunnelRequest) (*ComputeFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeFunnel not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateFunnelDropOffSegment(context.Context, *CreateFunnelDropOffSegmentRequest) (*CreateFunnelDropOffSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunnelDropOffSegment not implemented")
+}
+func (UnimplementedCDPServiceServer) CreateSegmentSplit(context.Context, *CreateSegmentSplitRequest) (*CreateSegmentSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSegmentSplit not implemented")
+}
+func (UnimplementedCDPServiceServer) GetListSegmentSplits(context.Context, *GetListSegmentSplitsRequest) (*GetListSegmentSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSegmentSplits not implemented")
+}
+func (UnimplementedCDPServiceServer) CompareSegmentSplitArms(context.Context, *CompareSegmentSplitArmsRequest) (*CompareSegmentSplitArmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareSegmentSplitArms not implemented")
+}
+func (UnimplementedCDPServiceServer) mustEmbedUnimplementedCDPServiceServer() {}
+
+// UnsafeCDPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CDPServiceServer will
+// result in compilation errors.
+type UnsafeCDPServiceServer interface {
+	mustEmbedUnimplementedCDPServiceServer()
+}
+
+func RegisterCDPServiceServer(s grpc.ServiceRegistrar, srv CDPServiceServer) {
+	s.RegisterService(&CDPService_ServiceDesc, srv)
+}
+
+func _CDPService_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CheckHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CheckHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CheckHealth(ctx, req.(*CheckHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).SignUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_SignUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).SignUp(ctx, req.(*SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetAccountInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).UpdateAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_UpdateAccountInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).UpdateAccountInfo(ctx, req.(*UpdateAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_UpdateAccountSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).UpdateAccountSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_UpdateAccountSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).UpdateAccountSetting(ctx, req.(*UpdateAccountSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListDataSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDataSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListDataSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListDataSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListDataSources(ctx, req.(*GetListDataSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetDataSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetDataSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetDataSource(ctx, req.(*GetDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListDataTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDataTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListDataTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListDataTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListDataTables(ctx, req.(*GetListDataTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetDataTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetDataTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetDataTable(ctx, req.(*GetDataTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetQueryDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryDataTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetQueryDataTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetQueryDataTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetQueryDataTable(ctx, req.(*GetQueryDataTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetConnection(ctx, req.(*GetConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListConnections(ctx, req.(*GetListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_CreateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CreateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CreateConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CreateConnection(ctx, req.(*CreateConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_UpdateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).UpdateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_UpdateConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).UpdateConnection(ctx, req.(*UpdateConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_DeleteConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).DeleteConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_DeleteConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).DeleteConnection(ctx, req.(*DeleteConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_ExportDataToFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDataToFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).ExportDataToFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_ExportDataToFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).ExportDataToFile(ctx, req.(*ExportDataToFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_ImportCsvFromS3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCsvFromS3Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).ImportCsvFromS3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_ImportCsvFromS3_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).ImportCsvFromS3(ctx, req.(*ImportCsvFromS3Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListFileExportRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListFileExportRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListFileExportRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListFileExportRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListFileExportRecords(ctx, req.(*GetListFileExportRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_CreateMasterSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMasterSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CreateMasterSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CreateMasterSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CreateMasterSegment(ctx, req.(*CreateMasterSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListMasterSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListMasterSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListMasterSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListMasterSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListMasterSegments(ctx, req.(*GetListMasterSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetMasterSegmentDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterSegmentDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetMasterSegmentDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetMasterSegmentDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetMasterSegmentDetail(ctx, req.(*GetMasterSegmentDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CreateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListSegments(ctx, req.(*GetListSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetSegmentDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetSegmentDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetSegmentDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetSegmentDetail(ctx, req.(*GetSegmentDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_CreateGophishUserGroupFromSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGophishUserGroupFromSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CreateGophishUserGroupFromSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CreateGophishUserGroupFromSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CreateGophishUserGroupFromSegment(ctx, req.(*CreateGophishUserGroupFromSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_ImportFromMySQLSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFromMySQLSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).ImportFromMySQLSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_ImportFromMySQLSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).ImportFromMySQLSource(ctx, req.(*ImportFromMySQLSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_ExportToMySQLDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportToMySQLDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).ExportToMySQLDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_ExportToMySQLDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).ExportToMySQLDestination(ctx, req.(*ExportToMySQLDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListDataDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDataDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListDataDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListDataDestinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListDataDestinations(ctx, req.(*GetListDataDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListDataActionRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDataActionRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListDataActionRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListDataActionRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListDataActionRuns(ctx, req.(*GetListDataActionRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_TrainPredictModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrainPredictModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).TrainPredictModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_TrainPredictModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).TrainPredictModel(ctx, req.(*TrainPredictModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListPredictModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPredictModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListPredictModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListPredictModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListPredictModels(ctx, req.(*GetListPredictModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListSourceTableMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListSourceTableMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListSourceTableMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListSourceTableMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListSourceTableMap(ctx, req.(*GetListSourceTableMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetDataDestinationDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataDestinationDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetDataDestinationDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetDataDestinationDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetDataDestinationDetail(ctx, req.(*GetDataDestinationDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetListPredictModels(context.Context, *GetListPredictModelsRequest) (*GetListPredictModelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListPredictModels not implemented")
+
+func _CDPService_GetPredictModelDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPredictModelDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetPredictModelDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetPredictModelDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetPredictModelDetail(ctx, req.(*GetPredictModelDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetListSourceTableMap(context.Context, *GetListSourceTableMapRequest) (*GetListSourceTableMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListSourceTableMap not implemented")
+
+func _CDPService_GetMySQLTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMySQLTableSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetMySQLTableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetMySQLTableSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetMySQLTableSchema(ctx, req.(*GetMySQLTableSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetDataDestinationDetail(context.Context, *GetDataDestinationDetailRequest) (*GetDataDestinationDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataDestinationDetail not implemented")
+
+func _CDPService_GetListMasterSegmentProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListMasterSegmentProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListMasterSegmentProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListMasterSegmentProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListMasterSegmentProfiles(ctx, req.(*GetListMasterSegmentProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetPredictModelDetail(context.Context, *GetPredictModelDetailRequest) (*GetPredictModelDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPredictModelDetail not implemented")
+
+func _CDPService_ApplyPredictModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPredictModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).ApplyPredictModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_ApplyPredictModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).ApplyPredictModel(ctx, req.(*ApplyPredictModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetMySQLTableSchema(context.Context, *GetMySQLTableSchemaRequest) (*GetMySQLTableSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMySQLTableSchema not implemented")
+
+func _CDPService_GetListPredictionActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPredictionActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListPredictionActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListPredictionActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListPredictionActions(ctx, req.(*GetListPredictionActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetListMasterSegmentProfiles(context.Context, *GetListMasterSegmentProfilesRequest) (*GetListMasterSegmentProfilesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListMasterSegmentProfiles not implemented")
+
+func _CDPService_TriggerDataActionRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerDataActionRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).TriggerDataActionRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_TriggerDataActionRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).TriggerDataActionRun(ctx, req.(*TriggerDataActionRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) ApplyPredictModel(context.Context, *ApplyPredictModelRequest) (*ApplyPredictModelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplyPredictModel not implemented")
+
+func _CDPService_GetMasterSegmentProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterSegmentProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetMasterSegmentProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetMasterSegmentProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetMasterSegmentProfile(ctx, req.(*GetMasterSegmentProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetResultPredictionActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResultPredictionActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetResultPredictionActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetResultPredictionActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetResultPredictionActions(ctx, req.(*GetResultPredictionActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetDataActionRunsPerDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataActionRunsPerDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetDataActionRunsPerDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetDataActionRunsPerDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetDataActionRunsPerDay(ctx, req.(*GetDataActionRunsPerDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetDataRunsProportion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataRunsProportionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetDataRunsProportion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetDataRunsProportion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetDataRunsProportion(ctx, req.(*GetDataRunsProportionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetBehaviorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBehaviorProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetBehaviorProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetBehaviorProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetBehaviorProfile(ctx, req.(*GetBehaviorProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_GetListDestinationMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDestinationMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListDestinationMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListDestinationMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListDestinationMap(ctx, req.(*GetListDestinationMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDPService_TotalProfilesMasterSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalProfilesMasterSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).TotalProfilesMasterSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_TotalProfilesMasterSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).TotalProfilesMasterSegment(ctx, req.(*TotalProfilesMasterSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetListPredictionActions(context.Context, *GetListPredictionActionsRequest) (*GetListPredictionActionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListPredictionActions not implemented")
+
+func _CDPService_PreviewFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).PreviewFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_PreviewFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).PreviewFile(ctx, req.(*PreviewFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) TriggerDataActionRun(context.Context, *TriggerDataActionRunRequest) (*TriggerDataActionRunResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerDataActionRun not implemented")
+
+func _CDPService_UpdateDataTableQualityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDataTableQualityRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).UpdateDataTableQualityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_UpdateDataTableQualityRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).UpdateDataTableQualityRules(ctx, req.(*UpdateDataTableQualityRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetMasterSegmentProfile(context.Context, *GetMasterSegmentProfileRequest) (*GetMasterSegmentProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMasterSegmentProfile not implemented")
+
+func _CDPService_CreateIdentityResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIdentityResolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CreateIdentityResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CreateIdentityResolution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CreateIdentityResolution(ctx, req.(*CreateIdentityResolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetResultPredictionActions(context.Context, *GetResultPredictionActionsRequest) (*GetResultPredictionActionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetResultPredictionActions not implemented")
+
+func _CDPService_GetListIdentityResolutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListIdentityResolutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListIdentityResolutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListIdentityResolutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListIdentityResolutions(ctx, req.(*GetListIdentityResolutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetDataActionRunsPerDay(context.Context, *GetDataActionRunsPerDayRequest) (*GetDataActionRunsPerDayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataActionRunsPerDay not implemented")
+
+func _CDPService_GetIdentityResolutionDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityResolutionDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetIdentityResolutionDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetIdentityResolutionDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetIdentityResolutionDetail(ctx, req.(*GetIdentityResolutionDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetDataRunsProportion(context.Context, *GetDataRunsProportionRequest) (*GetDataRunsProportionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataRunsProportion not implemented")
+
+func _CDPService_GetIdentityProfileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityProfileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetIdentityProfileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetIdentityProfileHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetIdentityProfileHistory(ctx, req.(*GetIdentityProfileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetBehaviorProfile(context.Context, *GetBehaviorProfileRequest) (*GetBehaviorProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBehaviorProfile not implemented")
+
+func _CDPService_DeduplicateDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeduplicateDataTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).DeduplicateDataTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_DeduplicateDataTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).DeduplicateDataTable(ctx, req.(*DeduplicateDataTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) GetListDestinationMap(context.Context, *GetListDestinationMapRequest) (*GetListDestinationMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListDestinationMap not implemented")
+
+func _CDPService_CreateEventSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).CreateEventSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_CreateEventSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).CreateEventSource(ctx, req.(*CreateEventSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) TotalProfilesMasterSegment(context.Context, *TotalProfilesMasterSegmentRequest) (*TotalProfilesMasterSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalProfilesMasterSegment not implemented")
+
+func _CDPService_GetListEventSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListEventSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).GetListEventSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_GetListEventSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).GetListEventSources(ctx, req.(*GetListEventSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCDPServiceServer) mustEmbedUnimplementedCDPServiceServer() {}
 
-// UnsafeCDPServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CDPServiceServer will
-// result in compilation errors.
-type UnsafeCDPServiceServer interface {
-	mustEmbedUnimplementedCDPServiceServer()
+func _CDPService_Track_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).Track(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_Track_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).Track(ctx, req.(*TrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCDPServiceServer(s grpc.ServiceRegistrar, srv CDPServiceServer) {
-	s.RegisterService(&CDPService_ServiceDesc, srv)
+func _CDPService_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDPServiceServer).Identify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CDPService_Identify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDPServiceServer).Identify(ctx, req.(*IdentifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckHealthRequest)
+func _CDPService_BatchTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTrackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).CheckHealth(ctx, in)
+		return srv.(CDPServiceServer).BatchTrack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_CheckHealth_FullMethodName,
+		FullMethod: CDPService_BatchTrack_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).CheckHealth(ctx, req.(*CheckHealthRequest))
+		return srv.(CDPServiceServer).BatchTrack(ctx, req.(*BatchTrackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _CDPService_UpdateDataTableSchemaPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDataTableSchemaPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).Login(ctx, in)
+		return srv.(CDPServiceServer).UpdateDataTableSchemaPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_Login_FullMethodName,
+		FullMethod: CDPService_UpdateDataTableSchemaPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(CDPServiceServer).UpdateDataTableSchemaPolicy(ctx, req.(*UpdateDataTableSchemaPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignUpRequest)
+func _CDPService_GetListDataTableSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDataTableSchemaVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).SignUp(ctx, in)
+		return srv.(CDPServiceServer).GetListDataTableSchemaVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_SignUp_FullMethodName,
+		FullMethod: CDPService_GetListDataTableSchemaVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).SignUp(ctx, req.(*SignUpRequest))
+		return srv.(CDPServiceServer).GetListDataTableSchemaVersions(ctx, req.(*GetListDataTableSchemaVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _CDPService_GetListDataTableVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDataTableVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetAccountInfo(ctx, in)
+		return srv.(CDPServiceServer).GetListDataTableVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetAccountInfo_FullMethodName,
+		FullMethod: CDPService_GetListDataTableVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(CDPServiceServer).GetListDataTableVersions(ctx, req.(*GetListDataTableVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountInfoRequest)
+func _CDPService_RestoreDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDataTableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).UpdateAccountInfo(ctx, in)
+		return srv.(CDPServiceServer).RestoreDataTable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_UpdateAccountInfo_FullMethodName,
+		FullMethod: CDPService_RestoreDataTable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).UpdateAccountInfo(ctx, req.(*UpdateAccountInfoRequest))
+		return srv.(CDPServiceServer).RestoreDataTable(ctx, req.(*RestoreDataTableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_UpdateAccountSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountSettingRequest)
+func _CDPService_DiffDataTableVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffDataTableVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).UpdateAccountSetting(ctx, in)
+		return srv.(CDPServiceServer).DiffDataTableVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_UpdateAccountSetting_FullMethodName,
+		FullMethod: CDPService_DiffDataTableVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).UpdateAccountSetting(ctx, req.(*UpdateAccountSettingRequest))
+		return srv.(CDPServiceServer).DiffDataTableVersions(ctx, req.(*DiffDataTableVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListDataSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListDataSourcesRequest)
+func _CDPService_ProfileDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileDataTableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListDataSources(ctx, in)
+		return srv.(CDPServiceServer).ProfileDataTable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListDataSources_FullMethodName,
+		FullMethod: CDPService_ProfileDataTable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListDataSources(ctx, req.(*GetListDataSourcesRequest))
+		return srv.(CDPServiceServer).ProfileDataTable(ctx, req.(*ProfileDataTableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataSourceRequest)
+func _CDPService_GetDataTableStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataTableStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetDataSource(ctx, in)
+		return srv.(CDPServiceServer).GetDataTableStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetDataSource_FullMethodName,
+		FullMethod: CDPService_GetDataTableStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetDataSource(ctx, req.(*GetDataSourceRequest))
+		return srv.(CDPServiceServer).GetDataTableStatistics(ctx, req.(*GetDataTableStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListDataTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListDataTablesRequest)
+func _CDPService_ProfileMasterSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileMasterSegmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListDataTables(ctx, in)
+		return srv.(CDPServiceServer).ProfileMasterSegment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListDataTables_FullMethodName,
+		FullMethod: CDPService_ProfileMasterSegment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListDataTables(ctx, req.(*GetListDataTablesRequest))
+		return srv.(CDPServiceServer).ProfileMasterSegment(ctx, req.(*ProfileMasterSegmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataTableRequest)
+func _CDPService_GetMasterSegmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterSegmentStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetDataTable(ctx, in)
+		return srv.(CDPServiceServer).GetMasterSegmentStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetDataTable_FullMethodName,
+		FullMethod: CDPService_GetMasterSegmentStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetDataTable(ctx, req.(*GetDataTableRequest))
+		return srv.(CDPServiceServer).GetMasterSegmentStatistics(ctx, req.(*GetMasterSegmentStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetQueryDataTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQueryDataTableRequest)
+func _CDPService_UpdateMasterSegmentComputedAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMasterSegmentComputedAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetQueryDataTable(ctx, in)
+		return srv.(CDPServiceServer).UpdateMasterSegmentComputedAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetQueryDataTable_FullMethodName,
+		FullMethod: CDPService_UpdateMasterSegmentComputedAttributes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetQueryDataTable(ctx, req.(*GetQueryDataTableRequest))
+		return srv.(CDPServiceServer).UpdateMasterSegmentComputedAttributes(ctx, req.(*UpdateMasterSegmentComputedAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConnectionRequest)
+func _CDPService_RefreshMasterSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshMasterSegmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetConnection(ctx, in)
+		return srv.(CDPServiceServer).RefreshMasterSegment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetConnection_FullMethodName,
+		FullMethod: CDPService_RefreshMasterSegment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetConnection(ctx, req.(*GetConnectionRequest))
+		return srv.(CDPServiceServer).RefreshMasterSegment(ctx, req.(*RefreshMasterSegmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListConnectionsRequest)
+func _CDPService_GetCustomerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListConnections(ctx, in)
+		return srv.(CDPServiceServer).GetCustomerProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListConnections_FullMethodName,
+		FullMethod: CDPService_GetCustomerProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListConnections(ctx, req.(*GetListConnectionsRequest))
+		return srv.(CDPServiceServer).GetCustomerProfile(ctx, req.(*GetCustomerProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_CreateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConnectionRequest)
+func _CDPService_UpdateMasterSegmentSearchableColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMasterSegmentSearchableColumnsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).CreateConnection(ctx, in)
+		return srv.(CDPServiceServer).UpdateMasterSegmentSearchableColumns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_CreateConnection_FullMethodName,
+		FullMethod: CDPService_UpdateMasterSegmentSearchableColumns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).CreateConnection(ctx, req.(*CreateConnectionRequest))
+		return srv.(CDPServiceServer).UpdateMasterSegmentSearchableColumns(ctx, req.(*UpdateMasterSegmentSearchableColumnsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_UpdateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConnectionRequest)
+func _CDPService_SearchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProfilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).UpdateConnection(ctx, in)
+		return srv.(CDPServiceServer).SearchProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_UpdateConnection_FullMethodName,
+		FullMethod: CDPService_SearchProfiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).UpdateConnection(ctx, req.(*UpdateConnectionRequest))
+		return srv.(CDPServiceServer).SearchProfiles(ctx, req.(*SearchProfilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_DeleteConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConnectionRequest)
+func _CDPService_SuggestColumnTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestColumnTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).DeleteConnection(ctx, in)
+		return srv.(CDPServiceServer).SuggestColumnTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_DeleteConnection_FullMethodName,
+		FullMethod: CDPService_SuggestColumnTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).DeleteConnection(ctx, req.(*DeleteConnectionRequest))
+		return srv.(CDPServiceServer).SuggestColumnTags(ctx, req.(*SuggestColumnTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_ExportDataToFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportDataToFileRequest)
+func _CDPService_UpdateColumnTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateColumnTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).ExportDataToFile(ctx, in)
+		return srv.(CDPServiceServer).UpdateColumnTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_ExportDataToFile_FullMethodName,
+		FullMethod: CDPService_UpdateColumnTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).ExportDataToFile(ctx, req.(*ExportDataToFileRequest))
+		return srv.(CDPServiceServer).UpdateColumnTags(ctx, req.(*UpdateColumnTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_ImportCsvFromS3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportCsvFromS3Request)
+func _CDPService_GetColumnTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetColumnTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).ImportCsvFromS3(ctx, in)
+		return srv.(CDPServiceServer).GetColumnTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_ImportCsvFromS3_FullMethodName,
+		FullMethod: CDPService_GetColumnTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).ImportCsvFromS3(ctx, req.(*ImportCsvFromS3Request))
+		return srv.(CDPServiceServer).GetColumnTags(ctx, req.(*GetColumnTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListFileExportRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListFileExportRecordsRequest)
+func _CDPService_UpdateMaskingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaskingPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListFileExportRecords(ctx, in)
+		return srv.(CDPServiceServer).UpdateMaskingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListFileExportRecords_FullMethodName,
+		FullMethod: CDPService_UpdateMaskingPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListFileExportRecords(ctx, req.(*GetListFileExportRecordsRequest))
+		return srv.(CDPServiceServer).UpdateMaskingPolicies(ctx, req.(*UpdateMaskingPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_CreateMasterSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMasterSegmentRequest)
+func _CDPService_GetMaskingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaskingPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).CreateMasterSegment(ctx, in)
+		return srv.(CDPServiceServer).GetMaskingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_CreateMasterSegment_FullMethodName,
+		FullMethod: CDPService_GetMaskingPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).CreateMasterSegment(ctx, req.(*CreateMasterSegmentRequest))
+		return srv.(CDPServiceServer).GetMaskingPolicies(ctx, req.(*GetMaskingPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListMasterSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListMasterSegmentsRequest)
+func _CDPService_ErasePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ErasePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListMasterSegments(ctx, in)
+		return srv.(CDPServiceServer).ErasePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListMasterSegments_FullMethodName,
+		FullMethod: CDPService_ErasePerson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListMasterSegments(ctx, req.(*GetListMasterSegmentsRequest))
+		return srv.(CDPServiceServer).ErasePerson(ctx, req.(*ErasePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetMasterSegmentDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMasterSegmentDetailRequest)
+func _CDPService_GetListErasureCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListErasureCertificatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetMasterSegmentDetail(ctx, in)
+		return srv.(CDPServiceServer).GetListErasureCertificates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetMasterSegmentDetail_FullMethodName,
+		FullMethod: CDPService_GetListErasureCertificates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetMasterSegmentDetail(ctx, req.(*GetMasterSegmentDetailRequest))
+		return srv.(CDPServiceServer).GetListErasureCertificates(ctx, req.(*GetListErasureCertificatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSegmentRequest)
+func _CDPService_GetErasureCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetErasureCertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).CreateSegment(ctx, in)
+		return srv.(CDPServiceServer).GetErasureCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_CreateSegment_FullMethodName,
+		FullMethod: CDPService_GetErasureCertificate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+		return srv.(CDPServiceServer).GetErasureCertificate(ctx, req.(*GetErasureCertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListSegmentsRequest)
+func _CDPService_UpdateMasterSegmentConsentColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMasterSegmentConsentColumnsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListSegments(ctx, in)
+		return srv.(CDPServiceServer).UpdateMasterSegmentConsentColumns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListSegments_FullMethodName,
+		FullMethod: CDPService_UpdateMasterSegmentConsentColumns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListSegments(ctx, req.(*GetListSegmentsRequest))
+		return srv.(CDPServiceServer).UpdateMasterSegmentConsentColumns(ctx, req.(*UpdateMasterSegmentConsentColumnsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetSegmentDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentDetailRequest)
+func _CDPService_CreateSuppressionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSuppressionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetSegmentDetail(ctx, in)
+		return srv.(CDPServiceServer).CreateSuppressionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetSegmentDetail_FullMethodName,
+		FullMethod: CDPService_CreateSuppressionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetSegmentDetail(ctx, req.(*GetSegmentDetailRequest))
+		return srv.(CDPServiceServer).CreateSuppressionList(ctx, req.(*CreateSuppressionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_CreateGophishUserGroupFromSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGophishUserGroupFromSegmentRequest)
+func _CDPService_GetListSuppressionLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListSuppressionListsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).CreateGophishUserGroupFromSegment(ctx, in)
+		return srv.(CDPServiceServer).GetListSuppressionLists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_CreateGophishUserGroupFromSegment_FullMethodName,
+		FullMethod: CDPService_GetListSuppressionLists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).CreateGophishUserGroupFromSegment(ctx, req.(*CreateGophishUserGroupFromSegmentRequest))
+		return srv.(CDPServiceServer).GetListSuppressionLists(ctx, req.(*GetListSuppressionListsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_ImportFromMySQLSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportFromMySQLSourceRequest)
+func _CDPService_UploadSuppressionEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSuppressionEntriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).ImportFromMySQLSource(ctx, in)
+		return srv.(CDPServiceServer).UploadSuppressionEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_ImportFromMySQLSource_FullMethodName,
+		FullMethod: CDPService_UploadSuppressionEntries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).ImportFromMySQLSource(ctx, req.(*ImportFromMySQLSourceRequest))
+		return srv.(CDPServiceServer).UploadSuppressionEntries(ctx, req.(*UploadSuppressionEntriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_ExportToMySQLDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportToMySQLDestinationRequest)
+func _CDPService_DeleteSuppressionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSuppressionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).ExportToMySQLDestination(ctx, in)
+		return srv.(CDPServiceServer).DeleteSuppressionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_ExportToMySQLDestination_FullMethodName,
+		FullMethod: CDPService_DeleteSuppressionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).ExportToMySQLDestination(ctx, req.(*ExportToMySQLDestinationRequest))
+		return srv.(CDPServiceServer).DeleteSuppressionList(ctx, req.(*DeleteSuppressionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListDataDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListDataDestinationsRequest)
+func _CDPService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListDataDestinations(ctx, in)
+		return srv.(CDPServiceServer).SetRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListDataDestinations_FullMethodName,
+		FullMethod: CDPService_SetRetentionPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListDataDestinations(ctx, req.(*GetListDataDestinationsRequest))
+		return srv.(CDPServiceServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListDataActionRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListDataActionRunsRequest)
+func _CDPService_GetListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRetentionPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListDataActionRuns(ctx, in)
+		return srv.(CDPServiceServer).GetListRetentionPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListDataActionRuns_FullMethodName,
+		FullMethod: CDPService_GetListRetentionPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListDataActionRuns(ctx, req.(*GetListDataActionRunsRequest))
+		return srv.(CDPServiceServer).GetListRetentionPolicies(ctx, req.(*GetListRetentionPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_TrainPredictModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TrainPredictModelRequest)
+func _CDPService_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).TrainPredictModel(ctx, in)
+		return srv.(CDPServiceServer).DeleteRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_TrainPredictModel_FullMethodName,
+		FullMethod: CDPService_DeleteRetentionPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).TrainPredictModel(ctx, req.(*TrainPredictModelRequest))
+		return srv.(CDPServiceServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListPredictModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListPredictModelsRequest)
+func _CDPService_GetListSegmentSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListSegmentSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListPredictModels(ctx, in)
+		return srv.(CDPServiceServer).GetListSegmentSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListPredictModels_FullMethodName,
+		FullMethod: CDPService_GetListSegmentSnapshots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListPredictModels(ctx, req.(*GetListPredictModelsRequest))
+		return srv.(CDPServiceServer).GetListSegmentSnapshots(ctx, req.(*GetListSegmentSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListSourceTableMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListSourceTableMapRequest)
+func _CDPService_GetSegmentMembershipChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentMembershipChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListSourceTableMap(ctx, in)
+		return srv.(CDPServiceServer).GetSegmentMembershipChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListSourceTableMap_FullMethodName,
+		FullMethod: CDPService_GetSegmentMembershipChanges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListSourceTableMap(ctx, req.(*GetListSourceTableMapRequest))
+		return srv.(CDPServiceServer).GetSegmentMembershipChanges(ctx, req.(*GetSegmentMembershipChangesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetDataDestinationDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataDestinationDetailRequest)
+func _CDPService_GetSegmentSizeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentSizeHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetDataDestinationDetail(ctx, in)
+		return srv.(CDPServiceServer).GetSegmentSizeHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetDataDestinationDetail_FullMethodName,
+		FullMethod: CDPService_GetSegmentSizeHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetDataDestinationDetail(ctx, req.(*GetDataDestinationDetailRequest))
+		return srv.(CDPServiceServer).GetSegmentSizeHistory(ctx, req.(*GetSegmentSizeHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetPredictModelDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPredictModelDetailRequest)
+func _CDPService_AnalyzeSegmentOverlap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeSegmentOverlapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetPredictModelDetail(ctx, in)
+		return srv.(CDPServiceServer).AnalyzeSegmentOverlap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetPredictModelDetail_FullMethodName,
+		FullMethod: CDPService_AnalyzeSegmentOverlap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetPredictModelDetail(ctx, req.(*GetPredictModelDetailRequest))
+		return srv.(CDPServiceServer).AnalyzeSegmentOverlap(ctx, req.(*AnalyzeSegmentOverlapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetMySQLTableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMySQLTableSchemaRequest)
+func _CDPService_GetSegmentInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentInsightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetMySQLTableSchema(ctx, in)
+		return srv.(CDPServiceServer).GetSegmentInsights(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetMySQLTableSchema_FullMethodName,
+		FullMethod: CDPService_GetSegmentInsights_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetMySQLTableSchema(ctx, req.(*GetMySQLTableSchemaRequest))
+		return srv.(CDPServiceServer).GetSegmentInsights(ctx, req.(*GetSegmentInsightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListMasterSegmentProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListMasterSegmentProfilesRequest)
+func _CDPService_CreateRFMAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRFMAnalysisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListMasterSegmentProfiles(ctx, in)
+		return srv.(CDPServiceServer).CreateRFMAnalysis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListMasterSegmentProfiles_FullMethodName,
+		FullMethod: CDPService_CreateRFMAnalysis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListMasterSegmentProfiles(ctx, req.(*GetListMasterSegmentProfilesRequest))
+		return srv.(CDPServiceServer).CreateRFMAnalysis(ctx, req.(*CreateRFMAnalysisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_ApplyPredictModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyPredictModelRequest)
+func _CDPService_GetListRFMAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRFMAnalysesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).ApplyPredictModel(ctx, in)
+		return srv.(CDPServiceServer).GetListRFMAnalyses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_ApplyPredictModel_FullMethodName,
+		FullMethod: CDPService_GetListRFMAnalyses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).ApplyPredictModel(ctx, req.(*ApplyPredictModelRequest))
+		return srv.(CDPServiceServer).GetListRFMAnalyses(ctx, req.(*GetListRFMAnalysesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListPredictionActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListPredictionActionsRequest)
+func _CDPService_GetCohortRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCohortRetentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListPredictionActions(ctx, in)
+		return srv.(CDPServiceServer).GetCohortRetention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListPredictionActions_FullMethodName,
+		FullMethod: CDPService_GetCohortRetention_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListPredictionActions(ctx, req.(*GetListPredictionActionsRequest))
+		return srv.(CDPServiceServer).GetCohortRetention(ctx, req.(*GetCohortRetentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_TriggerDataActionRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerDataActionRunRequest)
+func _CDPService_CreateFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFunnelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).TriggerDataActionRun(ctx, in)
+		return srv.(CDPServiceServer).CreateFunnel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_TriggerDataActionRun_FullMethodName,
+		FullMethod: CDPService_CreateFunnel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).TriggerDataActionRun(ctx, req.(*TriggerDataActionRunRequest))
+		return srv.(CDPServiceServer).CreateFunnel(ctx, req.(*CreateFunnelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetMasterSegmentProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMasterSegmentProfileRequest)
+func _CDPService_GetListFunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListFunnelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetMasterSegmentProfile(ctx, in)
+		return srv.(CDPServiceServer).GetListFunnels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetMasterSegmentProfile_FullMethodName,
+		FullMethod: CDPService_GetListFunnels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetMasterSegmentProfile(ctx, req.(*GetMasterSegmentProfileRequest))
+		return srv.(CDPServiceServer).GetListFunnels(ctx, req.(*GetListFunnelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetResultPredictionActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetResultPredictionActionsRequest)
+func _CDPService_DeleteFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFunnelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetResultPredictionActions(ctx, in)
+		return srv.(CDPServiceServer).DeleteFunnel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetResultPredictionActions_FullMethodName,
+		FullMethod: CDPService_DeleteFunnel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetResultPredictionActions(ctx, req.(*GetResultPredictionActionsRequest))
+		return srv.(CDPServiceServer).DeleteFunnel(ctx, req.(*DeleteFunnelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetDataActionRunsPerDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataActionRunsPerDayRequest)
+func _CDPService_ComputeFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeFunnelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetDataActionRunsPerDay(ctx, in)
+		return srv.(CDPServiceServer).ComputeFunnel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetDataActionRunsPerDay_FullMethodName,
+		FullMethod: CDPService_ComputeFunnel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetDataActionRunsPerDay(ctx, req.(*GetDataActionRunsPerDayRequest))
+		return srv.(CDPServiceServer).ComputeFunnel(ctx, req.(*ComputeFunnelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetDataRunsProportion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataRunsProportionRequest)
+func _CDPService_CreateFunnelDropOffSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFunnelDropOffSegmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetDataRunsProportion(ctx, in)
+		return srv.(CDPServiceServer).CreateFunnelDropOffSegment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetDataRunsProportion_FullMethodName,
+		FullMethod: CDPService_CreateFunnelDropOffSegment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetDataRunsProportion(ctx, req.(*GetDataRunsProportionRequest))
+		return srv.(CDPServiceServer).CreateFunnelDropOffSegment(ctx, req.(*CreateFunnelDropOffSegmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetBehaviorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBehaviorProfileRequest)
+func _CDPService_CreateSegmentSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentSplitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetBehaviorProfile(ctx, in)
+		return srv.(CDPServiceServer).CreateSegmentSplit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetBehaviorProfile_FullMethodName,
+		FullMethod: CDPService_CreateSegmentSplit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetBehaviorProfile(ctx, req.(*GetBehaviorProfileRequest))
+		return srv.(CDPServiceServer).CreateSegmentSplit(ctx, req.(*CreateSegmentSplitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_GetListDestinationMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetListDestinationMapRequest)
+func _CDPService_GetListSegmentSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListSegmentSplitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).GetListDestinationMap(ctx, in)
+		return srv.(CDPServiceServer).GetListSegmentSplits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_GetListDestinationMap_FullMethodName,
+		FullMethod: CDPService_GetListSegmentSplits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).GetListDestinationMap(ctx, req.(*GetListDestinationMapRequest))
+		return srv.(CDPServiceServer).GetListSegmentSplits(ctx, req.(*GetListSegmentSplitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CDPService_TotalProfilesMasterSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalProfilesMasterSegmentRequest)
+func _CDPService_CompareSegmentSplitArms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareSegmentSplitArmsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CDPServiceServer).TotalProfilesMasterSegment(ctx, in)
+		return srv.(CDPServiceServer).CompareSegmentSplitArms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CDPService_TotalProfilesMasterSegment_FullMethodName,
+		FullMethod: CDPService_CompareSegmentSplitArms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CDPServiceServer).TotalProfilesMasterSegment(ctx, req.(*TotalProfilesMasterSegmentRequest))
+		return srv.(CDPServiceServer).CompareSegmentSplitArms(ctx, req.(*CompareSegmentSplitArmsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1805,6 +3719,238 @@ var CDPService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalProfilesMasterSegment",
 			Handler:    _CDPService_TotalProfilesMasterSegment_Handler,
 		},
+		{
+			MethodName: "PreviewFile",
+			Handler:    _CDPService_PreviewFile_Handler,
+		},
+		{
+			MethodName: "UpdateDataTableQualityRules",
+			Handler:    _CDPService_UpdateDataTableQualityRules_Handler,
+		},
+		{
+			MethodName: "CreateIdentityResolution",
+			Handler:    _CDPService_CreateIdentityResolution_Handler,
+		},
+		{
+			MethodName: "GetListIdentityResolutions",
+			Handler:    _CDPService_GetListIdentityResolutions_Handler,
+		},
+		{
+			MethodName: "GetIdentityResolutionDetail",
+			Handler:    _CDPService_GetIdentityResolutionDetail_Handler,
+		},
+		{
+			MethodName: "GetIdentityProfileHistory",
+			Handler:    _CDPService_GetIdentityProfileHistory_Handler,
+		},
+		{
+			MethodName: "DeduplicateDataTable",
+			Handler:    _CDPService_DeduplicateDataTable_Handler,
+		},
+		{
+			MethodName: "CreateEventSource",
+			Handler:    _CDPService_CreateEventSource_Handler,
+		},
+		{
+			MethodName: "GetListEventSources",
+			Handler:    _CDPService_GetListEventSources_Handler,
+		},
+		{
+			MethodName: "Track",
+			Handler:    _CDPService_Track_Handler,
+		},
+		{
+			MethodName: "Identify",
+			Handler:    _CDPService_Identify_Handler,
+		},
+		{
+			MethodName: "BatchTrack",
+			Handler:    _CDPService_BatchTrack_Handler,
+		},
+		{
+			MethodName: "UpdateDataTableSchemaPolicy",
+			Handler:    _CDPService_UpdateDataTableSchemaPolicy_Handler,
+		},
+		{
+			MethodName: "GetListDataTableSchemaVersions",
+			Handler:    _CDPService_GetListDataTableSchemaVersions_Handler,
+		},
+		{
+			MethodName: "GetListDataTableVersions",
+			Handler:    _CDPService_GetListDataTableVersions_Handler,
+		},
+		{
+			MethodName: "RestoreDataTable",
+			Handler:    _CDPService_RestoreDataTable_Handler,
+		},
+		{
+			MethodName: "DiffDataTableVersions",
+			Handler:    _CDPService_DiffDataTableVersions_Handler,
+		},
+		{
+			MethodName: "ProfileDataTable",
+			Handler:    _CDPService_ProfileDataTable_Handler,
+		},
+		{
+			MethodName: "GetDataTableStatistics",
+			Handler:    _CDPService_GetDataTableStatistics_Handler,
+		},
+		{
+			MethodName: "ProfileMasterSegment",
+			Handler:    _CDPService_ProfileMasterSegment_Handler,
+		},
+		{
+			MethodName: "GetMasterSegmentStatistics",
+			Handler:    _CDPService_GetMasterSegmentStatistics_Handler,
+		},
+		{
+			MethodName: "UpdateMasterSegmentComputedAttributes",
+			Handler:    _CDPService_UpdateMasterSegmentComputedAttributes_Handler,
+		},
+		{
+			MethodName: "RefreshMasterSegment",
+			Handler:    _CDPService_RefreshMasterSegment_Handler,
+		},
+		{
+			MethodName: "GetCustomerProfile",
+			Handler:    _CDPService_GetCustomerProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMasterSegmentSearchableColumns",
+			Handler:    _CDPService_UpdateMasterSegmentSearchableColumns_Handler,
+		},
+		{
+			MethodName: "SearchProfiles",
+			Handler:    _CDPService_SearchProfiles_Handler,
+		},
+		{
+			MethodName: "SuggestColumnTags",
+			Handler:    _CDPService_SuggestColumnTags_Handler,
+		},
+		{
+			MethodName: "UpdateColumnTags",
+			Handler:    _CDPService_UpdateColumnTags_Handler,
+		},
+		{
+			MethodName: "GetColumnTags",
+			Handler:    _CDPService_GetColumnTags_Handler,
+		},
+		{
+			MethodName: "UpdateMaskingPolicies",
+			Handler:    _CDPService_UpdateMaskingPolicies_Handler,
+		},
+		{
+			MethodName: "GetMaskingPolicies",
+			Handler:    _CDPService_GetMaskingPolicies_Handler,
+		},
+		{
+			MethodName: "ErasePerson",
+			Handler:    _CDPService_ErasePerson_Handler,
+		},
+		{
+			MethodName: "GetListErasureCertificates",
+			Handler:    _CDPService_GetListErasureCertificates_Handler,
+		},
+		{
+			MethodName: "GetErasureCertificate",
+			Handler:    _CDPService_GetErasureCertificate_Handler,
+		},
+		{
+			MethodName: "UpdateMasterSegmentConsentColumns",
+			Handler:    _CDPService_UpdateMasterSegmentConsentColumns_Handler,
+		},
+		{
+			MethodName: "CreateSuppressionList",
+			Handler:    _CDPService_CreateSuppressionList_Handler,
+		},
+		{
+			MethodName: "GetListSuppressionLists",
+			Handler:    _CDPService_GetListSuppressionLists_Handler,
+		},
+		{
+			MethodName: "UploadSuppressionEntries",
+			Handler:    _CDPService_UploadSuppressionEntries_Handler,
+		},
+		{
+			MethodName: "DeleteSuppressionList",
+			Handler:    _CDPService_DeleteSuppressionList_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _CDPService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetListRetentionPolicies",
+			Handler:    _CDPService_GetListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _CDPService_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetListSegmentSnapshots",
+			Handler:    _CDPService_GetListSegmentSnapshots_Handler,
+		},
+		{
+			MethodName: "GetSegmentMembershipChanges",
+			Handler:    _CDPService_GetSegmentMembershipChanges_Handler,
+		},
+		{
+			MethodName: "GetSegmentSizeHistory",
+			Handler:    _CDPService_GetSegmentSizeHistory_Handler,
+		},
+		{
+			MethodName: "AnalyzeSegmentOverlap",
+			Handler:    _CDPService_AnalyzeSegmentOverlap_Handler,
+		},
+		{
+			MethodName: "GetSegmentInsights",
+			Handler:    _CDPService_GetSegmentInsights_Handler,
+		},
+		{
+			MethodName: "CreateRFMAnalysis",
+			Handler:    _CDPService_CreateRFMAnalysis_Handler,
+		},
+		{
+			MethodName: "GetListRFMAnalyses",
+			Handler:    _CDPService_GetListRFMAnalyses_Handler,
+		},
+		{
+			MethodName: "GetCohortRetention",
+			Handler:    _CDPService_GetCohortRetention_Handler,
+		},
+		{
+			MethodName: "CreateFunnel",
+			Handler:    _CDPService_CreateFunnel_Handler,
+		},
+		{
+			MethodName: "GetListFunnels",
+			Handler:    _CDPService_GetListFunnels_Handler,
+		},
+		{
+			MethodName: "DeleteFunnel",
+			Handler:    _CDPService_DeleteFunnel_Handler,
+		},
+		{
+			MethodName: "ComputeFunnel",
+			Handler:    _CDPService_ComputeFunnel_Handler,
+		},
+		{
+			MethodName: "CreateFunnelDropOffSegment",
+			Handler:    _CDPService_CreateFunnelDropOffSegment_Handler,
+		},
+		{
+			MethodName: "CreateSegmentSplit",
+			Handler:    _CDPService_CreateSegmentSplit_Handler,
+		},
+		{
+			MethodName: "GetListSegmentSplits",
+			Handler:    _CDPService_GetListSegmentSplits_Handler,
+		},
+		{
+			MethodName: "CompareSegmentSplitArms",
+			Handler:    _CDPService_CompareSegmentSplitArms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
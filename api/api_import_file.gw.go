@@ -46,6 +46,14 @@ func request_CDPServiceFile_ImportCsv_0(ctx context.Context, client CDPServiceFi
 		return nil, err
 	}
 
+	var deduplication *DeduplicationConfiguration
+	if jsonDeduplication := req.Form.Get("deduplication"); len(jsonDeduplication) > 0 {
+		err = json.Unmarshal([]byte(jsonDeduplication), &deduplication)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	form := req.Form
 	name := form.Get("name")
 	description := form.Get("description")
@@ -75,6 +83,7 @@ func request_CDPServiceFile_ImportCsv_0(ctx context.Context, client CDPServiceFi
 			TableId:        tableId,
 			NewTableName:   newTableName,
 			WriteMode:      writeMode,
+			Deduplication:  deduplication,
 		},
 		grpc.Header(&metadata.HeaderMD),
 		grpc.Trailer(&metadata.TrailerMD),
@@ -281,6 +281,55 @@ func (m *MappingOptionItem) validate(all bool) error {
 
 	// no validation rules for DestinationFieldName
 
+	if _, ok := _MappingOptionItem_DataType_InLookup[m.GetDataType()]; !ok {
+		err := MappingOptionItemValidationError{
+			field:  "DataType",
+			reason: "value must be in list [ int float bool date timestamp string]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Format
+
+	for idx, item := range m.GetTransforms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MappingOptionItemValidationError{
+						field:  fmt.Sprintf("Transforms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MappingOptionItemValidationError{
+						field:  fmt.Sprintf("Transforms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MappingOptionItemValidationError{
+					field:  fmt.Sprintf("Transforms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Derived
+
 	if len(errors) > 0 {
 		return MappingOptionItemMultiError(errors)
 	}
@@ -361,44 +410,53 @@ var _ interface {
 	ErrorName() string
 } = MappingOptionItemValidationError{}
 
-// Validate checks the field values on ImportCsvConfigurations with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ImportCsvConfigurations) Validate() error {
+var _MappingOptionItem_DataType_InLookup = map[string]struct{}{
+	"":          {},
+	"int":       {},
+	"float":     {},
+	"bool":      {},
+	"date":      {},
+	"timestamp": {},
+	"string":    {},
+}
+
+// Validate checks the field values on ColumnTransform with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTransform) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ImportCsvConfigurations with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnTransform with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ImportCsvConfigurationsMultiError, or nil if none found.
-func (m *ImportCsvConfigurations) ValidateAll() error {
+// ColumnTransformMultiError, or nil if none found.
+func (m *ColumnTransform) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ImportCsvConfigurations) validate(all bool) error {
+func (m *ColumnTransform) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetDelimiter()) != 1 {
-		err := ImportCsvConfigurationsValidationError{
-			field:  "Delimiter",
-			reason: "value length must be 1 runes",
+	if _, ok := _ColumnTransform_Type_InLookup[m.GetType()]; !ok {
+		err := ColumnTransformValidationError{
+			field:  "Type",
+			reason: "value must be in list [cast trim lower upper regex_replace parse_date default sha256 expression]",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
-
 	}
 
-	if m.GetSkipRows() <= -1 {
-		err := ImportCsvConfigurationsValidationError{
-			field:  "SkipRows",
-			reason: "value must be greater than -1",
+	if _, ok := _ColumnTransform_DataType_InLookup[m.GetDataType()]; !ok {
+		err := ColumnTransformValidationError{
+			field:  "DataType",
+			reason: "value must be in list [ int float bool date timestamp string]",
 		}
 		if !all {
 			return err
@@ -406,22 +464,30 @@ func (m *ImportCsvConfigurations) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for MultiLine
+	// no validation rules for Pattern
+
+	// no validation rules for Replacement
+
+	// no validation rules for Format
+
+	// no validation rules for Value
+
+	// no validation rules for Expression
 
 	if len(errors) > 0 {
-		return ImportCsvConfigurationsMultiError(errors)
+		return ColumnTransformMultiError(errors)
 	}
 
 	return nil
 }
 
-// ImportCsvConfigurationsMultiError is an error wrapping multiple validation
-// errors returned by ImportCsvConfigurations.ValidateAll() if the designated
-// constraints aren't met.
-type ImportCsvConfigurationsMultiError []error
+// ColumnTransformMultiError is an error wrapping multiple validation errors
+// returned by ColumnTransform.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnTransformMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ImportCsvConfigurationsMultiError) Error() string {
+func (m ColumnTransformMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -430,11 +496,11 @@ func (m ImportCsvConfigurationsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ImportCsvConfigurationsMultiError) AllErrors() []error { return m }
+func (m ColumnTransformMultiError) AllErrors() []error { return m }
 
-// ImportCsvConfigurationsValidationError is the validation error returned by
-// ImportCsvConfigurations.Validate if the designated constraints aren't met.
-type ImportCsvConfigurationsValidationError struct {
+// ColumnTransformValidationError is the validation error returned by
+// ColumnTransform.Validate if the designated constraints aren't met.
+type ColumnTransformValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -442,24 +508,22 @@ type ImportCsvConfigurationsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ImportCsvConfigurationsValidationError) Field() string { return e.field }
+func (e ColumnTransformValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ImportCsvConfigurationsValidationError) Reason() string { return e.reason }
+func (e ColumnTransformValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ImportCsvConfigurationsValidationError) Cause() error { return e.cause }
+func (e ColumnTransformValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ImportCsvConfigurationsValidationError) Key() bool { return e.key }
+func (e ColumnTransformValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ImportCsvConfigurationsValidationError) ErrorName() string {
-	return "ImportCsvConfigurationsValidationError"
-}
+func (e ColumnTransformValidationError) ErrorName() string { return "ColumnTransformValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ImportCsvConfigurationsValidationError) Error() string {
+func (e ColumnTransformValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -471,14 +535,14 @@ func (e ImportCsvConfigurationsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sImportCsvConfigurations.%s: %s%s",
+		"invalid %sColumnTransform.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ImportCsvConfigurationsValidationError{}
+var _ error = ColumnTransformValidationError{}
 
 var _ interface {
 	Field() string
@@ -486,48 +550,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ImportCsvConfigurationsValidationError{}
+} = ColumnTransformValidationError{}
+
+var _ColumnTransform_Type_InLookup = map[string]struct{}{
+	"cast":          {},
+	"trim":          {},
+	"lower":         {},
+	"upper":         {},
+	"regex_replace": {},
+	"parse_date":    {},
+	"default":       {},
+	"sha256":        {},
+	"expression":    {},
+}
+
+var _ColumnTransform_DataType_InLookup = map[string]struct{}{
+	"":          {},
+	"int":       {},
+	"float":     {},
+	"bool":      {},
+	"date":      {},
+	"timestamp": {},
+	"string":    {},
+}
 
-// Validate checks the field values on TransferredColumn with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransferredColumn) Validate() error {
+// Validate checks the field values on ImportCsvConfigurations with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCsvConfigurations) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransferredColumn with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportCsvConfigurations with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransferredColumnMultiError, or nil if none found.
-func (m *TransferredColumn) ValidateAll() error {
+// ImportCsvConfigurationsMultiError, or nil if none found.
+func (m *ImportCsvConfigurations) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransferredColumn) validate(all bool) error {
+func (m *ImportCsvConfigurations) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TableColumnName
+	if utf8.RuneCountInString(m.GetDelimiter()) != 1 {
+		err := ImportCsvConfigurationsValidationError{
+			field:  "Delimiter",
+			reason: "value length must be 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 
-	// no validation rules for NewTableColumnName
+	}
+
+	if m.GetSkipRows() <= -1 {
+		err := ImportCsvConfigurationsValidationError{
+			field:  "SkipRows",
+			reason: "value must be greater than -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MultiLine
 
 	if len(errors) > 0 {
-		return TransferredColumnMultiError(errors)
+		return ImportCsvConfigurationsMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransferredColumnMultiError is an error wrapping multiple validation errors
-// returned by TransferredColumn.ValidateAll() if the designated constraints
-// aren't met.
-type TransferredColumnMultiError []error
+// ImportCsvConfigurationsMultiError is an error wrapping multiple validation
+// errors returned by ImportCsvConfigurations.ValidateAll() if the designated
+// constraints aren't met.
+type ImportCsvConfigurationsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransferredColumnMultiError) Error() string {
+func (m ImportCsvConfigurationsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -536,11 +643,11 @@ func (m TransferredColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransferredColumnMultiError) AllErrors() []error { return m }
+func (m ImportCsvConfigurationsMultiError) AllErrors() []error { return m }
 
-// TransferredColumnValidationError is the validation error returned by
-// TransferredColumn.Validate if the designated constraints aren't met.
-type TransferredColumnValidationError struct {
+// ImportCsvConfigurationsValidationError is the validation error returned by
+// ImportCsvConfigurations.Validate if the designated constraints aren't met.
+type ImportCsvConfigurationsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -548,24 +655,24 @@ type TransferredColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransferredColumnValidationError) Field() string { return e.field }
+func (e ImportCsvConfigurationsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransferredColumnValidationError) Reason() string { return e.reason }
+func (e ImportCsvConfigurationsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransferredColumnValidationError) Cause() error { return e.cause }
+func (e ImportCsvConfigurationsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransferredColumnValidationError) Key() bool { return e.key }
+func (e ImportCsvConfigurationsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransferredColumnValidationError) ErrorName() string {
-	return "TransferredColumnValidationError"
+func (e ImportCsvConfigurationsValidationError) ErrorName() string {
+	return "ImportCsvConfigurationsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransferredColumnValidationError) Error() string {
+func (e ImportCsvConfigurationsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -577,14 +684,14 @@ func (e TransferredColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransferredColumn.%s: %s%s",
+		"invalid %sImportCsvConfigurations.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransferredColumnValidationError{}
+var _ error = ImportCsvConfigurationsValidationError{}
 
 var _ interface {
 	Field() string
@@ -592,24 +699,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransferredColumnValidationError{}
+} = ImportCsvConfigurationsValidationError{}
 
-// Validate checks the field values on SchemaColumn with the rules defined in
+// Validate checks the field values on DetectedColumn with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SchemaColumn) Validate() error {
+func (m *DetectedColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaColumn with the rules defined
+// ValidateAll checks the field values on DetectedColumn with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SchemaColumnMultiError, or
-// nil if none found.
-func (m *SchemaColumn) ValidateAll() error {
+// result is a list of violation errors wrapped in DetectedColumnMultiError,
+// or nil if none found.
+func (m *DetectedColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaColumn) validate(all bool) error {
+func (m *DetectedColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -620,19 +727,26 @@ func (m *SchemaColumn) validate(all bool) error {
 
 	// no validation rules for DataType
 
+	// no validation rules for Format
+
+	// no validation rules for Confidence
+
+	// no validation rules for NullCount
+
 	if len(errors) > 0 {
-		return SchemaColumnMultiError(errors)
+		return DetectedColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaColumnMultiError is an error wrapping multiple validation errors
-// returned by SchemaColumn.ValidateAll() if the designated constraints aren't met.
-type SchemaColumnMultiError []error
+// DetectedColumnMultiError is an error wrapping multiple validation errors
+// returned by DetectedColumn.ValidateAll() if the designated constraints
+// aren't met.
+type DetectedColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaColumnMultiError) Error() string {
+func (m DetectedColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -641,11 +755,11 @@ func (m SchemaColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaColumnMultiError) AllErrors() []error { return m }
+func (m DetectedColumnMultiError) AllErrors() []error { return m }
 
-// SchemaColumnValidationError is the validation error returned by
-// SchemaColumn.Validate if the designated constraints aren't met.
-type SchemaColumnValidationError struct {
+// DetectedColumnValidationError is the validation error returned by
+// DetectedColumn.Validate if the designated constraints aren't met.
+type DetectedColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -653,22 +767,22 @@ type SchemaColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaColumnValidationError) Field() string { return e.field }
+func (e DetectedColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaColumnValidationError) Reason() string { return e.reason }
+func (e DetectedColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaColumnValidationError) Cause() error { return e.cause }
+func (e DetectedColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaColumnValidationError) Key() bool { return e.key }
+func (e DetectedColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaColumnValidationError) ErrorName() string { return "SchemaColumnValidationError" }
+func (e DetectedColumnValidationError) ErrorName() string { return "DetectedColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SchemaColumnValidationError) Error() string {
+func (e DetectedColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -680,14 +794,14 @@ func (e SchemaColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaColumn.%s: %s%s",
+		"invalid %sDetectedColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaColumnValidationError{}
+var _ error = DetectedColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -695,66 +809,598 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaColumnValidationError{}
+} = DetectedColumnValidationError{}
 
-// Validate checks the field values on MasterSegment with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MasterSegment) Validate() error {
+// Validate checks the field values on DataQualityRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataQualityRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MasterSegment with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MasterSegmentMultiError, or
-// nil if none found.
-func (m *MasterSegment) ValidateAll() error {
+// ValidateAll checks the field values on DataQualityRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataQualityRuleMultiError, or nil if none found.
+func (m *DataQualityRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MasterSegment) validate(all bool) error {
+func (m *DataQualityRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for Status
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for UpdatedAt
-
-	if len(errors) > 0 {
-		return MasterSegmentMultiError(errors)
+	if _, ok := _DataQualityRule_Type_InLookup[m.GetType()]; !ok {
+		err := DataQualityRuleValidationError{
+			field:  "Type",
+			reason: "value must be in list [not_null unique regex email allowed_values range]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// MasterSegmentMultiError is an error wrapping multiple validation errors
-// returned by MasterSegment.ValidateAll() if the designated constraints
-// aren't met.
-type MasterSegmentMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m MasterSegmentMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := DataQualityRuleValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m MasterSegmentMultiError) AllErrors() []error { return m }
+	// no validation rules for Pattern
 
-// MasterSegmentValidationError is the validation error returned by
-// MasterSegment.Validate if the designated constraints aren't met.
+	if all {
+		switch v := interface{}(m.GetMin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataQualityRuleValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataQualityRuleValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataQualityRuleValidationError{
+				field:  "Min",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMax()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataQualityRuleValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataQualityRuleValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataQualityRuleValidationError{
+				field:  "Max",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DataQualityRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataQualityRuleMultiError is an error wrapping multiple validation errors
+// returned by DataQualityRule.ValidateAll() if the designated constraints
+// aren't met.
+type DataQualityRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataQualityRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataQualityRuleMultiError) AllErrors() []error { return m }
+
+// DataQualityRuleValidationError is the validation error returned by
+// DataQualityRule.Validate if the designated constraints aren't met.
+type DataQualityRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataQualityRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataQualityRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataQualityRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataQualityRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataQualityRuleValidationError) ErrorName() string { return "DataQualityRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataQualityRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataQualityRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataQualityRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataQualityRuleValidationError{}
+
+var _DataQualityRule_Type_InLookup = map[string]struct{}{
+	"not_null":       {},
+	"unique":         {},
+	"regex":          {},
+	"email":          {},
+	"allowed_values": {},
+	"range":          {},
+}
+
+// Validate checks the field values on DeduplicationConfiguration with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeduplicationConfiguration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeduplicationConfiguration with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeduplicationConfigurationMultiError, or nil if none found.
+func (m *DeduplicationConfiguration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeduplicationConfiguration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetKeyColumns()) < 1 {
+		err := DeduplicationConfigurationValidationError{
+			field:  "KeyColumns",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DeduplicationConfiguration_Survivorship_InLookup[m.GetSurvivorship()]; !ok {
+		err := DeduplicationConfigurationValidationError{
+			field:  "Survivorship",
+			reason: "value must be in list [latest first_seen most_complete]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimestampColumn
+
+	if len(errors) > 0 {
+		return DeduplicationConfigurationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeduplicationConfigurationMultiError is an error wrapping multiple
+// validation errors returned by DeduplicationConfiguration.ValidateAll() if
+// the designated constraints aren't met.
+type DeduplicationConfigurationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeduplicationConfigurationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeduplicationConfigurationMultiError) AllErrors() []error { return m }
+
+// DeduplicationConfigurationValidationError is the validation error returned
+// by DeduplicationConfiguration.Validate if the designated constraints aren't met.
+type DeduplicationConfigurationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicationConfigurationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicationConfigurationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicationConfigurationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicationConfigurationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicationConfigurationValidationError) ErrorName() string {
+	return "DeduplicationConfigurationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicationConfigurationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicationConfiguration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicationConfigurationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicationConfigurationValidationError{}
+
+var _DeduplicationConfiguration_Survivorship_InLookup = map[string]struct{}{
+	"latest":        {},
+	"first_seen":    {},
+	"most_complete": {},
+}
+
+// Validate checks the field values on TransferredColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransferredColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferredColumn with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferredColumnMultiError, or nil if none found.
+func (m *TransferredColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferredColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TableColumnName
+
+	// no validation rules for NewTableColumnName
+
+	if len(errors) > 0 {
+		return TransferredColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferredColumnMultiError is an error wrapping multiple validation errors
+// returned by TransferredColumn.ValidateAll() if the designated constraints
+// aren't met.
+type TransferredColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferredColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferredColumnMultiError) AllErrors() []error { return m }
+
+// TransferredColumnValidationError is the validation error returned by
+// TransferredColumn.Validate if the designated constraints aren't met.
+type TransferredColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferredColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferredColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferredColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferredColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferredColumnValidationError) ErrorName() string {
+	return "TransferredColumnValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferredColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferredColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferredColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferredColumnValidationError{}
+
+// Validate checks the field values on SchemaColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaColumnMultiError, or
+// nil if none found.
+func (m *SchemaColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ColumnName
+
+	// no validation rules for DataType
+
+	if len(errors) > 0 {
+		return SchemaColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaColumnMultiError is an error wrapping multiple validation errors
+// returned by SchemaColumn.ValidateAll() if the designated constraints aren't met.
+type SchemaColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaColumnMultiError) AllErrors() []error { return m }
+
+// SchemaColumnValidationError is the validation error returned by
+// SchemaColumn.Validate if the designated constraints aren't met.
+type SchemaColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaColumnValidationError) ErrorName() string { return "SchemaColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaColumnValidationError{}
+
+// Validate checks the field values on MasterSegment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MasterSegment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MasterSegment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MasterSegmentMultiError, or
+// nil if none found.
+func (m *MasterSegment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MasterSegment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return MasterSegmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasterSegmentMultiError is an error wrapping multiple validation errors
+// returned by MasterSegment.ValidateAll() if the designated constraints
+// aren't met.
+type MasterSegmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasterSegmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasterSegmentMultiError) AllErrors() []error { return m }
+
+// MasterSegmentValidationError is the validation error returned by
+// MasterSegment.Validate if the designated constraints aren't met.
 type MasterSegmentValidationError struct {
 	field  string
 	reason string
@@ -763,22 +1409,7239 @@ type MasterSegmentValidationError struct {
 }
 
 // Field function returns field value.
-func (e MasterSegmentValidationError) Field() string { return e.field }
+func (e MasterSegmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasterSegmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasterSegmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasterSegmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasterSegmentValidationError) ErrorName() string { return "MasterSegmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MasterSegmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMasterSegment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasterSegmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasterSegmentValidationError{}
+
+// Validate checks the field values on MasterSegmentDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MasterSegmentDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MasterSegmentDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MasterSegmentDetailMultiError, or nil if none found.
+func (m *MasterSegmentDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MasterSegmentDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for AudienceTableId
+
+	// no validation rules for MainRawTableId
+
+	// no validation rules for MainRawTableName
+
+	for idx, item := range m.GetAttributeTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("AttributeTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("AttributeTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MasterSegmentDetailValidationError{
+					field:  fmt.Sprintf("AttributeTables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBehaviorTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MasterSegmentDetailValidationError{
+					field:  fmt.Sprintf("BehaviorTables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAudienceSchema() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("AudienceSchema[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("AudienceSchema[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MasterSegmentDetailValidationError{
+					field:  fmt.Sprintf("AudienceSchema[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSchemaIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("SchemaIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("SchemaIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MasterSegmentDetailValidationError{
+					field:  fmt.Sprintf("SchemaIssues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetComputedAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MasterSegmentDetailValidationError{
+					field:  fmt.Sprintf("ComputedAttributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetConsentColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MasterSegmentDetailValidationError{
+						field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MasterSegmentDetailValidationError{
+					field:  fmt.Sprintf("ConsentColumns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MasterSegmentDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasterSegmentDetailMultiError is an error wrapping multiple validation
+// errors returned by MasterSegmentDetail.ValidateAll() if the designated
+// constraints aren't met.
+type MasterSegmentDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasterSegmentDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasterSegmentDetailMultiError) AllErrors() []error { return m }
+
+// MasterSegmentDetailValidationError is the validation error returned by
+// MasterSegmentDetail.Validate if the designated constraints aren't met.
+type MasterSegmentDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MasterSegmentDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasterSegmentDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasterSegmentDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasterSegmentDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasterSegmentDetailValidationError) ErrorName() string {
+	return "MasterSegmentDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MasterSegmentDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMasterSegmentDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasterSegmentDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasterSegmentDetailValidationError{}
+
+// Validate checks the field values on Segment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Segment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Segment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SegmentMultiError, or nil if none found.
+func (m *Segment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Segment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for MasterSegmentId
+
+	// no validation rules for MasterSegmentName
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return SegmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// SegmentMultiError is an error wrapping multiple validation errors returned
+// by Segment.ValidateAll() if the designated constraints aren't met.
+type SegmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SegmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SegmentMultiError) AllErrors() []error { return m }
+
+// SegmentValidationError is the validation error returned by Segment.Validate
+// if the designated constraints aren't met.
+type SegmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SegmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SegmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SegmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SegmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SegmentValidationError) ErrorName() string { return "SegmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SegmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSegment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SegmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SegmentValidationError{}
+
+// Validate checks the field values on MappingGophishProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MappingGophishProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MappingGophishProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MappingGophishProfileMultiError, or nil if none found.
+func (m *MappingGophishProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MappingGophishProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for FirstName
+
+	// no validation rules for LastName
+
+	// no validation rules for Position
+
+	if len(errors) > 0 {
+		return MappingGophishProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// MappingGophishProfileMultiError is an error wrapping multiple validation
+// errors returned by MappingGophishProfile.ValidateAll() if the designated
+// constraints aren't met.
+type MappingGophishProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MappingGophishProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MappingGophishProfileMultiError) AllErrors() []error { return m }
+
+// MappingGophishProfileValidationError is the validation error returned by
+// MappingGophishProfile.Validate if the designated constraints aren't met.
+type MappingGophishProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MappingGophishProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MappingGophishProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MappingGophishProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MappingGophishProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MappingGophishProfileValidationError) ErrorName() string {
+	return "MappingGophishProfileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MappingGophishProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMappingGophishProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MappingGophishProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MappingGophishProfileValidationError{}
+
+// Validate checks the field values on DataDestination with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataDestination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataDestination with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDestinationMultiError, or nil if none found.
+func (m *DataDestination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataDestination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Channel
+
+	if len(errors) > 0 {
+		return DataDestinationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataDestinationMultiError is an error wrapping multiple validation errors
+// returned by DataDestination.ValidateAll() if the designated constraints
+// aren't met.
+type DataDestinationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataDestinationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataDestinationMultiError) AllErrors() []error { return m }
+
+// DataDestinationValidationError is the validation error returned by
+// DataDestination.Validate if the designated constraints aren't met.
+type DataDestinationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataDestinationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataDestinationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataDestinationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataDestinationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataDestinationValidationError) ErrorName() string { return "DataDestinationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataDestinationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataDestination.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataDestinationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataDestinationValidationError{}
+
+// Validate checks the field values on DataSource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataSourceMultiError, or
+// nil if none found.
+func (m *DataSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DataSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataSourceMultiError is an error wrapping multiple validation errors
+// returned by DataSource.ValidateAll() if the designated constraints aren't met.
+type DataSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataSourceMultiError) AllErrors() []error { return m }
+
+// DataSourceValidationError is the validation error returned by
+// DataSource.Validate if the designated constraints aren't met.
+type DataSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataSourceValidationError) ErrorName() string { return "DataSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataSourceValidationError{}
+
+// Validate checks the field values on DataSourceDetail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataSourceDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataSourceDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataSourceDetailMultiError, or nil if none found.
+func (m *DataSourceDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataSourceDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return DataSourceDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataSourceDetailMultiError is an error wrapping multiple validation errors
+// returned by DataSourceDetail.ValidateAll() if the designated constraints
+// aren't met.
+type DataSourceDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataSourceDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataSourceDetailMultiError) AllErrors() []error { return m }
+
+// DataSourceDetailValidationError is the validation error returned by
+// DataSourceDetail.Validate if the designated constraints aren't met.
+type DataSourceDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataSourceDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataSourceDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataSourceDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataSourceDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataSourceDetailValidationError) ErrorName() string { return "DataSourceDetailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataSourceDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataSourceDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataSourceDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataSourceDetailValidationError{}
+
+// Validate checks the field values on EnrichedDataSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrichedDataSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichedDataSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichedDataSourceMultiError, or nil if none found.
+func (m *EnrichedDataSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichedDataSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return EnrichedDataSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichedDataSourceMultiError is an error wrapping multiple validation errors
+// returned by EnrichedDataSource.ValidateAll() if the designated constraints
+// aren't met.
+type EnrichedDataSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichedDataSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichedDataSourceMultiError) AllErrors() []error { return m }
+
+// EnrichedDataSourceValidationError is the validation error returned by
+// EnrichedDataSource.Validate if the designated constraints aren't met.
+type EnrichedDataSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichedDataSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichedDataSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichedDataSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichedDataSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichedDataSourceValidationError) ErrorName() string {
+	return "EnrichedDataSourceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrichedDataSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichedDataSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichedDataSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichedDataSourceValidationError{}
+
+// Validate checks the field values on EnrichedDataDestination with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrichedDataDestination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichedDataDestination with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichedDataDestinationMultiError, or nil if none found.
+func (m *EnrichedDataDestination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichedDataDestination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return EnrichedDataDestinationMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichedDataDestinationMultiError is an error wrapping multiple validation
+// errors returned by EnrichedDataDestination.ValidateAll() if the designated
+// constraints aren't met.
+type EnrichedDataDestinationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichedDataDestinationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichedDataDestinationMultiError) AllErrors() []error { return m }
+
+// EnrichedDataDestinationValidationError is the validation error returned by
+// EnrichedDataDestination.Validate if the designated constraints aren't met.
+type EnrichedDataDestinationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichedDataDestinationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichedDataDestinationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichedDataDestinationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichedDataDestinationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichedDataDestinationValidationError) ErrorName() string {
+	return "EnrichedDataDestinationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrichedDataDestinationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichedDataDestination.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichedDataDestinationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichedDataDestinationValidationError{}
+
+// Validate checks the field values on SegmentCondition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SegmentCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SegmentCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SegmentConditionMultiError, or nil if none found.
+func (m *SegmentCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SegmentCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAudienceCondition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SegmentConditionValidationError{
+					field:  "AudienceCondition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SegmentConditionValidationError{
+					field:  "AudienceCondition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudienceCondition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SegmentConditionValidationError{
+				field:  "AudienceCondition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AudienceSqlCondition
+
+	for idx, item := range m.GetBehaviorConditions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SegmentConditionValidationError{
+						field:  fmt.Sprintf("BehaviorConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SegmentConditionValidationError{
+						field:  fmt.Sprintf("BehaviorConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SegmentConditionValidationError{
+					field:  fmt.Sprintf("BehaviorConditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSequenceConditions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SegmentConditionValidationError{
+						field:  fmt.Sprintf("SequenceConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SegmentConditionValidationError{
+						field:  fmt.Sprintf("SequenceConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SegmentConditionValidationError{
+					field:  fmt.Sprintf("SequenceConditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SegmentConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SegmentConditionMultiError is an error wrapping multiple validation errors
+// returned by SegmentCondition.ValidateAll() if the designated constraints
+// aren't met.
+type SegmentConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SegmentConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SegmentConditionMultiError) AllErrors() []error { return m }
+
+// SegmentConditionValidationError is the validation error returned by
+// SegmentCondition.Validate if the designated constraints aren't met.
+type SegmentConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SegmentConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SegmentConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SegmentConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SegmentConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SegmentConditionValidationError) ErrorName() string { return "SegmentConditionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SegmentConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSegmentCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SegmentConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SegmentConditionValidationError{}
+
+// Validate checks the field values on BehaviorCondition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BehaviorCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BehaviorCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BehaviorConditionMultiError, or nil if none found.
+func (m *BehaviorCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BehaviorCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BehaviorTableId
+
+	if all {
+		switch v := interface{}(m.GetWhereCondition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BehaviorConditionValidationError{
+					field:  "WhereCondition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BehaviorConditionValidationError{
+					field:  "WhereCondition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhereCondition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BehaviorConditionValidationError{
+				field:  "WhereCondition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WhereSqlCondition
+
+	for idx, item := range m.GetHavingConditions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BehaviorConditionValidationError{
+						field:  fmt.Sprintf("HavingConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BehaviorConditionValidationError{
+						field:  fmt.Sprintf("HavingConditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BehaviorConditionValidationError{
+					field:  fmt.Sprintf("HavingConditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BehaviorConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// BehaviorConditionMultiError is an error wrapping multiple validation errors
+// returned by BehaviorCondition.ValidateAll() if the designated constraints
+// aren't met.
+type BehaviorConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BehaviorConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BehaviorConditionMultiError) AllErrors() []error { return m }
+
+// BehaviorConditionValidationError is the validation error returned by
+// BehaviorCondition.Validate if the designated constraints aren't met.
+type BehaviorConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BehaviorConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BehaviorConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BehaviorConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BehaviorConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BehaviorConditionValidationError) ErrorName() string {
+	return "BehaviorConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BehaviorConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBehaviorCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BehaviorConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BehaviorConditionValidationError{}
+
+// Validate checks the field values on SequenceCondition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SequenceCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SequenceCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SequenceConditionMultiError, or nil if none found.
+func (m *SequenceCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SequenceCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetSteps()); l < 1 || l > 10 {
+		err := SequenceConditionValidationError{
+			field:  "Steps",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SequenceConditionValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SequenceConditionValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SequenceConditionValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetWithinLastDays() < 0 {
+		err := SequenceConditionValidationError{
+			field:  "WithinLastDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SequenceConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SequenceConditionMultiError is an error wrapping multiple validation errors
+// returned by SequenceCondition.ValidateAll() if the designated constraints
+// aren't met.
+type SequenceConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SequenceConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SequenceConditionMultiError) AllErrors() []error { return m }
+
+// SequenceConditionValidationError is the validation error returned by
+// SequenceCondition.Validate if the designated constraints aren't met.
+type SequenceConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SequenceConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SequenceConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SequenceConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SequenceConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SequenceConditionValidationError) ErrorName() string {
+	return "SequenceConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SequenceConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSequenceCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SequenceConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SequenceConditionValidationError{}
+
+// Validate checks the field values on Rule with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Rule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Rule with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RuleMultiError, or nil if none found.
+func (m *Rule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Rule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Operator
+
+	// no validation rules for Value
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RuleValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Combinator
+
+	if len(errors) > 0 {
+		return RuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuleMultiError is an error wrapping multiple validation errors returned by
+// Rule.ValidateAll() if the designated constraints aren't met.
+type RuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuleMultiError) AllErrors() []error { return m }
+
+// RuleValidationError is the validation error returned by Rule.Validate if the
+// designated constraints aren't met.
+type RuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleValidationError) ErrorName() string { return "RuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleValidationError{}
+
+// Validate checks the field values on DataActionRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataActionRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataActionRun with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataActionRunMultiError, or
+// nil if none found.
+func (m *DataActionRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataActionRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ActionId
+
+	// no validation rules for ActionType
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataActionRunValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataActionRunValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataActionRunValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PassedCount
+
+	// no validation rules for FailedCount
+
+	// no validation rules for RowCountBefore
+
+	// no validation rules for RowCountAfter
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return DataActionRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataActionRunMultiError is an error wrapping multiple validation errors
+// returned by DataActionRun.ValidateAll() if the designated constraints
+// aren't met.
+type DataActionRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataActionRunMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataActionRunMultiError) AllErrors() []error { return m }
+
+// DataActionRunValidationError is the validation error returned by
+// DataActionRun.Validate if the designated constraints aren't met.
+type DataActionRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataActionRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataActionRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataActionRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataActionRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataActionRunValidationError) ErrorName() string { return "DataActionRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataActionRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataActionRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataActionRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataActionRunValidationError{}
+
+// Validate checks the field values on PredictModel with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PredictModel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PredictModel with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PredictModelMultiError, or
+// nil if none found.
+func (m *PredictModel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PredictModel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for MasterSegmentId
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return PredictModelMultiError(errors)
+	}
+
+	return nil
+}
+
+// PredictModelMultiError is an error wrapping multiple validation errors
+// returned by PredictModel.ValidateAll() if the designated constraints aren't met.
+type PredictModelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PredictModelMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PredictModelMultiError) AllErrors() []error { return m }
+
+// PredictModelValidationError is the validation error returned by
+// PredictModel.Validate if the designated constraints aren't met.
+type PredictModelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PredictModelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PredictModelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PredictModelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PredictModelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PredictModelValidationError) ErrorName() string { return "PredictModelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PredictModelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPredictModel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PredictModelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PredictModelValidationError{}
+
+// Validate checks the field values on EnrichedConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrichedConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichedConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichedConnectionMultiError, or nil if none found.
+func (m *EnrichedConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichedConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return EnrichedConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichedConnectionMultiError is an error wrapping multiple validation errors
+// returned by EnrichedConnection.ValidateAll() if the designated constraints
+// aren't met.
+type EnrichedConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichedConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichedConnectionMultiError) AllErrors() []error { return m }
+
+// EnrichedConnectionValidationError is the validation error returned by
+// EnrichedConnection.Validate if the designated constraints aren't met.
+type EnrichedConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichedConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichedConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichedConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichedConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichedConnectionValidationError) ErrorName() string {
+	return "EnrichedConnectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrichedConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichedConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichedConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichedConnectionValidationError{}
+
+// Validate checks the field values on EnrichedTable with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnrichedTable) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichedTable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrichedTableMultiError, or
+// nil if none found.
+func (m *EnrichedTable) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichedTable) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return EnrichedTableMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichedTableMultiError is an error wrapping multiple validation errors
+// returned by EnrichedTable.ValidateAll() if the designated constraints
+// aren't met.
+type EnrichedTableMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichedTableMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichedTableMultiError) AllErrors() []error { return m }
+
+// EnrichedTableValidationError is the validation error returned by
+// EnrichedTable.Validate if the designated constraints aren't met.
+type EnrichedTableValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichedTableValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichedTableValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichedTableValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichedTableValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichedTableValidationError) ErrorName() string { return "EnrichedTableValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrichedTableValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichedTable.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichedTableValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichedTableValidationError{}
+
+// Validate checks the field values on SourceTableMap with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SourceTableMap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceTableMap with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SourceTableMapMultiError,
+// or nil if none found.
+func (m *SourceTableMap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceTableMap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceTableMapValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceTableMapValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceTableMapValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceTableMapValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceTableMapValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceTableMapValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SourceTableMapValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SourceTableMapValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SourceTableMapValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DataActionId
+
+	if all {
+		switch v := interface{}(m.GetDeduplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceTableMapValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceTableMapValidationError{
+					field:  "Deduplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeduplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceTableMapValidationError{
+				field:  "Deduplication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SourceTableMapMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceTableMapMultiError is an error wrapping multiple validation errors
+// returned by SourceTableMap.ValidateAll() if the designated constraints
+// aren't met.
+type SourceTableMapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceTableMapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceTableMapMultiError) AllErrors() []error { return m }
+
+// SourceTableMapValidationError is the validation error returned by
+// SourceTableMap.Validate if the designated constraints aren't met.
+type SourceTableMapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceTableMapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceTableMapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceTableMapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceTableMapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceTableMapValidationError) ErrorName() string { return "SourceTableMapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SourceTableMapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceTableMap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceTableMapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceTableMapValidationError{}
+
+// Validate checks the field values on DestinationMappings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DestinationMappings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DestinationMappings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DestinationMappingsMultiError, or nil if none found.
+func (m *DestinationMappings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DestinationMappings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for ObjectType
+
+	// no validation rules for ObjectName
+
+	// no validation rules for ObjectId
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DestinationMappingsValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DestinationMappingsValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DestinationMappingsValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DataActionId
+
+	// no validation rules for SuppressedCount
+
+	if len(errors) > 0 {
+		return DestinationMappingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DestinationMappingsMultiError is an error wrapping multiple validation
+// errors returned by DestinationMappings.ValidateAll() if the designated
+// constraints aren't met.
+type DestinationMappingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DestinationMappingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DestinationMappingsMultiError) AllErrors() []error { return m }
+
+// DestinationMappingsValidationError is the validation error returned by
+// DestinationMappings.Validate if the designated constraints aren't met.
+type DestinationMappingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DestinationMappingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DestinationMappingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DestinationMappingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DestinationMappingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DestinationMappingsValidationError) ErrorName() string {
+	return "DestinationMappingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DestinationMappingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDestinationMappings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DestinationMappingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DestinationMappingsValidationError{}
+
+// Validate checks the field values on EnrichedMasterSegment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrichedMasterSegment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichedMasterSegment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichedMasterSegmentMultiError, or nil if none found.
+func (m *EnrichedMasterSegment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichedMasterSegment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return EnrichedMasterSegmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichedMasterSegmentMultiError is an error wrapping multiple validation
+// errors returned by EnrichedMasterSegment.ValidateAll() if the designated
+// constraints aren't met.
+type EnrichedMasterSegmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichedMasterSegmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichedMasterSegmentMultiError) AllErrors() []error { return m }
+
+// EnrichedMasterSegmentValidationError is the validation error returned by
+// EnrichedMasterSegment.Validate if the designated constraints aren't met.
+type EnrichedMasterSegmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichedMasterSegmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichedMasterSegmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichedMasterSegmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichedMasterSegmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichedMasterSegmentValidationError) ErrorName() string {
+	return "EnrichedMasterSegmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrichedMasterSegmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichedMasterSegment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichedMasterSegmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichedMasterSegmentValidationError{}
+
+// Validate checks the field values on EnrichedSegment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrichedSegment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrichedSegment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrichedSegmentMultiError, or nil if none found.
+func (m *EnrichedSegment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrichedSegment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return EnrichedSegmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrichedSegmentMultiError is an error wrapping multiple validation errors
+// returned by EnrichedSegment.ValidateAll() if the designated constraints
+// aren't met.
+type EnrichedSegmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrichedSegmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrichedSegmentMultiError) AllErrors() []error { return m }
+
+// EnrichedSegmentValidationError is the validation error returned by
+// EnrichedSegment.Validate if the designated constraints aren't met.
+type EnrichedSegmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrichedSegmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrichedSegmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrichedSegmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrichedSegmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrichedSegmentValidationError) ErrorName() string { return "EnrichedSegmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrichedSegmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrichedSegment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrichedSegmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrichedSegmentValidationError{}
+
+// Validate checks the field values on IdentitySource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IdentitySource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentitySource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdentitySourceMultiError,
+// or nil if none found.
+func (m *IdentitySource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentitySource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTableId() <= 0 {
+		err := IdentitySourceValidationError{
+			field:  "TableId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRecordKeyColumn()) < 1 {
+		err := IdentitySourceValidationError{
+			field:  "RecordKeyColumn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EmailColumn
+
+	// no validation rules for PhoneColumn
+
+	// no validation rules for CustomerIdColumn
+
+	// no validation rules for NameColumn
+
+	// no validation rules for AddressColumn
+
+	if len(errors) > 0 {
+		return IdentitySourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentitySourceMultiError is an error wrapping multiple validation errors
+// returned by IdentitySource.ValidateAll() if the designated constraints
+// aren't met.
+type IdentitySourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentitySourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentitySourceMultiError) AllErrors() []error { return m }
+
+// IdentitySourceValidationError is the validation error returned by
+// IdentitySource.Validate if the designated constraints aren't met.
+type IdentitySourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentitySourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentitySourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentitySourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentitySourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentitySourceValidationError) ErrorName() string { return "IdentitySourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdentitySourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentitySource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentitySourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentitySourceValidationError{}
+
+// Validate checks the field values on IdentityMatchRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IdentityMatchRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityMatchRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityMatchRuleMultiError, or nil if none found.
+func (m *IdentityMatchRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityMatchRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _IdentityMatchRule_Type_InLookup[m.GetType()]; !ok {
+		err := IdentityMatchRuleValidationError{
+			field:  "Type",
+			reason: "value must be in list [exact_email normalized_phone customer_id fuzzy_name_address]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetThreshold(); val < 0 || val > 1 {
+		err := IdentityMatchRuleValidationError{
+			field:  "Threshold",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DefaultCountryCode
+
+	if len(errors) > 0 {
+		return IdentityMatchRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityMatchRuleMultiError is an error wrapping multiple validation errors
+// returned by IdentityMatchRule.ValidateAll() if the designated constraints
+// aren't met.
+type IdentityMatchRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityMatchRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityMatchRuleMultiError) AllErrors() []error { return m }
+
+// IdentityMatchRuleValidationError is the validation error returned by
+// IdentityMatchRule.Validate if the designated constraints aren't met.
+type IdentityMatchRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityMatchRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityMatchRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityMatchRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityMatchRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityMatchRuleValidationError) ErrorName() string {
+	return "IdentityMatchRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityMatchRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityMatchRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityMatchRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityMatchRuleValidationError{}
+
+var _IdentityMatchRule_Type_InLookup = map[string]struct{}{
+	"exact_email":        {},
+	"normalized_phone":   {},
+	"customer_id":        {},
+	"fuzzy_name_address": {},
+}
+
+// Validate checks the field values on IdentityResolution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityResolution) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityResolution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityResolutionMultiError, or nil if none found.
+func (m *IdentityResolution) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityResolution) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Status
+
+	// no validation rules for OutputTableId
+
+	// no validation rules for OutputTableName
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return IdentityResolutionMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityResolutionMultiError is an error wrapping multiple validation errors
+// returned by IdentityResolution.ValidateAll() if the designated constraints
+// aren't met.
+type IdentityResolutionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityResolutionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityResolutionMultiError) AllErrors() []error { return m }
+
+// IdentityResolutionValidationError is the validation error returned by
+// IdentityResolution.Validate if the designated constraints aren't met.
+type IdentityResolutionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityResolutionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityResolutionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityResolutionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityResolutionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityResolutionValidationError) ErrorName() string {
+	return "IdentityResolutionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityResolutionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityResolution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityResolutionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityResolutionValidationError{}
+
+// Validate checks the field values on IdentityProfileEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IdentityProfileEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityProfileEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityProfileEventMultiError, or nil if none found.
+func (m *IdentityProfileEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityProfileEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnifiedProfileId
+
+	// no validation rules for EventType
+
+	// no validation rules for DagRunId
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return IdentityProfileEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityProfileEventMultiError is an error wrapping multiple validation
+// errors returned by IdentityProfileEvent.ValidateAll() if the designated
+// constraints aren't met.
+type IdentityProfileEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityProfileEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityProfileEventMultiError) AllErrors() []error { return m }
+
+// IdentityProfileEventValidationError is the validation error returned by
+// IdentityProfileEvent.Validate if the designated constraints aren't met.
+type IdentityProfileEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityProfileEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityProfileEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityProfileEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityProfileEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityProfileEventValidationError) ErrorName() string {
+	return "IdentityProfileEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityProfileEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityProfileEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityProfileEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityProfileEventValidationError{}
+
+// Validate checks the field values on EventSchemaField with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EventSchemaField) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSchemaField with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventSchemaFieldMultiError, or nil if none found.
+func (m *EventSchemaField) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSchemaField) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_EventSchemaField_Name_Pattern.MatchString(m.GetName()) {
+		err := EventSchemaFieldValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _EventSchemaField_DataType_InLookup[m.GetDataType()]; !ok {
+		err := EventSchemaFieldValidationError{
+			field:  "DataType",
+			reason: "value must be in list [string int float bool timestamp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Required
+
+	if len(errors) > 0 {
+		return EventSchemaFieldMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSchemaFieldMultiError is an error wrapping multiple validation errors
+// returned by EventSchemaField.ValidateAll() if the designated constraints
+// aren't met.
+type EventSchemaFieldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSchemaFieldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSchemaFieldMultiError) AllErrors() []error { return m }
+
+// EventSchemaFieldValidationError is the validation error returned by
+// EventSchemaField.Validate if the designated constraints aren't met.
+type EventSchemaFieldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSchemaFieldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSchemaFieldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSchemaFieldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSchemaFieldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSchemaFieldValidationError) ErrorName() string { return "EventSchemaFieldValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventSchemaFieldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSchemaField.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSchemaFieldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSchemaFieldValidationError{}
+
+var _EventSchemaField_Name_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+var _EventSchemaField_DataType_InLookup = map[string]struct{}{
+	"string":    {},
+	"int":       {},
+	"float":     {},
+	"bool":      {},
+	"timestamp": {},
+}
+
+// Validate checks the field values on EventSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventSourceMultiError, or
+// nil if none found.
+func (m *EventSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for WriteKey
+
+	// no validation rules for TableId
+
+	// no validation rules for TableName
+
+	for idx, item := range m.GetProperties() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventSourceValidationError{
+						field:  fmt.Sprintf("Properties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventSourceValidationError{
+						field:  fmt.Sprintf("Properties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventSourceValidationError{
+					field:  fmt.Sprintf("Properties[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTraits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventSourceValidationError{
+						field:  fmt.Sprintf("Traits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventSourceValidationError{
+						field:  fmt.Sprintf("Traits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventSourceValidationError{
+					field:  fmt.Sprintf("Traits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PendingCount
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return EventSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventSourceMultiError is an error wrapping multiple validation errors
+// returned by EventSource.ValidateAll() if the designated constraints aren't met.
+type EventSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventSourceMultiError) AllErrors() []error { return m }
+
+// EventSourceValidationError is the validation error returned by
+// EventSource.Validate if the designated constraints aren't met.
+type EventSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventSourceValidationError) ErrorName() string { return "EventSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventSourceValidationError{}
+
+// Validate checks the field values on TrackEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrackEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrackEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrackEventMultiError, or
+// nil if none found.
+func (m *TrackEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrackEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEvent()) < 1 {
+		err := TrackEventValidationError{
+			field:  "Event",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for AnonymousId
+
+	if all {
+		switch v := interface{}(m.GetProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrackEventValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrackEventValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrackEventValidationError{
+				field:  "Properties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timestamp
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return TrackEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrackEventMultiError is an error wrapping multiple validation errors
+// returned by TrackEvent.ValidateAll() if the designated constraints aren't met.
+type TrackEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrackEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrackEventMultiError) AllErrors() []error { return m }
+
+// TrackEventValidationError is the validation error returned by
+// TrackEvent.Validate if the designated constraints aren't met.
+type TrackEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrackEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrackEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrackEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrackEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrackEventValidationError) ErrorName() string { return "TrackEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrackEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrackEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrackEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrackEventValidationError{}
+
+// Validate checks the field values on SchemaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaChangeMultiError, or
+// nil if none found.
+func (m *SchemaChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChangeType
+
+	// no validation rules for ColumnName
+
+	// no validation rules for OldDataType
+
+	// no validation rules for NewDataType
+
+	if len(errors) > 0 {
+		return SchemaChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaChange.ValidateAll() if the designated constraints aren't met.
+type SchemaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaChangeMultiError) AllErrors() []error { return m }
+
+// SchemaChangeValidationError is the validation error returned by
+// SchemaChange.Validate if the designated constraints aren't met.
+type SchemaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaChangeValidationError) ErrorName() string { return "SchemaChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaChangeValidationError{}
+
+// Validate checks the field values on DataTableSchemaVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataTableSchemaVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataTableSchemaVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataTableSchemaVersionMultiError, or nil if none found.
+func (m *DataTableSchemaVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataTableSchemaVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetSchema() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataTableSchemaVersionValidationError{
+						field:  fmt.Sprintf("Schema[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataTableSchemaVersionValidationError{
+						field:  fmt.Sprintf("Schema[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataTableSchemaVersionValidationError{
+					field:  fmt.Sprintf("Schema[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataTableSchemaVersionValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataTableSchemaVersionValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataTableSchemaVersionValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsBreaking
+
+	// no validation rules for DataActionId
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return DataTableSchemaVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataTableSchemaVersionMultiError is an error wrapping multiple validation
+// errors returned by DataTableSchemaVersion.ValidateAll() if the designated
+// constraints aren't met.
+type DataTableSchemaVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataTableSchemaVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataTableSchemaVersionMultiError) AllErrors() []error { return m }
+
+// DataTableSchemaVersionValidationError is the validation error returned by
+// DataTableSchemaVersion.Validate if the designated constraints aren't met.
+type DataTableSchemaVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataTableSchemaVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataTableSchemaVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataTableSchemaVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataTableSchemaVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataTableSchemaVersionValidationError) ErrorName() string {
+	return "DataTableSchemaVersionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataTableSchemaVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataTableSchemaVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataTableSchemaVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataTableSchemaVersionValidationError{}
+
+// Validate checks the field values on SchemaIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaIssueMultiError, or
+// nil if none found.
+func (m *SchemaIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TableId
+
+	// no validation rules for TableName
+
+	// no validation rules for ColumnName
+
+	// no validation rules for ChangeType
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaIssueMultiError is an error wrapping multiple validation errors
+// returned by SchemaIssue.ValidateAll() if the designated constraints aren't met.
+type SchemaIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaIssueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaIssueMultiError) AllErrors() []error { return m }
+
+// SchemaIssueValidationError is the validation error returned by
+// SchemaIssue.Validate if the designated constraints aren't met.
+type SchemaIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaIssueValidationError) ErrorName() string { return "SchemaIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaIssueValidationError{}
+
+// Validate checks the field values on DataTableVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataTableVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataTableVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataTableVersionMultiError, or nil if none found.
+func (m *DataTableVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataTableVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Operation
+
+	// no validation rules for NumOutputRows
+
+	// no validation rules for DataActionId
+
+	// no validation rules for DataActionRunId
+
+	// no validation rules for ActionType
+
+	if len(errors) > 0 {
+		return DataTableVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataTableVersionMultiError is an error wrapping multiple validation errors
+// returned by DataTableVersion.ValidateAll() if the designated constraints
+// aren't met.
+type DataTableVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataTableVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataTableVersionMultiError) AllErrors() []error { return m }
+
+// DataTableVersionValidationError is the validation error returned by
+// DataTableVersion.Validate if the designated constraints aren't met.
+type DataTableVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataTableVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataTableVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataTableVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataTableVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataTableVersionValidationError) ErrorName() string { return "DataTableVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataTableVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataTableVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataTableVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataTableVersionValidationError{}
+
+// Validate checks the field values on ValueCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ValueCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValueCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ValueCountMultiError, or
+// nil if none found.
+func (m *ValueCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValueCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ValueCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValueCountMultiError is an error wrapping multiple validation errors
+// returned by ValueCount.ValidateAll() if the designated constraints aren't met.
+type ValueCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValueCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValueCountMultiError) AllErrors() []error { return m }
+
+// ValueCountValidationError is the validation error returned by
+// ValueCount.Validate if the designated constraints aren't met.
+type ValueCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValueCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValueCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValueCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValueCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValueCountValidationError) ErrorName() string { return "ValueCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValueCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValueCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValueCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValueCountValidationError{}
+
+// Validate checks the field values on HistogramBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HistogramBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistogramBucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HistogramBucketMultiError, or nil if none found.
+func (m *HistogramBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistogramBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Lower
+
+	// no validation rules for Upper
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return HistogramBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistogramBucketMultiError is an error wrapping multiple validation errors
+// returned by HistogramBucket.ValidateAll() if the designated constraints
+// aren't met.
+type HistogramBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistogramBucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistogramBucketMultiError) AllErrors() []error { return m }
+
+// HistogramBucketValidationError is the validation error returned by
+// HistogramBucket.Validate if the designated constraints aren't met.
+type HistogramBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistogramBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistogramBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistogramBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistogramBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistogramBucketValidationError) ErrorName() string { return "HistogramBucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistogramBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistogramBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistogramBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistogramBucketValidationError{}
+
+// Validate checks the field values on ColumnProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnProfileMultiError, or
+// nil if none found.
+func (m *ColumnProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ColumnName
+
+	// no validation rules for DataType
+
+	// no validation rules for NullRatio
+
+	// no validation rules for DistinctCount
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if all {
+		switch v := interface{}(m.GetMean()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Mean",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Mean",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMean()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnProfileValidationError{
+				field:  "Mean",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTopValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnProfileValidationError{
+						field:  fmt.Sprintf("TopValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnProfileValidationError{
+						field:  fmt.Sprintf("TopValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnProfileValidationError{
+					field:  fmt.Sprintf("TopValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHistogram() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnProfileValidationError{
+						field:  fmt.Sprintf("Histogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnProfileValidationError{
+						field:  fmt.Sprintf("Histogram[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnProfileValidationError{
+					field:  fmt.Sprintf("Histogram[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ColumnProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnProfileMultiError is an error wrapping multiple validation errors
+// returned by ColumnProfile.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnProfileMultiError) AllErrors() []error { return m }
+
+// ColumnProfileValidationError is the validation error returned by
+// ColumnProfile.Validate if the designated constraints aren't met.
+type ColumnProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnProfileValidationError) ErrorName() string { return "ColumnProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnProfileValidationError{}
+
+// Validate checks the field values on DataProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataProfileMultiError, or
+// nil if none found.
+func (m *DataProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RowCount
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataProfileValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataProfileValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataProfileValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ProfiledAt
+
+	if len(errors) > 0 {
+		return DataProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataProfileMultiError is an error wrapping multiple validation errors
+// returned by DataProfile.ValidateAll() if the designated constraints aren't met.
+type DataProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataProfileMultiError) AllErrors() []error { return m }
+
+// DataProfileValidationError is the validation error returned by
+// DataProfile.Validate if the designated constraints aren't met.
+type DataProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataProfileValidationError) ErrorName() string { return "DataProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataProfileValidationError{}
+
+// Validate checks the field values on BehaviorTableProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BehaviorTableProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BehaviorTableProfile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BehaviorTableProfileMultiError, or nil if none found.
+func (m *BehaviorTableProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BehaviorTableProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BehaviorTableId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BehaviorTableProfileValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BehaviorTableProfileValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BehaviorTableProfileValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BehaviorTableProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// BehaviorTableProfileMultiError is an error wrapping multiple validation
+// errors returned by BehaviorTableProfile.ValidateAll() if the designated
+// constraints aren't met.
+type BehaviorTableProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BehaviorTableProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BehaviorTableProfileMultiError) AllErrors() []error { return m }
+
+// BehaviorTableProfileValidationError is the validation error returned by
+// BehaviorTableProfile.Validate if the designated constraints aren't met.
+type BehaviorTableProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BehaviorTableProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BehaviorTableProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BehaviorTableProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BehaviorTableProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BehaviorTableProfileValidationError) ErrorName() string {
+	return "BehaviorTableProfileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BehaviorTableProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBehaviorTableProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BehaviorTableProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BehaviorTableProfileValidationError{}
+
+// Validate checks the field values on TableQuery with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableQuery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableQuery with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableQueryMultiError, or
+// nil if none found.
+func (m *TableQuery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableQuery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableQueryValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableQueryValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableQueryValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSorts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableQueryValidationError{
+						field:  fmt.Sprintf("Sorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableQueryValidationError{
+						field:  fmt.Sprintf("Sorts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableQueryValidationError{
+					field:  fmt.Sprintf("Sorts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := TableQueryValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return TableQueryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableQueryMultiError is an error wrapping multiple validation errors
+// returned by TableQuery.ValidateAll() if the designated constraints aren't met.
+type TableQueryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableQueryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableQueryMultiError) AllErrors() []error { return m }
+
+// TableQueryValidationError is the validation error returned by
+// TableQuery.Validate if the designated constraints aren't met.
+type TableQueryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableQueryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableQueryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableQueryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableQueryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableQueryValidationError) ErrorName() string { return "TableQueryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableQueryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableQuery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableQueryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableQueryValidationError{}
+
+// Validate checks the field values on TableQuerySort with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableQuerySort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableQuerySort with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableQuerySortMultiError,
+// or nil if none found.
+func (m *TableQuerySort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableQuerySort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetColumn()) < 1 {
+		err := TableQuerySortValidationError{
+			field:  "Column",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Descending
+
+	if len(errors) > 0 {
+		return TableQuerySortMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableQuerySortMultiError is an error wrapping multiple validation errors
+// returned by TableQuerySort.ValidateAll() if the designated constraints
+// aren't met.
+type TableQuerySortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableQuerySortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableQuerySortMultiError) AllErrors() []error { return m }
+
+// TableQuerySortValidationError is the validation error returned by
+// TableQuerySort.Validate if the designated constraints aren't met.
+type TableQuerySortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableQuerySortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableQuerySortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableQuerySortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableQuerySortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableQuerySortValidationError) ErrorName() string { return "TableQuerySortValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableQuerySortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableQuerySort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableQuerySortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableQuerySortValidationError{}
+
+// Validate checks the field values on ComputedAttribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ComputedAttribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComputedAttribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComputedAttributeMultiError, or nil if none found.
+func (m *ComputedAttribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComputedAttribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ComputedAttribute_Name_Pattern.MatchString(m.GetName()) {
+		err := ComputedAttributeValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBehaviorTable()) < 1 {
+		err := ComputedAttributeValidationError{
+			field:  "BehaviorTable",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ComputedAttribute_Aggregation_InLookup[m.GetAggregation()]; !ok {
+		err := ComputedAttributeValidationError{
+			field:  "Aggregation",
+			reason: "value must be in list [count sum avg min max first_value last_value]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Column
+
+	// no validation rules for TimeColumn
+
+	if m.GetWindowDays() < 0 {
+		err := ComputedAttributeValidationError{
+			field:  "WindowDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComputedAttributeValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComputedAttributeValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComputedAttributeValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ComputedAttributeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComputedAttributeMultiError is an error wrapping multiple validation errors
+// returned by ComputedAttribute.ValidateAll() if the designated constraints
+// aren't met.
+type ComputedAttributeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComputedAttributeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComputedAttributeMultiError) AllErrors() []error { return m }
+
+// ComputedAttributeValidationError is the validation error returned by
+// ComputedAttribute.Validate if the designated constraints aren't met.
+type ComputedAttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComputedAttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComputedAttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComputedAttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComputedAttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComputedAttributeValidationError) ErrorName() string {
+	return "ComputedAttributeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComputedAttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComputedAttribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComputedAttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComputedAttributeValidationError{}
+
+var _ComputedAttribute_Name_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+var _ComputedAttribute_Aggregation_InLookup = map[string]struct{}{
+	"count":       {},
+	"sum":         {},
+	"avg":         {},
+	"min":         {},
+	"max":         {},
+	"first_value": {},
+	"last_value":  {},
+}
+
+// Validate checks the field values on CustomerAttribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CustomerAttribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerAttribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CustomerAttributeMultiError, or nil if none found.
+func (m *CustomerAttribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerAttribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for DataType
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerAttributeValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerAttributeValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerAttributeValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CustomerAttributeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerAttributeMultiError is an error wrapping multiple validation errors
+// returned by CustomerAttribute.ValidateAll() if the designated constraints
+// aren't met.
+type CustomerAttributeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerAttributeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerAttributeMultiError) AllErrors() []error { return m }
+
+// CustomerAttributeValidationError is the validation error returned by
+// CustomerAttribute.Validate if the designated constraints aren't met.
+type CustomerAttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerAttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerAttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerAttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerAttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerAttributeValidationError) ErrorName() string {
+	return "CustomerAttributeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CustomerAttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerAttribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerAttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerAttributeValidationError{}
+
+// Validate checks the field values on CustomerEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CustomerEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CustomerEventMultiError, or
+// nil if none found.
+func (m *CustomerEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BehaviorTableId
+
+	// no validation rules for BehaviorTableName
+
+	// no validation rules for EventTime
+
+	// no validation rules for Record
+
+	if len(errors) > 0 {
+		return CustomerEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerEventMultiError is an error wrapping multiple validation errors
+// returned by CustomerEvent.ValidateAll() if the designated constraints
+// aren't met.
+type CustomerEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerEventMultiError) AllErrors() []error { return m }
+
+// CustomerEventValidationError is the validation error returned by
+// CustomerEvent.Validate if the designated constraints aren't met.
+type CustomerEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerEventValidationError) ErrorName() string { return "CustomerEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CustomerEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerEventValidationError{}
+
+// Validate checks the field values on CustomerSegmentMembership with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CustomerSegmentMembership) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerSegmentMembership with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CustomerSegmentMembershipMultiError, or nil if none found.
+func (m *CustomerSegmentMembership) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerSegmentMembership) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SegmentId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CustomerSegmentMembershipMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerSegmentMembershipMultiError is an error wrapping multiple validation
+// errors returned by CustomerSegmentMembership.ValidateAll() if the
+// designated constraints aren't met.
+type CustomerSegmentMembershipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerSegmentMembershipMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerSegmentMembershipMultiError) AllErrors() []error { return m }
+
+// CustomerSegmentMembershipValidationError is the validation error returned by
+// CustomerSegmentMembership.Validate if the designated constraints aren't met.
+type CustomerSegmentMembershipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerSegmentMembershipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerSegmentMembershipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerSegmentMembershipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerSegmentMembershipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerSegmentMembershipValidationError) ErrorName() string {
+	return "CustomerSegmentMembershipValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CustomerSegmentMembershipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerSegmentMembership.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerSegmentMembershipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerSegmentMembershipValidationError{}
+
+// Validate checks the field values on CustomerPrediction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CustomerPrediction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerPrediction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CustomerPredictionMultiError, or nil if none found.
+func (m *CustomerPrediction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerPrediction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PredictModelId
+
+	// no validation rules for PredictModelName
+
+	// no validation rules for Label_1
+
+	// no validation rules for Label_2
+
+	// no validation rules for SegmentId
+
+	// no validation rules for ActionId
+
+	// no validation rules for AppliedAt
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return CustomerPredictionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerPredictionMultiError is an error wrapping multiple validation errors
+// returned by CustomerPrediction.ValidateAll() if the designated constraints
+// aren't met.
+type CustomerPredictionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerPredictionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerPredictionMultiError) AllErrors() []error { return m }
+
+// CustomerPredictionValidationError is the validation error returned by
+// CustomerPrediction.Validate if the designated constraints aren't met.
+type CustomerPredictionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerPredictionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerPredictionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerPredictionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerPredictionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerPredictionValidationError) ErrorName() string {
+	return "CustomerPredictionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CustomerPredictionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerPrediction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerPredictionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerPredictionValidationError{}
+
+// Validate checks the field values on CustomerExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CustomerExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CustomerExportMultiError,
+// or nil if none found.
+func (m *CustomerExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationId
+
+	// no validation rules for DestinationName
+
+	// no validation rules for ObjectType
+
+	// no validation rules for ObjectId
+
+	// no validation rules for ObjectName
+
+	// no validation rules for DataActionId
+
+	if len(errors) > 0 {
+		return CustomerExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerExportMultiError is an error wrapping multiple validation errors
+// returned by CustomerExport.ValidateAll() if the designated constraints
+// aren't met.
+type CustomerExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerExportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerExportMultiError) AllErrors() []error { return m }
+
+// CustomerExportValidationError is the validation error returned by
+// CustomerExport.Validate if the designated constraints aren't met.
+type CustomerExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerExportValidationError) ErrorName() string { return "CustomerExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CustomerExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerExportValidationError{}
+
+// Validate checks the field values on ProfileSearchHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProfileSearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileSearchHit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileSearchHitMultiError, or nil if none found.
+func (m *ProfileSearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileSearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CdpSystemUuid
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProfileSearchHitValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProfileSearchHitValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProfileSearchHitValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProfileSearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileSearchHitMultiError is an error wrapping multiple validation errors
+// returned by ProfileSearchHit.ValidateAll() if the designated constraints
+// aren't met.
+type ProfileSearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileSearchHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileSearchHitMultiError) AllErrors() []error { return m }
+
+// ProfileSearchHitValidationError is the validation error returned by
+// ProfileSearchHit.Validate if the designated constraints aren't met.
+type ProfileSearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileSearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileSearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileSearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileSearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileSearchHitValidationError) ErrorName() string { return "ProfileSearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileSearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileSearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileSearchHitValidationError{}
+
+// Validate checks the field values on ProfileSearchMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileSearchMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileSearchMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileSearchMatchMultiError, or nil if none found.
+func (m *ProfileSearchMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileSearchMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	// no validation rules for Value
+
+	// no validation rules for Highlight
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return ProfileSearchMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileSearchMatchMultiError is an error wrapping multiple validation errors
+// returned by ProfileSearchMatch.ValidateAll() if the designated constraints
+// aren't met.
+type ProfileSearchMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileSearchMatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileSearchMatchMultiError) AllErrors() []error { return m }
+
+// ProfileSearchMatchValidationError is the validation error returned by
+// ProfileSearchMatch.Validate if the designated constraints aren't met.
+type ProfileSearchMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileSearchMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileSearchMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileSearchMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileSearchMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileSearchMatchValidationError) ErrorName() string {
+	return "ProfileSearchMatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfileSearchMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileSearchMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileSearchMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileSearchMatchValidationError{}
+
+// Validate checks the field values on ColumnTag with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnTag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnTagMultiError, or nil
+// if none found.
+func (m *ColumnTag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnTagValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ColumnTag_Tag_InLookup[m.GetTag()]; !ok {
+		err := ColumnTagValidationError{
+			field:  "Tag",
+			reason: "value must be in list [email phone name address national_id]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ColumnTagMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTagMultiError is an error wrapping multiple validation errors returned
+// by ColumnTag.ValidateAll() if the designated constraints aren't met.
+type ColumnTagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTagMultiError) AllErrors() []error { return m }
+
+// ColumnTagValidationError is the validation error returned by
+// ColumnTag.Validate if the designated constraints aren't met.
+type ColumnTagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTagValidationError) ErrorName() string { return "ColumnTagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnTagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTagValidationError{}
+
+var _ColumnTag_Tag_InLookup = map[string]struct{}{
+	"email":       {},
+	"phone":       {},
+	"name":        {},
+	"address":     {},
+	"national_id": {},
+}
+
+// Validate checks the field values on ColumnTagSuggestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTagSuggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTagSuggestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTagSuggestionMultiError, or nil if none found.
+func (m *ColumnTagSuggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTagSuggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ColumnName
+
+	// no validation rules for Tag
+
+	// no validation rules for Reason
+
+	// no validation rules for Confidence
+
+	// no validation rules for CurrentTag
+
+	if len(errors) > 0 {
+		return ColumnTagSuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTagSuggestionMultiError is an error wrapping multiple validation
+// errors returned by ColumnTagSuggestion.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTagSuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTagSuggestionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTagSuggestionMultiError) AllErrors() []error { return m }
+
+// ColumnTagSuggestionValidationError is the validation error returned by
+// ColumnTagSuggestion.Validate if the designated constraints aren't met.
+type ColumnTagSuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTagSuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTagSuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTagSuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTagSuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTagSuggestionValidationError) ErrorName() string {
+	return "ColumnTagSuggestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTagSuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTagSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTagSuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTagSuggestionValidationError{}
+
+// Validate checks the field values on MaskingPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MaskingPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MaskingPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MaskingPolicyMultiError, or
+// nil if none found.
+func (m *MaskingPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MaskingPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _MaskingPolicy_Role_InLookup[m.GetRole()]; !ok {
+		err := MaskingPolicyValidationError{
+			field:  "Role",
+			reason: "value must be in list [admin user]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _MaskingPolicy_Tag_InLookup[m.GetTag()]; !ok {
+		err := MaskingPolicyValidationError{
+			field:  "Tag",
+			reason: "value must be in list [email phone name address national_id]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _MaskingPolicy_Method_InLookup[m.GetMethod()]; !ok {
+		err := MaskingPolicyValidationError{
+			field:  "Method",
+			reason: "value must be in list [none full partial hashed null]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MaskingPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MaskingPolicyMultiError is an error wrapping multiple validation errors
+// returned by MaskingPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type MaskingPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MaskingPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MaskingPolicyMultiError) AllErrors() []error { return m }
+
+// MaskingPolicyValidationError is the validation error returned by
+// MaskingPolicy.Validate if the designated constraints aren't met.
+type MaskingPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MaskingPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MaskingPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MaskingPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MaskingPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MaskingPolicyValidationError) ErrorName() string { return "MaskingPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MaskingPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMaskingPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MaskingPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MaskingPolicyValidationError{}
+
+var _MaskingPolicy_Role_InLookup = map[string]struct{}{
+	"admin": {},
+	"user":  {},
+}
+
+var _MaskingPolicy_Tag_InLookup = map[string]struct{}{
+	"email":       {},
+	"phone":       {},
+	"name":        {},
+	"address":     {},
+	"national_id": {},
+}
+
+var _MaskingPolicy_Method_InLookup = map[string]struct{}{
+	"none":    {},
+	"full":    {},
+	"partial": {},
+	"hashed":  {},
+	"null":    {},
+}
+
+// Validate checks the field values on ErasureCertificate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ErasureCertificate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasureCertificate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasureCertificateMultiError, or nil if none found.
+func (m *ErasureCertificate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasureCertificate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for IdentifierHash
+
+	for idx, item := range m.GetErasedObjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ErasureCertificateValidationError{
+						field:  fmt.Sprintf("ErasedObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ErasureCertificateValidationError{
+						field:  fmt.Sprintf("ErasedObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ErasureCertificateValidationError{
+					field:  fmt.Sprintf("ErasedObjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ErasureCertificateValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ErasureCertificateValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ErasureCertificateValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ErasedAt
+
+	// no validation rules for PreviousHash
+
+	// no validation rules for Hash
+
+	for idx, item := range m.GetSkippedObjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ErasureCertificateValidationError{
+						field:  fmt.Sprintf("SkippedObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ErasureCertificateValidationError{
+						field:  fmt.Sprintf("SkippedObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ErasureCertificateValidationError{
+					field:  fmt.Sprintf("SkippedObjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ErasureCertificateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasureCertificateMultiError is an error wrapping multiple validation errors
+// returned by ErasureCertificate.ValidateAll() if the designated constraints
+// aren't met.
+type ErasureCertificateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasureCertificateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasureCertificateMultiError) AllErrors() []error { return m }
+
+// ErasureCertificateValidationError is the validation error returned by
+// ErasureCertificate.Validate if the designated constraints aren't met.
+type ErasureCertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasureCertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasureCertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasureCertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasureCertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasureCertificateValidationError) ErrorName() string {
+	return "ErasureCertificateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ErasureCertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasureCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasureCertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasureCertificateValidationError{}
+
+// Validate checks the field values on ErasedObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErasedObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasedObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErasedObjectMultiError, or
+// nil if none found.
+func (m *ErasedObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasedObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectType
+
+	// no validation rules for ObjectId
+
+	// no validation rules for Name
+
+	// no validation rules for DeletedRows
+
+	// no validation rules for HistoryVacuumed
+
+	if len(errors) > 0 {
+		return ErasedObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasedObjectMultiError is an error wrapping multiple validation errors
+// returned by ErasedObject.ValidateAll() if the designated constraints aren't met.
+type ErasedObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasedObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasedObjectMultiError) AllErrors() []error { return m }
+
+// ErasedObjectValidationError is the validation error returned by
+// ErasedObject.Validate if the designated constraints aren't met.
+type ErasedObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasedObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasedObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasedObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasedObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasedObjectValidationError) ErrorName() string { return "ErasedObjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasedObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasedObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasedObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasedObjectValidationError{}
+
+// Validate checks the field values on ErasureSkippedObject with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ErasureSkippedObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasureSkippedObject with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasureSkippedObjectMultiError, or nil if none found.
+func (m *ErasureSkippedObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasureSkippedObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectType
+
+	// no validation rules for ObjectId
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ErasureSkippedObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasureSkippedObjectMultiError is an error wrapping multiple validation
+// errors returned by ErasureSkippedObject.ValidateAll() if the designated
+// constraints aren't met.
+type ErasureSkippedObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasureSkippedObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasureSkippedObjectMultiError) AllErrors() []error { return m }
+
+// ErasureSkippedObjectValidationError is the validation error returned by
+// ErasureSkippedObject.Validate if the designated constraints aren't met.
+type ErasureSkippedObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasureSkippedObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasureSkippedObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasureSkippedObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasureSkippedObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasureSkippedObjectValidationError) ErrorName() string {
+	return "ErasureSkippedObjectValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ErasureSkippedObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasureSkippedObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasureSkippedObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasureSkippedObjectValidationError{}
+
+// Validate checks the field values on ErasureDestination with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ErasureDestination) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasureDestination with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasureDestinationMultiError, or nil if none found.
+func (m *ErasureDestination) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasureDestination) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationId
+
+	// no validation rules for DestinationName
+
+	// no validation rules for DestinationType
+
+	// no validation rules for ObjectType
+
+	// no validation rules for ObjectId
+
+	// no validation rules for ObjectName
+
+	if len(errors) > 0 {
+		return ErasureDestinationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasureDestinationMultiError is an error wrapping multiple validation errors
+// returned by ErasureDestination.ValidateAll() if the designated constraints
+// aren't met.
+type ErasureDestinationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasureDestinationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasureDestinationMultiError) AllErrors() []error { return m }
+
+// ErasureDestinationValidationError is the validation error returned by
+// ErasureDestination.Validate if the designated constraints aren't met.
+type ErasureDestinationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasureDestinationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MasterSegmentValidationError) Reason() string { return e.reason }
+func (e ErasureDestinationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MasterSegmentValidationError) Cause() error { return e.cause }
+func (e ErasureDestinationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MasterSegmentValidationError) Key() bool { return e.key }
+func (e ErasureDestinationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MasterSegmentValidationError) ErrorName() string { return "MasterSegmentValidationError" }
+func (e ErasureDestinationValidationError) ErrorName() string {
+	return "ErasureDestinationValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MasterSegmentValidationError) Error() string {
+func (e ErasureDestinationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -790,14 +8653,14 @@ func (e MasterSegmentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMasterSegment.%s: %s%s",
+		"invalid %sErasureDestination.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MasterSegmentValidationError{}
+var _ error = ErasureDestinationValidationError{}
 
 var _ interface {
 	Field() string
@@ -805,24 +8668,153 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MasterSegmentValidationError{}
+} = ErasureDestinationValidationError{}
 
-// Validate checks the field values on MasterSegmentDetail with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MasterSegmentDetail) Validate() error {
+// Validate checks the field values on ConsentColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsentColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MasterSegmentDetail with the rules
+// ValidateAll checks the field values on ConsentColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsentColumnMultiError, or
+// nil if none found.
+func (m *ConsentColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsentColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ConsentColumn_Channel_InLookup[m.GetChannel()]; !ok {
+		err := ConsentColumnValidationError{
+			field:  "Channel",
+			reason: "value must be in list [email sms push ads]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ConsentColumnValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConsentColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsentColumnMultiError is an error wrapping multiple validation errors
+// returned by ConsentColumn.ValidateAll() if the designated constraints
+// aren't met.
+type ConsentColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsentColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsentColumnMultiError) AllErrors() []error { return m }
+
+// ConsentColumnValidationError is the validation error returned by
+// ConsentColumn.Validate if the designated constraints aren't met.
+type ConsentColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsentColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsentColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsentColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsentColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsentColumnValidationError) ErrorName() string { return "ConsentColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsentColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsentColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsentColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsentColumnValidationError{}
+
+var _ConsentColumn_Channel_InLookup = map[string]struct{}{
+	"email": {},
+	"sms":   {},
+	"push":  {},
+	"ads":   {},
+}
+
+// Validate checks the field values on SuppressionList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SuppressionList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuppressionList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MasterSegmentDetailMultiError, or nil if none found.
-func (m *MasterSegmentDetail) ValidateAll() error {
+// SuppressionListMultiError, or nil if none found.
+func (m *SuppressionList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MasterSegmentDetail) validate(all bool) error {
+func (m *SuppressionList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -833,136 +8825,183 @@ func (m *MasterSegmentDetail) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for Description
+	// no validation rules for Channel
 
-	// no validation rules for Status
+	// no validation rules for IdentifierColumn
+
+	// no validation rules for SourceDataTableId
+
+	// no validation rules for SourceColumn
+
+	// no validation rules for EntryCount
 
 	// no validation rules for CreatedAt
 
 	// no validation rules for UpdatedAt
 
-	// no validation rules for AudienceTableId
+	if len(errors) > 0 {
+		return SuppressionListMultiError(errors)
+	}
 
-	// no validation rules for MainRawTableId
+	return nil
+}
 
-	// no validation rules for MainRawTableName
+// SuppressionListMultiError is an error wrapping multiple validation errors
+// returned by SuppressionList.ValidateAll() if the designated constraints
+// aren't met.
+type SuppressionListMultiError []error
 
-	for idx, item := range m.GetAttributeTables() {
-		_, _ = idx, item
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuppressionListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MasterSegmentDetailValidationError{
-						field:  fmt.Sprintf("AttributeTables[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MasterSegmentDetailValidationError{
-						field:  fmt.Sprintf("AttributeTables[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MasterSegmentDetailValidationError{
-					field:  fmt.Sprintf("AttributeTables[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// AllErrors returns a list of validation violation errors.
+func (m SuppressionListMultiError) AllErrors() []error { return m }
+
+// SuppressionListValidationError is the validation error returned by
+// SuppressionList.Validate if the designated constraints aren't met.
+type SuppressionListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuppressionListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuppressionListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuppressionListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuppressionListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuppressionListValidationError) ErrorName() string { return "SuppressionListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuppressionListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuppressionList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuppressionListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuppressionListValidationError{}
+
+// Validate checks the field values on RetentionPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetentionPolicy) Validate() error {
+	return m.validate(false)
+}
 
+// ValidateAll checks the field values on RetentionPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionPolicyMultiError, or nil if none found.
+func (m *RetentionPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetBehaviorTables() {
-		_, _ = idx, item
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ObjectType
+
+	// no validation rules for ObjectId
+
+	// no validation rules for TimestampColumn
+
+	// no validation rules for MaxAgeDays
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MasterSegmentDetailValidationError{
-						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MasterSegmentDetailValidationError{
-						field:  fmt.Sprintf("BehaviorTables[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MasterSegmentDetailValidationError{
-					field:  fmt.Sprintf("BehaviorTables[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for MaxVersions
 
-	}
+	// no validation rules for Clickstream
 
-	for idx, item := range m.GetAudienceSchema() {
-		_, _ = idx, item
+	// no validation rules for DataActionId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MasterSegmentDetailValidationError{
-						field:  fmt.Sprintf("AudienceSchema[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MasterSegmentDetailValidationError{
-						field:  fmt.Sprintf("AudienceSchema[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetLastRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MasterSegmentDetailValidationError{
-					field:  fmt.Sprintf("AudienceSchema[%v]", idx),
+				errors = append(errors, RetentionPolicyValidationError{
+					field:  "LastRun",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetentionPolicyValidationError{
+				field:  "LastRun",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
 	if len(errors) > 0 {
-		return MasterSegmentDetailMultiError(errors)
+		return RetentionPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// MasterSegmentDetailMultiError is an error wrapping multiple validation
-// errors returned by MasterSegmentDetail.ValidateAll() if the designated
-// constraints aren't met.
-type MasterSegmentDetailMultiError []error
+// RetentionPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetentionPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type RetentionPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MasterSegmentDetailMultiError) Error() string {
+func (m RetentionPolicyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -971,11 +9010,11 @@ func (m MasterSegmentDetailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MasterSegmentDetailMultiError) AllErrors() []error { return m }
+func (m RetentionPolicyMultiError) AllErrors() []error { return m }
 
-// MasterSegmentDetailValidationError is the validation error returned by
-// MasterSegmentDetail.Validate if the designated constraints aren't met.
-type MasterSegmentDetailValidationError struct {
+// RetentionPolicyValidationError is the validation error returned by
+// RetentionPolicy.Validate if the designated constraints aren't met.
+type RetentionPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -983,24 +9022,22 @@ type MasterSegmentDetailValidationError struct {
 }
 
 // Field function returns field value.
-func (e MasterSegmentDetailValidationError) Field() string { return e.field }
+func (e RetentionPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MasterSegmentDetailValidationError) Reason() string { return e.reason }
+func (e RetentionPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MasterSegmentDetailValidationError) Cause() error { return e.cause }
+func (e RetentionPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MasterSegmentDetailValidationError) Key() bool { return e.key }
+func (e RetentionPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MasterSegmentDetailValidationError) ErrorName() string {
-	return "MasterSegmentDetailValidationError"
-}
+func (e RetentionPolicyValidationError) ErrorName() string { return "RetentionPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MasterSegmentDetailValidationError) Error() string {
+func (e RetentionPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1012,14 +9049,14 @@ func (e MasterSegmentDetailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMasterSegmentDetail.%s: %s%s",
+		"invalid %sRetentionPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MasterSegmentDetailValidationError{}
+var _ error = RetentionPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1027,23 +9064,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MasterSegmentDetailValidationError{}
+} = RetentionPolicyValidationError{}
 
-// Validate checks the field values on Segment with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Segment) Validate() error {
+// Validate checks the field values on SegmentSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SegmentSnapshot) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Segment with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in SegmentMultiError, or nil if none found.
-func (m *Segment) ValidateAll() error {
+// ValidateAll checks the field values on SegmentSnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SegmentSnapshotMultiError, or nil if none found.
+func (m *SegmentSnapshot) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Segment) validate(all bool) error {
+func (m *SegmentSnapshot) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1052,33 +9090,32 @@ func (m *Segment) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
-
-	// no validation rules for Description
+	// no validation rules for SegmentId
 
-	// no validation rules for MasterSegmentId
+	// no validation rules for DataActionRunId
 
-	// no validation rules for MasterSegmentName
+	// no validation rules for Size
 
-	// no validation rules for CreatedAt
+	// no validation rules for EnteredCount
 
-	// no validation rules for UpdatedAt
+	// no validation rules for ExitedCount
 
-	// no validation rules for Status
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return SegmentMultiError(errors)
+		return SegmentSnapshotMultiError(errors)
 	}
 
 	return nil
 }
 
-// SegmentMultiError is an error wrapping multiple validation errors returned
-// by Segment.ValidateAll() if the designated constraints aren't met.
-type SegmentMultiError []error
+// SegmentSnapshotMultiError is an error wrapping multiple validation errors
+// returned by SegmentSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type SegmentSnapshotMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SegmentMultiError) Error() string {
+func (m SegmentSnapshotMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1087,11 +9124,11 @@ func (m SegmentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SegmentMultiError) AllErrors() []error { return m }
+func (m SegmentSnapshotMultiError) AllErrors() []error { return m }
 
-// SegmentValidationError is the validation error returned by Segment.Validate
-// if the designated constraints aren't met.
-type SegmentValidationError struct {
+// SegmentSnapshotValidationError is the validation error returned by
+// SegmentSnapshot.Validate if the designated constraints aren't met.
+type SegmentSnapshotValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1099,22 +9136,22 @@ type SegmentValidationError struct {
 }
 
 // Field function returns field value.
-func (e SegmentValidationError) Field() string { return e.field }
+func (e SegmentSnapshotValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SegmentValidationError) Reason() string { return e.reason }
+func (e SegmentSnapshotValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SegmentValidationError) Cause() error { return e.cause }
+func (e SegmentSnapshotValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SegmentValidationError) Key() bool { return e.key }
+func (e SegmentSnapshotValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SegmentValidationError) ErrorName() string { return "SegmentValidationError" }
+func (e SegmentSnapshotValidationError) ErrorName() string { return "SegmentSnapshotValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SegmentValidationError) Error() string {
+func (e SegmentSnapshotValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1126,14 +9163,14 @@ func (e SegmentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSegment.%s: %s%s",
+		"invalid %sSegmentSnapshot.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SegmentValidationError{}
+var _ error = SegmentSnapshotValidationError{}
 
 var _ interface {
 	Field() string
@@ -1141,52 +9178,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SegmentValidationError{}
+} = SegmentSnapshotValidationError{}
 
-// Validate checks the field values on MappingGophishProfile with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MappingGophishProfile) Validate() error {
+// Validate checks the field values on SegmentSizePoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SegmentSizePoint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MappingGophishProfile with the rules
+// ValidateAll checks the field values on SegmentSizePoint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MappingGophishProfileMultiError, or nil if none found.
-func (m *MappingGophishProfile) ValidateAll() error {
+// SegmentSizePointMultiError, or nil if none found.
+func (m *SegmentSizePoint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MappingGophishProfile) validate(all bool) error {
+func (m *SegmentSizePoint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	// no validation rules for Period
 
-	// no validation rules for FirstName
+	// no validation rules for Size
 
-	// no validation rules for LastName
+	// no validation rules for EnteredCount
 
-	// no validation rules for Position
+	// no validation rules for ExitedCount
 
 	if len(errors) > 0 {
-		return MappingGophishProfileMultiError(errors)
+		return SegmentSizePointMultiError(errors)
 	}
 
 	return nil
 }
 
-// MappingGophishProfileMultiError is an error wrapping multiple validation
-// errors returned by MappingGophishProfile.ValidateAll() if the designated
-// constraints aren't met.
-type MappingGophishProfileMultiError []error
+// SegmentSizePointMultiError is an error wrapping multiple validation errors
+// returned by SegmentSizePoint.ValidateAll() if the designated constraints
+// aren't met.
+type SegmentSizePointMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MappingGophishProfileMultiError) Error() string {
+func (m SegmentSizePointMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1195,11 +9232,11 @@ func (m MappingGophishProfileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MappingGophishProfileMultiError) AllErrors() []error { return m }
+func (m SegmentSizePointMultiError) AllErrors() []error { return m }
 
-// MappingGophishProfileValidationError is the validation error returned by
-// MappingGophishProfile.Validate if the designated constraints aren't met.
-type MappingGophishProfileValidationError struct {
+// SegmentSizePointValidationError is the validation error returned by
+// SegmentSizePoint.Validate if the designated constraints aren't met.
+type SegmentSizePointValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1207,24 +9244,22 @@ type MappingGophishProfileValidationError struct {
 }
 
 // Field function returns field value.
-func (e MappingGophishProfileValidationError) Field() string { return e.field }
+func (e SegmentSizePointValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MappingGophishProfileValidationError) Reason() string { return e.reason }
+func (e SegmentSizePointValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MappingGophishProfileValidationError) Cause() error { return e.cause }
+func (e SegmentSizePointValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MappingGophishProfileValidationError) Key() bool { return e.key }
+func (e SegmentSizePointValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MappingGophishProfileValidationError) ErrorName() string {
-	return "MappingGophishProfileValidationError"
-}
+func (e SegmentSizePointValidationError) ErrorName() string { return "SegmentSizePointValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MappingGophishProfileValidationError) Error() string {
+func (e SegmentSizePointValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1236,14 +9271,14 @@ func (e MappingGophishProfileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMappingGophishProfile.%s: %s%s",
+		"invalid %sSegmentSizePoint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MappingGophishProfileValidationError{}
+var _ error = SegmentSizePointValidationError{}
 
 var _ interface {
 	Field() string
@@ -1251,54 +9286,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MappingGophishProfileValidationError{}
+} = SegmentSizePointValidationError{}
 
-// Validate checks the field values on DataDestination with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataDestination) Validate() error {
+// Validate checks the field values on SegmentOverlapSegment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SegmentOverlapSegment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDestination with the rules
+// ValidateAll checks the field values on SegmentOverlapSegment with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataDestinationMultiError, or nil if none found.
-func (m *DataDestination) ValidateAll() error {
+// SegmentOverlapSegmentMultiError, or nil if none found.
+func (m *SegmentOverlapSegment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDestination) validate(all bool) error {
+func (m *SegmentOverlapSegment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for SegmentId
 
 	// no validation rules for Name
 
-	// no validation rules for Type
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for UpdatedAt
+	// no validation rules for Size
 
 	if len(errors) > 0 {
-		return DataDestinationMultiError(errors)
+		return SegmentOverlapSegmentMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDestinationMultiError is an error wrapping multiple validation errors
-// returned by DataDestination.ValidateAll() if the designated constraints
-// aren't met.
-type DataDestinationMultiError []error
+// SegmentOverlapSegmentMultiError is an error wrapping multiple validation
+// errors returned by SegmentOverlapSegment.ValidateAll() if the designated
+// constraints aren't met.
+type SegmentOverlapSegmentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDestinationMultiError) Error() string {
+func (m SegmentOverlapSegmentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1307,11 +9338,11 @@ func (m DataDestinationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDestinationMultiError) AllErrors() []error { return m }
+func (m SegmentOverlapSegmentMultiError) AllErrors() []error { return m }
 
-// DataDestinationValidationError is the validation error returned by
-// DataDestination.Validate if the designated constraints aren't met.
-type DataDestinationValidationError struct {
+// SegmentOverlapSegmentValidationError is the validation error returned by
+// SegmentOverlapSegment.Validate if the designated constraints aren't met.
+type SegmentOverlapSegmentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1319,22 +9350,24 @@ type DataDestinationValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDestinationValidationError) Field() string { return e.field }
+func (e SegmentOverlapSegmentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDestinationValidationError) Reason() string { return e.reason }
+func (e SegmentOverlapSegmentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDestinationValidationError) Cause() error { return e.cause }
+func (e SegmentOverlapSegmentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDestinationValidationError) Key() bool { return e.key }
+func (e SegmentOverlapSegmentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDestinationValidationError) ErrorName() string { return "DataDestinationValidationError" }
+func (e SegmentOverlapSegmentValidationError) ErrorName() string {
+	return "SegmentOverlapSegmentValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DataDestinationValidationError) Error() string {
+func (e SegmentOverlapSegmentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1346,14 +9379,14 @@ func (e DataDestinationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDestination.%s: %s%s",
+		"invalid %sSegmentOverlapSegment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDestinationValidationError{}
+var _ error = SegmentOverlapSegmentValidationError{}
 
 var _ interface {
 	Field() string
@@ -1361,43 +9394,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDestinationValidationError{}
+} = SegmentOverlapSegmentValidationError{}
 
-// Validate checks the field values on DataSource with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DataSource) Validate() error {
+// Validate checks the field values on SegmentIntersection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SegmentIntersection) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataSource with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DataSourceMultiError, or
-// nil if none found.
-func (m *DataSource) ValidateAll() error {
+// ValidateAll checks the field values on SegmentIntersection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SegmentIntersectionMultiError, or nil if none found.
+func (m *SegmentIntersection) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataSource) validate(all bool) error {
+func (m *SegmentIntersection) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Count
+
+	// no validation rules for ExclusiveCount
+
 	if len(errors) > 0 {
-		return DataSourceMultiError(errors)
+		return SegmentIntersectionMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataSourceMultiError is an error wrapping multiple validation errors
-// returned by DataSource.ValidateAll() if the designated constraints aren't met.
-type DataSourceMultiError []error
+// SegmentIntersectionMultiError is an error wrapping multiple validation
+// errors returned by SegmentIntersection.ValidateAll() if the designated
+// constraints aren't met.
+type SegmentIntersectionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataSourceMultiError) Error() string {
+func (m SegmentIntersectionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1406,11 +9444,11 @@ func (m DataSourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataSourceMultiError) AllErrors() []error { return m }
+func (m SegmentIntersectionMultiError) AllErrors() []error { return m }
 
-// DataSourceValidationError is the validation error returned by
-// DataSource.Validate if the designated constraints aren't met.
-type DataSourceValidationError struct {
+// SegmentIntersectionValidationError is the validation error returned by
+// SegmentIntersection.Validate if the designated constraints aren't met.
+type SegmentIntersectionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1418,22 +9456,24 @@ type DataSourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataSourceValidationError) Field() string { return e.field }
+func (e SegmentIntersectionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataSourceValidationError) Reason() string { return e.reason }
+func (e SegmentIntersectionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataSourceValidationError) Cause() error { return e.cause }
+func (e SegmentIntersectionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataSourceValidationError) Key() bool { return e.key }
+func (e SegmentIntersectionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataSourceValidationError) ErrorName() string { return "DataSourceValidationError" }
+func (e SegmentIntersectionValidationError) ErrorName() string {
+	return "SegmentIntersectionValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DataSourceValidationError) Error() string {
+func (e SegmentIntersectionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1445,14 +9485,14 @@ func (e DataSourceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataSource.%s: %s%s",
+		"invalid %sSegmentIntersection.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataSourceValidationError{}
+var _ error = SegmentIntersectionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1460,58 +9500,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataSourceValidationError{}
+} = SegmentIntersectionValidationError{}
 
-// Validate checks the field values on DataSourceDetail with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataSourceDetail) Validate() error {
+// Validate checks the field values on SegmentPairOverlap with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SegmentPairOverlap) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataSourceDetail with the rules
+// ValidateAll checks the field values on SegmentPairOverlap with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DataSourceDetailMultiError, or nil if none found.
-func (m *DataSourceDetail) ValidateAll() error {
+// violated, the result is a list of violation errors wrapped in
+// SegmentPairOverlapMultiError, or nil if none found.
+func (m *SegmentPairOverlap) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataSourceDetail) validate(all bool) error {
+func (m *SegmentPairOverlap) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for Description
+	// no validation rules for SegmentIdA
 
-	// no validation rules for Type
+	// no validation rules for SegmentIdB
 
-	// no validation rules for CreatedAt
+	// no validation rules for IntersectionCount
 
-	// no validation rules for UpdatedAt
+	// no validation rules for UnionCount
 
-	// no validation rules for ConnectionId
+	// no validation rules for Jaccard
 
 	if len(errors) > 0 {
-		return DataSourceDetailMultiError(errors)
+		return SegmentPairOverlapMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataSourceDetailMultiError is an error wrapping multiple validation errors
-// returned by DataSourceDetail.ValidateAll() if the designated constraints
+// SegmentPairOverlapMultiError is an error wrapping multiple validation errors
+// returned by SegmentPairOverlap.ValidateAll() if the designated constraints
 // aren't met.
-type DataSourceDetailMultiError []error
+type SegmentPairOverlapMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataSourceDetailMultiError) Error() string {
+func (m SegmentPairOverlapMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1520,11 +9556,11 @@ func (m DataSourceDetailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataSourceDetailMultiError) AllErrors() []error { return m }
+func (m SegmentPairOverlapMultiError) AllErrors() []error { return m }
 
-// DataSourceDetailValidationError is the validation error returned by
-// DataSourceDetail.Validate if the designated constraints aren't met.
-type DataSourceDetailValidationError struct {
+// SegmentPairOverlapValidationError is the validation error returned by
+// SegmentPairOverlap.Validate if the designated constraints aren't met.
+type SegmentPairOverlapValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1532,22 +9568,24 @@ type DataSourceDetailValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataSourceDetailValidationError) Field() string { return e.field }
+func (e SegmentPairOverlapValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataSourceDetailValidationError) Reason() string { return e.reason }
+func (e SegmentPairOverlapValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataSourceDetailValidationError) Cause() error { return e.cause }
+func (e SegmentPairOverlapValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataSourceDetailValidationError) Key() bool { return e.key }
+func (e SegmentPairOverlapValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataSourceDetailValidationError) ErrorName() string { return "DataSourceDetailValidationError" }
+func (e SegmentPairOverlapValidationError) ErrorName() string {
+	return "SegmentPairOverlapValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DataSourceDetailValidationError) Error() string {
+func (e SegmentPairOverlapValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1559,14 +9597,14 @@ func (e DataSourceDetailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataSourceDetail.%s: %s%s",
+		"invalid %sSegmentPairOverlap.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataSourceDetailValidationError{}
+var _ error = SegmentPairOverlapValidationError{}
 
 var _ interface {
 	Field() string
@@ -1574,50 +9612,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataSourceDetailValidationError{}
+} = SegmentPairOverlapValidationError{}
 
-// Validate checks the field values on EnrichedDataSource with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnrichedDataSource) Validate() error {
+// Validate checks the field values on ColumnInsight with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnInsight) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrichedDataSource with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EnrichedDataSourceMultiError, or nil if none found.
-func (m *EnrichedDataSource) ValidateAll() error {
+// ValidateAll checks the field values on ColumnInsight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnInsightMultiError, or
+// nil if none found.
+func (m *ColumnInsight) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrichedDataSource) validate(all bool) error {
+func (m *ColumnInsight) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ColumnName
 
-	// no validation rules for Name
+	// no validation rules for DataType
 
-	// no validation rules for Type
+	// no validation rules for Kind
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnInsightValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnInsightValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnInsightValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return EnrichedDataSourceMultiError(errors)
+		return ColumnInsightMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrichedDataSourceMultiError is an error wrapping multiple validation errors
-// returned by EnrichedDataSource.ValidateAll() if the designated constraints
+// ColumnInsightMultiError is an error wrapping multiple validation errors
+// returned by ColumnInsight.ValidateAll() if the designated constraints
 // aren't met.
-type EnrichedDataSourceMultiError []error
+type ColumnInsightMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrichedDataSourceMultiError) Error() string {
+func (m ColumnInsightMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1626,11 +9698,11 @@ func (m EnrichedDataSourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrichedDataSourceMultiError) AllErrors() []error { return m }
+func (m ColumnInsightMultiError) AllErrors() []error { return m }
 
-// EnrichedDataSourceValidationError is the validation error returned by
-// EnrichedDataSource.Validate if the designated constraints aren't met.
-type EnrichedDataSourceValidationError struct {
+// ColumnInsightValidationError is the validation error returned by
+// ColumnInsight.Validate if the designated constraints aren't met.
+type ColumnInsightValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1638,24 +9710,22 @@ type EnrichedDataSourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrichedDataSourceValidationError) Field() string { return e.field }
+func (e ColumnInsightValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrichedDataSourceValidationError) Reason() string { return e.reason }
+func (e ColumnInsightValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrichedDataSourceValidationError) Cause() error { return e.cause }
+func (e ColumnInsightValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrichedDataSourceValidationError) Key() bool { return e.key }
+func (e ColumnInsightValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrichedDataSourceValidationError) ErrorName() string {
-	return "EnrichedDataSourceValidationError"
-}
+func (e ColumnInsightValidationError) ErrorName() string { return "ColumnInsightValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EnrichedDataSourceValidationError) Error() string {
+func (e ColumnInsightValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1667,14 +9737,14 @@ func (e EnrichedDataSourceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrichedDataSource.%s: %s%s",
+		"invalid %sColumnInsight.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrichedDataSourceValidationError{}
+var _ error = ColumnInsightValidationError{}
 
 var _ interface {
 	Field() string
@@ -1682,50 +9752,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrichedDataSourceValidationError{}
+} = ColumnInsightValidationError{}
 
-// Validate checks the field values on EnrichedDataDestination with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnrichedDataDestination) Validate() error {
+// Validate checks the field values on InsightBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InsightBucket) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrichedDataDestination with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EnrichedDataDestinationMultiError, or nil if none found.
-func (m *EnrichedDataDestination) ValidateAll() error {
+// ValidateAll checks the field values on InsightBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InsightBucketMultiError, or
+// nil if none found.
+func (m *InsightBucket) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrichedDataDestination) validate(all bool) error {
+func (m *InsightBucket) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Label
 
-	// no validation rules for Name
+	// no validation rules for Lower
 
-	// no validation rules for Type
+	// no validation rules for Upper
+
+	// no validation rules for SegmentCount
+
+	// no validation rules for AudienceCount
+
+	// no validation rules for SegmentRatio
+
+	// no validation rules for AudienceRatio
+
+	// no validation rules for Lift
 
 	if len(errors) > 0 {
-		return EnrichedDataDestinationMultiError(errors)
+		return InsightBucketMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrichedDataDestinationMultiError is an error wrapping multiple validation
-// errors returned by EnrichedDataDestination.ValidateAll() if the designated
-// constraints aren't met.
-type EnrichedDataDestinationMultiError []error
+// InsightBucketMultiError is an error wrapping multiple validation errors
+// returned by InsightBucket.ValidateAll() if the designated constraints
+// aren't met.
+type InsightBucketMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrichedDataDestinationMultiError) Error() string {
+func (m InsightBucketMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1734,11 +9814,11 @@ func (m EnrichedDataDestinationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrichedDataDestinationMultiError) AllErrors() []error { return m }
+func (m InsightBucketMultiError) AllErrors() []error { return m }
 
-// EnrichedDataDestinationValidationError is the validation error returned by
-// EnrichedDataDestination.Validate if the designated constraints aren't met.
-type EnrichedDataDestinationValidationError struct {
+// InsightBucketValidationError is the validation error returned by
+// InsightBucket.Validate if the designated constraints aren't met.
+type InsightBucketValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1746,24 +9826,22 @@ type EnrichedDataDestinationValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrichedDataDestinationValidationError) Field() string { return e.field }
+func (e InsightBucketValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrichedDataDestinationValidationError) Reason() string { return e.reason }
+func (e InsightBucketValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrichedDataDestinationValidationError) Cause() error { return e.cause }
+func (e InsightBucketValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrichedDataDestinationValidationError) Key() bool { return e.key }
+func (e InsightBucketValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrichedDataDestinationValidationError) ErrorName() string {
-	return "EnrichedDataDestinationValidationError"
-}
+func (e InsightBucketValidationError) ErrorName() string { return "InsightBucketValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EnrichedDataDestinationValidationError) Error() string {
+func (e InsightBucketValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1775,14 +9853,14 @@ func (e EnrichedDataDestinationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrichedDataDestination.%s: %s%s",
+		"invalid %sInsightBucket.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrichedDataDestinationValidationError{}
+var _ error = InsightBucketValidationError{}
 
 var _ interface {
 	Field() string
@@ -1790,78 +9868,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrichedDataDestinationValidationError{}
+} = InsightBucketValidationError{}
 
-// Validate checks the field values on SegmentCondition with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SegmentCondition) Validate() error {
+// Validate checks the field values on RFMAnalysis with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RFMAnalysis) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SegmentCondition with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SegmentConditionMultiError, or nil if none found.
-func (m *SegmentCondition) ValidateAll() error {
+// ValidateAll checks the field values on RFMAnalysis with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RFMAnalysisMultiError, or
+// nil if none found.
+func (m *RFMAnalysis) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SegmentCondition) validate(all bool) error {
+func (m *RFMAnalysis) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAudienceCondition()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SegmentConditionValidationError{
-					field:  "AudienceCondition",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SegmentConditionValidationError{
-					field:  "AudienceCondition",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAudienceCondition()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SegmentConditionValidationError{
-				field:  "AudienceCondition",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
-	// no validation rules for AudienceSqlCondition
+	// no validation rules for MasterSegmentId
 
-	for idx, item := range m.GetBehaviorConditions() {
+	// no validation rules for Name
+
+	// no validation rules for BehaviorTableId
+
+	// no validation rules for CustomerKey
+
+	// no validation rules for DateColumn
+
+	// no validation rules for AmountColumn
+
+	// no validation rules for Bins
+
+	for idx, item := range m.GetSegments() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SegmentConditionValidationError{
-						field:  fmt.Sprintf("BehaviorConditions[%v]", idx),
+					errors = append(errors, RFMAnalysisValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SegmentConditionValidationError{
-						field:  fmt.Sprintf("BehaviorConditions[%v]", idx),
+					errors = append(errors, RFMAnalysisValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1869,8 +9932,8 @@ func (m *SegmentCondition) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SegmentConditionValidationError{
-					field:  fmt.Sprintf("BehaviorConditions[%v]", idx),
+				return RFMAnalysisValidationError{
+					field:  fmt.Sprintf("Segments[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1879,20 +9942,52 @@ func (m *SegmentCondition) validate(all bool) error {
 
 	}
 
+	// no validation rules for DataActionId
+
+	if all {
+		switch v := interface{}(m.GetLastRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RFMAnalysisValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RFMAnalysisValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RFMAnalysisValidationError{
+				field:  "LastRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedAt
+
 	if len(errors) > 0 {
-		return SegmentConditionMultiError(errors)
+		return RFMAnalysisMultiError(errors)
 	}
 
 	return nil
 }
 
-// SegmentConditionMultiError is an error wrapping multiple validation errors
-// returned by SegmentCondition.ValidateAll() if the designated constraints
-// aren't met.
-type SegmentConditionMultiError []error
+// RFMAnalysisMultiError is an error wrapping multiple validation errors
+// returned by RFMAnalysis.ValidateAll() if the designated constraints aren't met.
+type RFMAnalysisMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SegmentConditionMultiError) Error() string {
+func (m RFMAnalysisMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1901,11 +9996,11 @@ func (m SegmentConditionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SegmentConditionMultiError) AllErrors() []error { return m }
+func (m RFMAnalysisMultiError) AllErrors() []error { return m }
 
-// SegmentConditionValidationError is the validation error returned by
-// SegmentCondition.Validate if the designated constraints aren't met.
-type SegmentConditionValidationError struct {
+// RFMAnalysisValidationError is the validation error returned by
+// RFMAnalysis.Validate if the designated constraints aren't met.
+type RFMAnalysisValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1913,22 +10008,22 @@ type SegmentConditionValidationError struct {
 }
 
 // Field function returns field value.
-func (e SegmentConditionValidationError) Field() string { return e.field }
+func (e RFMAnalysisValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SegmentConditionValidationError) Reason() string { return e.reason }
+func (e RFMAnalysisValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SegmentConditionValidationError) Cause() error { return e.cause }
+func (e RFMAnalysisValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SegmentConditionValidationError) Key() bool { return e.key }
+func (e RFMAnalysisValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SegmentConditionValidationError) ErrorName() string { return "SegmentConditionValidationError" }
+func (e RFMAnalysisValidationError) ErrorName() string { return "RFMAnalysisValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SegmentConditionValidationError) Error() string {
+func (e RFMAnalysisValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1940,14 +10035,14 @@ func (e SegmentConditionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSegmentCondition.%s: %s%s",
+		"invalid %sRFMAnalysis.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SegmentConditionValidationError{}
+var _ error = RFMAnalysisValidationError{}
 
 var _ interface {
 	Field() string
@@ -1955,111 +10050,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SegmentConditionValidationError{}
+} = RFMAnalysisValidationError{}
 
-// Validate checks the field values on BehaviorCondition with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BehaviorCondition) Validate() error {
+// Validate checks the field values on RFMSegment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RFMSegment) Validate() error {
 	return m.validate(false)
 }
-
-// ValidateAll checks the field values on BehaviorCondition with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// BehaviorConditionMultiError, or nil if none found.
-func (m *BehaviorCondition) ValidateAll() error {
+
+// ValidateAll checks the field values on RFMSegment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RFMSegmentMultiError, or
+// nil if none found.
+func (m *RFMSegment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BehaviorCondition) validate(all bool) error {
+func (m *RFMSegment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BehaviorTableId
-
-	if all {
-		switch v := interface{}(m.GetWhereCondition()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BehaviorConditionValidationError{
-					field:  "WhereCondition",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BehaviorConditionValidationError{
-					field:  "WhereCondition",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWhereCondition()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BehaviorConditionValidationError{
-				field:  "WhereCondition",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for WhereSqlCondition
-
-	for idx, item := range m.GetHavingConditions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, BehaviorConditionValidationError{
-						field:  fmt.Sprintf("HavingConditions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, BehaviorConditionValidationError{
-						field:  fmt.Sprintf("HavingConditions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return BehaviorConditionValidationError{
-					field:  fmt.Sprintf("HavingConditions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Label
 
-	}
+	// no validation rules for SegmentId
 
 	if len(errors) > 0 {
-		return BehaviorConditionMultiError(errors)
+		return RFMSegmentMultiError(errors)
 	}
 
 	return nil
 }
 
-// BehaviorConditionMultiError is an error wrapping multiple validation errors
-// returned by BehaviorCondition.ValidateAll() if the designated constraints
-// aren't met.
-type BehaviorConditionMultiError []error
+// RFMSegmentMultiError is an error wrapping multiple validation errors
+// returned by RFMSegment.ValidateAll() if the designated constraints aren't met.
+type RFMSegmentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BehaviorConditionMultiError) Error() string {
+func (m RFMSegmentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2068,11 +10099,11 @@ func (m BehaviorConditionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BehaviorConditionMultiError) AllErrors() []error { return m }
+func (m RFMSegmentMultiError) AllErrors() []error { return m }
 
-// BehaviorConditionValidationError is the validation error returned by
-// BehaviorCondition.Validate if the designated constraints aren't met.
-type BehaviorConditionValidationError struct {
+// RFMSegmentValidationError is the validation error returned by
+// RFMSegment.Validate if the designated constraints aren't met.
+type RFMSegmentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2080,24 +10111,22 @@ type BehaviorConditionValidationError struct {
 }
 
 // Field function returns field value.
-func (e BehaviorConditionValidationError) Field() string { return e.field }
+func (e RFMSegmentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BehaviorConditionValidationError) Reason() string { return e.reason }
+func (e RFMSegmentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BehaviorConditionValidationError) Cause() error { return e.cause }
+func (e RFMSegmentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BehaviorConditionValidationError) Key() bool { return e.key }
+func (e RFMSegmentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BehaviorConditionValidationError) ErrorName() string {
-	return "BehaviorConditionValidationError"
-}
+func (e RFMSegmentValidationError) ErrorName() string { return "RFMSegmentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BehaviorConditionValidationError) Error() string {
+func (e RFMSegmentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2109,14 +10138,14 @@ func (e BehaviorConditionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBehaviorCondition.%s: %s%s",
+		"invalid %sRFMSegment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BehaviorConditionValidationError{}
+var _ error = RFMSegmentValidationError{}
 
 var _ interface {
 	Field() string
@@ -2124,84 +10153,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BehaviorConditionValidationError{}
+} = RFMSegmentValidationError{}
 
-// Validate checks the field values on Rule with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Rule) Validate() error {
+// Validate checks the field values on Cohort with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Cohort) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Rule with the rules defined in the
+// ValidateAll checks the field values on Cohort with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RuleMultiError, or nil if none found.
-func (m *Rule) ValidateAll() error {
+// a list of violation errors wrapped in CohortMultiError, or nil if none found.
+func (m *Cohort) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Rule) validate(all bool) error {
+func (m *Cohort) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Field
-
-	// no validation rules for Operator
-
-	// no validation rules for Value
-
-	for idx, item := range m.GetRules() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RuleValidationError{
-						field:  fmt.Sprintf("Rules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RuleValidationError{
-						field:  fmt.Sprintf("Rules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RuleValidationError{
-					field:  fmt.Sprintf("Rules[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Period
 
-	// no validation rules for Combinator
+	// no validation rules for Size
 
 	if len(errors) > 0 {
-		return RuleMultiError(errors)
+		return CohortMultiError(errors)
 	}
 
 	return nil
 }
 
-// RuleMultiError is an error wrapping multiple validation errors returned by
-// Rule.ValidateAll() if the designated constraints aren't met.
-type RuleMultiError []error
+// CohortMultiError is an error wrapping multiple validation errors returned by
+// Cohort.ValidateAll() if the designated constraints aren't met.
+type CohortMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RuleMultiError) Error() string {
+func (m CohortMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2210,11 +10201,11 @@ func (m RuleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RuleMultiError) AllErrors() []error { return m }
+func (m CohortMultiError) AllErrors() []error { return m }
 
-// RuleValidationError is the validation error returned by Rule.Validate if the
-// designated constraints aren't met.
-type RuleValidationError struct {
+// CohortValidationError is the validation error returned by Cohort.Validate if
+// the designated constraints aren't met.
+type CohortValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2222,22 +10213,22 @@ type RuleValidationError struct {
 }
 
 // Field function returns field value.
-func (e RuleValidationError) Field() string { return e.field }
+func (e CohortValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RuleValidationError) Reason() string { return e.reason }
+func (e CohortValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RuleValidationError) Cause() error { return e.cause }
+func (e CohortValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RuleValidationError) Key() bool { return e.key }
+func (e CohortValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RuleValidationError) ErrorName() string { return "RuleValidationError" }
+func (e CohortValidationError) ErrorName() string { return "CohortValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RuleValidationError) Error() string {
+func (e CohortValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2249,14 +10240,14 @@ func (e RuleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRule.%s: %s%s",
+		"invalid %sCohort.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RuleValidationError{}
+var _ error = CohortValidationError{}
 
 var _ interface {
 	Field() string
@@ -2264,85 +10255,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RuleValidationError{}
+} = CohortValidationError{}
 
-// Validate checks the field values on DataActionRun with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on FunnelStep with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DataActionRun) Validate() error {
+func (m *FunnelStep) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataActionRun with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DataActionRunMultiError, or
+// ValidateAll checks the field values on FunnelStep with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FunnelStepMultiError, or
 // nil if none found.
-func (m *DataActionRun) ValidateAll() error {
+func (m *FunnelStep) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataActionRun) validate(all bool) error {
+func (m *FunnelStep) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ActionId
-
-	// no validation rules for ActionType
-
-	// no validation rules for Status
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := FunnelStepValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CreatedAt
+	if m.GetBehaviorTableId() <= 0 {
+		err := FunnelStepValidationError{
+			field:  "BehaviorTableId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for UpdatedAt
+	// no validation rules for TimeColumn
 
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetEventFilter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataActionRunValidationError{
-					field:  "Metadata",
+				errors = append(errors, FunnelStepValidationError{
+					field:  "EventFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DataActionRunValidationError{
-					field:  "Metadata",
+				errors = append(errors, FunnelStepValidationError{
+					field:  "EventFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEventFilter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DataActionRunValidationError{
-				field:  "Metadata",
+			return FunnelStepValidationError{
+				field:  "EventFilter",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.GetMaxIntervalSeconds() < 0 {
+		err := FunnelStepValidationError{
+			field:  "MaxIntervalSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DataActionRunMultiError(errors)
+		return FunnelStepMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataActionRunMultiError is an error wrapping multiple validation errors
-// returned by DataActionRun.ValidateAll() if the designated constraints
-// aren't met.
-type DataActionRunMultiError []error
+// FunnelStepMultiError is an error wrapping multiple validation errors
+// returned by FunnelStep.ValidateAll() if the designated constraints aren't met.
+type FunnelStepMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataActionRunMultiError) Error() string {
+func (m FunnelStepMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2351,11 +10364,11 @@ func (m DataActionRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataActionRunMultiError) AllErrors() []error { return m }
+func (m FunnelStepMultiError) AllErrors() []error { return m }
 
-// DataActionRunValidationError is the validation error returned by
-// DataActionRun.Validate if the designated constraints aren't met.
-type DataActionRunValidationError struct {
+// FunnelStepValidationError is the validation error returned by
+// FunnelStep.Validate if the designated constraints aren't met.
+type FunnelStepValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2363,22 +10376,22 @@ type DataActionRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataActionRunValidationError) Field() string { return e.field }
+func (e FunnelStepValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataActionRunValidationError) Reason() string { return e.reason }
+func (e FunnelStepValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataActionRunValidationError) Cause() error { return e.cause }
+func (e FunnelStepValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataActionRunValidationError) Key() bool { return e.key }
+func (e FunnelStepValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataActionRunValidationError) ErrorName() string { return "DataActionRunValidationError" }
+func (e FunnelStepValidationError) ErrorName() string { return "FunnelStepValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DataActionRunValidationError) Error() string {
+func (e FunnelStepValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2390,14 +10403,14 @@ func (e DataActionRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataActionRun.%s: %s%s",
+		"invalid %sFunnelStep.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataActionRunValidationError{}
+var _ error = FunnelStepValidationError{}
 
 var _ interface {
 	Field() string
@@ -2405,24 +10418,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataActionRunValidationError{}
+} = FunnelStepValidationError{}
 
-// Validate checks the field values on PredictModel with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Funnel with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *PredictModel) Validate() error {
+func (m *Funnel) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PredictModel with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PredictModelMultiError, or
-// nil if none found.
-func (m *PredictModel) ValidateAll() error {
+// ValidateAll checks the field values on Funnel with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FunnelMultiError, or nil if none found.
+func (m *Funnel) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PredictModel) validate(all bool) error {
+func (m *Funnel) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2431,29 +10443,63 @@ func (m *PredictModel) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for MasterSegmentId
+
 	// no validation rules for Name
 
-	// no validation rules for MasterSegmentId
+	// no validation rules for Description
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FunnelValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FunnelValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FunnelValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Status
+	}
 
 	// no validation rules for CreatedAt
 
 	// no validation rules for UpdatedAt
 
 	if len(errors) > 0 {
-		return PredictModelMultiError(errors)
+		return FunnelMultiError(errors)
 	}
 
 	return nil
 }
 
-// PredictModelMultiError is an error wrapping multiple validation errors
-// returned by PredictModel.ValidateAll() if the designated constraints aren't met.
-type PredictModelMultiError []error
+// FunnelMultiError is an error wrapping multiple validation errors returned by
+// Funnel.ValidateAll() if the designated constraints aren't met.
+type FunnelMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PredictModelMultiError) Error() string {
+func (m FunnelMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2462,11 +10508,11 @@ func (m PredictModelMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PredictModelMultiError) AllErrors() []error { return m }
+func (m FunnelMultiError) AllErrors() []error { return m }
 
-// PredictModelValidationError is the validation error returned by
-// PredictModel.Validate if the designated constraints aren't met.
-type PredictModelValidationError struct {
+// FunnelValidationError is the validation error returned by Funnel.Validate if
+// the designated constraints aren't met.
+type FunnelValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2474,22 +10520,22 @@ type PredictModelValidationError struct {
 }
 
 // Field function returns field value.
-func (e PredictModelValidationError) Field() string { return e.field }
+func (e FunnelValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PredictModelValidationError) Reason() string { return e.reason }
+func (e FunnelValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PredictModelValidationError) Cause() error { return e.cause }
+func (e FunnelValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PredictModelValidationError) Key() bool { return e.key }
+func (e FunnelValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PredictModelValidationError) ErrorName() string { return "PredictModelValidationError" }
+func (e FunnelValidationError) ErrorName() string { return "FunnelValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PredictModelValidationError) Error() string {
+func (e FunnelValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2501,14 +10547,14 @@ func (e PredictModelValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPredictModel.%s: %s%s",
+		"invalid %sFunnel.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PredictModelValidationError{}
+var _ error = FunnelValidationError{}
 
 var _ interface {
 	Field() string
@@ -2516,50 +10562,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PredictModelValidationError{}
+} = FunnelValidationError{}
 
-// Validate checks the field values on EnrichedConnection with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnrichedConnection) Validate() error {
+// Validate checks the field values on FunnelStepResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FunnelStepResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrichedConnection with the rules
+// ValidateAll checks the field values on FunnelStepResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnrichedConnectionMultiError, or nil if none found.
-func (m *EnrichedConnection) ValidateAll() error {
+// FunnelStepResultMultiError, or nil if none found.
+func (m *FunnelStepResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrichedConnection) validate(all bool) error {
+func (m *FunnelStepResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Step
 
 	// no validation rules for Name
 
-	// no validation rules for Type
+	// no validation rules for Count
+
+	// no validation rules for ConversionRate
+
+	// no validation rules for StepConversionRate
+
+	// no validation rules for DropOffCount
+
+	// no validation rules for MedianSecondsFromPrevious
+
+	// no validation rules for MedianSecondsFromStart
 
 	if len(errors) > 0 {
-		return EnrichedConnectionMultiError(errors)
+		return FunnelStepResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrichedConnectionMultiError is an error wrapping multiple validation errors
-// returned by EnrichedConnection.ValidateAll() if the designated constraints
+// FunnelStepResultMultiError is an error wrapping multiple validation errors
+// returned by FunnelStepResult.ValidateAll() if the designated constraints
 // aren't met.
-type EnrichedConnectionMultiError []error
+type FunnelStepResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrichedConnectionMultiError) Error() string {
+func (m FunnelStepResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2568,11 +10624,11 @@ func (m EnrichedConnectionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrichedConnectionMultiError) AllErrors() []error { return m }
+func (m FunnelStepResultMultiError) AllErrors() []error { return m }
 
-// EnrichedConnectionValidationError is the validation error returned by
-// EnrichedConnection.Validate if the designated constraints aren't met.
-type EnrichedConnectionValidationError struct {
+// FunnelStepResultValidationError is the validation error returned by
+// FunnelStepResult.Validate if the designated constraints aren't met.
+type FunnelStepResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2580,24 +10636,22 @@ type EnrichedConnectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrichedConnectionValidationError) Field() string { return e.field }
+func (e FunnelStepResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrichedConnectionValidationError) Reason() string { return e.reason }
+func (e FunnelStepResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrichedConnectionValidationError) Cause() error { return e.cause }
+func (e FunnelStepResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrichedConnectionValidationError) Key() bool { return e.key }
+func (e FunnelStepResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrichedConnectionValidationError) ErrorName() string {
-	return "EnrichedConnectionValidationError"
-}
+func (e FunnelStepResultValidationError) ErrorName() string { return "FunnelStepResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EnrichedConnectionValidationError) Error() string {
+func (e FunnelStepResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2609,14 +10663,14 @@ func (e EnrichedConnectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrichedConnection.%s: %s%s",
+		"invalid %sFunnelStepResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrichedConnectionValidationError{}
+var _ error = FunnelStepResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -2624,48 +10678,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrichedConnectionValidationError{}
+} = FunnelStepResultValidationError{}
 
-// Validate checks the field values on EnrichedTable with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *EnrichedTable) Validate() error {
+// Validate checks the field values on FunnelBreakdown with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FunnelBreakdown) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrichedTable with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EnrichedTableMultiError, or
-// nil if none found.
-func (m *EnrichedTable) ValidateAll() error {
+// ValidateAll checks the field values on FunnelBreakdown with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FunnelBreakdownMultiError, or nil if none found.
+func (m *FunnelBreakdown) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrichedTable) validate(all bool) error {
+func (m *FunnelBreakdown) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Value
 
-	// no validation rules for Name
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FunnelBreakdownValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FunnelBreakdownValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FunnelBreakdownValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return EnrichedTableMultiError(errors)
+		return FunnelBreakdownMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrichedTableMultiError is an error wrapping multiple validation errors
-// returned by EnrichedTable.ValidateAll() if the designated constraints
+// FunnelBreakdownMultiError is an error wrapping multiple validation errors
+// returned by FunnelBreakdown.ValidateAll() if the designated constraints
 // aren't met.
-type EnrichedTableMultiError []error
+type FunnelBreakdownMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrichedTableMultiError) Error() string {
+func (m FunnelBreakdownMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2674,11 +10760,11 @@ func (m EnrichedTableMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrichedTableMultiError) AllErrors() []error { return m }
+func (m FunnelBreakdownMultiError) AllErrors() []error { return m }
 
-// EnrichedTableValidationError is the validation error returned by
-// EnrichedTable.Validate if the designated constraints aren't met.
-type EnrichedTableValidationError struct {
+// FunnelBreakdownValidationError is the validation error returned by
+// FunnelBreakdown.Validate if the designated constraints aren't met.
+type FunnelBreakdownValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2686,22 +10772,22 @@ type EnrichedTableValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrichedTableValidationError) Field() string { return e.field }
+func (e FunnelBreakdownValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrichedTableValidationError) Reason() string { return e.reason }
+func (e FunnelBreakdownValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrichedTableValidationError) Cause() error { return e.cause }
+func (e FunnelBreakdownValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrichedTableValidationError) Key() bool { return e.key }
+func (e FunnelBreakdownValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrichedTableValidationError) ErrorName() string { return "EnrichedTableValidationError" }
+func (e FunnelBreakdownValidationError) ErrorName() string { return "FunnelBreakdownValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EnrichedTableValidationError) Error() string {
+func (e FunnelBreakdownValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2713,14 +10799,14 @@ func (e EnrichedTableValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrichedTable.%s: %s%s",
+		"invalid %sFunnelBreakdown.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrichedTableValidationError{}
+var _ error = FunnelBreakdownValidationError{}
 
 var _ interface {
 	Field() string
@@ -2728,140 +10814,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrichedTableValidationError{}
+} = FunnelBreakdownValidationError{}
 
-// Validate checks the field values on SourceTableMap with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SourceTableMap) Validate() error {
+// Validate checks the field values on SegmentSplitArm with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SegmentSplitArm) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SourceTableMap with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SourceTableMapMultiError,
-// or nil if none found.
-func (m *SourceTableMap) ValidateAll() error {
+// ValidateAll checks the field values on SegmentSplitArm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SegmentSplitArmMultiError, or nil if none found.
+func (m *SegmentSplitArm) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SourceTableMap) validate(all bool) error {
+func (m *SegmentSplitArm) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetTable()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SourceTableMapValidationError{
-					field:  "Table",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SourceTableMapValidationError{
-					field:  "Table",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := SegmentSplitArmValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SourceTableMapValidationError{
-				field:  "Table",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SourceTableMapValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SourceTableMapValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SourceTableMapValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if val := m.GetPercentage(); val <= 0 || val >= 100 {
+		err := SegmentSplitArmValidationError{
+			field:  "Percentage",
+			reason: "value must be inside range (0, 100)",
 		}
-	}
-
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SourceTableMapValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SourceTableMapValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SourceTableMapValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
-	// no validation rules for DataActionId
+	// no validation rules for SegmentId
 
 	if len(errors) > 0 {
-		return SourceTableMapMultiError(errors)
+		return SegmentSplitArmMultiError(errors)
 	}
 
 	return nil
 }
 
-// SourceTableMapMultiError is an error wrapping multiple validation errors
-// returned by SourceTableMap.ValidateAll() if the designated constraints
+// SegmentSplitArmMultiError is an error wrapping multiple validation errors
+// returned by SegmentSplitArm.ValidateAll() if the designated constraints
 // aren't met.
-type SourceTableMapMultiError []error
+type SegmentSplitArmMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SourceTableMapMultiError) Error() string {
+func (m SegmentSplitArmMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2870,11 +10884,11 @@ func (m SourceTableMapMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SourceTableMapMultiError) AllErrors() []error { return m }
+func (m SegmentSplitArmMultiError) AllErrors() []error { return m }
 
-// SourceTableMapValidationError is the validation error returned by
-// SourceTableMap.Validate if the designated constraints aren't met.
-type SourceTableMapValidationError struct {
+// SegmentSplitArmValidationError is the validation error returned by
+// SegmentSplitArm.Validate if the designated constraints aren't met.
+type SegmentSplitArmValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2882,22 +10896,22 @@ type SourceTableMapValidationError struct {
 }
 
 // Field function returns field value.
-func (e SourceTableMapValidationError) Field() string { return e.field }
+func (e SegmentSplitArmValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SourceTableMapValidationError) Reason() string { return e.reason }
+func (e SegmentSplitArmValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SourceTableMapValidationError) Cause() error { return e.cause }
+func (e SegmentSplitArmValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SourceTableMapValidationError) Key() bool { return e.key }
+func (e SegmentSplitArmValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SourceTableMapValidationError) ErrorName() string { return "SourceTableMapValidationError" }
+func (e SegmentSplitArmValidationError) ErrorName() string { return "SegmentSplitArmValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SourceTableMapValidationError) Error() string {
+func (e SegmentSplitArmValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2909,14 +10923,14 @@ func (e SourceTableMapValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSourceTableMap.%s: %s%s",
+		"invalid %sSegmentSplitArm.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SourceTableMapValidationError{}
+var _ error = SegmentSplitArmValidationError{}
 
 var _ interface {
 	Field() string
@@ -2924,24 +10938,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SourceTableMapValidationError{}
+} = SegmentSplitArmValidationError{}
 
-// Validate checks the field values on DestinationMappings with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DestinationMappings) Validate() error {
+// Validate checks the field values on SegmentSplit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SegmentSplit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DestinationMappings with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DestinationMappingsMultiError, or nil if none found.
-func (m *DestinationMappings) ValidateAll() error {
+// ValidateAll checks the field values on SegmentSplit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SegmentSplitMultiError, or
+// nil if none found.
+func (m *SegmentSplit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DestinationMappings) validate(all bool) error {
+func (m *SegmentSplit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2950,31 +10964,29 @@ func (m *DestinationMappings) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Type
-
-	// no validation rules for ObjectType
+	// no validation rules for SegmentId
 
-	// no validation rules for ObjectName
+	// no validation rules for Name
 
-	// no validation rules for ObjectId
+	// no validation rules for Salt
 
-	for idx, item := range m.GetMappings() {
+	for idx, item := range m.GetArms() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DestinationMappingsValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
+					errors = append(errors, SegmentSplitValidationError{
+						field:  fmt.Sprintf("Arms[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DestinationMappingsValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
+					errors = append(errors, SegmentSplitValidationError{
+						field:  fmt.Sprintf("Arms[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2982,8 +10994,8 @@ func (m *DestinationMappings) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DestinationMappingsValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
+				return SegmentSplitValidationError{
+					field:  fmt.Sprintf("Arms[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2992,22 +11004,21 @@ func (m *DestinationMappings) validate(all bool) error {
 
 	}
 
-	// no validation rules for DataActionId
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return DestinationMappingsMultiError(errors)
+		return SegmentSplitMultiError(errors)
 	}
 
 	return nil
 }
 
-// DestinationMappingsMultiError is an error wrapping multiple validation
-// errors returned by DestinationMappings.ValidateAll() if the designated
-// constraints aren't met.
-type DestinationMappingsMultiError []error
+// SegmentSplitMultiError is an error wrapping multiple validation errors
+// returned by SegmentSplit.ValidateAll() if the designated constraints aren't met.
+type SegmentSplitMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DestinationMappingsMultiError) Error() string {
+func (m SegmentSplitMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3016,11 +11027,11 @@ func (m DestinationMappingsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DestinationMappingsMultiError) AllErrors() []error { return m }
+func (m SegmentSplitMultiError) AllErrors() []error { return m }
 
-// DestinationMappingsValidationError is the validation error returned by
-// DestinationMappings.Validate if the designated constraints aren't met.
-type DestinationMappingsValidationError struct {
+// SegmentSplitValidationError is the validation error returned by
+// SegmentSplit.Validate if the designated constraints aren't met.
+type SegmentSplitValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3028,24 +11039,22 @@ type DestinationMappingsValidationError struct {
 }
 
 // Field function returns field value.
-func (e DestinationMappingsValidationError) Field() string { return e.field }
+func (e SegmentSplitValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DestinationMappingsValidationError) Reason() string { return e.reason }
+func (e SegmentSplitValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DestinationMappingsValidationError) Cause() error { return e.cause }
+func (e SegmentSplitValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DestinationMappingsValidationError) Key() bool { return e.key }
+func (e SegmentSplitValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DestinationMappingsValidationError) ErrorName() string {
-	return "DestinationMappingsValidationError"
-}
+func (e SegmentSplitValidationError) ErrorName() string { return "SegmentSplitValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DestinationMappingsValidationError) Error() string {
+func (e SegmentSplitValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3057,14 +11066,14 @@ func (e DestinationMappingsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDestinationMappings.%s: %s%s",
+		"invalid %sSegmentSplit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DestinationMappingsValidationError{}
+var _ error = SegmentSplitValidationError{}
 
 var _ interface {
 	Field() string
@@ -3072,48 +11081,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DestinationMappingsValidationError{}
+} = SegmentSplitValidationError{}
 
-// Validate checks the field values on EnrichedMasterSegment with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnrichedMasterSegment) Validate() error {
+// Validate checks the field values on SplitArmOutcome with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SplitArmOutcome) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrichedMasterSegment with the rules
+// ValidateAll checks the field values on SplitArmOutcome with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnrichedMasterSegmentMultiError, or nil if none found.
-func (m *EnrichedMasterSegment) ValidateAll() error {
+// SplitArmOutcomeMultiError, or nil if none found.
+func (m *SplitArmOutcome) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrichedMasterSegment) validate(all bool) error {
+func (m *SplitArmOutcome) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	// no validation rules for Name
 
+	// no validation rules for SegmentId
+
+	// no validation rules for Size
+
+	// no validation rules for Converted
+
+	// no validation rules for Value
+
+	// no validation rules for Stddev
+
 	if len(errors) > 0 {
-		return EnrichedMasterSegmentMultiError(errors)
+		return SplitArmOutcomeMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrichedMasterSegmentMultiError is an error wrapping multiple validation
-// errors returned by EnrichedMasterSegment.ValidateAll() if the designated
-// constraints aren't met.
-type EnrichedMasterSegmentMultiError []error
+// SplitArmOutcomeMultiError is an error wrapping multiple validation errors
+// returned by SplitArmOutcome.ValidateAll() if the designated constraints
+// aren't met.
+type SplitArmOutcomeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrichedMasterSegmentMultiError) Error() string {
+func (m SplitArmOutcomeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3122,11 +11139,11 @@ func (m EnrichedMasterSegmentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrichedMasterSegmentMultiError) AllErrors() []error { return m }
+func (m SplitArmOutcomeMultiError) AllErrors() []error { return m }
 
-// EnrichedMasterSegmentValidationError is the validation error returned by
-// EnrichedMasterSegment.Validate if the designated constraints aren't met.
-type EnrichedMasterSegmentValidationError struct {
+// SplitArmOutcomeValidationError is the validation error returned by
+// SplitArmOutcome.Validate if the designated constraints aren't met.
+type SplitArmOutcomeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3134,24 +11151,22 @@ type EnrichedMasterSegmentValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrichedMasterSegmentValidationError) Field() string { return e.field }
+func (e SplitArmOutcomeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrichedMasterSegmentValidationError) Reason() string { return e.reason }
+func (e SplitArmOutcomeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrichedMasterSegmentValidationError) Cause() error { return e.cause }
+func (e SplitArmOutcomeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrichedMasterSegmentValidationError) Key() bool { return e.key }
+func (e SplitArmOutcomeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrichedMasterSegmentValidationError) ErrorName() string {
-	return "EnrichedMasterSegmentValidationError"
-}
+func (e SplitArmOutcomeValidationError) ErrorName() string { return "SplitArmOutcomeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EnrichedMasterSegmentValidationError) Error() string {
+func (e SplitArmOutcomeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3163,14 +11178,14 @@ func (e EnrichedMasterSegmentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrichedMasterSegment.%s: %s%s",
+		"invalid %sSplitArmOutcome.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrichedMasterSegmentValidationError{}
+var _ error = SplitArmOutcomeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3178,48 +11193,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrichedMasterSegmentValidationError{}
+} = SplitArmOutcomeValidationError{}
 
-// Validate checks the field values on EnrichedSegment with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EnrichedSegment) Validate() error {
+// Validate checks the field values on SplitArmComparison with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SplitArmComparison) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrichedSegment with the rules
+// ValidateAll checks the field values on SplitArmComparison with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnrichedSegmentMultiError, or nil if none found.
-func (m *EnrichedSegment) ValidateAll() error {
+// SplitArmComparisonMultiError, or nil if none found.
+func (m *SplitArmComparison) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrichedSegment) validate(all bool) error {
+func (m *SplitArmComparison) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Arm
 
-	// no validation rules for Name
+	// no validation rules for ControlArm
+
+	// no validation rules for Difference
+
+	// no validation rules for Lift
+
+	// no validation rules for Statistic
+
+	// no validation rules for PValue
+
+	// no validation rules for Significant
 
 	if len(errors) > 0 {
-		return EnrichedSegmentMultiError(errors)
+		return SplitArmComparisonMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrichedSegmentMultiError is an error wrapping multiple validation errors
-// returned by EnrichedSegment.ValidateAll() if the designated constraints
+// SplitArmComparisonMultiError is an error wrapping multiple validation errors
+// returned by SplitArmComparison.ValidateAll() if the designated constraints
 // aren't met.
-type EnrichedSegmentMultiError []error
+type SplitArmComparisonMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrichedSegmentMultiError) Error() string {
+func (m SplitArmComparisonMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3228,11 +11253,11 @@ func (m EnrichedSegmentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrichedSegmentMultiError) AllErrors() []error { return m }
+func (m SplitArmComparisonMultiError) AllErrors() []error { return m }
 
-// EnrichedSegmentValidationError is the validation error returned by
-// EnrichedSegment.Validate if the designated constraints aren't met.
-type EnrichedSegmentValidationError struct {
+// SplitArmComparisonValidationError is the validation error returned by
+// SplitArmComparison.Validate if the designated constraints aren't met.
+type SplitArmComparisonValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3240,22 +11265,24 @@ type EnrichedSegmentValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrichedSegmentValidationError) Field() string { return e.field }
+func (e SplitArmComparisonValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrichedSegmentValidationError) Reason() string { return e.reason }
+func (e SplitArmComparisonValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrichedSegmentValidationError) Cause() error { return e.cause }
+func (e SplitArmComparisonValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrichedSegmentValidationError) Key() bool { return e.key }
+func (e SplitArmComparisonValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrichedSegmentValidationError) ErrorName() string { return "EnrichedSegmentValidationError" }
+func (e SplitArmComparisonValidationError) ErrorName() string {
+	return "SplitArmComparisonValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EnrichedSegmentValidationError) Error() string {
+func (e SplitArmComparisonValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3267,14 +11294,14 @@ func (e EnrichedSegmentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrichedSegment.%s: %s%s",
+		"invalid %sSplitArmComparison.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrichedSegmentValidationError{}
+var _ error = SplitArmComparisonValidationError{}
 
 var _ interface {
 	Field() string
@@ -3282,7 +11309,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrichedSegmentValidationError{}
+} = SplitArmComparisonValidationError{}
 
 // Validate checks the field values on MasterSegmentDetail_AttributeTable with
 // the rules defined in the proto definition for this message. If any rules
@@ -3500,6 +11527,35 @@ func (m *MasterSegmentDetail_BehaviorTable) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetRetentionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MasterSegmentDetail_BehaviorTableValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MasterSegmentDetail_BehaviorTableValidationError{
+					field:  "RetentionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MasterSegmentDetail_BehaviorTableValidationError{
+				field:  "RetentionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MasterSegmentDetail_BehaviorTableMultiError(errors)
 	}
@@ -3689,6 +11745,164 @@ var _ interface {
 	ErrorName() string
 } = BehaviorCondition_HavingConditionValidationError{}
 
+// Validate checks the field values on SequenceCondition_SequenceStep with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SequenceCondition_SequenceStep) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SequenceCondition_SequenceStep with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SequenceCondition_SequenceStepMultiError, or nil if none found.
+func (m *SequenceCondition_SequenceStep) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SequenceCondition_SequenceStep) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBehaviorTableId() <= 0 {
+		err := SequenceCondition_SequenceStepValidationError{
+			field:  "BehaviorTableId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimeColumn
+
+	if all {
+		switch v := interface{}(m.GetEventFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SequenceCondition_SequenceStepValidationError{
+					field:  "EventFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SequenceCondition_SequenceStepValidationError{
+					field:  "EventFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SequenceCondition_SequenceStepValidationError{
+				field:  "EventFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxGapSeconds() < 0 {
+		err := SequenceCondition_SequenceStepValidationError{
+			field:  "MaxGapSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Negated
+
+	if len(errors) > 0 {
+		return SequenceCondition_SequenceStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// SequenceCondition_SequenceStepMultiError is an error wrapping multiple
+// validation errors returned by SequenceCondition_SequenceStep.ValidateAll()
+// if the designated constraints aren't met.
+type SequenceCondition_SequenceStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SequenceCondition_SequenceStepMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SequenceCondition_SequenceStepMultiError) AllErrors() []error { return m }
+
+// SequenceCondition_SequenceStepValidationError is the validation error
+// returned by SequenceCondition_SequenceStep.Validate if the designated
+// constraints aren't met.
+type SequenceCondition_SequenceStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SequenceCondition_SequenceStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SequenceCondition_SequenceStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SequenceCondition_SequenceStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SequenceCondition_SequenceStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SequenceCondition_SequenceStepValidationError) ErrorName() string {
+	return "SequenceCondition_SequenceStepValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SequenceCondition_SequenceStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSequenceCondition_SequenceStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SequenceCondition_SequenceStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SequenceCondition_SequenceStepValidationError{}
+
 // Validate checks the field values on DataActionRun_MetaData with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
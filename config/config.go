@@ -12,6 +12,8 @@ type Config struct {
 	QueryAdapterConfig   QueryConfig   `json:"query_adapter_config" mapstructure:"query_adapter_config"`
 	MqttAdapterConfig    MqttConfig    `json:"mqtt_adapter_config" mapstructure:"mqtt_adapter_config"`
 	AlertAdapterConfig   AlertConfig   `json:"alert_adapter_config" mapstructure:"alert_adapter_config"`
+
+	ErasureConfig ErasureConfig `json:"erasure_config" mapstructure:"erasure_config"`
 }
 
 type Base struct {
@@ -57,6 +59,9 @@ func loadDefaultConfig() *Config {
 			Password: "secret",
 		},
 		AlertAdapterConfig: AlertConfig{},
+		ErasureConfig: ErasureConfig{
+			IdentifierHashKey: "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		},
 	}
 	return c
 }
@@ -0,0 +1,7 @@
+package config
+
+type ErasureConfig struct {
+	// IdentifierHashKey keys the hash of the identifiers kept by the erasure certificates, so an identifier
+	// cannot be found back by hashing guesses
+	IdentifierHashKey string `json:"identifier_hash_key" mapstructure:"identifier_hash_key"`
+}
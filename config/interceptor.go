@@ -4,50 +4,105 @@ func AccessibleRoles() map[string][]string {
 	const rootServicePath = "/api.CDPService/"
 	const rootServiceFilePath = "/api.CDPServiceFile/"
 	return map[string][]string{
-		rootServicePath + "Admin":                             {"admin"},
-		rootServicePath + "GetAccountInfo":                    {"admin", "user"},
-		rootServicePath + "UpdateAccountInfo":                 {"admin", "user"},
-		rootServicePath + "UpdateAccountSetting":              {"admin", "user"},
-		rootServiceFilePath + "ImportCsv":                     {"admin", "user"},
-		rootServicePath + "GetListDataSources":                {"admin", "user"},
-		rootServicePath + "GetDataSource":                     {"admin", "user"},
-		rootServicePath + "GetListDataTables":                 {"admin", "user"},
-		rootServicePath + "GetDataTable":                      {"admin", "user"},
-		rootServicePath + "GetListConnections":                {"admin", "user"},
-		rootServicePath + "GetConnection":                     {"admin", "user"},
-		rootServicePath + "CreateConnection":                  {"admin", "user"},
-		rootServicePath + "UpdateConnection":                  {"admin", "user"},
-		rootServicePath + "DeleteConnection":                  {"admin", "user"},
-		rootServicePath + "ExportDataToFile":                  {"admin", "user"},
-		rootServicePath + "GetListFileExportRecords":          {"admin", "user"},
-		rootServicePath + "ImportCsvFromS3":                   {"admin", "user"},
-		rootServicePath + "CreateMasterSegment":               {"admin", "user"},
-		rootServicePath + "GetListMasterSegments":             {"admin", "user"},
-		rootServicePath + "GetMasterSegmentDetail":            {"admin", "user"},
-		rootServicePath + "CreateSegment":                     {"admin", "user"},
-		rootServicePath + "GetListSegments":                   {"admin", "user"},
-		rootServicePath + "GetQueryDataTable":                 {"admin", "user"},
-		rootServicePath + "GetSegmentDetail":                  {"admin", "user"},
-		rootServicePath + "CreateGophishUserGroupFromSegment": {"admin", "user"},
-		rootServicePath + "ImportFromMySQLSource":             {"admin", "user"},
-		rootServicePath + "ExportToMySQLDestination":          {"admin", "user"},
-		rootServicePath + "GetListDataDestinations":           {"admin", "user"},
-		rootServicePath + "GetListDataActionRuns":             {"admin", "user"},
-		rootServicePath + "TrainPredictModel":                 {"admin", "user"},
-		rootServicePath + "GetListPredictModels":              {"admin", "user"},
-		rootServicePath + "GetDataDestinationDetail":          {"admin", "user"},
-		rootServicePath + "GetPredictModelDetail":             {"admin", "user"},
-		rootServicePath + "GetMySQLTableSchema":               {"admin", "user"},
-		rootServicePath + "GetListMasterSegmentProfiles":      {"admin", "user"},
-		rootServicePath + "GetListPredictionActions":          {"admin", "user"},
-		rootServicePath + "ApplyPredictModel":                 {"admin", "user"},
-		rootServicePath + "TriggerDataActionRun":              {"admin", "user"},
-		rootServicePath + "GetResultPredictionActions":        {"admin", "user"},
-		rootServicePath + "GetDataActionRunsPerDay":           {"admin", "user"},
-		rootServicePath + "GetDataRunsProportion":             {"admin", "user"},
-		rootServicePath + "GetMasterSegmentProfile":           {"admin", "user"},
-		rootServicePath + "GetBehaviorProfile":                {"admin", "user"},
-		rootServicePath + "GetListDestinationMap":             {"admin", "user"},
-		rootServicePath + "TotalProfilesMasterSegment":        {"admin", "user"},
+		rootServicePath + "Admin":                                 {"admin"},
+		rootServicePath + "GetAccountInfo":                        {"admin", "user"},
+		rootServicePath + "UpdateAccountInfo":                     {"admin", "user"},
+		rootServicePath + "UpdateAccountSetting":                  {"admin", "user"},
+		rootServiceFilePath + "ImportCsv":                         {"admin", "user"},
+		rootServicePath + "GetListDataSources":                    {"admin", "user"},
+		rootServicePath + "GetDataSource":                         {"admin", "user"},
+		rootServicePath + "GetListDataTables":                     {"admin", "user"},
+		rootServicePath + "GetDataTable":                          {"admin", "user"},
+		rootServicePath + "GetListConnections":                    {"admin", "user"},
+		rootServicePath + "GetConnection":                         {"admin", "user"},
+		rootServicePath + "CreateConnection":                      {"admin", "user"},
+		rootServicePath + "UpdateConnection":                      {"admin", "user"},
+		rootServicePath + "DeleteConnection":                      {"admin", "user"},
+		rootServicePath + "ExportDataToFile":                      {"admin", "user"},
+		rootServicePath + "GetListFileExportRecords":              {"admin", "user"},
+		rootServicePath + "ImportCsvFromS3":                       {"admin", "user"},
+		rootServicePath + "CreateMasterSegment":                   {"admin", "user"},
+		rootServicePath + "GetListMasterSegments":                 {"admin", "user"},
+		rootServicePath + "GetMasterSegmentDetail":                {"admin", "user"},
+		rootServicePath + "CreateSegment":                         {"admin", "user"},
+		rootServicePath + "GetListSegments":                       {"admin", "user"},
+		rootServicePath + "GetQueryDataTable":                     {"admin", "user"},
+		rootServicePath + "GetSegmentDetail":                      {"admin", "user"},
+		rootServicePath + "CreateGophishUserGroupFromSegment":     {"admin", "user"},
+		rootServicePath + "ImportFromMySQLSource":                 {"admin", "user"},
+		rootServicePath + "ExportToMySQLDestination":              {"admin", "user"},
+		rootServicePath + "GetListDataDestinations":               {"admin", "user"},
+		rootServicePath + "GetListDataActionRuns":                 {"admin", "user"},
+		rootServicePath + "TrainPredictModel":                     {"admin", "user"},
+		rootServicePath + "GetListPredictModels":                  {"admin", "user"},
+		rootServicePath + "GetDataDestinationDetail":              {"admin", "user"},
+		rootServicePath + "GetPredictModelDetail":                 {"admin", "user"},
+		rootServicePath + "GetMySQLTableSchema":                   {"admin", "user"},
+		rootServicePath + "GetListMasterSegmentProfiles":          {"admin", "user"},
+		rootServicePath + "GetListPredictionActions":              {"admin", "user"},
+		rootServicePath + "ApplyPredictModel":                     {"admin", "user"},
+		rootServicePath + "TriggerDataActionRun":                  {"admin", "user"},
+		rootServicePath + "GetResultPredictionActions":            {"admin", "user"},
+		rootServicePath + "GetDataActionRunsPerDay":               {"admin", "user"},
+		rootServicePath + "GetDataRunsProportion":                 {"admin", "user"},
+		rootServicePath + "GetMasterSegmentProfile":               {"admin", "user"},
+		rootServicePath + "GetBehaviorProfile":                    {"admin", "user"},
+		rootServicePath + "GetListDestinationMap":                 {"admin", "user"},
+		rootServicePath + "TotalProfilesMasterSegment":            {"admin", "user"},
+		rootServicePath + "PreviewFile":                           {"admin", "user"},
+		rootServicePath + "UpdateDataTableQualityRules":           {"admin", "user"},
+		rootServicePath + "CreateIdentityResolution":              {"admin", "user"},
+		rootServicePath + "GetListIdentityResolutions":            {"admin", "user"},
+		rootServicePath + "GetIdentityResolutionDetail":           {"admin", "user"},
+		rootServicePath + "GetIdentityProfileHistory":             {"admin", "user"},
+		rootServicePath + "DeduplicateDataTable":                  {"admin", "user"},
+		rootServicePath + "CreateEventSource":                     {"admin", "user"},
+		rootServicePath + "GetListEventSources":                   {"admin", "user"},
+		rootServicePath + "UpdateDataTableSchemaPolicy":           {"admin", "user"},
+		rootServicePath + "GetListDataTableSchemaVersions":        {"admin", "user"},
+		rootServicePath + "GetListDataTableVersions":              {"admin", "user"},
+		rootServicePath + "RestoreDataTable":                      {"admin", "user"},
+		rootServicePath + "DiffDataTableVersions":                 {"admin", "user"},
+		rootServicePath + "ProfileDataTable":                      {"admin", "user"},
+		rootServicePath + "GetDataTableStatistics":                {"admin", "user"},
+		rootServicePath + "ProfileMasterSegment":                  {"admin", "user"},
+		rootServicePath + "GetMasterSegmentStatistics":            {"admin", "user"},
+		rootServicePath + "UpdateMasterSegmentComputedAttributes": {"admin", "user"},
+		rootServicePath + "RefreshMasterSegment":                  {"admin", "user"},
+		rootServicePath + "GetCustomerProfile":                    {"admin", "user"},
+		rootServicePath + "UpdateMasterSegmentSearchableColumns":  {"admin", "user"},
+		rootServicePath + "SearchProfiles":                        {"admin", "user"},
+		rootServicePath + "SuggestColumnTags":                     {"admin", "user"},
+		rootServicePath + "UpdateColumnTags":                      {"admin"},
+		rootServicePath + "GetColumnTags":                         {"admin", "user"},
+		rootServicePath + "UpdateMaskingPolicies":                 {"admin"},
+		rootServicePath + "GetMaskingPolicies":                    {"admin", "user"},
+		rootServicePath + "ErasePerson":                           {"admin", "user"},
+		rootServicePath + "GetListErasureCertificates":            {"admin", "user"},
+		rootServicePath + "GetErasureCertificate":                 {"admin", "user"},
+		rootServicePath + "UpdateMasterSegmentConsentColumns":     {"admin", "user"},
+		rootServicePath + "CreateSuppressionList":                 {"admin", "user"},
+		rootServicePath + "GetListSuppressionLists":               {"admin", "user"},
+		rootServicePath + "UploadSuppressionEntries":              {"admin", "user"},
+		rootServicePath + "DeleteSuppressionList":                 {"admin", "user"},
+		rootServicePath + "SetRetentionPolicy":                    {"admin", "user"},
+		rootServicePath + "GetListRetentionPolicies":              {"admin", "user"},
+		rootServicePath + "DeleteRetentionPolicy":                 {"admin", "user"},
+		rootServicePath + "GetListSegmentSnapshots":               {"admin", "user"},
+		rootServicePath + "GetSegmentMembershipChanges":           {"admin", "user"},
+		rootServicePath + "GetSegmentSizeHistory":                 {"admin", "user"},
+		rootServicePath + "AnalyzeSegmentOverlap":                 {"admin", "user"},
+		rootServicePath + "GetSegmentInsights":                    {"admin", "user"},
+		rootServicePath + "CreateRFMAnalysis":                     {"admin", "user"},
+		rootServicePath + "GetListRFMAnalyses":                    {"admin", "user"},
+		rootServicePath + "GetCohortRetention":                    {"admin", "user"},
+		rootServicePath + "CreateFunnel":                          {"admin", "user"},
+		rootServicePath + "GetListFunnels":                        {"admin", "user"},
+		rootServicePath + "DeleteFunnel":                          {"admin", "user"},
+		rootServicePath + "ComputeFunnel":                         {"admin", "user"},
+		rootServicePath + "CreateFunnelDropOffSegment":            {"admin", "user"},
+		rootServicePath + "CreateSegmentSplit":                    {"admin", "user"},
+		rootServicePath + "GetListSegmentSplits":                  {"admin", "user"},
+		rootServicePath + "CompareSegmentSplitArms":               {"admin", "user"},
 	}
 }
@@ -21,6 +21,10 @@ const (
 	Endpoint_TRIGGER_GENERATE_DAG_CREATE_SEGMENT        string = "/api/v1/dags/generate_create_segment/dagRuns"
 	Endpoint_TRIGGER_GENERATE_DAG_TRAIN_PREDICT_MODEL   string = "/api/v1/dags/generate_train_predict_model/dagRuns"
 	Endpoint_TRIGGER_GENERATE_DAG_APPLY_PREDICT_MODEL   string = "/api/v1/dags/generate_apply_predict_model/dagRuns"
+	Endpoint_TRIGGER_GENERATE_DAG_IDENTITY_RESOLUTION   string = "/api/v1/dags/generate_identity_resolution/dagRuns"
+	// dags rewriting a data table in place
+	Endpoint_TRIGGER_GENERATE_DAG_DEDUPLICATE_DATA_TABLE string = "/api/v1/dags/generate_deduplicate_data_table/dagRuns"
+	Endpoint_TRIGGER_GENERATE_DAG_RESTORE_DATA_TABLE     string = "/api/v1/dags/generate_restore_data_table/dagRuns"
 
 	WriteMode_Append    DeltaWriteMode = "append"
 	WriteMode_Overwrite DeltaWriteMode = "overwrite"
@@ -36,6 +40,9 @@ type AirflowAdapter interface {
 	TriggerGenerateDagCreateSegment(ctx context.Context, request *TriggerGenerateDagCreateSegmentRequest) error
 	TriggerGenerateDagTrainPredictModel(ctx context.Context, request *TriggerGenerateDagTrainPredictModelRequest) (*TriggerNewDagRunResponse, error)
 	TriggerGenerateDagApplyPredictModel(ctx context.Context, request *TriggerGenerateDagApplyPredictModelRequest) error
+	TriggerGenerateDagIdentityResolution(ctx context.Context, request *TriggerGenerateDagIdentityResolutionRequest) (*TriggerNewDagRunResponse, error)
+	TriggerGenerateDagDeduplicateDataTable(ctx context.Context, request *TriggerGenerateDagDeduplicateDataTableRequest) (*TriggerNewDagRunResponse, error)
+	TriggerGenerateDagRestoreDataTable(ctx context.Context, request *TriggerGenerateDagRestoreDataTableRequest) (*TriggerNewDagRunResponse, error)
 
 	ListDags(ctx context.Context, request *ListDagsParams) (*ListDagsResponse, error)
 	UpdateDag(ctx context.Context, dagId string, request *UpdateDagRequest) (*UpdateDagResponse, error)
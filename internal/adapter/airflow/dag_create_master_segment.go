@@ -17,6 +17,8 @@ type (
 		MainAttributes  string `json:"main_attributes"`
 		AttributeTables string `json:"attribute_tables"`
 		BehaviorTables  string `json:"behavior_tables"`
+		// ComputedAttributes is the json list of CreateMasterSegmentConfig_ComputedAttribute
+		ComputedAttributes string `json:"computed_attributes"`
 	}
 	CreateMasterSegmentConfig_TableColumns struct {
 		TableColumnName    string `json:"table_column_name"`
@@ -35,6 +37,16 @@ type (
 		ForeignKey        string                                   `json:"foreign_key"`
 		Columns           []CreateMasterSegmentConfig_TableColumns `json:"columns"`
 	}
+	// CreateMasterSegmentConfig_ComputedAttribute is SELECT foreign_key, expression AS audience_column_name
+	// FROM table WHERE condition GROUP BY foreign_key, joined to the audience on join_key
+	CreateMasterSegmentConfig_ComputedAttribute struct {
+		AudienceColumnName string `json:"audience_column_name"`
+		TableName          string `json:"table_name"`
+		JoinKey            string `json:"join_key"`
+		ForeignKey         string `json:"foreign_key"`
+		Expression         string `json:"expression"`
+		Condition          string `json:"condition"`
+	}
 )
 
 func (c *airflow) TriggerGenerateDagCreateMasterSegment(ctx context.Context, request *TriggerGenerateDagCreateMasterSegmentRequest) error {
@@ -0,0 +1,48 @@
+package airflow
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/utils"
+)
+
+type (
+	TriggerGenerateDagDeduplicateDataTableRequest struct {
+		Conf DagDeduplicateDataTableConfig `json:"conf"`
+	}
+
+	DagDeduplicateDataTableConfig struct {
+		DagId         string                        `json:"dag_id"`
+		TableKey      string                        `json:"table_key"`
+		Deduplication DagDeduplicationConfiguration `json:"deduplication"`
+	}
+
+	// DagDeduplicationConfiguration keeps one row per KeyColumns group, chosen by Survivorship: the row with the
+	// greatest TimestampColumn (latest), the earliest written row (first_seen) or the row with the fewest nulls
+	// (most_complete). The row counts before and after are written to ReportKey with the dag run id.
+	DagDeduplicationConfiguration struct {
+		KeyColumns      []string `json:"key_columns"`
+		Survivorship    string   `json:"survivorship"`
+		TimestampColumn string   `json:"timestamp_column,omitempty"`
+		ReportKey       string   `json:"report_key"`
+	}
+)
+
+func (c *airflow) TriggerGenerateDagDeduplicateDataTable(ctx context.Context, request *TriggerGenerateDagDeduplicateDataTableRequest) (*TriggerNewDagRunResponse, error) {
+	c.log.Info("Endpoint", "endpoint", Endpoint_TRIGGER_GENERATE_DAG_DEDUPLICATE_DATA_TABLE)
+	response := &TriggerNewDagRunResponse{}
+
+	err := c.client.SendHttpRequestWithBasicAuth(ctx, utils.BasicAuth{
+		Username: c.username,
+		Password: c.password,
+	}, utils.Request{
+		Endpoint: Endpoint_TRIGGER_GENERATE_DAG_DEDUPLICATE_DATA_TABLE,
+		Method:   utils.Method_POST,
+		Body:     request,
+		Headers:  map[string]string{utils.Header_CONTENT_TYPE: "application/json"},
+	}, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
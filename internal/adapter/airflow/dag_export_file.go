@@ -15,6 +15,13 @@ type (
 		Key              string           `json:"key"`
 		Condition        string           `json:"condition"`
 		S3Configurations S3Configurations `json:"s3_configurations"`
+		MaskedColumns    []MaskedColumn   `json:"masked_columns"`
+	}
+
+	// MaskedColumn replaces the values of a column with a spark sql expression before they are exported
+	MaskedColumn struct {
+		ColumnName string `json:"column_name"`
+		Expression string `json:"expression"`
 	}
 )
 
@@ -17,6 +17,7 @@ type DagExportMySQLConfig struct {
 	Condition             string                              `json:"condition"`
 	DatabaseConfiguration DagExportMySQLDatabaseConfiguration `json:"database_configuration"`
 	DestinationTableName  string                              `json:"destination_table_name"`
+	MaskedColumns         []MaskedColumn                      `json:"masked_columns"`
 }
 
 type DagExportMySQLDatabaseConfiguration struct {
@@ -0,0 +1,53 @@
+package airflow
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/utils"
+)
+
+type (
+	TriggerGenerateDagIdentityResolutionRequest struct {
+		Conf DagIdentityResolutionConfig `json:"conf"`
+	}
+
+	// DagIdentityResolutionConfig builds the identity graph of the source records and writes one row per unified profile.
+	// Unified profile ids are kept stable by reusing the ids of GraphKey: a connected component with several existing ids
+	// keeps the oldest and records a MERGE, records of an id that are now in several components keep the id in the
+	// largest component and record a SPLIT for the others.
+	DagIdentityResolutionConfig struct {
+		DagId                  string                        `json:"dag_id"`
+		IdentityResolutionId   int64                         `json:"identity_resolution_id"`
+		Sources                []DagIdentityResolutionSource `json:"sources"`
+		Rules                  []*api.IdentityMatchRule      `json:"rules"`
+		UnifiedProfileIdColumn string                        `json:"unified_profile_id_column"`
+		GraphKey               string                        `json:"graph_key"`
+		HistoryKey             string                        `json:"history_key"`
+		OutputTableKey         string                        `json:"output_table_key"`
+	}
+
+	DagIdentityResolutionSource struct {
+		TableKey string              `json:"table_key"`
+		Source   *api.IdentitySource `json:"source"`
+	}
+)
+
+func (c *airflow) TriggerGenerateDagIdentityResolution(ctx context.Context, request *TriggerGenerateDagIdentityResolutionRequest) (*TriggerNewDagRunResponse, error) {
+	c.log.Info("Endpoint", "endpoint", Endpoint_TRIGGER_GENERATE_DAG_IDENTITY_RESOLUTION)
+	response := &TriggerNewDagRunResponse{}
+
+	err := c.client.SendHttpRequestWithBasicAuth(ctx, utils.BasicAuth{
+		Username: c.username,
+		Password: c.password,
+	}, utils.Request{
+		Endpoint: Endpoint_TRIGGER_GENERATE_DAG_IDENTITY_RESOLUTION,
+		Method:   utils.Method_POST,
+		Body:     request,
+		Headers:  map[string]string{utils.Header_CONTENT_TYPE: "application/json"},
+	}, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -19,6 +19,33 @@ type (
 		WriteMode        DeltaWriteMode               `json:"write_mode"`
 		CsvReadOptions   *api.ImportCsvConfigurations `json:"csv_read_options"`
 		Headers          []string                     `json:"headers"`
+		ColumnTypes      map[string]DagColumnType     `json:"column_types,omitempty"`
+		Transformations  []DagColumnTransformation    `json:"transformations,omitempty"`
+		DataQuality      *DagDataQualityConfiguration `json:"data_quality,omitempty"`
+		// Deduplication drops the duplicate rows of the file before they are written
+		Deduplication *DagDeduplicationConfiguration `json:"deduplication,omitempty"`
+		SchemaPolicy  string                         `json:"schema_policy"`
+	}
+
+	// DagColumnType is the type a column is cast to when writing the delta table
+	DagColumnType struct {
+		DataType string `json:"data_type"`
+		Format   string `json:"format,omitempty"`
+	}
+
+	// DagColumnTransformation sets column_name to the spark sql expression, applied in order after the columns are renamed
+	DagColumnTransformation struct {
+		ColumnName string `json:"column_name"`
+		Expression string `json:"expression"`
+	}
+
+	// DagDataQualityConfiguration makes the dag write rejected rows with their reasons to QuarantineKey,
+	// append the passed/failed counts of the run to ReportKey and fail when the rejected ratio is above ErrorThreshold
+	DagDataQualityConfiguration struct {
+		Rules          []*api.DataQualityRule `json:"rules"`
+		ErrorThreshold float64                `json:"error_threshold"`
+		QuarantineKey  string                 `json:"quarantine_key"`
+		ReportKey      string                 `json:"report_key"`
 	}
 
 	S3Configurations struct {
@@ -17,6 +17,10 @@ type (
 		DeltaTableKey         string                              `json:"delta_table_key"`
 		WriteMode             DeltaWriteMode                      `json:"write_mode"`
 		Headers               []*api.MappingOptionItem            `json:"headers"`
+		Transformations       []DagColumnTransformation           `json:"transformations,omitempty"`
+		DataQuality           *DagDataQualityConfiguration        `json:"data_quality,omitempty"`
+		Deduplication         *DagDeduplicationConfiguration      `json:"deduplication,omitempty"`
+		SchemaPolicy          string                              `json:"schema_policy"`
 		DatabaseConfiguration DagImportMySQLDatabaseConfiguration `json:"database_configuration"`
 	}
 
@@ -0,0 +1,40 @@
+package airflow
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/utils"
+)
+
+type (
+	TriggerGenerateDagRestoreDataTableRequest struct {
+		Conf DagRestoreDataTableConfig `json:"conf"`
+	}
+
+	// DagRestoreDataTableConfig restores the delta table to Version, or as of Timestamp when Version is nil
+	DagRestoreDataTableConfig struct {
+		DagId     string `json:"dag_id"`
+		TableKey  string `json:"table_key"`
+		Version   *int64 `json:"version,omitempty"`
+		Timestamp string `json:"timestamp,omitempty"`
+	}
+)
+
+func (c *airflow) TriggerGenerateDagRestoreDataTable(ctx context.Context, request *TriggerGenerateDagRestoreDataTableRequest) (*TriggerNewDagRunResponse, error) {
+	c.log.Info("Endpoint", "endpoint", Endpoint_TRIGGER_GENERATE_DAG_RESTORE_DATA_TABLE)
+	response := &TriggerNewDagRunResponse{}
+
+	err := c.client.SendHttpRequestWithBasicAuth(ctx, utils.BasicAuth{
+		Username: c.username,
+		Password: c.password,
+	}, utils.Request{
+		Endpoint: Endpoint_TRIGGER_GENERATE_DAG_RESTORE_DATA_TABLE,
+		Method:   utils.Method_POST,
+		Body:     request,
+		Headers:  map[string]string{utils.Header_CONTENT_TYPE: "application/json"},
+	}, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
@@ -4,4 +4,5 @@ package constants
 const (
 	KeyAccountUuid   = "account_uuid"
 	KeyAuthorization = "authorization"
+	KeyRole          = "role"
 )
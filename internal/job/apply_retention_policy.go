@@ -0,0 +1,20 @@
+package job
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/alert"
+)
+
+func (j *job) ApplyRetentionPolicies(ctx context.Context) {
+	err := j.business.DataTableBusiness.ApplyRetentionPolicies(ctx)
+	if err != nil {
+		j.logger.WithName("ApplyRetentionPolicies").Error(err, "fail to apply retention policies")
+		if j.config.AlertAdapterConfig.EnableAlert {
+			_ = j.alertAdapter.AlertError(ctx, &alert.ErrorMessage{
+				Title:        "Job error",
+				Description:  "There is error in job apply retention policies",
+				ErrorMessage: err.Error(),
+			})
+		}
+	}
+}
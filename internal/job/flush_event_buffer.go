@@ -0,0 +1,20 @@
+package job
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/alert"
+)
+
+func (j *job) FlushEventBuffers(ctx context.Context) {
+	err := j.business.EventSourceBusiness.FlushEventBuffers(ctx)
+	if err != nil {
+		j.logger.WithName("FlushEventBuffers").Error(err, "fail to flush event buffers")
+		if j.config.AlertAdapterConfig.EnableAlert {
+			_ = j.alertAdapter.AlertError(ctx, &alert.ErrorMessage{
+				Title:        "Job error",
+				Description:  "There is error in job flush event buffers",
+				ErrorMessage: err.Error(),
+			})
+		}
+	}
+}
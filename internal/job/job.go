@@ -7,6 +7,7 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/alert"
 	"github.com/APCS20-Thesis/Backend/internal/adapter/mqtt"
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
 	"github.com/APCS20-Thesis/Backend/internal/service/business"
 	"github.com/go-logr/logr"
@@ -21,6 +22,8 @@ type Job interface {
 	// jobs
 	TriggerDagRuns(ctx context.Context)
 	SyncDagRunStatus(ctx context.Context)
+	FlushEventBuffers(ctx context.Context)
+	ApplyRetentionPolicies(ctx context.Context)
 }
 
 type job struct {
@@ -88,4 +91,12 @@ func (j *job) RegisterCronJobs() {
 	if err != nil {
 		j.logger.Error(err, "error add cronjob SyncDagRunStatus")
 	}
+	_, err = j.cronJob.AddFunc("* * * * *", func() { j.FlushEventBuffers(context.Background()) })
+	if err != nil {
+		j.logger.Error(err, "error add cronjob FlushEventBuffers")
+	}
+	_, err = j.cronJob.AddFunc(model.RetentionPolicy_Schedule, func() { j.ApplyRetentionPolicies(context.Background()) })
+	if err != nil {
+		j.logger.Error(err, "error add cronjob ApplyRetentionPolicies")
+	}
 }
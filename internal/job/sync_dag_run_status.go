@@ -94,7 +94,7 @@ func (j *job) SyncRelatedStatusFromDataActionRunStatus(ctx context.Context, data
 	}
 
 	switch dataAction.ActionType {
-	case model.ActionType_ImportDataFromFile, model.ActionType_ImportDataFromMySQL:
+	case model.ActionType_ImportDataFromFile, model.ActionType_ImportDataFromMySQL, model.ActionType_ImportDataFromS3:
 		if dataAction.Status == model.DataActionStatus_Success {
 			err = j.business.DataSourceBusiness.SyncOnImportFromSourceSuccess(ctx, dataActionId)
 		} else if dataAction.Status == model.DataActionStatus_Failed {
@@ -124,6 +124,45 @@ func (j *job) SyncRelatedStatusFromDataActionRunStatus(ctx context.Context, data
 			}
 			return
 		}
+	case model.ActionType_IdentityResolution:
+		err = j.business.IdentityResolutionBusiness.SyncOnIdentityResolution(ctx, dataAction.ObjectId, dataAction.Status)
+		if err != nil {
+			if j.config.AlertAdapterConfig.EnableAlert {
+				_ = j.alertAdapter.AlertError(ctx, &alert.ErrorMessage{
+					Title:        "Job error",
+					Description:  "There is error in job sync dag run status",
+					Request:      syncStatusInfo{DataActionRunId: dataActionId},
+					ErrorMessage: err.Error(),
+				})
+			}
+			return
+		}
+	case model.ActionType_DeduplicateDataTable:
+		err = j.business.DataTableBusiness.SyncOnDeduplicateDataTable(ctx, dataActionId)
+		if err != nil {
+			if j.config.AlertAdapterConfig.EnableAlert {
+				_ = j.alertAdapter.AlertError(ctx, &alert.ErrorMessage{
+					Title:        "Job error",
+					Description:  "There is error in job sync dag run status",
+					Request:      syncStatusInfo{DataActionRunId: dataActionId},
+					ErrorMessage: err.Error(),
+				})
+			}
+			return
+		}
+	case model.ActionType_RestoreDataTable:
+		err = j.business.DataTableBusiness.SyncOnRestoreDataTable(ctx, dataActionId)
+		if err != nil {
+			if j.config.AlertAdapterConfig.EnableAlert {
+				_ = j.alertAdapter.AlertError(ctx, &alert.ErrorMessage{
+					Title:        "Job error",
+					Description:  "There is error in job sync dag run status",
+					Request:      syncStatusInfo{DataActionRunId: dataActionId},
+					ErrorMessage: err.Error(),
+				})
+			}
+			return
+		}
 	case model.ActionType_TrainPredictModel:
 		err = j.business.PredictModelBusiness.SyncOnTrainPredictModel(ctx, dataActionId)
 		if err != nil {
@@ -150,6 +189,20 @@ func (j *job) SyncRelatedStatusFromDataActionRunStatus(ctx context.Context, data
 			Id:     dataAction.ObjectId,
 			Status: status,
 		})
+		if err == nil && dataAction.Status == model.DataActionStatus_Success {
+			err = j.business.SegmentBusiness.SyncOnBuildSegment(ctx, dataActionId)
+		}
+		if err != nil {
+			if j.config.AlertAdapterConfig.EnableAlert {
+				_ = j.alertAdapter.AlertError(ctx, &alert.ErrorMessage{
+					Title:        "Job error",
+					Description:  "There is error in job sync dag run status",
+					Request:      syncStatusInfo{DataActionRunId: dataActionId},
+					ErrorMessage: err.Error(),
+				})
+			}
+			return
+		}
 	default:
 	}
 
@@ -5,6 +5,9 @@ import (
 	"time"
 )
 
+// AudienceColumn_CdpSystemUuid is the unique key of the profiles of an audience table
+const AudienceColumn_CdpSystemUuid = "cdp_system_uuid"
+
 type AudienceTable struct {
 	ID                 int64 `gorm:"primaryKey"`
 	MasterSegmentId    int64
@@ -18,3 +21,15 @@ type AudienceTable struct {
 func (AudienceTable) TableName() string {
 	return "audience_table"
 }
+
+type ComputedAttributeAggregation string
+
+const (
+	ComputedAttributeAggregation_Count      ComputedAttributeAggregation = "count"
+	ComputedAttributeAggregation_Sum        ComputedAttributeAggregation = "sum"
+	ComputedAttributeAggregation_Avg        ComputedAttributeAggregation = "avg"
+	ComputedAttributeAggregation_Min        ComputedAttributeAggregation = "min"
+	ComputedAttributeAggregation_Max        ComputedAttributeAggregation = "max"
+	ComputedAttributeAggregation_FirstValue ComputedAttributeAggregation = "first_value"
+	ComputedAttributeAggregation_LastValue  ComputedAttributeAggregation = "last_value"
+)
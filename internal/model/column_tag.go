@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+type PiiTag string
+
+const (
+	PiiTag_Email      PiiTag = "email"
+	PiiTag_Phone      PiiTag = "phone"
+	PiiTag_Name       PiiTag = "name"
+	PiiTag_Address    PiiTag = "address"
+	PiiTag_NationalId PiiTag = "national_id"
+)
+
+const (
+	// ColumnTag_SampleSize is the number of rows read to suggest tags from values
+	ColumnTag_SampleSize = 100
+	// ColumnTag_MinMatchRatio is the share of the sampled values matching a pattern to suggest its tag
+	ColumnTag_MinMatchRatio = 0.8
+)
+
+// ColumnTag classifies a column of a data table, audience and behavior columns inherit the tags of their source columns
+type ColumnTag struct {
+	ID          int64 `gorm:"primaryKey"`
+	DataTableId int64
+	ColumnName  string
+	Tag         PiiTag
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (ColumnTag) TableName() string {
+	return "column_tag"
+}
@@ -19,6 +19,12 @@ const (
 	ActionType_TrainPredictModel   ActionType = "TRAIN_PREDICT_MODEL"
 	ActionType_ApplyPredictModel   ActionType = "APPLY_PREDICT_MODEL"
 	ActionType_ExportGophish       ActionType = "EXPORT_GOPHISH"
+	ActionType_IdentityResolution  ActionType = "IDENTITY_RESOLUTION"
+	// ActionType_DeduplicateDataTable rewrites a data table without its duplicate rows
+	ActionType_DeduplicateDataTable ActionType = "DEDUPLICATE_DATA_TABLE"
+	ActionType_RestoreDataTable     ActionType = "RESTORE_DATA_TABLE"
+	ActionType_ApplyRetention       ActionType = "APPLY_RETENTION"
+	ActionType_RFMAnalysis          ActionType = "RFM_ANALYSIS"
 )
 
 type DataActionTargetTable string
@@ -34,6 +40,9 @@ const (
 	TargetTable_PredictModel         DataActionTargetTable = "predict_model"
 	TargetTable_DataSource           DataActionTargetTable = "data_source"
 	TargetTable_DataDestination      DataActionTargetTable = "data_destination"
+	TargetTable_IdentityResolution   DataActionTargetTable = "identity_resolution"
+	TargetTable_BehaviorTable        DataActionTargetTable = "behavior_table"
+	TargetTable_RFMAnalysis          DataActionTargetTable = "rfm_analysis"
 )
 
 type DataActionStatus string
@@ -22,9 +22,14 @@ type DataActionRun struct {
 	DagRunId    string
 	Status      DataActionRunStatus
 	Error       string
+	PassedCount int64
+	FailedCount int64
 	AccountUuid uuid.UUID
 	CreatedAt   time.Time `gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	// RowCountBefore and RowCountAfter are the rows of the table before and after a deduplication
+	RowCountBefore int64
+	RowCountAfter  int64
 }
 
 func (DataActionRun) TableName() string {
@@ -27,6 +27,7 @@ type DataDestination struct {
 	Configurations pqtype.NullRawMessage
 	AccountUuid    uuid.UUID
 	ConnectionId   int64
+	Channel        string
 	CreatedAt      time.Time `gorm:"autoCreateTime"`
 	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
 }
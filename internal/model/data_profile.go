@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+type DataProfileObjectType string
+
+const (
+	DataProfileObjectType_DataTable DataProfileObjectType = "data_table"
+	DataProfileObjectType_Audience  DataProfileObjectType = "audience"
+	DataProfileObjectType_Behavior  DataProfileObjectType = "behavior"
+)
+
+const (
+	// DataProfile_TopValuesSize is the number of most frequent values kept per column
+	DataProfile_TopValuesSize = 10
+	// DataProfile_HistogramBuckets is the number of equal width buckets of numeric columns
+	DataProfile_HistogramBuckets = 10
+)
+
+type DataProfile struct {
+	ID         int64 `gorm:"primaryKey"`
+	ObjectType DataProfileObjectType
+	ObjectId   int64
+	RowCount   int64
+	Columns    pqtype.NullRawMessage
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+func (DataProfile) TableName() string {
+	return "data_profile"
+}
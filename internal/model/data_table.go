@@ -1,6 +1,7 @@
 package model
 
 import (
+	"github.com/APCS20-Thesis/Backend/api"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 	"time"
@@ -15,14 +16,38 @@ const (
 	DataTableStatus_UP_TO_DATE   DataTableStatus = "UP_TO_DATE"
 )
 
+type ColumnDataType string
+
+const (
+	ColumnDataType_Int       ColumnDataType = "int"
+	ColumnDataType_Float     ColumnDataType = "float"
+	ColumnDataType_Bool      ColumnDataType = "bool"
+	ColumnDataType_Date      ColumnDataType = "date"
+	ColumnDataType_Timestamp ColumnDataType = "timestamp"
+	ColumnDataType_String    ColumnDataType = "string"
+)
+
+type DataTableSchemaPolicy string
+
+const (
+	// DataTableSchemaPolicy_AllowAdditive applies added columns, keeps dropped columns and rejects retyped columns
+	DataTableSchemaPolicy_AllowAdditive DataTableSchemaPolicy = "allow_additive"
+	// DataTableSchemaPolicy_BlockBreaking applies added columns and rejects dropped or retyped columns
+	DataTableSchemaPolicy_BlockBreaking DataTableSchemaPolicy = "block_breaking"
+	// DataTableSchemaPolicy_AutoEvolve applies every change
+	DataTableSchemaPolicy_AutoEvolve DataTableSchemaPolicy = "auto_evolve"
+)
+
 type DataTable struct {
-	ID          int64 `gorm:"primaryKey"`
-	Schema      pqtype.NullRawMessage
-	Name        string
-	AccountUuid uuid.UUID
-	Status      DataTableStatus
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	ID                   int64 `gorm:"primaryKey"`
+	Schema               pqtype.NullRawMessage
+	Name                 string
+	AccountUuid          uuid.UUID
+	Status               DataTableStatus
+	QualityConfiguration pqtype.NullRawMessage
+	SchemaPolicy         DataTableSchemaPolicy
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
 }
 
 func (DataTable) TableName() string {
@@ -32,4 +57,29 @@ func (DataTable) TableName() string {
 type SchemaUnit struct {
 	ColumnName string `json:"column_name"`
 	DataType   string `json:"data_type"`
+	Format     string `json:"format,omitempty"`
+}
+
+type DataQualityRuleType string
+
+const (
+	DataQualityRuleType_NotNull       DataQualityRuleType = "not_null"
+	DataQualityRuleType_Unique        DataQualityRuleType = "unique"
+	DataQualityRuleType_Regex         DataQualityRuleType = "regex"
+	DataQualityRuleType_Email         DataQualityRuleType = "email"
+	DataQualityRuleType_AllowedValues DataQualityRuleType = "allowed_values"
+	DataQualityRuleType_Range         DataQualityRuleType = "range"
+)
+
+type DataQualityConfiguration struct {
+	Rules          []*api.DataQualityRule `json:"rules"`
+	ErrorThreshold float64                `json:"error_threshold"`
 }
+
+type DeduplicationSurvivorship string
+
+const (
+	DeduplicationSurvivorship_Latest       DeduplicationSurvivorship = "latest"
+	DeduplicationSurvivorship_FirstSeen    DeduplicationSurvivorship = "first_seen"
+	DeduplicationSurvivorship_MostComplete DeduplicationSurvivorship = "most_complete"
+)
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+type SchemaChangeType string
+
+const (
+	SchemaChangeType_Added   SchemaChangeType = "added"
+	SchemaChangeType_Removed SchemaChangeType = "removed"
+	SchemaChangeType_Retyped SchemaChangeType = "retyped"
+)
+
+type DataTableSchemaVersion struct {
+	ID           int64 `gorm:"primaryKey"`
+	TableId      int64
+	Version      int32
+	Schema       pqtype.NullRawMessage
+	Changes      pqtype.NullRawMessage
+	IsBreaking   bool
+	DataActionId int64
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (DataTableSchemaVersion) TableName() string {
+	return "data_table_schema_version"
+}
@@ -10,6 +10,7 @@ type DestMasterSegmentMap struct {
 	MasterSegmentId int64
 	DestinationId   int64
 	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
 	CreatedAt       time.Time `gorm:"autoCreateTime"`
 	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
 }
@@ -6,12 +6,13 @@ import (
 )
 
 type DestSegmentMap struct {
-	ID             int64 `gorm:"primaryKey"`
-	SegmentId      int64
-	DestinationId  int64
-	MappingOptions pqtype.NullRawMessage
-	CreatedAt      time.Time `gorm:"autoCreateTime"`
-	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+	ID              int64 `gorm:"primaryKey"`
+	SegmentId       int64
+	DestinationId   int64
+	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
 }
 
 func (DestSegmentMap) TableName() string {
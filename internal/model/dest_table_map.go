@@ -6,12 +6,13 @@ import (
 )
 
 type DestTableMap struct {
-	ID             int64 `gorm:"primaryKey"`
-	TableId        int64
-	DestinationId  int64
-	CreatedAt      time.Time `gorm:"autoCreateTime"`
-	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
-	MappingOptions pqtype.NullRawMessage
+	ID              int64 `gorm:"primaryKey"`
+	TableId         int64
+	DestinationId   int64
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
 }
 
 func (DestTableMap) TableName() string {
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ErasedObjectType string
+
+const (
+	ErasedObjectType_DataTable           ErasedObjectType = "data_table"
+	ErasedObjectType_Quarantine          ErasedObjectType = "quarantine"
+	ErasedObjectType_QualityReport       ErasedObjectType = "quality_report"
+	ErasedObjectType_DeduplicationReport ErasedObjectType = "deduplication_report"
+	ErasedObjectType_MasterSegment       ErasedObjectType = "master_segment"
+	ErasedObjectType_Audience            ErasedObjectType = "audience"
+	ErasedObjectType_BehaviorTable       ErasedObjectType = "behavior_table"
+	ErasedObjectType_Segment             ErasedObjectType = "segment"
+	ErasedObjectType_SegmentSnapshot     ErasedObjectType = "segment_snapshot"
+	ErasedObjectType_PredictionResult    ErasedObjectType = "prediction_result"
+	ErasedObjectType_IdentityGraph       ErasedObjectType = "identity_graph"
+	ErasedObjectType_IdentityHistory     ErasedObjectType = "identity_history"
+	ErasedObjectType_EventBuffer         ErasedObjectType = "event_buffer"
+	ErasedObjectType_DataProfile         ErasedObjectType = "data_profile"
+	ErasedObjectType_IdentityResolution  ErasedObjectType = "identity_resolution"
+)
+
+// ErasureCertificate_MaxAttempts is the number of times a certificate is chained, a concurrent erasure of the account
+// can chain to the same previous certificate first
+const ErasureCertificate_MaxAttempts = 3
+
+// ErasureCertificate records an erasure of a person. Hash is the sha256 of PreviousHash followed by Content, every
+// certificate of an account chains to the previous one so a changed or removed certificate breaks the chain.
+type ErasureCertificate struct {
+	ID             int64 `gorm:"primaryKey"`
+	AccountUuid    uuid.UUID
+	IdentifierHash string
+	// Content is the json of an ErasureCertificateContent, kept as text so its hash can be checked again
+	Content      string
+	PreviousHash string
+	Hash         string
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (ErasureCertificate) TableName() string {
+	return "erasure_certificate"
+}
+
+type ErasureCertificateContent struct {
+	AccountUuid       string               `json:"account_uuid"`
+	IdentifierHash    string               `json:"identifier_hash"`
+	IdentifierColumns []string             `json:"identifier_columns"`
+	ErasedObjects     []ErasedObject       `json:"erased_objects"`
+	Destinations      []ErasureDestination `json:"destinations"`
+	SkippedObjects    []SkippedObject      `json:"skipped_objects,omitempty"`
+	ErasedAt          time.Time            `json:"erased_at"`
+}
+
+type ErasedObject struct {
+	ObjectType      ErasedObjectType `json:"object_type"`
+	ObjectId        int64            `json:"object_id"`
+	Name            string           `json:"name"`
+	DeletedRows     int64            `json:"deleted_rows"`
+	HistoryVacuumed bool             `json:"history_vacuumed,omitempty"`
+}
+
+// SkippedObject is a store which may hold the person and was not erased
+type SkippedObject struct {
+	ObjectType ErasedObjectType `json:"object_type"`
+	ObjectId   int64            `json:"object_id"`
+	Name       string           `json:"name"`
+	Reason     string           `json:"reason"`
+}
+
+type ErasureDestination struct {
+	DestinationId   int64               `json:"destination_id"`
+	DestinationName string              `json:"destination_name"`
+	DestinationType DataDestinationType `json:"destination_type"`
+	ObjectType      string              `json:"object_type"`
+	ObjectId        int64               `json:"object_id"`
+	ObjectName      string              `json:"object_name"`
+}
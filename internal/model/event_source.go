@@ -0,0 +1,70 @@
+package model
+
+import (
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventType_Track    EventType = "track"
+	EventType_Identify EventType = "identify"
+)
+
+type EventFieldDataType string
+
+const (
+	EventFieldDataType_String    EventFieldDataType = "string"
+	EventFieldDataType_Int       EventFieldDataType = "int"
+	EventFieldDataType_Float     EventFieldDataType = "float"
+	EventFieldDataType_Bool      EventFieldDataType = "bool"
+	EventFieldDataType_Timestamp EventFieldDataType = "timestamp"
+)
+
+// Columns every event table has, the declared properties follow them
+const (
+	EventColumn_MessageId   = "message_id"
+	EventColumn_Type        = "type"
+	EventColumn_Event       = "event"
+	EventColumn_UserId      = "user_id"
+	EventColumn_AnonymousId = "anonymous_id"
+	EventColumn_Timestamp   = "timestamp"
+	EventColumn_ReceivedAt  = "received_at"
+	// EventColumn_Traits keeps the traits of identify events as a json string
+	EventColumn_Traits = "traits"
+)
+
+type EventSource struct {
+	ID             int64 `gorm:"primaryKey"`
+	Name           string
+	WriteKey       string
+	TableId        int64
+	Configurations pqtype.NullRawMessage
+	AccountUuid    uuid.UUID
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+func (EventSource) TableName() string {
+	return "event_source"
+}
+
+type EventSourceConfiguration struct {
+	Properties []*api.EventSchemaField `json:"properties"`
+	Traits     []*api.EventSchemaField `json:"traits"`
+}
+
+// EventBuffer keeps a validated event, keyed by column of the event table, until it is flushed
+type EventBuffer struct {
+	ID            int64 `gorm:"primaryKey"`
+	EventSourceId int64
+	Record        pqtype.NullRawMessage
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (EventBuffer) TableName() string {
+	return "event_buffer"
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+const (
+	Funnel_MaxSteps         = 10
+	Funnel_BreakdownMaxSize = 20
+)
+
+// Funnel is an ordered list of events of the behavior tables of a master segment, a profile reaches a step with
+// its first matching event after the step before it, within the max interval of the step
+type Funnel struct {
+	ID              int64 `gorm:"primaryKey"`
+	AccountUuid     uuid.UUID
+	MasterSegmentId int64
+	Name            string
+	Description     string
+	// Steps are the api.FunnelStep of the funnel in order
+	Steps     pqtype.NullRawMessage
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (Funnel) TableName() string {
+	return "funnel"
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+type IdentityResolutionStatus string
+
+const (
+	IdentityResolutionStatus_DRAFT      IdentityResolutionStatus = "DRAFT"
+	IdentityResolutionStatus_UP_TO_DATE IdentityResolutionStatus = "UP_TO_DATE"
+	IdentityResolutionStatus_FAILED     IdentityResolutionStatus = "FAILED"
+)
+
+type IdentityMatchRuleType string
+
+const (
+	IdentityMatchRuleType_ExactEmail       IdentityMatchRuleType = "exact_email"
+	IdentityMatchRuleType_NormalizedPhone  IdentityMatchRuleType = "normalized_phone"
+	IdentityMatchRuleType_CustomerId       IdentityMatchRuleType = "customer_id"
+	IdentityMatchRuleType_FuzzyNameAddress IdentityMatchRuleType = "fuzzy_name_address"
+)
+
+const (
+	// IdentityResolution_UnifiedProfileIdColumn is the key column of the output table
+	IdentityResolution_UnifiedProfileIdColumn = "unified_profile_id"
+	IdentityResolution_DefaultFuzzyThreshold  = 0.85
+)
+
+type IdentityResolution struct {
+	ID             int64 `gorm:"primaryKey"`
+	Name           string
+	Description    string
+	Configurations pqtype.NullRawMessage
+	OutputTableId  int64
+	Status         IdentityResolutionStatus
+	AccountUuid    uuid.UUID
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+func (IdentityResolution) TableName() string {
+	return "identity_resolution"
+}
+
+type IdentityResolutionConfiguration struct {
+	Sources []*api.IdentitySource    `json:"sources"`
+	Rules   []*api.IdentityMatchRule `json:"rules"`
+}
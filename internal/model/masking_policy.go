@@ -0,0 +1,39 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type MaskingMethod string
+
+const (
+	MaskingMethod_None    MaskingMethod = "none"
+	MaskingMethod_Full    MaskingMethod = "full"
+	MaskingMethod_Partial MaskingMethod = "partial"
+	MaskingMethod_Hashed  MaskingMethod = "hashed"
+	MaskingMethod_Null    MaskingMethod = "null"
+)
+
+const (
+	Role_Admin = "admin"
+	Role_User  = "user"
+)
+
+// MaskingMethod_Default applies to a tagged column read by a role other than admin without a policy for its tag
+const MaskingMethod_Default = MaskingMethod_Partial
+
+// MaskingPolicy is the masking of the columns with a tag for a role of the account
+type MaskingPolicy struct {
+	ID          int64 `gorm:"primaryKey"`
+	AccountUuid uuid.UUID
+	Role        string
+	Tag         PiiTag
+	Method      MaskingMethod
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (MaskingPolicy) TableName() string {
+	return "masking_policy"
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 	"time"
 )
 
@@ -15,13 +16,14 @@ const (
 )
 
 type MasterSegment struct {
-	ID          int64 `gorm:"primaryKey"`
-	Description string
-	Name        string
-	AccountUuid uuid.UUID
-	Status      MasterSegmentStatus
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	ID           int64 `gorm:"primaryKey"`
+	Description  string
+	Name         string
+	AccountUuid  uuid.UUID
+	Status       MasterSegmentStatus
+	SchemaIssues pqtype.NullRawMessage
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 }
 
 func (MasterSegment) TableName() string {
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+const (
+	ProfileSearch_DefaultLimit = 20
+	ProfileSearch_MaxLimit     = 100
+	// ProfileSearch_MaxValueLength is the length of the values kept in the index, longer values are cut
+	ProfileSearch_MaxValueLength = 256
+)
+
+// ProfileSearchIndex is one value of a searchable column of a profile of the audience of a master segment
+type ProfileSearchIndex struct {
+	ID              int64 `gorm:"primaryKey"`
+	MasterSegmentId int64
+	CdpSystemUuid   string
+	ColumnName      string
+	Value           string
+	NormalizedValue string
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+func (ProfileSearchIndex) TableName() string {
+	return "profile_search_index"
+}
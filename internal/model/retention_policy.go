@@ -0,0 +1,42 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type RetentionObjectType string
+
+const (
+	RetentionObjectType_DataTable     RetentionObjectType = "data_table"
+	RetentionObjectType_BehaviorTable RetentionObjectType = "behavior_table"
+)
+
+const (
+	// RetentionPolicy_Schedule is the cron spec of the maintenance job applying the policies
+	RetentionPolicy_Schedule = "0 3 * * *"
+	// RetentionPolicy_ClickstreamMaxAgeDays is the legal cap on the age of clickstream rows
+	RetentionPolicy_ClickstreamMaxAgeDays = 730
+	// RetentionPolicy_MinVacuumRetainHours is the delta safety interval, vacuum never removes files of more recent versions
+	RetentionPolicy_MinVacuumRetainHours = 168
+)
+
+// RetentionPolicy bounds the rows and versions kept by a data table or behavior table.
+// Clickstream objects, the event source tables and the behavior tables built from them, always keep a policy capped by RetentionPolicy_ClickstreamMaxAgeDays
+type RetentionPolicy struct {
+	ID              int64 `gorm:"primaryKey"`
+	AccountUuid     uuid.UUID
+	ObjectType      RetentionObjectType
+	ObjectId        int64
+	TimestampColumn string
+	MaxAgeDays      int32
+	MaxVersions     int32
+	Clickstream     bool
+	DataActionId    int64
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+}
+
+func (RetentionPolicy) TableName() string {
+	return "retention_policy"
+}
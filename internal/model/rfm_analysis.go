@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+const (
+	RFMAnalysis_DefaultBins            = 5
+	RFMAnalysis_DefaultAttributePrefix = "rfm"
+
+	// RFMAnalysis_RunTimeout bounds the scoring of an analysis in the backend, a PROCESSING analysis not updated for longer
+	// stopped with the backend and can be created again
+	RFMAnalysis_RunTimeout = 6 * time.Hour
+)
+
+// RFMSegmentLabel is the standard segment of a profile by its recency and frequency scores, and its monetary score for the best customers.
+// The labels do not overlap, a profile with transactions has exactly one
+type RFMSegmentLabel string
+
+const (
+	RFMSegmentLabel_Champions          RFMSegmentLabel = "Champions"
+	RFMSegmentLabel_CannotLoseThem     RFMSegmentLabel = "Cannot Lose Them"
+	RFMSegmentLabel_LoyalCustomers     RFMSegmentLabel = "Loyal Customers"
+	RFMSegmentLabel_AtRisk             RFMSegmentLabel = "At Risk"
+	RFMSegmentLabel_NewCustomers       RFMSegmentLabel = "New Customers"
+	RFMSegmentLabel_PotentialLoyalists RFMSegmentLabel = "Potential Loyalists"
+	RFMSegmentLabel_NeedAttention      RFMSegmentLabel = "Need Attention"
+	RFMSegmentLabel_Hibernating        RFMSegmentLabel = "Hibernating"
+	RFMSegmentLabel_Lost               RFMSegmentLabel = "Lost"
+)
+
+var RFMSegmentLabels = []RFMSegmentLabel{
+	RFMSegmentLabel_Champions,
+	RFMSegmentLabel_CannotLoseThem,
+	RFMSegmentLabel_LoyalCustomers,
+	RFMSegmentLabel_AtRisk,
+	RFMSegmentLabel_NewCustomers,
+	RFMSegmentLabel_PotentialLoyalists,
+	RFMSegmentLabel_NeedAttention,
+	RFMSegmentLabel_Hibernating,
+	RFMSegmentLabel_Lost,
+}
+
+type RFMSegment struct {
+	Label     RFMSegmentLabel `json:"label"`
+	SegmentId int64           `json:"segment_id"`
+}
+
+// RFMAnalysis scores the profiles of a master segment by the transactions of one of its behavior tables,
+// the scores are audience attributes computed again after every build of the master segment
+type RFMAnalysis struct {
+	ID              int64 `gorm:"primaryKey"`
+	AccountUuid     uuid.UUID
+	MasterSegmentId int64
+	Name            string
+	BehaviorTableId int64
+	CustomerKey     string
+	DateColumn      string
+	AmountColumn    string
+	Bins            int32
+	AttributePrefix string
+	DataActionId    int64
+	// Segments maps the labels to the standard segments created with the analysis
+	Segments  pqtype.NullRawMessage
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (RFMAnalysis) TableName() string {
+	return "rfm_analysis"
+}
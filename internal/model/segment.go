@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"github.com/APCS20-Thesis/Backend/api"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
@@ -12,21 +13,35 @@ type Segment struct {
 	MasterSegmentId int64
 	Condition       pqtype.NullRawMessage
 	SqlCondition    string
-	Description     string
-	Name            string
-	AccountUuid     uuid.UUID
-	Status          SegmentStatus
-	CreatedAt       time.Time `gorm:"autoCreateTime"`
-	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+	// SequenceSqlCondition is compiled from the sequence conditions, it reads the behavior tables and is never returned to the user
+	SequenceSqlCondition string
+	Description          string
+	Name                 string
+	AccountUuid          uuid.UUID
+	Status               SegmentStatus
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
 }
 
 func (Segment) TableName() string {
 	return "segment"
 }
 
+// BuildSqlCondition selects the audience rows of the segment, the builds and the exports filter the audience with it
+func (s Segment) BuildSqlCondition() string {
+	if s.SequenceSqlCondition == "" {
+		return s.SqlCondition
+	}
+	if s.SqlCondition == "" {
+		return s.SequenceSqlCondition
+	}
+	return fmt.Sprintf("(%s) AND %s", s.SqlCondition, s.SequenceSqlCondition)
+}
+
 type SegmentBuildConditions struct {
 	AudienceCondition  *api.Rule                `json:"audience_condition"`
 	BehaviorConditions []*api.BehaviorCondition `json:"behavior_conditions"`
+	SequenceConditions []*api.SequenceCondition `json:"sequence_conditions"`
 }
 
 type SegmentStatus string
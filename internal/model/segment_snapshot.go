@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type MembershipChange string
+
+const (
+	MembershipChange_Entered MembershipChange = "entered"
+	MembershipChange_Exited  MembershipChange = "exited"
+)
+
+// SegmentSnapshotColumn_DataActionRunId partitions the membership delta table of a segment by the build it was taken after
+const SegmentSnapshotColumn_DataActionRunId = "data_action_run_id"
+
+// SegmentSnapshot_KeptMembershipChanges is the number of latest snapshots whose membership changes can be listed,
+// the members of older snapshots are deleted from the snapshot delta table, their records are kept for the size history
+const SegmentSnapshot_KeptMembershipChanges = 30
+
+// SegmentSnapshot is the membership of a segment after one of its builds, the members are kept in the segment snapshot delta table
+type SegmentSnapshot struct {
+	ID              int64 `gorm:"primaryKey"`
+	AccountUuid     uuid.UUID
+	SegmentId       int64
+	DataActionRunId int64
+	Size            int64
+	EnteredCount    int64
+	ExitedCount     int64
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+}
+
+func (SegmentSnapshot) TableName() string {
+	return "segment_snapshot"
+}
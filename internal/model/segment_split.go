@@ -0,0 +1,46 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"time"
+)
+
+const (
+	SegmentSplit_MaxArms = 10
+	// SegmentSplit_SignificanceLevel is the p-value under which a difference between arms is significant
+	SegmentSplit_SignificanceLevel = 0.05
+)
+
+type SplitMetric string
+
+const (
+	// SplitMetric_Conversion is the share of the members of the arm with an outcome event, compared with a two-proportion z-test
+	SplitMetric_Conversion SplitMetric = "conversion"
+	// SplitMetric_Mean is the mean per member of the sum of the outcome value, members without events count 0, compared with a Welch test
+	SplitMetric_Mean SplitMetric = "mean"
+)
+
+// SegmentSplit assigns the members of a segment to arms by a stable hash of their profile id and the salt,
+// each arm is a child segment keeping its share of the members across builds
+type SegmentSplit struct {
+	ID          int64 `gorm:"primaryKey"`
+	AccountUuid uuid.UUID
+	SegmentId   int64
+	Name        string
+	Salt        string
+	// Arms are the SegmentSplitArm of the split in the order of their hash ranges
+	Arms      pqtype.NullRawMessage
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (SegmentSplit) TableName() string {
+	return "segment_split"
+}
+
+type SegmentSplitArm struct {
+	Name       string `json:"name"`
+	Percentage int32  `json:"percentage"`
+	SegmentId  int64  `json:"segment_id"`
+}
@@ -13,6 +13,8 @@ type SourceTableMap struct {
 	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
 	MappingOptions  pqtype.NullRawMessage
 	SourceTableName string
+	// DedupeConfiguration is the deduplication applied to each import of the mapping
+	DedupeConfiguration pqtype.NullRawMessage
 }
 
 func (SourceTableMap) TableName() string {
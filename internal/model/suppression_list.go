@@ -0,0 +1,56 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Channel string
+
+const (
+	Channel_Email Channel = "email"
+	Channel_Sms   Channel = "sms"
+	Channel_Push  Channel = "push"
+	Channel_Ads   Channel = "ads"
+)
+
+// SuppressionList_MaxEntries caps the uploaded entries of a list, larger lists are synced from a data table
+const SuppressionList_MaxEntries = 10000
+
+// columns of the suppression delta table of an account, it mirrors the uploaded entries so the exports read them at every run
+const (
+	SuppressionColumn_SuppressionListId = "suppression_list_id"
+	SuppressionColumn_Channel           = "channel"
+	SuppressionColumn_IdentifierColumn  = "identifier_column"
+	SuppressionColumn_Value             = "value"
+)
+
+// SuppressionList holds identifiers never exported to the destinations of its channel, every channel when Channel is empty.
+// A list with a SourceDataTableId reads its entries from the data table at every export instead of its uploaded entries
+type SuppressionList struct {
+	ID                int64 `gorm:"primaryKey"`
+	AccountUuid       uuid.UUID
+	Name              string
+	Channel           Channel
+	IdentifierColumn  string
+	SourceDataTableId int64
+	SourceColumn      string
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (SuppressionList) TableName() string {
+	return "suppression_list"
+}
+
+// SuppressionEntry values are trimmed and lower-cased, they are compared with the normalized identifiers of the exported rows
+type SuppressionEntry struct {
+	ID                int64 `gorm:"primaryKey"`
+	SuppressionListId int64
+	Value             string
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+}
+
+func (SuppressionEntry) TableName() string {
+	return "suppression_entry"
+}
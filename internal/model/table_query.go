@@ -0,0 +1,36 @@
+package model
+
+type RuleOperator string
+
+const (
+	RuleOperator_Equal            RuleOperator = "="
+	RuleOperator_NotEqual         RuleOperator = "!="
+	RuleOperator_Less             RuleOperator = "<"
+	RuleOperator_Greater          RuleOperator = ">"
+	RuleOperator_LessOrEqual      RuleOperator = "<="
+	RuleOperator_GreaterOrEqual   RuleOperator = ">="
+	RuleOperator_Contains         RuleOperator = "contains"
+	RuleOperator_DoesNotContain   RuleOperator = "doesNotContain"
+	RuleOperator_BeginsWith       RuleOperator = "beginsWith"
+	RuleOperator_DoesNotBeginWith RuleOperator = "doesNotBeginWith"
+	RuleOperator_EndsWith         RuleOperator = "endsWith"
+	RuleOperator_DoesNotEndWith   RuleOperator = "doesNotEndWith"
+	RuleOperator_Null             RuleOperator = "null"
+	RuleOperator_NotNull          RuleOperator = "notNull"
+	RuleOperator_In               RuleOperator = "in"
+	RuleOperator_NotIn            RuleOperator = "notIn"
+	RuleOperator_Between          RuleOperator = "between"
+	RuleOperator_NotBetween       RuleOperator = "notBetween"
+)
+
+type RuleCombinator string
+
+const (
+	RuleCombinator_And RuleCombinator = "and"
+	RuleCombinator_Or  RuleCombinator = "or"
+)
+
+const (
+	TableQuery_DefaultLimit = 100
+	TableQuery_MaxLimit     = 1000
+)
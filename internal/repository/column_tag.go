@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"gorm.io/gorm"
+)
+
+type ColumnTagRepository interface {
+	ReplaceColumnTags(ctx context.Context, dataTableId int64, tags []model.ColumnTag) error
+	ListColumnTags(ctx context.Context, dataTableIds []int64) ([]model.ColumnTag, error)
+}
+
+type columnTagRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewColumnTagRepository(db *gorm.DB) ColumnTagRepository {
+	return &columnTagRepo{
+		DB:        db,
+		TableName: model.ColumnTag{}.TableName(),
+	}
+}
+
+// ReplaceColumnTags sets the tags of the data table, its columns missing from tags are untagged
+func (r *columnTagRepo) ReplaceColumnTags(ctx context.Context, dataTableId int64, tags []model.ColumnTag) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(r.TableName).Where("data_table_id = ?", dataTableId).Delete(&model.ColumnTag{}).Error
+		if err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+		return tx.Table(r.TableName).Create(&tags).Error
+	})
+}
+
+func (r *columnTagRepo) ListColumnTags(ctx context.Context, dataTableIds []int64) ([]model.ColumnTag, error) {
+	var tags []model.ColumnTag
+	if len(dataTableIds) == 0 {
+		return tags, nil
+	}
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("data_table_id IN ?", dataTableIds).
+		Order("data_table_id, column_name").
+		Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
@@ -122,6 +122,7 @@ type GetListDataActionsParams struct {
 	PageSize    int
 	TargetTable model.DataActionTargetTable
 	ObjectId    int64
+	ObjectIds   []int64
 }
 
 type GetListDataActionsResult struct {
@@ -151,6 +152,9 @@ func (r *dataActionRepo) GetListDataActions(ctx context.Context, params *GetList
 	if params.ObjectId != 0 {
 		query.Where("object_id = ?", params.ObjectId)
 	}
+	if len(params.ObjectIds) > 0 {
+		query.Where("object_id IN ?", params.ObjectIds)
+	}
 
 	emptyUuid, _ := uuid.Parse("")
 	if params.AccountUuid != emptyUuid {
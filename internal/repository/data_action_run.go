@@ -11,6 +11,10 @@ import (
 type DataActionRunRepository interface {
 	CreateDataActionRun(ctx context.Context, params *CreateDataActionRunParams) (*model.DataActionRun, error)
 	UpdateDataActionRunStatus(ctx context.Context, id int64, status model.DataActionRunStatus) error
+	UpdateDataActionRunQualityCounts(ctx context.Context, id int64, passedCount int64, failedCount int64) error
+	GetLatestDataActionRun(ctx context.Context, actionId int64) (*model.DataActionRun, error)
+	UpdateDataActionRunRowCounts(ctx context.Context, id int64, rowCountBefore int64, rowCountAfter int64) error
+	UpdateDataActionRunError(ctx context.Context, id int64, message string) error
 	GetListDataActionRuns(ctx context.Context, params *GetListDataActionRunsParams) (*GetListDataActionRunsResult, error)
 	GetListDataActionRunsWithExtraInfo(ctx context.Context, params *GetListDataActionRunsWithExtraInfoParams) ([]DataActionRunWithExtraInfo, error)
 	GetTotalRunsPerDay(ctx context.Context, params *GetTotalRunsPerDayParams) ([]TotalRunsPerDay, error)
@@ -62,6 +66,37 @@ func (r *dataActionRunRepo) UpdateDataActionRunStatus(ctx context.Context, id in
 	return err
 }
 
+func (r *dataActionRunRepo) UpdateDataActionRunQualityCounts(ctx context.Context, id int64, passedCount int64, failedCount int64) error {
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).
+		Updates(map[string]interface{}{"passed_count": passedCount, "failed_count": failedCount}).Error
+
+	return err
+}
+
+func (r *dataActionRunRepo) UpdateDataActionRunRowCounts(ctx context.Context, id int64, rowCountBefore int64, rowCountAfter int64) error {
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).
+		Updates(map[string]interface{}{"row_count_before": rowCountBefore, "row_count_after": rowCountAfter}).Error
+
+	return err
+}
+
+func (r *dataActionRunRepo) UpdateDataActionRunError(ctx context.Context, id int64, message string) error {
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).
+		Updates(map[string]interface{}{"status": model.DataActionRunStatus_Failed, "error": message}).Error
+
+	return err
+}
+
+func (r *dataActionRunRepo) GetLatestDataActionRun(ctx context.Context, actionId int64) (*model.DataActionRun, error) {
+	var dataActionRun model.DataActionRun
+	err := r.WithContext(ctx).Table(r.TableName).Where("action_id = ?", actionId).Order("run_id desc, id desc").First(&dataActionRun).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataActionRun, nil
+}
+
 type GetListDataActionRunsParams struct {
 	Ids         []int64
 	ActionTypes []string
@@ -101,6 +136,10 @@ func (r *dataActionRunRepo) GetListDataActionRuns(ctx context.Context, params *G
 			"data_action_run.dag_run_id AS dag_run_id, " +
 			"data_action_run.status AS status, " +
 			"data_action_run.error AS error, " +
+			"data_action_run.passed_count AS passed_count, " +
+			"data_action_run.failed_count AS failed_count, " +
+			"data_action_run.row_count_before AS row_count_before, " +
+			"data_action_run.row_count_after AS row_count_after, " +
 			"data_action_run.account_uuid AS account_uuid, " +
 			"data_action_run.created_at AS created_at, " +
 			"data_action_run.updated_at AS updated_at, " +
@@ -123,6 +162,7 @@ func (r *dataActionRunRepo) GetListDataActionRuns(ctx context.Context, params *G
 
 type GetListDataActionRunsWithExtraInfoParams struct {
 	Ids         []int64
+	DagRunIds   []string
 	Statuses    []model.DataActionRunStatus
 	AccountUuid uuid.UUID
 }
@@ -134,11 +174,16 @@ type DataActionRunWithExtraInfo struct {
 	DagRunId    string                    `gorm:"column:dag_run_id"`
 	Status      model.DataActionRunStatus `gorm:"column:status"`
 	Error       string                    `gorm:"column:error"`
+	PassedCount int64                     `gorm:"column:passed_count"`
+	FailedCount int64                     `gorm:"column:failed_count"`
 	AccountUuid uuid.UUID                 `gorm:"column:account_uuid"`
 	CreatedAt   time.Time                 `gorm:"column:created_at"`
 	UpdatedAt   time.Time                 `gorm:"column:updated_at"`
 	TargetTable string                    `gorm:"column:target_table"`
 	ObjectId    int64                     `gorm:"column:object_id"`
+	// row counts of a deduplication
+	RowCountBefore int64 `gorm:"column:row_count_before"`
+	RowCountAfter  int64 `gorm:"column:row_count_after"`
 	// extra
 	DagId      string           `gorm:"column:dag_id"`
 	ActionType model.ActionType `gorm:"column:action_type"`
@@ -149,6 +194,9 @@ func (r *dataActionRunRepo) GetListDataActionRunsWithExtraInfo(ctx context.Conte
 	if len(params.Ids) > 0 {
 		query.Where("data_action_run.id IN ?", params.Ids)
 	}
+	if len(params.DagRunIds) > 0 {
+		query.Where("data_action_run.dag_run_id IN ?", params.DagRunIds)
+	}
 	if len(params.Statuses) > 0 {
 		query.Where("data_action_run.status IN ?", params.Statuses)
 	}
@@ -33,6 +33,7 @@ type CreateDataDestinationParams struct {
 	Type          model.DataDestinationType
 	Configuration pqtype.NullRawMessage
 	ConnectionId  int64
+	Channel       string
 }
 
 func (r *dataDestinationRepo) CreateDataDestination(ctx context.Context, params *CreateDataDestinationParams) (*model.DataDestination, error) {
@@ -42,6 +43,7 @@ func (r *dataDestinationRepo) CreateDataDestination(ctx context.Context, params
 		Configurations: params.Configuration,
 		AccountUuid:    params.AccountUuid,
 		ConnectionId:   params.ConnectionId,
+		Channel:        params.Channel,
 	}
 
 	var createErr error
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/sqlc-dev/pqtype"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DataProfileRepository interface {
+	SaveDataProfile(ctx context.Context, params *SaveDataProfileParams) (*model.DataProfile, error)
+	GetDataProfile(ctx context.Context, objectType model.DataProfileObjectType, objectId int64) (*model.DataProfile, error)
+	ListDataProfiles(ctx context.Context, objectType model.DataProfileObjectType, objectIds []int64) ([]model.DataProfile, error)
+}
+
+type dataProfileRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewDataProfileRepository(db *gorm.DB) DataProfileRepository {
+	return &dataProfileRepo{
+		DB:        db,
+		TableName: model.DataProfile{}.TableName(),
+	}
+}
+
+type SaveDataProfileParams struct {
+	ObjectType model.DataProfileObjectType
+	ObjectId   int64
+	RowCount   int64
+	Columns    pqtype.NullRawMessage
+}
+
+// SaveDataProfile replaces the profile of the object
+func (r *dataProfileRepo) SaveDataProfile(ctx context.Context, params *SaveDataProfileParams) (*model.DataProfile, error) {
+	dataProfile := model.DataProfile{
+		ObjectType: params.ObjectType,
+		ObjectId:   params.ObjectId,
+		RowCount:   params.RowCount,
+		Columns:    params.Columns,
+	}
+	err := r.WithContext(ctx).Table(r.TableName).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "object_type"}, {Name: "object_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"row_count", "columns", "updated_at"}),
+	}).Create(&dataProfile).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataProfile, nil
+}
+
+// GetDataProfile returns nil when the object has not been profiled yet
+func (r *dataProfileRepo) GetDataProfile(ctx context.Context, objectType model.DataProfileObjectType, objectId int64) (*model.DataProfile, error) {
+	var dataProfile model.DataProfile
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("object_type = ? AND object_id = ?", objectType, objectId).
+		First(&dataProfile).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &dataProfile, nil
+}
+
+func (r *dataProfileRepo) ListDataProfiles(ctx context.Context, objectType model.DataProfileObjectType, objectIds []int64) ([]model.DataProfile, error) {
+	var dataProfiles []model.DataProfile
+	if len(objectIds) == 0 {
+		return dataProfiles, nil
+	}
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("object_type = ? AND object_id IN ?", objectType, objectIds).
+		Find(&dataProfiles).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return dataProfiles, nil
+}
@@ -41,10 +41,11 @@ type CreateDataTableParams struct {
 
 func (r *dataTableRepo) CreateDataTable(ctx context.Context, params *CreateDataTableParams) (*model.DataTable, error) {
 	dataTable := &model.DataTable{
-		Name:        params.Name,
-		AccountUuid: params.AccountUuid,
-		Schema:      params.Schema,
-		Status:      model.DataTableStatus_DRAFT,
+		Name:         params.Name,
+		AccountUuid:  params.AccountUuid,
+		Schema:       params.Schema,
+		Status:       model.DataTableStatus_DRAFT,
+		SchemaPolicy: model.DataTableSchemaPolicy_AllowAdditive,
 	}
 
 	var createErr error
@@ -71,19 +72,23 @@ func (r *dataTableRepo) GetDataTable(ctx context.Context, id int64) (*model.Data
 }
 
 type UpdateDataTableParams struct {
-	Tx     *gorm.DB
-	ID     int64
-	Name   string
-	Schema pqtype.NullRawMessage
-	Status model.DataTableStatus
+	Tx                   *gorm.DB
+	ID                   int64
+	Name                 string
+	Schema               pqtype.NullRawMessage
+	Status               model.DataTableStatus
+	QualityConfiguration pqtype.NullRawMessage
+	SchemaPolicy         model.DataTableSchemaPolicy
 }
 
 func (r *dataTableRepo) UpdateDataTable(ctx context.Context, params *UpdateDataTableParams) (*model.DataTable, error) {
 	dataTable := &model.DataTable{
-		ID:     params.ID,
-		Name:   params.Name,
-		Schema: params.Schema,
-		Status: params.Status,
+		ID:                   params.ID,
+		Name:                 params.Name,
+		Schema:               params.Schema,
+		Status:               params.Status,
+		QualityConfiguration: params.QualityConfiguration,
+		SchemaPolicy:         params.SchemaPolicy,
 	}
 	var updateErr error
 	if params.Tx != nil {
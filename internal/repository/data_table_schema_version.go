@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/sqlc-dev/pqtype"
+	"gorm.io/gorm"
+)
+
+type DataTableSchemaVersionRepository interface {
+	CreateDataTableSchemaVersion(ctx context.Context, params *CreateDataTableSchemaVersionParams) (*model.DataTableSchemaVersion, error)
+	GetLatestDataTableSchemaVersion(ctx context.Context, tableId int64) (*model.DataTableSchemaVersion, error)
+	ListDataTableSchemaVersions(ctx context.Context, params *ListDataTableSchemaVersionsParams) (*ListDataTableSchemaVersionsResult, error)
+}
+
+type dataTableSchemaVersionRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewDataTableSchemaVersionRepository(db *gorm.DB) DataTableSchemaVersionRepository {
+	return &dataTableSchemaVersionRepo{
+		DB:        db,
+		TableName: model.DataTableSchemaVersion{}.TableName(),
+	}
+}
+
+type CreateDataTableSchemaVersionParams struct {
+	Tx           *gorm.DB
+	TableId      int64
+	Version      int32
+	Schema       pqtype.NullRawMessage
+	Changes      pqtype.NullRawMessage
+	IsBreaking   bool
+	DataActionId int64
+}
+
+func (r *dataTableSchemaVersionRepo) CreateDataTableSchemaVersion(ctx context.Context, params *CreateDataTableSchemaVersionParams) (*model.DataTableSchemaVersion, error) {
+	schemaVersion := model.DataTableSchemaVersion{
+		TableId:      params.TableId,
+		Version:      params.Version,
+		Schema:       params.Schema,
+		Changes:      params.Changes,
+		IsBreaking:   params.IsBreaking,
+		DataActionId: params.DataActionId,
+	}
+
+	var createErr error
+	if params.Tx != nil {
+		createErr = params.Tx.WithContext(ctx).Table(r.TableName).Create(&schemaVersion).Error
+	} else {
+		createErr = r.WithContext(ctx).Table(r.TableName).Create(&schemaVersion).Error
+	}
+	if createErr != nil {
+		return nil, createErr
+	}
+
+	return &schemaVersion, nil
+}
+
+// GetLatestDataTableSchemaVersion returns nil when no version of the table is recorded yet
+func (r *dataTableSchemaVersionRepo) GetLatestDataTableSchemaVersion(ctx context.Context, tableId int64) (*model.DataTableSchemaVersion, error) {
+	var schemaVersion model.DataTableSchemaVersion
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("table_id = ?", tableId).
+		Order("version desc").
+		First(&schemaVersion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &schemaVersion, nil
+}
+
+type ListDataTableSchemaVersionsParams struct {
+	TableId  int64
+	Page     int
+	PageSize int
+}
+
+type ListDataTableSchemaVersionsResult struct {
+	SchemaVersions []model.DataTableSchemaVersion
+	Count          int64
+}
+
+func (r *dataTableSchemaVersionRepo) ListDataTableSchemaVersions(ctx context.Context, params *ListDataTableSchemaVersionsParams) (*ListDataTableSchemaVersionsResult, error) {
+	var (
+		schemaVersions []model.DataTableSchemaVersion
+		count          int64
+	)
+
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("table_id = ?", params.TableId).
+		Order("version desc").
+		Count(&count).
+		Scopes(Paginate(params.Page, params.PageSize)).
+		Find(&schemaVersions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListDataTableSchemaVersionsResult{
+		SchemaVersions: schemaVersions,
+		Count:          count,
+	}, nil
+}
@@ -26,6 +26,7 @@ type CreateDestinationMasterSegmentMapParams struct {
 	MasterSegmentId int64
 	DestinationId   int64
 	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
 }
 
 func (r *destMasterSegmentMapRepo) CreateDestinationMasterSegmentMap(ctx context.Context, params *CreateDestinationMasterSegmentMapParams) (*model.DestMasterSegmentMap, error) {
@@ -33,6 +34,7 @@ func (r *destMasterSegmentMapRepo) CreateDestinationMasterSegmentMap(ctx context
 		MasterSegmentId: params.MasterSegmentId,
 		DestinationId:   params.DestinationId,
 		MappingOptions:  params.MappingOptions,
+		SuppressedCount: params.SuppressedCount,
 	}
 
 	var createErr error
@@ -49,8 +51,9 @@ func (r *destMasterSegmentMapRepo) CreateDestinationMasterSegmentMap(ctx context
 }
 
 type ListDestinationMasterSegmentMapsParams struct {
-	DestinationId int64
-	Ids           []int64
+	DestinationId    int64
+	Ids              []int64
+	MasterSegmentIds []int64
 }
 
 type DestinationMasterSegmentMapItem struct {
@@ -60,7 +63,9 @@ type DestinationMasterSegmentMapItem struct {
 	MasterSegmentId   int64
 	MasterSegmentName string
 	MappingOptions    pqtype.NullRawMessage
+	SuppressedCount   int64
 	DataActionId      int64
+	DataActionStatus  string
 }
 
 func (r *destMasterSegmentMapRepo) ListDestinationMasterSegmentMaps(ctx context.Context, params *ListDestinationMasterSegmentMapsParams) ([]DestinationMasterSegmentMapItem, error) {
@@ -74,14 +79,19 @@ func (r *destMasterSegmentMapRepo) ListDestinationMasterSegmentMaps(ctx context.
 			"data_destination.name AS destination_name, " +
 			"dest_ms_segment_map.master_segment_id AS master_segment_id, " +
 			"dest_ms_segment_map.mapping_options AS mapping_options, " +
+			"dest_ms_segment_map.suppressed_count AS suppressed_count, " +
 			"master_segment.name AS master_segment_name, " +
-			"data_action.id AS data_action_id")
+			"data_action.id AS data_action_id, " +
+			"data_action.status AS data_action_status")
 	if params.DestinationId > 0 {
 		query = query.Where("dest_ms_segment_map.destination_id = ?", params.DestinationId)
 	}
 	if len(params.Ids) > 0 {
 		query = query.Where("dest_ms_segment_map.id IN ?", params.Ids)
 	}
+	if len(params.MasterSegmentIds) > 0 {
+		query = query.Where("dest_ms_segment_map.master_segment_id IN ?", params.MasterSegmentIds)
+	}
 
 	err := query.Find(&mappings).Error
 	if err != nil {
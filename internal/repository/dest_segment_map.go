@@ -22,17 +22,19 @@ func NewDestSegmentMapRepository(db *gorm.DB) DestSegmentMapRepository {
 }
 
 type CreateDestinationSegmentMapParams struct {
-	Tx             *gorm.DB
-	SegmentId      int64
-	DestinationId  int64
-	MappingOptions pqtype.NullRawMessage
+	Tx              *gorm.DB
+	SegmentId       int64
+	DestinationId   int64
+	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
 }
 
 func (r *destSegmentMapRepo) CreateDestinationSegmentMap(ctx context.Context, params *CreateDestinationSegmentMapParams) (*model.DestSegmentMap, error) {
 	destSegmentMap := &model.DestSegmentMap{
-		SegmentId:      params.SegmentId,
-		DestinationId:  params.DestinationId,
-		MappingOptions: params.MappingOptions,
+		SegmentId:       params.SegmentId,
+		DestinationId:   params.DestinationId,
+		MappingOptions:  params.MappingOptions,
+		SuppressedCount: params.SuppressedCount,
 	}
 
 	var createErr error
@@ -51,16 +53,19 @@ func (r *destSegmentMapRepo) CreateDestinationSegmentMap(ctx context.Context, pa
 type ListDestinationSegmentMapsParams struct {
 	DestinationId int64
 	Ids           []int64
+	SegmentIds    []int64
 }
 
 type DestinationSegmentMapItem struct {
-	ID              int64 `gorm:"primaryKey"`
-	DestinationId   int64
-	DestinationName string
-	SegmentId       int64
-	SegmentName     string
-	MappingOptions  pqtype.NullRawMessage
-	DataActionId    int64
+	ID               int64 `gorm:"primaryKey"`
+	DestinationId    int64
+	DestinationName  string
+	SegmentId        int64
+	SegmentName      string
+	MappingOptions   pqtype.NullRawMessage
+	SuppressedCount  int64
+	DataActionId     int64
+	DataActionStatus string
 }
 
 func (r *destSegmentMapRepo) ListDestinationSegmentMaps(ctx context.Context, params *ListDestinationSegmentMapsParams) ([]DestinationSegmentMapItem, error) {
@@ -74,14 +79,19 @@ func (r *destSegmentMapRepo) ListDestinationSegmentMaps(ctx context.Context, par
 			"data_destination.name AS destination_name, " +
 			"dest_segment_map.segment_id AS segment_id, " +
 			"dest_segment_map.mapping_options AS mapping_options, " +
-			"segment.name AS segment_id, " +
-			"data_action.id AS data_action_id")
+			"dest_segment_map.suppressed_count AS suppressed_count, " +
+			"segment.name AS segment_name, " +
+			"data_action.id AS data_action_id, " +
+			"data_action.status AS data_action_status")
 	if params.DestinationId > 0 {
 		query = query.Where("dest_segment_map.destination_id = ?", params.DestinationId)
 	}
 	if len(params.Ids) > 0 {
 		query = query.Where("dest_segment_map.id IN ?", params.Ids)
 	}
+	if len(params.SegmentIds) > 0 {
+		query = query.Where("dest_segment_map.segment_id IN ?", params.SegmentIds)
+	}
 
 	err := query.Find(&mappings).Error
 	if err != nil {
@@ -22,17 +22,19 @@ func NewDestTableMapRepository(db *gorm.DB) DestTableMapRepository {
 }
 
 type CreateDestinationTableMapParams struct {
-	Tx             *gorm.DB
-	TableId        int64
-	DestinationId  int64
-	MappingOptions pqtype.NullRawMessage
+	Tx              *gorm.DB
+	TableId         int64
+	DestinationId   int64
+	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
 }
 
 func (r *destTableMapRepo) CreateDestinationTableMap(ctx context.Context, params *CreateDestinationTableMapParams) (*model.DestTableMap, error) {
 	destTableMap := &model.DestTableMap{
-		TableId:        params.TableId,
-		DestinationId:  params.DestinationId,
-		MappingOptions: params.MappingOptions,
+		TableId:         params.TableId,
+		DestinationId:   params.DestinationId,
+		MappingOptions:  params.MappingOptions,
+		SuppressedCount: params.SuppressedCount,
 	}
 
 	var createErr error
@@ -60,6 +62,7 @@ type DestinationTableMapItem struct {
 	TableId         int64
 	TableName       string
 	MappingOptions  pqtype.NullRawMessage
+	SuppressedCount int64
 	DataActionId    int64
 }
 
@@ -74,6 +77,7 @@ func (r *destTableMapRepo) ListDestinationTableMaps(ctx context.Context, params
 			"data_destination.name AS destination_name, " +
 			"dest_table_map.table_id AS table_id, " +
 			"dest_table_map.mapping_options AS mapping_options, " +
+			"dest_table_map.suppressed_count AS suppressed_count, " +
 			"data_table.name AS table_name, " +
 			"data_action.id AS data_action_id")
 	if params.DestinationId > 0 {
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ErasureCertificateRepository interface {
+	CreateErasureCertificate(ctx context.Context, certificate *model.ErasureCertificate) error
+	GetErasureCertificate(ctx context.Context, accountUuid uuid.UUID, id int64) (*model.ErasureCertificate, error)
+	GetLatestErasureCertificate(ctx context.Context, accountUuid uuid.UUID) (*model.ErasureCertificate, error)
+	GetPreviousErasureCertificate(ctx context.Context, accountUuid uuid.UUID, id int64) (*model.ErasureCertificate, error)
+	ListErasureCertificates(ctx context.Context, params *ListErasureCertificatesParams) (*ListErasureCertificatesResult, error)
+}
+
+type erasureCertificateRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewErasureCertificateRepository(db *gorm.DB) ErasureCertificateRepository {
+	return &erasureCertificateRepo{
+		DB:        db,
+		TableName: model.ErasureCertificate{}.TableName(),
+	}
+}
+
+func (r *erasureCertificateRepo) CreateErasureCertificate(ctx context.Context, certificate *model.ErasureCertificate) error {
+	return r.WithContext(ctx).Table(r.TableName).Create(certificate).Error
+}
+
+func (r *erasureCertificateRepo) GetErasureCertificate(ctx context.Context, accountUuid uuid.UUID, id int64) (*model.ErasureCertificate, error) {
+	var certificate model.ErasureCertificate
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("account_uuid = ? AND id = ?", accountUuid, id).
+		First(&certificate).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// GetLatestErasureCertificate returns nil when the account has no certificate
+func (r *erasureCertificateRepo) GetLatestErasureCertificate(ctx context.Context, accountUuid uuid.UUID) (*model.ErasureCertificate, error) {
+	var certificate model.ErasureCertificate
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("account_uuid = ?", accountUuid).
+		Order("id desc").
+		First(&certificate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// GetPreviousErasureCertificate returns the certificate of the account before id, nil for the first certificate
+func (r *erasureCertificateRepo) GetPreviousErasureCertificate(ctx context.Context, accountUuid uuid.UUID, id int64) (*model.ErasureCertificate, error) {
+	var certificate model.ErasureCertificate
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("account_uuid = ? AND id < ?", accountUuid, id).
+		Order("id desc").
+		First(&certificate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+type ListErasureCertificatesParams struct {
+	AccountUuid uuid.UUID
+	Page        int
+	PageSize    int
+}
+
+type ListErasureCertificatesResult struct {
+	Count        int64
+	Certificates []model.ErasureCertificate
+}
+
+func (r *erasureCertificateRepo) ListErasureCertificates(ctx context.Context, params *ListErasureCertificatesParams) (*ListErasureCertificatesResult, error) {
+	var (
+		certificates []model.ErasureCertificate
+		count        int64
+	)
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("account_uuid = ?", params.AccountUuid).
+		Count(&count).
+		Order("id desc").
+		Scopes(Paginate(params.Page, params.PageSize)).
+		Find(&certificates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListErasureCertificatesResult{
+		Count:        count,
+		Certificates: certificates,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"gorm.io/gorm"
+)
+
+type EventBufferRepository interface {
+	CreateEventBuffers(ctx context.Context, eventBuffers []model.EventBuffer) error
+	ListEventBuffers(ctx context.Context, eventSourceId int64, limit int) ([]model.EventBuffer, error)
+	DeleteEventBuffers(ctx context.Context, ids []int64) error
+	DeleteEventBuffersByValue(ctx context.Context, eventSourceId int64, columns []string, value string) (int64, error)
+	GetBufferedEventSourceIds(ctx context.Context) ([]int64, error)
+	CountEventBuffers(ctx context.Context, eventSourceIds []int64) (map[int64]int64, error)
+}
+
+type eventBufferRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewEventBufferRepository(db *gorm.DB) EventBufferRepository {
+	return &eventBufferRepo{
+		DB:        db,
+		TableName: model.EventBuffer{}.TableName(),
+	}
+}
+
+func (r *eventBufferRepo) CreateEventBuffers(ctx context.Context, eventBuffers []model.EventBuffer) error {
+	return r.WithContext(ctx).Table(r.TableName).Create(&eventBuffers).Error
+}
+
+// ListEventBuffers returns the oldest buffered events of the event source
+func (r *eventBufferRepo) ListEventBuffers(ctx context.Context, eventSourceId int64, limit int) ([]model.EventBuffer, error) {
+	var eventBuffers []model.EventBuffer
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("event_source_id = ?", eventSourceId).
+		Order("id asc").
+		Limit(limit).
+		Find(&eventBuffers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return eventBuffers, nil
+}
+
+func (r *eventBufferRepo) DeleteEventBuffers(ctx context.Context, ids []int64) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id IN ?", ids).Delete(&model.EventBuffer{}).Error
+}
+
+// DeleteEventBuffersByValue deletes the buffered events of the event source having one of columns equal to value
+func (r *eventBufferRepo) DeleteEventBuffersByValue(ctx context.Context, eventSourceId int64, columns []string, value string) (int64, error) {
+	if len(columns) == 0 {
+		return 0, nil
+	}
+	matches := r.Where("record ->> ? = ?", columns[0], value)
+	for _, column := range columns[1:] {
+		matches = matches.Or("record ->> ? = ?", column, value)
+	}
+	result := r.WithContext(ctx).Table(r.TableName).
+		Where("event_source_id = ?", eventSourceId).
+		Where(matches).
+		Delete(&model.EventBuffer{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *eventBufferRepo) GetBufferedEventSourceIds(ctx context.Context) ([]int64, error) {
+	var eventSourceIds []int64
+	err := r.WithContext(ctx).Table(r.TableName).Distinct("event_source_id").Pluck("event_source_id", &eventSourceIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return eventSourceIds, nil
+}
+
+func (r *eventBufferRepo) CountEventBuffers(ctx context.Context, eventSourceIds []int64) (map[int64]int64, error) {
+	var results []struct {
+		EventSourceId int64
+		Total         int64
+	}
+	err := r.WithContext(ctx).Table(r.TableName).
+		Select("event_source_id, count(*) as total").
+		Where("event_source_id IN ?", eventSourceIds).
+		Group("event_source_id").
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int64, len(results))
+	for _, result := range results {
+		counts[result.EventSourceId] = result.Total
+	}
+	return counts, nil
+}
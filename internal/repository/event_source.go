@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"gorm.io/gorm"
+)
+
+type EventSourceRepository interface {
+	CreateEventSource(ctx context.Context, params *CreateEventSourceParams) (*model.EventSource, error)
+	GetEventSource(ctx context.Context, id int64) (*model.EventSource, error)
+	GetEventSourceByWriteKey(ctx context.Context, writeKey string) (*model.EventSource, error)
+	ListEventSources(ctx context.Context, params *ListEventSourcesParams) (*ListEventSourcesResult, error)
+	ListEventTableIds(ctx context.Context) ([]int64, error)
+}
+
+type eventSourceRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewEventSourceRepository(db *gorm.DB) EventSourceRepository {
+	return &eventSourceRepo{
+		DB:        db,
+		TableName: model.EventSource{}.TableName(),
+	}
+}
+
+type CreateEventSourceParams struct {
+	Tx             *gorm.DB
+	Name           string
+	WriteKey       string
+	TableId        int64
+	Configurations pqtype.NullRawMessage
+	AccountUuid    uuid.UUID
+}
+
+func (r *eventSourceRepo) CreateEventSource(ctx context.Context, params *CreateEventSourceParams) (*model.EventSource, error) {
+	eventSource := model.EventSource{
+		Name:           params.Name,
+		WriteKey:       params.WriteKey,
+		TableId:        params.TableId,
+		Configurations: params.Configurations,
+		AccountUuid:    params.AccountUuid,
+	}
+
+	var createErr error
+	if params.Tx != nil {
+		createErr = params.Tx.WithContext(ctx).Table(r.TableName).Create(&eventSource).Error
+	} else {
+		createErr = r.WithContext(ctx).Table(r.TableName).Create(&eventSource).Error
+	}
+	if createErr != nil {
+		return nil, createErr
+	}
+
+	return &eventSource, nil
+}
+
+func (r *eventSourceRepo) GetEventSource(ctx context.Context, id int64) (*model.EventSource, error) {
+	var eventSource model.EventSource
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).First(&eventSource).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventSource, nil
+}
+
+func (r *eventSourceRepo) GetEventSourceByWriteKey(ctx context.Context, writeKey string) (*model.EventSource, error) {
+	var eventSource model.EventSource
+	err := r.WithContext(ctx).Table(r.TableName).Where("write_key = ?", writeKey).First(&eventSource).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventSource, nil
+}
+
+type ListEventSourcesParams struct {
+	AccountUuid uuid.UUID
+	Ids         []int64
+	Page        int
+	PageSize    int
+}
+
+type ListEventSourcesResult struct {
+	EventSources []model.EventSource
+	Count        int64
+}
+
+func (r *eventSourceRepo) ListEventSources(ctx context.Context, params *ListEventSourcesParams) (*ListEventSourcesResult, error) {
+	var (
+		eventSources []model.EventSource
+		count        int64
+	)
+
+	query := r.WithContext(ctx).Table(r.TableName)
+	if params.AccountUuid != uuid.Nil {
+		query = query.Where("account_uuid = ?", params.AccountUuid)
+	}
+	if len(params.Ids) > 0 {
+		query = query.Where("id IN ?", params.Ids)
+	}
+	err := query.Order("updated_at desc").Count(&count).Scopes(Paginate(params.Page, params.PageSize)).Find(&eventSources).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListEventSourcesResult{
+		EventSources: eventSources,
+		Count:        count,
+	}, nil
+}
+
+// ListEventTableIds returns the data tables written by the event sources of every account
+func (r *eventSourceRepo) ListEventTableIds(ctx context.Context) ([]int64, error) {
+	var tableIds []int64
+	err := r.WithContext(ctx).Table(r.TableName).Distinct("table_id").Pluck("table_id", &tableIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tableIds, nil
+}
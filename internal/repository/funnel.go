@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"gorm.io/gorm"
+)
+
+type FunnelRepository interface {
+	CreateFunnel(ctx context.Context, funnel *model.Funnel) error
+	GetFunnel(ctx context.Context, id int64) (*model.Funnel, error)
+	ListFunnels(ctx context.Context, masterSegmentId int64) ([]model.Funnel, error)
+	DeleteFunnel(ctx context.Context, id int64) error
+}
+
+type funnelRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewFunnelRepository(db *gorm.DB) FunnelRepository {
+	return &funnelRepo{
+		DB:        db,
+		TableName: model.Funnel{}.TableName(),
+	}
+}
+
+func (r *funnelRepo) CreateFunnel(ctx context.Context, funnel *model.Funnel) error {
+	return r.WithContext(ctx).Table(r.TableName).Create(funnel).Error
+}
+
+func (r *funnelRepo) GetFunnel(ctx context.Context, id int64) (*model.Funnel, error) {
+	var funnel model.Funnel
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).First(&funnel).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &funnel, nil
+}
+
+func (r *funnelRepo) ListFunnels(ctx context.Context, masterSegmentId int64) ([]model.Funnel, error) {
+	var funnels []model.Funnel
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("master_segment_id = ?", masterSegmentId).
+		Order("id").
+		Find(&funnels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return funnels, nil
+}
+
+func (r *funnelRepo) DeleteFunnel(ctx context.Context, id int64) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).Delete(&model.Funnel{}).Error
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"gorm.io/gorm"
+)
+
+type IdentityResolutionRepository interface {
+	CreateIdentityResolution(ctx context.Context, params *CreateIdentityResolutionParams) (*model.IdentityResolution, error)
+	GetIdentityResolution(ctx context.Context, id int64) (*model.IdentityResolution, error)
+	ListIdentityResolutions(ctx context.Context, params *ListIdentityResolutionsParams) (*ListIdentityResolutionsResult, error)
+	UpdateIdentityResolution(ctx context.Context, params *UpdateIdentityResolutionParams) error
+}
+
+type identityResolutionRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewIdentityResolutionRepository(db *gorm.DB) IdentityResolutionRepository {
+	return &identityResolutionRepo{
+		DB:        db,
+		TableName: model.IdentityResolution{}.TableName(),
+	}
+}
+
+type CreateIdentityResolutionParams struct {
+	Tx             *gorm.DB
+	Name           string
+	Description    string
+	Configurations pqtype.NullRawMessage
+	OutputTableId  int64
+	AccountUuid    uuid.UUID
+}
+
+func (r *identityResolutionRepo) CreateIdentityResolution(ctx context.Context, params *CreateIdentityResolutionParams) (*model.IdentityResolution, error) {
+	identityResolution := model.IdentityResolution{
+		Name:           params.Name,
+		Description:    params.Description,
+		Configurations: params.Configurations,
+		OutputTableId:  params.OutputTableId,
+		Status:         model.IdentityResolutionStatus_DRAFT,
+		AccountUuid:    params.AccountUuid,
+	}
+
+	var createErr error
+	if params.Tx != nil {
+		createErr = params.Tx.WithContext(ctx).Table(r.TableName).Create(&identityResolution).Error
+	} else {
+		createErr = r.WithContext(ctx).Table(r.TableName).Create(&identityResolution).Error
+	}
+	if createErr != nil {
+		return nil, createErr
+	}
+
+	return &identityResolution, nil
+}
+
+func (r *identityResolutionRepo) GetIdentityResolution(ctx context.Context, id int64) (*model.IdentityResolution, error) {
+	var identityResolution model.IdentityResolution
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).First(&identityResolution).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &identityResolution, nil
+}
+
+type ListIdentityResolutionsParams struct {
+	AccountUuid   uuid.UUID
+	OutputTableId int64
+	Page          int
+	PageSize      int
+}
+
+type ListIdentityResolutionsResult struct {
+	IdentityResolutions []model.IdentityResolution
+	Count               int64
+}
+
+func (r *identityResolutionRepo) ListIdentityResolutions(ctx context.Context, params *ListIdentityResolutionsParams) (*ListIdentityResolutionsResult, error) {
+	var (
+		identityResolutions []model.IdentityResolution
+		count               int64
+	)
+
+	query := r.WithContext(ctx).Table(r.TableName)
+	if params.AccountUuid != uuid.Nil {
+		query = query.Where("account_uuid = ?", params.AccountUuid)
+	}
+	if params.OutputTableId > 0 {
+		query = query.Where("output_table_id = ?", params.OutputTableId)
+	}
+	err := query.Order("updated_at desc").Count(&count).Scopes(Paginate(params.Page, params.PageSize)).Find(&identityResolutions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListIdentityResolutionsResult{
+		IdentityResolutions: identityResolutions,
+		Count:               count,
+	}, nil
+}
+
+type UpdateIdentityResolutionParams struct {
+	Tx     *gorm.DB
+	Id     int64
+	Status model.IdentityResolutionStatus
+}
+
+func (r *identityResolutionRepo) UpdateIdentityResolution(ctx context.Context, params *UpdateIdentityResolutionParams) error {
+	identityResolution := model.IdentityResolution{
+		ID:     params.Id,
+		Status: params.Status,
+	}
+	var updateErr error
+	if params.Tx != nil {
+		updateErr = params.Tx.WithContext(ctx).Table(r.TableName).Where("id = ?", params.Id).Updates(&identityResolution).Error
+	} else {
+		updateErr = r.WithContext(ctx).Table(r.TableName).Where("id = ?", params.Id).Updates(&identityResolution).Error
+	}
+	return updateErr
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MaskingPolicyRepository interface {
+	ReplaceMaskingPolicies(ctx context.Context, accountUuid uuid.UUID, policies []model.MaskingPolicy) error
+	ListMaskingPolicies(ctx context.Context, params *ListMaskingPoliciesParams) ([]model.MaskingPolicy, error)
+}
+
+type maskingPolicyRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewMaskingPolicyRepository(db *gorm.DB) MaskingPolicyRepository {
+	return &maskingPolicyRepo{
+		DB:        db,
+		TableName: model.MaskingPolicy{}.TableName(),
+	}
+}
+
+// ReplaceMaskingPolicies sets the policies of the account, the pairs of role and tag missing from policies use the default
+func (r *maskingPolicyRepo) ReplaceMaskingPolicies(ctx context.Context, accountUuid uuid.UUID, policies []model.MaskingPolicy) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(r.TableName).Where("account_uuid = ?", accountUuid).Delete(&model.MaskingPolicy{}).Error
+		if err != nil {
+			return err
+		}
+		if len(policies) == 0 {
+			return nil
+		}
+		return tx.Table(r.TableName).Create(&policies).Error
+	})
+}
+
+type ListMaskingPoliciesParams struct {
+	AccountUuid uuid.UUID
+	Role        string
+}
+
+func (r *maskingPolicyRepo) ListMaskingPolicies(ctx context.Context, params *ListMaskingPoliciesParams) ([]model.MaskingPolicy, error) {
+	var policies []model.MaskingPolicy
+	query := r.WithContext(ctx).Table(r.TableName).Where("account_uuid = ?", params.AccountUuid)
+	if params.Role != "" {
+		query = query.Where("role = ?", params.Role)
+	}
+	err := query.Order("role, tag").Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"gorm.io/gorm"
+)
+
+type ProfileSearchIndexRepository interface {
+	ReplaceProfileSearchIndex(ctx context.Context, masterSegmentId int64, entries []model.ProfileSearchIndex) error
+	SearchProfileSearchIndex(ctx context.Context, params *SearchProfileSearchIndexParams) ([]ProfileSearchIndexMatch, error)
+	DeleteProfileSearchIndexEntries(ctx context.Context, masterSegmentId int64, cdpSystemUuids []string) error
+}
+
+type profileSearchIndexRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewProfileSearchIndexRepository(db *gorm.DB) ProfileSearchIndexRepository {
+	return &profileSearchIndexRepo{
+		DB:        db,
+		TableName: model.ProfileSearchIndex{}.TableName(),
+	}
+}
+
+// ReplaceProfileSearchIndex swaps the entries of the master segment in one transaction, so searches never see a partial index
+func (r *profileSearchIndexRepo) ReplaceProfileSearchIndex(ctx context.Context, masterSegmentId int64, entries []model.ProfileSearchIndex) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(r.TableName).Where("master_segment_id = ?", masterSegmentId).Delete(&model.ProfileSearchIndex{}).Error
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		return tx.Table(r.TableName).CreateInBatches(&entries, 1000).Error
+	})
+}
+
+// DeleteProfileSearchIndexEntries removes the entries of profiles without rebuilding the index of the master segment
+func (r *profileSearchIndexRepo) DeleteProfileSearchIndexEntries(ctx context.Context, masterSegmentId int64, cdpSystemUuids []string) error {
+	if len(cdpSystemUuids) == 0 {
+		return nil
+	}
+	return r.WithContext(ctx).Table(r.TableName).
+		Where("master_segment_id = ? AND cdp_system_uuid IN ?", masterSegmentId, cdpSystemUuids).
+		Delete(&model.ProfileSearchIndex{}).Error
+}
+
+type SearchProfileSearchIndexParams struct {
+	MasterSegmentId int64
+	// NormalizedQuery is matched as a prefix of the normalized values, and by trigram similarity when Fuzzy
+	NormalizedQuery string
+	Columns         []string
+	Fuzzy           bool
+	Limit           int
+}
+
+type ProfileSearchIndexMatch struct {
+	CdpSystemUuid string
+	ColumnName    string
+	Value         string
+	Score         float64
+	ProfileScore  float64
+}
+
+// SearchProfileSearchIndex returns the matches of the Limit best profiles, best profile first.
+// An exact match scores 1, a prefix match between 0.5 and 1 by the matched share of the value, a fuzzy match its
+// trigram similarity halved. A profile scores its best match.
+func (r *profileSearchIndexRepo) SearchProfileSearchIndex(ctx context.Context, params *SearchProfileSearchIndexParams) ([]ProfileSearchIndexMatch, error) {
+	prefix := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(params.NormalizedQuery) + "%"
+
+	conditions := []string{"master_segment_id = ?"}
+	args := []any{
+		params.NormalizedQuery, prefix, params.NormalizedQuery, params.NormalizedQuery,
+		params.MasterSegmentId,
+	}
+	if params.Fuzzy {
+		conditions = append(conditions, `(normalized_value LIKE ? ESCAPE '\' OR normalized_value % ?)`)
+		args = append(args, prefix, params.NormalizedQuery)
+	} else {
+		conditions = append(conditions, `normalized_value LIKE ? ESCAPE '\'`)
+		args = append(args, prefix)
+	}
+	if len(params.Columns) > 0 {
+		conditions = append(conditions, "column_name IN ?")
+		args = append(args, params.Columns)
+	}
+	args = append(args, params.Limit)
+
+	var matches []ProfileSearchIndexMatch
+	err := r.WithContext(ctx).Raw(fmt.Sprintf(`WITH matches AS (
+	SELECT cdp_system_uuid, column_name, value,
+		CASE WHEN normalized_value = ? THEN 1
+			WHEN normalized_value LIKE ? ESCAPE '\' THEN 0.5 + 0.5 * CAST(char_length(CAST(? AS text)) AS float) / char_length(normalized_value)
+			ELSE 0.5 * similarity(normalized_value, CAST(? AS text))
+		END AS score
+	FROM %s
+	WHERE %s
+), ranked AS (
+	SELECT cdp_system_uuid, MAX(score) AS profile_score FROM matches
+	GROUP BY cdp_system_uuid ORDER BY profile_score DESC, cdp_system_uuid LIMIT ?
+)
+SELECT matches.cdp_system_uuid, matches.column_name, matches.value, matches.score, ranked.profile_score
+FROM matches JOIN ranked ON matches.cdp_system_uuid = ranked.cdp_system_uuid
+ORDER BY ranked.profile_score DESC, matches.cdp_system_uuid, matches.score DESC`, r.TableName, strings.Join(conditions, " AND ")), args...).
+		Scan(&matches).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
@@ -20,25 +20,55 @@ type Repository struct {
 	DestSegmentMapRepository
 	DestMasterSegmentMapRepository
 	PredictModelRepository
+	IdentityResolutionRepository
+	EventSourceRepository
+	EventBufferRepository
+	DataTableSchemaVersionRepository
+	DataProfileRepository
+	ProfileSearchIndexRepository
+	ColumnTagRepository
+	MaskingPolicyRepository
+	ErasureCertificateRepository
+	SuppressionListRepository
+	RetentionPolicyRepository
+	SegmentSnapshotRepository
+	RFMAnalysisRepository
+	FunnelRepository
+	SegmentSplitRepository
 }
 
 func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{
-		AccountRepository:              NewAccountRepository(db),
-		DataSourceRepository:           NewDataSourceRepository(db),
-		DataActionRepository:           NewDataActionRepository(db),
-		DataActionRunRepository:        NewDataActionRunRepository(db),
-		DataTableRepository:            NewDataTableRepository(db),
-		ConnectionRepository:           NewConnectionRepository(db),
-		TransactionRepository:          NewTransactionRepository(db),
-		FileExportRecordRepository:     NewFileExportRecordRepository(db),
-		SourceTableMapRepository:       NewSourceTableMapRepository(db),
-		SegmentRepository:              NewSegmentRepository(db),
-		DataDestinationRepository:      NewDataDestinationRepository(db),
-		DestTableMapRepository:         NewDestTableMapRepository(db),
-		DestSegmentMapRepository:       NewDestSegmentMapRepository(db),
-		DestMasterSegmentMapRepository: NewDestMasterSegmentMapRepository(db),
-		PredictModelRepository:         NewPredictModelRepository(db),
+		AccountRepository:                NewAccountRepository(db),
+		DataSourceRepository:             NewDataSourceRepository(db),
+		DataActionRepository:             NewDataActionRepository(db),
+		DataActionRunRepository:          NewDataActionRunRepository(db),
+		DataTableRepository:              NewDataTableRepository(db),
+		ConnectionRepository:             NewConnectionRepository(db),
+		TransactionRepository:            NewTransactionRepository(db),
+		FileExportRecordRepository:       NewFileExportRecordRepository(db),
+		SourceTableMapRepository:         NewSourceTableMapRepository(db),
+		SegmentRepository:                NewSegmentRepository(db),
+		DataDestinationRepository:        NewDataDestinationRepository(db),
+		DestTableMapRepository:           NewDestTableMapRepository(db),
+		DestSegmentMapRepository:         NewDestSegmentMapRepository(db),
+		DestMasterSegmentMapRepository:   NewDestMasterSegmentMapRepository(db),
+		PredictModelRepository:           NewPredictModelRepository(db),
+		IdentityResolutionRepository:     NewIdentityResolutionRepository(db),
+		EventSourceRepository:            NewEventSourceRepository(db),
+		EventBufferRepository:            NewEventBufferRepository(db),
+		DataTableSchemaVersionRepository: NewDataTableSchemaVersionRepository(db),
+		DataProfileRepository:            NewDataProfileRepository(db),
+		ProfileSearchIndexRepository:     NewProfileSearchIndexRepository(db),
+		ColumnTagRepository:              NewColumnTagRepository(db),
+		MaskingPolicyRepository:          NewMaskingPolicyRepository(db),
+		ErasureCertificateRepository:     NewErasureCertificateRepository(db),
+		SuppressionListRepository:        NewSuppressionListRepository(db),
+		RetentionPolicyRepository:        NewRetentionPolicyRepository(db),
+		SegmentSnapshotRepository:        NewSegmentSnapshotRepository(db),
+		RFMAnalysisRepository:            NewRFMAnalysisRepository(db),
+		FunnelRepository:                 NewFunnelRepository(db),
+		SegmentSplitRepository:           NewSegmentSplitRepository(db),
 	}
 }
 
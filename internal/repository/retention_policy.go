@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RetentionPolicyRepository interface {
+	CreateRetentionPolicy(ctx context.Context, params *CreateRetentionPolicyParams) (*model.RetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, policy *model.RetentionPolicy) error
+	GetRetentionPolicy(ctx context.Context, id int64) (*model.RetentionPolicy, error)
+	GetRetentionPolicyOfObject(ctx context.Context, objectType model.RetentionObjectType, objectId int64) (*model.RetentionPolicy, error)
+	ListRetentionPolicies(ctx context.Context, params *ListRetentionPoliciesParams) ([]model.RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, id int64) error
+}
+
+type retentionPolicyRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewRetentionPolicyRepository(db *gorm.DB) RetentionPolicyRepository {
+	return &retentionPolicyRepo{
+		DB:        db,
+		TableName: model.RetentionPolicy{}.TableName(),
+	}
+}
+
+type CreateRetentionPolicyParams struct {
+	Tx              *gorm.DB
+	AccountUuid     uuid.UUID
+	ObjectType      model.RetentionObjectType
+	ObjectId        int64
+	TimestampColumn string
+	MaxAgeDays      int32
+	MaxVersions     int32
+	Clickstream     bool
+	DataActionId    int64
+}
+
+func (r *retentionPolicyRepo) CreateRetentionPolicy(ctx context.Context, params *CreateRetentionPolicyParams) (*model.RetentionPolicy, error) {
+	policy := &model.RetentionPolicy{
+		AccountUuid:     params.AccountUuid,
+		ObjectType:      params.ObjectType,
+		ObjectId:        params.ObjectId,
+		TimestampColumn: params.TimestampColumn,
+		MaxAgeDays:      params.MaxAgeDays,
+		MaxVersions:     params.MaxVersions,
+		Clickstream:     params.Clickstream,
+		DataActionId:    params.DataActionId,
+	}
+
+	var createErr error
+	if params.Tx != nil {
+		createErr = params.Tx.WithContext(ctx).Table(r.TableName).Create(policy).Error
+	} else {
+		createErr = r.WithContext(ctx).Table(r.TableName).Create(policy).Error
+	}
+	if createErr != nil {
+		return nil, createErr
+	}
+
+	return policy, nil
+}
+
+// UpdateRetentionPolicy saves the rules of the policy, zero values included
+func (r *retentionPolicyRepo) UpdateRetentionPolicy(ctx context.Context, policy *model.RetentionPolicy) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", policy.ID).
+		Updates(map[string]interface{}{
+			"timestamp_column": policy.TimestampColumn,
+			"max_age_days":     policy.MaxAgeDays,
+			"max_versions":     policy.MaxVersions,
+			"clickstream":      policy.Clickstream,
+		}).Error
+}
+
+func (r *retentionPolicyRepo) GetRetentionPolicy(ctx context.Context, id int64) (*model.RetentionPolicy, error) {
+	var policy model.RetentionPolicy
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).First(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetRetentionPolicyOfObject returns nil when the object keeps its rows forever
+func (r *retentionPolicyRepo) GetRetentionPolicyOfObject(ctx context.Context, objectType model.RetentionObjectType, objectId int64) (*model.RetentionPolicy, error) {
+	var policy model.RetentionPolicy
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("object_type = ? AND object_id = ?", objectType, objectId).
+		First(&policy).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+type ListRetentionPoliciesParams struct {
+	// AccountUuid lists the policies of every account when nil
+	AccountUuid uuid.UUID
+	ObjectType  model.RetentionObjectType
+	ObjectIds   []int64
+}
+
+func (r *retentionPolicyRepo) ListRetentionPolicies(ctx context.Context, params *ListRetentionPoliciesParams) ([]model.RetentionPolicy, error) {
+	var policies []model.RetentionPolicy
+	query := r.WithContext(ctx).Table(r.TableName)
+	if params.AccountUuid != uuid.Nil {
+		query = query.Where("account_uuid = ?", params.AccountUuid)
+	}
+	if params.ObjectType != "" {
+		query = query.Where("object_type = ?", params.ObjectType)
+	}
+	if len(params.ObjectIds) > 0 {
+		query = query.Where("object_id IN ?", params.ObjectIds)
+	}
+	err := query.Order("id").Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (r *retentionPolicyRepo) DeleteRetentionPolicy(ctx context.Context, id int64) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).Delete(&model.RetentionPolicy{}).Error
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/sqlc-dev/pqtype"
+	"gorm.io/gorm"
+)
+
+type RFMAnalysisRepository interface {
+	CreateRFMAnalysis(ctx context.Context, tx *gorm.DB, analysis *model.RFMAnalysis) error
+	GetRFMAnalysis(ctx context.Context, id int64) (*model.RFMAnalysis, error)
+	ListRFMAnalyses(ctx context.Context, masterSegmentId int64) ([]model.RFMAnalysis, error)
+	UpdateRFMAnalysis(ctx context.Context, analysis *model.RFMAnalysis) error
+	UpdateRFMAnalysisSegments(ctx context.Context, id int64, segments pqtype.NullRawMessage) error
+}
+
+type rfmAnalysisRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewRFMAnalysisRepository(db *gorm.DB) RFMAnalysisRepository {
+	return &rfmAnalysisRepo{
+		DB:        db,
+		TableName: model.RFMAnalysis{}.TableName(),
+	}
+}
+
+func (r *rfmAnalysisRepo) CreateRFMAnalysis(ctx context.Context, tx *gorm.DB, analysis *model.RFMAnalysis) error {
+	if tx != nil {
+		return tx.WithContext(ctx).Table(r.TableName).Create(analysis).Error
+	}
+	return r.WithContext(ctx).Table(r.TableName).Create(analysis).Error
+}
+
+func (r *rfmAnalysisRepo) GetRFMAnalysis(ctx context.Context, id int64) (*model.RFMAnalysis, error) {
+	var analysis model.RFMAnalysis
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).First(&analysis).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &analysis, nil
+}
+
+func (r *rfmAnalysisRepo) ListRFMAnalyses(ctx context.Context, masterSegmentId int64) ([]model.RFMAnalysis, error) {
+	var analyses []model.RFMAnalysis
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("master_segment_id = ?", masterSegmentId).
+		Order("id").
+		Find(&analyses).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return analyses, nil
+}
+
+// UpdateRFMAnalysis saves the configuration of the analysis, its prefix, action and segments are kept
+func (r *rfmAnalysisRepo) UpdateRFMAnalysis(ctx context.Context, analysis *model.RFMAnalysis) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", analysis.ID).
+		Select("name", "behavior_table_id", "customer_key", "date_column", "amount_column", "bins").
+		Updates(analysis).Error
+}
+
+func (r *rfmAnalysisRepo) UpdateRFMAnalysisSegments(ctx context.Context, id int64, segments pqtype.NullRawMessage) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).Update("segments", segments).Error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/APCS20-Thesis/Backend/api"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/google/uuid"
@@ -20,6 +21,7 @@ type SegmentRepository interface {
 
 	CreateAudienceTable(ctx context.Context, params *CreateAudienceTableParams) error
 	GetAudienceTable(ctx context.Context, params GetAudienceTableParams) (model.AudienceTable, error)
+	ListAudienceTablesByDataTableId(ctx context.Context, dataTableId int64) ([]model.AudienceTable, error)
 	UpdateAudienceTable(ctx context.Context, params *UpdateAudienceTableParams) error
 
 	CreateBehaviorTable(ctx context.Context, params *CreateBehaviorTableParams) error
@@ -31,6 +33,7 @@ type SegmentRepository interface {
 	ListSegments(ctx context.Context, filter *ListSegmentFilter) ([]SegmentListItem, error)
 	GetSegment(ctx context.Context, segmentId int64) (model.Segment, error)
 	UpdateSegment(ctx context.Context, params *UpdateSegmentParams) error
+	DeleteSegment(ctx context.Context, segmentId int64) error
 }
 
 type segmentRepo struct {
@@ -77,6 +80,12 @@ type AudienceBuildConfiguration struct {
 	MainTableId     int64                    `json:"mainTableId"`
 	SelectedColumns []*api.TransferredColumn `json:"selectedColumns"`
 	AttributeTables []*AttributeTableInfo    `json:"attributeTables"`
+	// ComputedAttributes are aggregates over the behavior tables materialized into the audience
+	ComputedAttributes []*api.ComputedAttribute `json:"computedAttributes,omitempty"`
+	// SearchableColumns are the audience columns indexed for profile search after each build
+	SearchableColumns []string `json:"searchableColumns,omitempty"`
+	// ConsentColumns are the boolean audience columns holding the consent of the profiles per channel, checked at export time
+	ConsentColumns []*api.ConsentColumn `json:"consentColumns,omitempty"`
 }
 
 type AttributeTableInfo struct {
@@ -129,18 +138,21 @@ type CreateSegmentParams struct {
 	MasterSegmentId int64
 	Condition       pqtype.NullRawMessage
 	SqlCondition    string
-	AccountUuid     uuid.UUID
+	// SequenceSqlCondition is the compiled sequence conditions of the segment
+	SequenceSqlCondition string
+	AccountUuid          uuid.UUID
 }
 
 func (r *segmentRepo) CreateSegment(ctx context.Context, params *CreateSegmentParams) (*model.Segment, error) {
 	segment := &model.Segment{
-		MasterSegmentId: params.MasterSegmentId,
-		Condition:       params.Condition,
-		SqlCondition:    params.SqlCondition,
-		Description:     params.Description,
-		Name:            params.Name,
-		AccountUuid:     params.AccountUuid,
-		Status:          model.SegmentStatus_DRAFT,
+		MasterSegmentId:      params.MasterSegmentId,
+		Condition:            params.Condition,
+		SqlCondition:         params.SqlCondition,
+		SequenceSqlCondition: params.SequenceSqlCondition,
+		Description:          params.Description,
+		Name:                 params.Name,
+		AccountUuid:          params.AccountUuid,
+		Status:               model.SegmentStatus_DRAFT,
 	}
 
 	var createErr error
@@ -200,15 +212,17 @@ func (r *segmentRepo) ListMasterSegments(ctx context.Context, filter *ListMaster
 }
 
 type UpdateMasterSegmentParams struct {
-	Tx     *gorm.DB
-	Id     int64
-	Status model.MasterSegmentStatus
+	Tx           *gorm.DB
+	Id           int64
+	Status       model.MasterSegmentStatus
+	SchemaIssues pqtype.NullRawMessage
 }
 
 func (r *segmentRepo) UpdateMasterSegment(ctx context.Context, params *UpdateMasterSegmentParams) error {
 	masterSegment := model.MasterSegment{
-		ID:     params.Id,
-		Status: params.Status,
+		ID:           params.Id,
+		Status:       params.Status,
+		SchemaIssues: params.SchemaIssues,
 	}
 	var updateErr error
 	if params.Tx != nil {
@@ -224,13 +238,23 @@ func (r *segmentRepo) UpdateMasterSegment(ctx context.Context, params *UpdateMas
 
 type ListBehaviorTablesParams struct {
 	MasterSegmentId int64
+	DataTableId     int64
+	DataTableIds    []int64
 }
 
 func (r *segmentRepo) ListBehaviorTables(ctx context.Context, params *ListBehaviorTablesParams) ([]model.BehaviorTable, error) {
 	var behaviorTables []model.BehaviorTable
-	err := r.WithContext(ctx).Table(r.BehaviorTableName).
-		Where("master_segment_id = ?", params.MasterSegmentId).
-		Find(&behaviorTables).Error
+	query := r.WithContext(ctx).Table(r.BehaviorTableName)
+	if params.MasterSegmentId > 0 {
+		query = query.Where("master_segment_id = ?", params.MasterSegmentId)
+	}
+	if params.DataTableId > 0 {
+		query = query.Where("data_table_id = ?", params.DataTableId)
+	}
+	if len(params.DataTableIds) > 0 {
+		query = query.Where("data_table_id IN ?", params.DataTableIds)
+	}
+	err := query.Find(&behaviorTables).Error
 	if err != nil {
 		return nil, err
 	}
@@ -277,6 +301,20 @@ func (r *segmentRepo) GetAudienceTable(ctx context.Context, params GetAudienceTa
 	return audienceTable, nil
 }
 
+// ListAudienceTablesByDataTableId returns the audience tables built from the data table, as main or attribute table
+func (r *segmentRepo) ListAudienceTablesByDataTableId(ctx context.Context, dataTableId int64) ([]model.AudienceTable, error) {
+	var audienceTables []model.AudienceTable
+	err := r.WithContext(ctx).Table(r.AudienceTableName).
+		Where("(build_configuration->>'mainTableId')::bigint = ? OR build_configuration->'attributeTables' @> ?::jsonb",
+			dataTableId, fmt.Sprintf(`[{"tableId": %d}]`, dataTableId)).
+		Find(&audienceTables).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return audienceTables, nil
+}
+
 type GetBehaviorTableParams struct {
 	Id int64
 }
@@ -294,15 +332,17 @@ func (r *segmentRepo) GetBehaviorTable(ctx context.Context, params *GetBehaviorT
 }
 
 type UpdateAudienceTableParams struct {
-	Tx     *gorm.DB
-	Id     int64
-	Schema pqtype.NullRawMessage
+	Tx                 *gorm.DB
+	Id                 int64
+	Schema             pqtype.NullRawMessage
+	BuildConfiguration pqtype.NullRawMessage
 }
 
 func (r *segmentRepo) UpdateAudienceTable(ctx context.Context, params *UpdateAudienceTableParams) error {
 	audienceTable := model.AudienceTable{
-		ID:     params.Id,
-		Schema: params.Schema,
+		ID:                 params.Id,
+		Schema:             params.Schema,
+		BuildConfiguration: params.BuildConfiguration,
 	}
 	var updateErr error
 	if params.Tx != nil {
@@ -406,3 +446,22 @@ func (r *segmentRepo) UpdateSegment(ctx context.Context, params *UpdateSegmentPa
 	}
 	return nil
 }
+
+// DeleteSegment deletes the segment with the data actions building it and their runs
+func (r *segmentRepo) DeleteSegment(ctx context.Context, segmentId int64) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(model.DataActionRun{}.TableName()).
+			Where("action_id IN (SELECT id FROM data_action WHERE target_table = ? AND object_id = ?)", model.TargetTable_Segment, segmentId).
+			Delete(&model.DataActionRun{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Table(model.DataAction{}.TableName()).
+			Where("target_table = ? AND object_id = ?", model.TargetTable_Segment, segmentId).
+			Delete(&model.DataAction{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Table(r.SegmentTableName).Where("id = ?", segmentId).Delete(&model.Segment{}).Error
+	})
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"gorm.io/gorm"
+)
+
+type SegmentSnapshotRepository interface {
+	CreateSegmentSnapshot(ctx context.Context, snapshot *model.SegmentSnapshot) error
+	GetSegmentSnapshot(ctx context.Context, segmentId int64, id int64) (*model.SegmentSnapshot, error)
+	GetLatestSegmentSnapshot(ctx context.Context, segmentId int64) (*model.SegmentSnapshot, error)
+	GetPreviousSegmentSnapshot(ctx context.Context, snapshot *model.SegmentSnapshot) (*model.SegmentSnapshot, error)
+	ListSegmentSnapshots(ctx context.Context, segmentId int64) ([]model.SegmentSnapshot, error)
+}
+
+type segmentSnapshotRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewSegmentSnapshotRepository(db *gorm.DB) SegmentSnapshotRepository {
+	return &segmentSnapshotRepo{
+		DB:        db,
+		TableName: model.SegmentSnapshot{}.TableName(),
+	}
+}
+
+func (r *segmentSnapshotRepo) CreateSegmentSnapshot(ctx context.Context, snapshot *model.SegmentSnapshot) error {
+	return r.WithContext(ctx).Table(r.TableName).Create(snapshot).Error
+}
+
+func (r *segmentSnapshotRepo) GetSegmentSnapshot(ctx context.Context, segmentId int64, id int64) (*model.SegmentSnapshot, error) {
+	var snapshot model.SegmentSnapshot
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("segment_id = ? AND id = ?", segmentId, id).
+		First(&snapshot).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// GetLatestSegmentSnapshot returns nil when the segment has not been built since snapshots are taken
+func (r *segmentSnapshotRepo) GetLatestSegmentSnapshot(ctx context.Context, segmentId int64) (*model.SegmentSnapshot, error) {
+	var snapshot model.SegmentSnapshot
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("segment_id = ?", segmentId).
+		Order("id desc").
+		First(&snapshot).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// GetPreviousSegmentSnapshot returns nil for the first snapshot of the segment
+func (r *segmentSnapshotRepo) GetPreviousSegmentSnapshot(ctx context.Context, snapshot *model.SegmentSnapshot) (*model.SegmentSnapshot, error) {
+	var previous model.SegmentSnapshot
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("segment_id = ? AND id < ?", snapshot.SegmentId, snapshot.ID).
+		Order("id desc").
+		First(&previous).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &previous, nil
+}
+
+func (r *segmentSnapshotRepo) ListSegmentSnapshots(ctx context.Context, segmentId int64) ([]model.SegmentSnapshot, error) {
+	var snapshots []model.SegmentSnapshot
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("segment_id = ?", segmentId).
+		Order("id").
+		Find(&snapshots).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/sqlc-dev/pqtype"
+	"gorm.io/gorm"
+)
+
+type SegmentSplitRepository interface {
+	CreateSegmentSplit(ctx context.Context, split *model.SegmentSplit) error
+	GetSegmentSplit(ctx context.Context, id int64) (*model.SegmentSplit, error)
+	ListSegmentSplits(ctx context.Context, segmentId int64) ([]model.SegmentSplit, error)
+	UpdateSegmentSplitArms(ctx context.Context, id int64, arms pqtype.NullRawMessage) error
+	DeleteSegmentSplit(ctx context.Context, id int64) error
+}
+
+type segmentSplitRepo struct {
+	*gorm.DB
+	TableName string
+}
+
+func NewSegmentSplitRepository(db *gorm.DB) SegmentSplitRepository {
+	return &segmentSplitRepo{
+		DB:        db,
+		TableName: model.SegmentSplit{}.TableName(),
+	}
+}
+
+func (r *segmentSplitRepo) CreateSegmentSplit(ctx context.Context, split *model.SegmentSplit) error {
+	return r.WithContext(ctx).Table(r.TableName).Create(split).Error
+}
+
+func (r *segmentSplitRepo) GetSegmentSplit(ctx context.Context, id int64) (*model.SegmentSplit, error) {
+	var split model.SegmentSplit
+	err := r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).First(&split).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &split, nil
+}
+
+func (r *segmentSplitRepo) ListSegmentSplits(ctx context.Context, segmentId int64) ([]model.SegmentSplit, error) {
+	var splits []model.SegmentSplit
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("segment_id = ?", segmentId).
+		Order("id").
+		Find(&splits).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return splits, nil
+}
+
+func (r *segmentSplitRepo) UpdateSegmentSplitArms(ctx context.Context, id int64, arms pqtype.NullRawMessage) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).Update("arms", arms).Error
+}
+
+func (r *segmentSplitRepo) DeleteSegmentSplit(ctx context.Context, id int64) error {
+	return r.WithContext(ctx).Table(r.TableName).Where("id = ?", id).Delete(&model.SegmentSplit{}).Error
+}
@@ -28,6 +28,8 @@ type CreateSourceTableMapParams struct {
 	TableId        int64
 	SourceId       int64
 	MappingOptions pqtype.NullRawMessage
+	// DedupeConfiguration is the deduplication applied to each import of the mapping
+	DedupeConfiguration pqtype.NullRawMessage
 }
 
 func (r *sourceTableMapRepo) CreateSourceTableMap(ctx context.Context, params *CreateSourceTableMapParams) (*model.SourceTableMap, error) {
@@ -35,6 +37,8 @@ func (r *sourceTableMapRepo) CreateSourceTableMap(ctx context.Context, params *C
 		TableId:        params.TableId,
 		SourceId:       params.SourceId,
 		MappingOptions: params.MappingOptions,
+		// deduplication applied to each import
+		DedupeConfiguration: params.DedupeConfiguration,
 	}
 
 	var createErr error
@@ -77,6 +81,8 @@ type (
 		MappingOptions pqtype.NullRawMessage
 		CreatedAt      time.Time `gorm:"autoCreateTime"`
 		UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+		// deduplication applied to each import
+		DedupeConfiguration pqtype.NullRawMessage
 	}
 	ListSourceTableMapResult struct {
 		TableSourceMaps []TableSourceMapWithExtraInfo
@@ -103,6 +109,7 @@ func (r *sourceTableMapRepo) ListSourceTableMap(ctx context.Context, params *Lis
 				"source_table_map.table_id AS table_id, " +
 				"source_table_map.source_id AS source_id, " +
 				"source_table_map.mapping_options AS mapping_options, " +
+				"source_table_map.dedupe_configuration AS dedupe_configuration, " +
 				"source_table_map.created_at AS created_at, " +
 				"source_table_map.updated_at AS updated_at, " +
 				"data_table.name AS table_name, " +
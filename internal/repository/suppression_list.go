@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SuppressionListRepository interface {
+	CreateSuppressionList(ctx context.Context, list *model.SuppressionList, values []string) error
+	GetSuppressionList(ctx context.Context, accountUuid uuid.UUID, id int64) (*model.SuppressionList, error)
+	ListSuppressionLists(ctx context.Context, accountUuid uuid.UUID) ([]model.SuppressionList, error)
+	DeleteSuppressionList(ctx context.Context, id int64) error
+	AddSuppressionEntries(ctx context.Context, params *AddSuppressionEntriesParams) error
+	CountSuppressionEntries(ctx context.Context, suppressionListIds []int64) (map[int64]int64, error)
+	ListSuppressionEntryValues(ctx context.Context, suppressionListId int64) ([]string, error)
+}
+
+type suppressionListRepo struct {
+	*gorm.DB
+	TableName      string
+	EntryTableName string
+}
+
+func NewSuppressionListRepository(db *gorm.DB) SuppressionListRepository {
+	return &suppressionListRepo{
+		DB:             db,
+		TableName:      model.SuppressionList{}.TableName(),
+		EntryTableName: model.SuppressionEntry{}.TableName(),
+	}
+}
+
+func (r *suppressionListRepo) CreateSuppressionList(ctx context.Context, list *model.SuppressionList, values []string) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(r.TableName).Create(list).Error
+		if err != nil {
+			return err
+		}
+		return r.createEntries(tx, list.ID, values)
+	})
+}
+
+func (r *suppressionListRepo) GetSuppressionList(ctx context.Context, accountUuid uuid.UUID, id int64) (*model.SuppressionList, error) {
+	var list model.SuppressionList
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("account_uuid = ? AND id = ?", accountUuid, id).
+		First(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (r *suppressionListRepo) ListSuppressionLists(ctx context.Context, accountUuid uuid.UUID) ([]model.SuppressionList, error) {
+	var lists []model.SuppressionList
+	err := r.WithContext(ctx).Table(r.TableName).
+		Where("account_uuid = ?", accountUuid).
+		Order("id").
+		Find(&lists).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+func (r *suppressionListRepo) DeleteSuppressionList(ctx context.Context, id int64) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(r.EntryTableName).Where("suppression_list_id = ?", id).Delete(&model.SuppressionEntry{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Table(r.TableName).Where("id = ?", id).Delete(&model.SuppressionList{}).Error
+	})
+}
+
+type AddSuppressionEntriesParams struct {
+	SuppressionListId int64
+	Values            []string
+	// Replace deletes the entries of the list before adding Values
+	Replace bool
+}
+
+func (r *suppressionListRepo) AddSuppressionEntries(ctx context.Context, params *AddSuppressionEntriesParams) error {
+	return r.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if params.Replace {
+			err := tx.Table(r.EntryTableName).Where("suppression_list_id = ?", params.SuppressionListId).Delete(&model.SuppressionEntry{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		err := r.createEntries(tx, params.SuppressionListId, params.Values)
+		if err != nil {
+			return err
+		}
+		// touch the list so its updated_at tells when the entries last changed
+		return tx.Table(r.TableName).Where("id = ?", params.SuppressionListId).Update("updated_at", gorm.Expr("NOW()")).Error
+	})
+}
+
+// createEntries skips the values already in the list
+func (r *suppressionListRepo) createEntries(tx *gorm.DB, suppressionListId int64, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	entries := make([]model.SuppressionEntry, 0, len(values))
+	for _, value := range values {
+		entries = append(entries, model.SuppressionEntry{
+			SuppressionListId: suppressionListId,
+			Value:             value,
+		})
+	}
+	return tx.Table(r.EntryTableName).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&entries, 1000).Error
+}
+
+func (r *suppressionListRepo) CountSuppressionEntries(ctx context.Context, suppressionListIds []int64) (map[int64]int64, error) {
+	var counts []struct {
+		SuppressionListId int64
+		Count             int64
+	}
+	err := r.WithContext(ctx).Table(r.EntryTableName).
+		Select("suppression_list_id, COUNT(*) AS count").
+		Where("suppression_list_id IN ?", suppressionListIds).
+		Group("suppression_list_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]int64, len(counts))
+	for _, count := range counts {
+		result[count.SuppressionListId] = count.Count
+	}
+	return result, nil
+}
+
+func (r *suppressionListRepo) ListSuppressionEntryValues(ctx context.Context, suppressionListId int64) ([]string, error) {
+	var values []string
+	err := r.WithContext(ctx).Table(r.EntryTableName).
+		Where("suppression_list_id = ?", suppressionListId).
+		Order("value").
+		Pluck("value", &values).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -53,6 +53,11 @@ type ImportCsvTransactionParams struct {
 	WriteMode                airflow.DeltaWriteMode
 	CsvReadOptions           *api.ImportCsvConfigurations
 	Headers                  []string
+	ColumnTypes              map[string]airflow.DagColumnType
+	Transformations          []airflow.DagColumnTransformation
+	DataQuality              *airflow.DagDataQualityConfiguration
+	Deduplication            *airflow.DagDeduplicationConfiguration
+	DedupeConfiguration      pqtype.NullRawMessage
 	Schema                   pqtype.NullRawMessage
 	ConnectionId             int64
 }
@@ -87,10 +92,11 @@ func (r transactionRepo) ImportCsvTransaction(ctx context.Context, params *Impor
 		}
 	} else {
 		dataTable = model.DataTable{
-			Name:        params.NewTableName,
-			Status:      model.DataTableStatus_DRAFT,
-			AccountUuid: params.AccountUuid,
-			Schema:      params.Schema,
+			Name:         params.NewTableName,
+			Status:       model.DataTableStatus_DRAFT,
+			AccountUuid:  params.AccountUuid,
+			Schema:       params.Schema,
+			SchemaPolicy: model.DataTableSchemaPolicy_AllowAdditive,
 		}
 		err = tx.WithContext(ctx).Table("data_table").Create(&dataTable).Error
 		if err != nil {
@@ -102,6 +108,8 @@ func (r transactionRepo) ImportCsvTransaction(ctx context.Context, params *Impor
 		TableId:        dataTable.ID,
 		SourceId:       dataSource.ID,
 		MappingOptions: params.MappingOptions,
+		// deduplication applied to each import
+		DedupeConfiguration: params.DedupeConfiguration,
 	}
 
 	err = tx.WithContext(ctx).Table("source_table_map").Create(&sourceTableMap).Error
@@ -118,6 +126,11 @@ func (r transactionRepo) ImportCsvTransaction(ctx context.Context, params *Impor
 			WriteMode:        params.WriteMode,
 			CsvReadOptions:   params.CsvReadOptions,
 			Headers:          params.Headers,
+			ColumnTypes:      params.ColumnTypes,
+			Transformations:  params.Transformations,
+			DataQuality:      params.DataQuality,
+			Deduplication:    params.Deduplication,
+			SchemaPolicy:     string(dataTable.SchemaPolicy),
 		},
 	})
 	if err != nil {
@@ -297,6 +310,7 @@ type CreateMasterSegmentTransactionParams struct {
 	AudienceName       string
 	BuildConfiguration AudienceBuildConfiguration
 	BehaviorTables     []*CreateBehaviorTableParams
+	ComputedAttributes []airflow.CreateMasterSegmentConfig_ComputedAttribute
 }
 
 func (r transactionRepo) CreateMasterSegmentTransaction(ctx context.Context, params *CreateMasterSegmentTransactionParams, airflowAdapter airflow.AirflowAdapter) error {
@@ -322,6 +336,12 @@ func (r transactionRepo) CreateMasterSegmentTransaction(ctx context.Context, par
 			})
 		}
 	}
+	for _, attribute := range params.BuildConfiguration.ComputedAttributes {
+		schema = append(schema, &api.SchemaColumn{
+			ColumnName: attribute.Name,
+			DataType:   "",
+		})
+	}
 	jsonSchema, err := json.Marshal(schema)
 	if err != nil {
 		return err
@@ -466,17 +486,22 @@ func (r transactionRepo) TriggerAirflowCreateMasterSegment(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	jsonComputedAttributes, err := json.Marshal(params.ComputedAttributes)
+	if err != nil {
+		return err
+	}
 
 	dagId := utils.GenerateDagIdForCreateMasterSegment(params.AccountUuid.String(), masterSegmentId)
 	request := airflow.TriggerGenerateDagCreateMasterSegmentRequest{
 		Config: airflow.CreateMasterSegmentConfig{
-			DagId:           dagId,
-			AccountUuid:     params.AccountUuid.String(),
-			MasterSegmentId: masterSegmentId,
-			MainTableName:   mainTable.Name,
-			MainAttributes:  string(jsonMainAttributes),
-			AttributeTables: string(jsonAttributeTables),
-			BehaviorTables:  string(jsonBehaviorTables),
+			DagId:              dagId,
+			AccountUuid:        params.AccountUuid.String(),
+			MasterSegmentId:    masterSegmentId,
+			MainTableName:      mainTable.Name,
+			MainAttributes:     string(jsonMainAttributes),
+			AttributeTables:    string(jsonAttributeTables),
+			BehaviorTables:     string(jsonBehaviorTables),
+			ComputedAttributes: string(jsonComputedAttributes),
 		},
 	}
 	err = airflowAdapter.TriggerGenerateDagCreateMasterSegment(ctx, &request)
@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"github.com/APCS20-Thesis/Backend/api"
 	"github.com/APCS20-Thesis/Backend/internal/constants"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
 )
 
 func (s *Service) Login(ctx context.Context, request *api.LoginRequest) (*api.LoginResponse, error) {
@@ -96,3 +99,21 @@ COMMON AUTH FUNCTIONS
 func GetAccountUuidFromCtx(ctx context.Context) (string, error) {
 	return GetMetadata(ctx, constants.KeyAccountUuid)
 }
+
+// GetWriteKeyFromCtx reads the write key of an event source from the authorization header,
+// sent as basic auth with the write key as username and an empty password
+func GetWriteKeyFromCtx(ctx context.Context) (string, error) {
+	authorization, err := GetMetadata(ctx, constants.KeyAuthorization)
+	if err != nil {
+		return "", err
+	}
+	credentials, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(authorization, "Basic "))
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "Invalid write key")
+	}
+	writeKey, _, _ := strings.Cut(string(credentials), ":")
+	if writeKey == "" {
+		return "", status.Error(codes.Unauthenticated, "Invalid write key")
+	}
+	return writeKey, nil
+}
@@ -12,6 +12,8 @@ import (
 	data_destination "github.com/APCS20-Thesis/Backend/internal/service/business/data-destination"
 	data_source "github.com/APCS20-Thesis/Backend/internal/service/business/data-source"
 	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	event_source "github.com/APCS20-Thesis/Backend/internal/service/business/event-source"
+	identity_resolution "github.com/APCS20-Thesis/Backend/internal/service/business/identity-resolution"
 	predict_model "github.com/APCS20-Thesis/Backend/internal/service/business/predict-model"
 	"github.com/APCS20-Thesis/Backend/internal/service/business/segment"
 	"github.com/go-logr/logr"
@@ -19,16 +21,18 @@ import (
 )
 
 type Business struct {
-	db                      *gorm.DB
-	repository              *repository.Repository
-	AuthBusiness            auth.Business
-	DataSourceBusiness      data_source.Business
-	DataTableBusiness       data_table.Business
-	SegmentBusiness         segment.Business
-	DataDestinationBusiness data_destination.Business
-	ConnectionBusiness      connection.Business
-	PredictModelBusiness    predict_model.Business
-	DataActionBusiness      data_action.Business
+	db                         *gorm.DB
+	repository                 *repository.Repository
+	AuthBusiness               auth.Business
+	DataSourceBusiness         data_source.Business
+	DataTableBusiness          data_table.Business
+	SegmentBusiness            segment.Business
+	DataDestinationBusiness    data_destination.Business
+	ConnectionBusiness         connection.Business
+	PredictModelBusiness       predict_model.Business
+	DataActionBusiness         data_action.Business
+	IdentityResolutionBusiness identity_resolution.Business
+	EventSourceBusiness        event_source.Business
 }
 
 func NewBusiness(
@@ -42,15 +46,17 @@ func NewBusiness(
 ) *Business {
 	repo := repository.NewRepository(db)
 	return &Business{
-		db:                      db,
-		repository:              repo,
-		AuthBusiness:            auth.NewAuthBusiness(log, repo),
-		DataSourceBusiness:      data_source.NewDataSourceBusiness(db, log, repo, airflowAdapter, queryAdapter, config),
-		DataTableBusiness:       data_table.NewDataTableBusiness(log, repo, airflowAdapter, queryAdapter),
-		SegmentBusiness:         segment.NewSegmentBusiness(db, log, repo, airflowAdapter, queryAdapter, config),
-		DataDestinationBusiness: data_destination.NewDataDestinationBusiness(db, log, repo, gophishAdapter, queryAdapter, airflowAdapter, config),
-		ConnectionBusiness:      connection.NewConnectionBusiness(log, repo),
-		PredictModelBusiness:    predict_model.NewPredictModelBusiness(db, log, repo, airflowAdapter),
-		DataActionBusiness:      data_action.NewDataActionBusiness(db, log, repo, airflowAdapter),
+		db:                         db,
+		repository:                 repo,
+		AuthBusiness:               auth.NewAuthBusiness(log, repo),
+		DataSourceBusiness:         data_source.NewDataSourceBusiness(db, log, repo, airflowAdapter, queryAdapter, config),
+		DataTableBusiness:          data_table.NewDataTableBusiness(db, log, repo, airflowAdapter, queryAdapter, config),
+		SegmentBusiness:            segment.NewSegmentBusiness(db, log, repo, airflowAdapter, queryAdapter, config),
+		DataDestinationBusiness:    data_destination.NewDataDestinationBusiness(db, log, repo, gophishAdapter, queryAdapter, airflowAdapter, config),
+		ConnectionBusiness:         connection.NewConnectionBusiness(log, repo),
+		PredictModelBusiness:       predict_model.NewPredictModelBusiness(db, log, repo, airflowAdapter),
+		DataActionBusiness:         data_action.NewDataActionBusiness(db, log, repo, airflowAdapter),
+		IdentityResolutionBusiness: identity_resolution.NewIdentityResolutionBusiness(db, log, repo, airflowAdapter, queryAdapter, config),
+		EventSourceBusiness:        event_source.NewEventSourceBusiness(db, log, repo, queryAdapter, config),
 	}
 }
@@ -24,6 +24,12 @@ func (b business) ProcessNewDataActionRun(ctx context.Context, request *api.Trig
 	if dataAction.AccountUuid.String() != accountUuid {
 		return status.Error(codes.PermissionDenied, "user not have access to this data action")
 	}
+	if dataAction.ActionType == model.ActionType_ApplyRetention {
+		return status.Error(codes.FailedPrecondition, "retention runs are scheduled by the retention policy")
+	}
+	if dataAction.ActionType == model.ActionType_RFMAnalysis {
+		return status.Error(codes.FailedPrecondition, "rfm analysis runs follow the builds of the master segment, create a failed analysis again to retry it")
+	}
 
 	err = b.db.Transaction(func(tx *gorm.DB) error {
 		// trigger airflow
@@ -86,13 +92,19 @@ func (b business) ProcessGetListDataActionRuns(ctx context.Context, request *api
 			return nil, err
 		}
 		returnDataActionRuns = append(returnDataActionRuns, &api.DataActionRun{
-			Id:         dataActionRun.ID,
-			ActionId:   dataActionRun.ActionId,
-			ActionType: string(dataActionRun.ActionType),
-			Status:     string(dataActionRun.Status),
-			CreatedAt:  dataActionRun.CreatedAt.String(),
-			UpdatedAt:  dataActionRun.UpdatedAt.String(),
-			Metadata:   metadata,
+			Id:          dataActionRun.ID,
+			ActionId:    dataActionRun.ActionId,
+			ActionType:  string(dataActionRun.ActionType),
+			Status:      string(dataActionRun.Status),
+			CreatedAt:   dataActionRun.CreatedAt.String(),
+			UpdatedAt:   dataActionRun.UpdatedAt.String(),
+			Metadata:    metadata,
+			PassedCount: dataActionRun.PassedCount,
+			FailedCount: dataActionRun.FailedCount,
+			Error:       dataActionRun.Error,
+			// row counts of a deduplication
+			RowCountBefore: dataActionRun.RowCountBefore,
+			RowCountAfter:  dataActionRun.RowCountAfter,
 		})
 	}
 
@@ -176,6 +188,19 @@ func (b business) EnrichDataActionRunMetaData(ctx context.Context, dataActionRun
 			Name: masterSegment.Name,
 			Id:   masterSegment.ID,
 		}}, nil
+	case model.ActionType_RFMAnalysis:
+		masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, dataActionRun.ObjectId)
+		if err != nil {
+			return nil, err
+		}
+		return &api.DataActionRun_MetaData{
+			ObjectReference: &api.DataActionRun_ObjectReference{
+				Type: string(model.TargetTable_MasterSegment),
+				Name: masterSegment.Name,
+				Id:   masterSegment.ID,
+			},
+			MasterSegmentId: masterSegment.ID,
+		}, nil
 	case model.ActionType_CreateSegment, model.ActionType_ApplyPredictModel:
 		segment, err := b.repository.SegmentRepository.GetSegment(ctx, dataActionRun.ObjectId)
 		if err != nil {
@@ -189,6 +214,50 @@ func (b business) EnrichDataActionRunMetaData(ctx context.Context, dataActionRun
 			},
 			MasterSegmentId: segment.MasterSegmentId,
 		}, nil
+	case model.ActionType_IdentityResolution:
+		identityResolution, err := b.repository.IdentityResolutionRepository.GetIdentityResolution(ctx, dataActionRun.ObjectId)
+		if err != nil {
+			return nil, err
+		}
+		return &api.DataActionRun_MetaData{ObjectReference: &api.DataActionRun_ObjectReference{
+			Type: string(model.TargetTable_IdentityResolution),
+			Name: identityResolution.Name,
+			Id:   identityResolution.ID,
+		}}, nil
+	case model.ActionType_DeduplicateDataTable, model.ActionType_RestoreDataTable:
+		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, dataActionRun.ObjectId)
+		if err != nil {
+			return nil, err
+		}
+		return &api.DataActionRun_MetaData{ObjectReference: &api.DataActionRun_ObjectReference{
+			Type: string(model.TargetTable_DataTable),
+			Name: dataTable.Name,
+			Id:   dataTable.ID,
+		}}, nil
+	case model.ActionType_ApplyRetention:
+		if dataActionRun.TargetTable == string(model.TargetTable_BehaviorTable) {
+			behaviorTable, err := b.repository.SegmentRepository.GetBehaviorTable(ctx, &repository.GetBehaviorTableParams{Id: dataActionRun.ObjectId})
+			if err != nil {
+				return nil, err
+			}
+			return &api.DataActionRun_MetaData{
+				ObjectReference: &api.DataActionRun_ObjectReference{
+					Type: string(model.TargetTable_BehaviorTable),
+					Name: behaviorTable.Name,
+					Id:   behaviorTable.ID,
+				},
+				MasterSegmentId: behaviorTable.MasterSegmentId,
+			}, nil
+		}
+		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, dataActionRun.ObjectId)
+		if err != nil {
+			return nil, err
+		}
+		return &api.DataActionRun_MetaData{ObjectReference: &api.DataActionRun_ObjectReference{
+			Type: string(model.TargetTable_DataTable),
+			Name: dataTable.Name,
+			Id:   dataTable.ID,
+		}}, nil
 	case model.ActionType_TrainPredictModel:
 		predictModel, err := b.repository.PredictModelRepository.GetPredictModel(ctx, dataActionRun.ObjectId)
 		if err != nil {
@@ -267,11 +336,11 @@ func (b business) ProcessGetDataRunsProportion(ctx context.Context, accountUuid
 	}
 	for _, each := range results {
 		switch each.Type {
-		case model.ActionType_ImportDataFromMySQL, model.ActionType_ImportDataFromFile, model.ActionType_ImportDataFromS3:
+		case model.ActionType_ImportDataFromMySQL, model.ActionType_ImportDataFromFile, model.ActionType_ImportDataFromS3, model.ActionType_IdentityResolution, model.ActionType_DeduplicateDataTable, model.ActionType_RestoreDataTable, model.ActionType_ApplyRetention:
 			typeCount["source"] = typeCount["source"] + int32(each.Total)
 		case model.ActionType_ExportDataToS3CSV, model.ActionType_ExportToMySQL, model.ActionType_ExportGophish:
 			typeCount["destination"] = typeCount["destination"] + int32(each.Total)
-		case model.ActionType_CreateSegment, model.ActionType_CreateMasterSegment, model.ActionType_TrainPredictModel, model.ActionType_ApplyPredictModel:
+		case model.ActionType_CreateSegment, model.ActionType_CreateMasterSegment, model.ActionType_TrainPredictModel, model.ActionType_ApplyPredictModel, model.ActionType_RFMAnalysis:
 			typeCount["segmentation"] = typeCount["segmentation"] + int32(each.Total)
 		default:
 		}
@@ -21,6 +21,11 @@ type Business interface {
 	ProcessGetDataDestinationDetail(ctx context.Context, request *api.GetDataDestinationDetailRequest, accountUuid string) (*api.GetDataDestinationDetailResponse, error)
 
 	ProcessGetListDestinationMap(ctx context.Context, request *api.GetListDestinationMapRequest, accountUuid string) (*api.GetListDestinationMapResponse, error)
+
+	ProcessCreateSuppressionList(ctx context.Context, request *api.CreateSuppressionListRequest, accountUuid string) (*api.CreateSuppressionListResponse, error)
+	ProcessGetListSuppressionLists(ctx context.Context, request *api.GetListSuppressionListsRequest, accountUuid string) (*api.GetListSuppressionListsResponse, error)
+	ProcessUploadSuppressionEntries(ctx context.Context, request *api.UploadSuppressionEntriesRequest, accountUuid string) (*api.UploadSuppressionEntriesResponse, error)
+	ProcessDeleteSuppressionList(ctx context.Context, request *api.DeleteSuppressionListRequest, accountUuid string) (*api.DeleteSuppressionListResponse, error)
 }
 
 type business struct {
@@ -34,6 +34,7 @@ func (b business) ProcessGetListDataDestinations(ctx context.Context, request *a
 				Type:      string(modelDest.Type),
 				CreatedAt: modelDest.CreatedAt.String(),
 				UpdatedAt: modelDest.UpdatedAt.String(),
+				Channel:   modelDest.Channel,
 			}
 		}),
 	}, nil
@@ -70,5 +71,6 @@ func (b business) ProcessGetDataDestinationDetail(ctx context.Context, request *
 		Configurations: string(destination.Configurations.RawMessage),
 		CreatedAt:      destination.CreatedAt.String(),
 		UpdatedAt:      destination.UpdatedAt.String(),
+		Channel:        destination.Channel,
 	}, nil
 }
@@ -68,13 +68,14 @@ func (b business) GetDestinationTableMaps(ctx context.Context, destinationId int
 			}
 		}
 		destMappings = append(destMappings, &api.DestinationMappings{
-			Id:           mapping.ID,
-			Type:         mapType,
-			ObjectType:   objectType,
-			ObjectName:   mapping.TableName,
-			ObjectId:     mapping.TableId,
-			Mappings:     mappingOptions,
-			DataActionId: mapping.DataActionId,
+			Id:              mapping.ID,
+			Type:            mapType,
+			ObjectType:      objectType,
+			ObjectName:      mapping.TableName,
+			ObjectId:        mapping.TableId,
+			Mappings:        mappingOptions,
+			DataActionId:    mapping.DataActionId,
+			SuppressedCount: mapping.SuppressedCount,
 		})
 	}
 
@@ -99,13 +100,14 @@ func (b business) GetDestinationSegmentMaps(ctx context.Context, destinationId i
 			}
 		}
 		destMappings = append(destMappings, &api.DestinationMappings{
-			Id:           mapping.ID,
-			Type:         mapType,
-			ObjectType:   objectType,
-			ObjectName:   mapping.SegmentName,
-			ObjectId:     mapping.SegmentId,
-			Mappings:     mappingOptions,
-			DataActionId: mapping.DataActionId,
+			Id:              mapping.ID,
+			Type:            mapType,
+			ObjectType:      objectType,
+			ObjectName:      mapping.SegmentName,
+			ObjectId:        mapping.SegmentId,
+			Mappings:        mappingOptions,
+			DataActionId:    mapping.DataActionId,
+			SuppressedCount: mapping.SuppressedCount,
 		})
 	}
 
@@ -130,13 +132,14 @@ func (b business) GetDestinationMasterSegmentMaps(ctx context.Context, destinati
 			}
 		}
 		destMappings = append(destMappings, &api.DestinationMappings{
-			Id:           mapping.ID,
-			Type:         mapType,
-			ObjectType:   objectType,
-			ObjectName:   mapping.MasterSegmentName,
-			ObjectId:     mapping.MasterSegmentId,
-			Mappings:     mappingOptions,
-			DataActionId: mapping.DataActionId,
+			Id:              mapping.ID,
+			Type:            mapType,
+			ObjectType:      objectType,
+			ObjectName:      mapping.MasterSegmentName,
+			ObjectId:        mapping.MasterSegmentId,
+			Mappings:        mappingOptions,
+			DataActionId:    mapping.DataActionId,
+			SuppressedCount: mapping.SuppressedCount,
 		})
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
 	"github.com/APCS20-Thesis/Backend/utils"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
@@ -35,6 +36,23 @@ func (b business) CreateGophishUserGroupFromSegment(ctx context.Context, account
 	if err != nil {
 		return err
 	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, segment.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks", "masterSegmentId", segment.MasterSegmentId)
+		return err
+	}
+	// the emails are delivered to the mapped address, it is sent in clear whatever its mask
+	delete(masks, request.Mapping.Email)
+	condition, err := b.segmentExportCondition(ctx, &segment, request.MembershipChange)
+	if err != nil {
+		logger.Error(err, "cannot get membership changes of segment")
+		return err
+	}
+	suppression, err := b.suppressAudienceExport(ctx, accountUuid, request.Channel, segment.MasterSegmentId, condition)
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles", "masterSegmentId", segment.MasterSegmentId)
+		return err
+	}
 
 	config, err := json.Marshal(model.GophishDestinationConfiguration{
 		UserGroupName: request.Name,
@@ -77,6 +95,7 @@ func (b business) CreateGophishUserGroupFromSegment(ctx context.Context, account
 			Type:          model.DataDestinationType_GOPHISH,
 			Configuration: pqtype.NullRawMessage{RawMessage: config, Valid: config != nil},
 			ConnectionId:  0,
+			Channel:       request.Channel,
 		})
 		if err != nil {
 			logger.Error(err, "cannot create data destination")
@@ -84,9 +103,10 @@ func (b business) CreateGophishUserGroupFromSegment(ctx context.Context, account
 		}
 
 		destSegmentMap, err := b.repository.DestSegmentMapRepository.CreateDestinationSegmentMap(ctx, &repository.CreateDestinationSegmentMapParams{
-			SegmentId:      request.SegmentId,
-			DestinationId:  destination.ID,
-			MappingOptions: pqtype.NullRawMessage{RawMessage: jsonMappingOptions, Valid: jsonMappingOptions != nil},
+			SegmentId:       request.SegmentId,
+			DestinationId:   destination.ID,
+			MappingOptions:  pqtype.NullRawMessage{RawMessage: jsonMappingOptions, Valid: jsonMappingOptions != nil},
+			SuppressedCount: suppression.SuppressedCount,
 		})
 		if err != nil {
 			logger.Error(err, "cannot create destination segment map")
@@ -126,7 +146,7 @@ func (b business) CreateGophishUserGroupFromSegment(ctx context.Context, account
 		s3path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(segment.MasterSegmentId))
 
 		queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
-			Query: fmt.Sprintf("SELECT * FROM delta.`%s` WHERE %s;", s3path, segment.SqlCondition),
+			Query: fmt.Sprintf("SELECT * FROM delta.`%s` WHERE %s;", s3path, suppression.Condition),
 		})
 		if err != nil {
 			return err
@@ -134,6 +154,7 @@ func (b business) CreateGophishUserGroupFromSegment(ctx context.Context, account
 
 		targets := make([]map[string]string, 0, queryResponse.Count)
 		for _, data := range queryResponse.Data {
+			masks.MaskRow(data)
 			profile := make(map[string]string)
 			profile["email"] = fmt.Sprintf("%s", data[request.Mapping.Email])
 			if request.Mapping.FirstName != "" {
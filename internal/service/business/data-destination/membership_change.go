@@ -0,0 +1,16 @@
+package data_destination
+
+import (
+	"context"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	segment_business "github.com/APCS20-Thesis/Backend/internal/service/business/segment"
+)
+
+// segmentExportCondition selects the audience rows exported for the segment, only the profiles that entered or exited it at its latest build when membershipChange is set
+func (b business) segmentExportCondition(ctx context.Context, segment *model.Segment, membershipChange string) (string, error) {
+	if membershipChange == "" {
+		return segment.BuildSqlCondition(), nil
+	}
+	return segment_business.MembershipChangeCondition(ctx, b.repository, b.config.S3StorageConfig.Bucket, segment, model.MembershipChange(membershipChange))
+}
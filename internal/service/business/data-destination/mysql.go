@@ -7,6 +7,7 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
 	"github.com/APCS20-Thesis/Backend/utils"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
@@ -71,13 +72,25 @@ func (b business) ExportDataTableToMySQL(ctx context.Context, request *api.Expor
 		return err
 	}
 
+	masks, err := data_table.DataTableColumnMasks(ctx, b.repository, accountUuid, dataTable.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return err
+	}
+
+	suppression, err := b.suppressDataTableExport(ctx, accountUuid, request.Channel, dataTable)
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles")
+		return err
+	}
+
 	payload := &airflow.TriggerGenerateDagExportMySQLRequest{
 		Conf: airflow.DagExportMySQLConfig{
 			DagId:           dagId,
 			AccountUuid:     accountUuid,
 			DeltaTableName:  dataTable.Name,
 			MasterSegmentId: 0,
-			Condition:       "",
+			Condition:       suppression.Condition,
 			DatabaseConfiguration: airflow.DagExportMySQLDatabaseConfiguration{
 				Host:     mySQLConnection.Host,
 				Port:     mySQLConnection.Port,
@@ -86,6 +99,7 @@ func (b business) ExportDataTableToMySQL(ctx context.Context, request *api.Expor
 				Database: mySQLConnection.Database,
 			},
 			DestinationTableName: request.DestinationTableName,
+			MaskedColumns:        masks.SparkColumns(),
 		},
 	}
 	_, err = b.airflowAdapter.TriggerGenerateDagExportMySQL(ctx, payload)
@@ -116,6 +130,7 @@ func (b business) ExportDataTableToMySQL(ctx context.Context, request *api.Expor
 		Type:          model.DataDestinationType_MYSQL,
 		Configuration: pqtype.NullRawMessage{RawMessage: jsonMySQLDesConfig, Valid: jsonMySQLDesConfig != nil},
 		ConnectionId:  request.ConnectionId,
+		Channel:       request.Channel,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create data destination")
@@ -125,10 +140,11 @@ func (b business) ExportDataTableToMySQL(ctx context.Context, request *api.Expor
 
 	// 2. Create dest table map
 	dstTableMap, err := b.repository.DestTableMapRepository.CreateDestinationTableMap(ctx, &repository.CreateDestinationTableMapParams{
-		Tx:             tx,
-		TableId:        request.DataTableId,
-		DestinationId:  destination.ID,
-		MappingOptions: pqtype.NullRawMessage{RawMessage: jsonMappingOptions, Valid: jsonMappingOptions != nil},
+		Tx:              tx,
+		TableId:         request.DataTableId,
+		DestinationId:   destination.ID,
+		MappingOptions:  pqtype.NullRawMessage{RawMessage: jsonMappingOptions, Valid: jsonMappingOptions != nil},
+		SuppressedCount: suppression.SuppressedCount,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create destination table mapping")
@@ -190,13 +206,25 @@ func (b business) ExportMasterSegmentAudienceToMySQL(ctx context.Context, reques
 		return err
 	}
 
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, request.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return err
+	}
+
+	suppression, err := b.suppressAudienceExport(ctx, accountUuid, request.Channel, request.MasterSegmentId, "")
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles")
+		return err
+	}
+
 	payload := &airflow.TriggerGenerateDagExportMySQLRequest{
 		Conf: airflow.DagExportMySQLConfig{
 			DagId:           dagId,
 			AccountUuid:     accountUuid,
 			DeltaTableName:  "",
 			MasterSegmentId: request.MasterSegmentId,
-			Condition:       "",
+			Condition:       suppression.Condition,
 			DatabaseConfiguration: airflow.DagExportMySQLDatabaseConfiguration{
 				Host:     mySQLConnection.Host,
 				Port:     mySQLConnection.Port,
@@ -205,6 +233,7 @@ func (b business) ExportMasterSegmentAudienceToMySQL(ctx context.Context, reques
 				Database: mySQLConnection.Database,
 			},
 			DestinationTableName: request.DestinationTableName,
+			MaskedColumns:        masks.SparkColumns(),
 		},
 	}
 	_, err = b.airflowAdapter.TriggerGenerateDagExportMySQL(ctx, payload)
@@ -235,6 +264,7 @@ func (b business) ExportMasterSegmentAudienceToMySQL(ctx context.Context, reques
 		Type:          model.DataDestinationType_MYSQL,
 		Configuration: pqtype.NullRawMessage{RawMessage: jsonMySQLDesConfig, Valid: jsonMySQLDesConfig != nil},
 		ConnectionId:  request.ConnectionId,
+		Channel:       request.Channel,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create data destination")
@@ -248,6 +278,7 @@ func (b business) ExportMasterSegmentAudienceToMySQL(ctx context.Context, reques
 		MasterSegmentId: request.MasterSegmentId,
 		DestinationId:   destination.ID,
 		//MappingOptions:  pqtype.NullRawMessage{},
+		SuppressedCount: suppression.SuppressedCount,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create destination master segment mapping")
@@ -315,13 +346,30 @@ func (b business) ExportSegmentToMySQL(ctx context.Context, request *api.ExportT
 		return err
 	}
 
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, segment.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return err
+	}
+
+	condition, err := b.segmentExportCondition(ctx, &segment, request.MembershipChange)
+	if err != nil {
+		logger.Error(err, "cannot get membership changes of segment")
+		return err
+	}
+	suppression, err := b.suppressAudienceExport(ctx, accountUuid, request.Channel, segment.MasterSegmentId, condition)
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles")
+		return err
+	}
+
 	payload := &airflow.TriggerGenerateDagExportMySQLRequest{
 		Conf: airflow.DagExportMySQLConfig{
 			DagId:           dagId,
 			AccountUuid:     accountUuid,
 			DeltaTableName:  "",
 			MasterSegmentId: segment.MasterSegmentId,
-			Condition:       segment.SqlCondition,
+			Condition:       suppression.Condition,
 			DatabaseConfiguration: airflow.DagExportMySQLDatabaseConfiguration{
 				Host:     mySQLConnection.Host,
 				Port:     mySQLConnection.Port,
@@ -330,6 +378,7 @@ func (b business) ExportSegmentToMySQL(ctx context.Context, request *api.ExportT
 				Database: mySQLConnection.Database,
 			},
 			DestinationTableName: request.DestinationTableName,
+			MaskedColumns:        masks.SparkColumns(),
 		},
 	}
 	_, err = b.airflowAdapter.TriggerGenerateDagExportMySQL(ctx, payload)
@@ -360,6 +409,7 @@ func (b business) ExportSegmentToMySQL(ctx context.Context, request *api.ExportT
 		Type:          model.DataDestinationType_MYSQL,
 		Configuration: pqtype.NullRawMessage{RawMessage: jsonMySQLDesConfig, Valid: jsonMySQLDesConfig != nil},
 		ConnectionId:  request.ConnectionId,
+		Channel:       request.Channel,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create data destination")
@@ -373,6 +423,7 @@ func (b business) ExportSegmentToMySQL(ctx context.Context, request *api.ExportT
 		SegmentId:     request.SegmentId,
 		DestinationId: destination.ID,
 		//MappingOptions:  pqtype.NullRawMessage{},
+		SuppressedCount: suppression.SuppressedCount,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create destination segment mapping")
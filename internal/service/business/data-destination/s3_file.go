@@ -7,6 +7,7 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
 	"github.com/APCS20-Thesis/Backend/utils"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
@@ -76,6 +77,18 @@ func (b business) ExportDataTableToS3File(ctx context.Context, request *api.Expo
 		return err
 	}
 
+	masks, err := data_table.DataTableColumnMasks(ctx, b.repository, accountUuid, dataTable.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return err
+	}
+
+	suppression, err := b.suppressDataTableExport(ctx, accountUuid, request.Channel, dataTable)
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles")
+		return err
+	}
+
 	// BEGIN TRANSACTION
 	tx := b.db.Begin()
 
@@ -86,6 +99,7 @@ func (b business) ExportDataTableToS3File(ctx context.Context, request *api.Expo
 		Type:          model.DataDestinationType_S3FileCSV,
 		Configuration: pqtype.NullRawMessage{RawMessage: dstConfiguration, Valid: dstConfiguration != nil},
 		ConnectionId:  request.ConnectionId,
+		Channel:       request.Channel,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create data destination")
@@ -98,6 +112,7 @@ func (b business) ExportDataTableToS3File(ctx context.Context, request *api.Expo
 		TableId:       request.TableId,
 		DestinationId: destination.ID,
 		//MappingOptions: pqtype.NullRawMessage{},
+		SuppressedCount: suppression.SuppressedCount,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create destination table map")
@@ -106,8 +121,9 @@ func (b business) ExportDataTableToS3File(ctx context.Context, request *api.Expo
 	}
 
 	payload := airflow.ExportFileRequestConfig{
-		DagId: dagId,
-		Key:   tablePathKey,
+		DagId:     dagId,
+		Key:       tablePathKey,
+		Condition: suppression.Condition,
 		S3Configurations: airflow.S3Configurations{
 			AccessKeyId:     s3Configuration.AccessKeyId,
 			SecretAccessKey: s3Configuration.SecretAccessKey,
@@ -115,6 +131,7 @@ func (b business) ExportDataTableToS3File(ctx context.Context, request *api.Expo
 			Region:          s3Configuration.Region,
 			Key:             dstKey,
 		},
+		MaskedColumns: masks.SparkColumns(),
 	}
 	_, err = b.airflowAdapter.TriggerGenerateDagExportFile(ctx, &airflow.TriggerGenerateDagExportFileRequest{
 		Config: payload,
@@ -204,6 +221,23 @@ func (b business) ExportSegmentToS3File(ctx context.Context, request *api.Export
 	dagId := utils.GenerateDagId(accountUuid, model.ActionType_ExportDataToS3CSV)
 	audiencePathKey := utils.GenerateDeltaAudiencePath(segment.MasterSegmentId)
 
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, segment.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return err
+	}
+
+	condition, err := b.segmentExportCondition(ctx, &segment, request.MembershipChange)
+	if err != nil {
+		logger.Error(err, "cannot get membership changes of segment")
+		return err
+	}
+	suppression, err := b.suppressAudienceExport(ctx, accountUuid, request.Channel, segment.MasterSegmentId, condition)
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles")
+		return err
+	}
+
 	// BEGIN TRANSACTION
 	tx := b.db.Begin()
 
@@ -214,6 +248,7 @@ func (b business) ExportSegmentToS3File(ctx context.Context, request *api.Export
 		Type:          model.DataDestinationType_S3FileCSV,
 		Configuration: pqtype.NullRawMessage{RawMessage: dstConfiguration, Valid: dstConfiguration != nil},
 		ConnectionId:  request.ConnectionId,
+		Channel:       request.Channel,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create data destination")
@@ -226,6 +261,7 @@ func (b business) ExportSegmentToS3File(ctx context.Context, request *api.Export
 		SegmentId:     request.SegmentId,
 		DestinationId: destination.ID,
 		//MappingOptions: pqtype.NullRawMessage{},
+		SuppressedCount: suppression.SuppressedCount,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create destination segment map")
@@ -236,7 +272,7 @@ func (b business) ExportSegmentToS3File(ctx context.Context, request *api.Export
 	payload := airflow.ExportFileRequestConfig{
 		DagId:     dagId,
 		Key:       audiencePathKey,
-		Condition: segment.SqlCondition,
+		Condition: suppression.Condition,
 		S3Configurations: airflow.S3Configurations{
 			AccessKeyId:     s3Configuration.AccessKeyId,
 			SecretAccessKey: s3Configuration.SecretAccessKey,
@@ -244,6 +280,7 @@ func (b business) ExportSegmentToS3File(ctx context.Context, request *api.Export
 			Region:          s3Configuration.Region,
 			Key:             dstKey,
 		},
+		MaskedColumns: masks.SparkColumns(),
 	}
 	_, err = b.airflowAdapter.TriggerGenerateDagExportFile(ctx, &airflow.TriggerGenerateDagExportFileRequest{
 		Config: payload,
@@ -352,6 +389,18 @@ func (b business) ExportMasterSegmentToS3File(ctx context.Context, request *api.
 		return err
 	}
 
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, request.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return err
+	}
+
+	suppression, err := b.suppressAudienceExport(ctx, accountUuid, request.Channel, request.MasterSegmentId, "")
+	if err != nil {
+		logger.Error(err, "cannot get suppressed profiles")
+		return err
+	}
+
 	// BEGIN TRANSACTION
 	tx := b.db.Begin()
 
@@ -362,6 +411,7 @@ func (b business) ExportMasterSegmentToS3File(ctx context.Context, request *api.
 		Type:          model.DataDestinationType_S3FileCSV,
 		Configuration: pqtype.NullRawMessage{RawMessage: dstConfiguration, Valid: dstConfiguration != nil},
 		ConnectionId:  request.ConnectionId,
+		Channel:       request.Channel,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create data destination")
@@ -374,6 +424,7 @@ func (b business) ExportMasterSegmentToS3File(ctx context.Context, request *api.
 		MasterSegmentId: request.MasterSegmentId,
 		DestinationId:   destination.ID,
 		MappingOptions:  pqtype.NullRawMessage{RawMessage: jsonMappingOptions, Valid: jsonMappingOptions != nil},
+		SuppressedCount: suppression.SuppressedCount,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create destination segment map")
@@ -384,7 +435,7 @@ func (b business) ExportMasterSegmentToS3File(ctx context.Context, request *api.
 	payload := airflow.ExportFileRequestConfig{
 		DagId:     dagId,
 		Key:       audiencePathKey,
-		Condition: "",
+		Condition: suppression.Condition,
 		S3Configurations: airflow.S3Configurations{
 			AccessKeyId:     s3Configuration.AccessKeyId,
 			SecretAccessKey: s3Configuration.SecretAccessKey,
@@ -392,6 +443,7 @@ func (b business) ExportMasterSegmentToS3File(ctx context.Context, request *api.
 			Region:          s3Configuration.Region,
 			Key:             dstKey,
 		},
+		MaskedColumns: masks.SparkColumns(),
 	}
 	_, err = b.airflowAdapter.TriggerGenerateDagExportFile(ctx, &airflow.TriggerGenerateDagExportFileRequest{
 		Config: payload,
@@ -0,0 +1,431 @@
+package data_destination
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (b business) ProcessCreateSuppressionList(ctx context.Context, request *api.CreateSuppressionListRequest, accountUuid string) (*api.CreateSuppressionListResponse, error) {
+	logger := b.log.WithName("ProcessCreateSuppressionList").WithValues("name", request.Name, "channel", request.Channel)
+
+	var values []string
+	if request.SourceDataTableId > 0 {
+		if len(request.Entries) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "entries of a list synced from a data table cannot be uploaded")
+		}
+		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.SourceDataTableId)
+		if err != nil {
+			logger.Error(err, "cannot get source data table")
+			return nil, err
+		}
+		if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+			return nil, status.Error(codes.PermissionDenied, "No have permission with source data table")
+		}
+		schema, err := data_table.ParseSchema(dataTable.Schema)
+		if err != nil {
+			logger.Error(err, "cannot parse source data table schema")
+			return nil, err
+		}
+		if !slices.Contains(schemaColumnNames(schema), request.SourceColumn) {
+			return nil, status.Errorf(codes.InvalidArgument, "source column %s is not a column of the source data table", request.SourceColumn)
+		}
+	} else {
+		if request.SourceColumn != "" {
+			return nil, status.Error(codes.InvalidArgument, "source_column requires source_data_table_id")
+		}
+		var err error
+		values, err = normalizeSuppressionValues(request.Entries)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > model.SuppressionList_MaxEntries {
+			return nil, status.Errorf(codes.InvalidArgument, "a suppression list holds at most %d entries, sync larger lists from a data table", model.SuppressionList_MaxEntries)
+		}
+	}
+
+	list := &model.SuppressionList{
+		AccountUuid:       uuid.MustParse(accountUuid),
+		Name:              request.Name,
+		Channel:           model.Channel(request.Channel),
+		IdentifierColumn:  request.IdentifierColumn,
+		SourceDataTableId: request.SourceDataTableId,
+		SourceColumn:      request.SourceColumn,
+	}
+	err := b.repository.SuppressionListRepository.CreateSuppressionList(ctx, list, values)
+	if err != nil {
+		logger.Error(err, "cannot create suppression list")
+		return nil, err
+	}
+	if list.SourceDataTableId == 0 {
+		err = b.syncSuppressionEntries(ctx, accountUuid, list)
+		if err != nil {
+			logger.Error(err, "cannot sync suppression entries")
+			deleteErr := b.repository.SuppressionListRepository.DeleteSuppressionList(ctx, list.ID)
+			if deleteErr != nil {
+				// not return this error, the sync error is the one to report
+				logger.Error(deleteErr, "cannot delete suppression list", "id", list.ID)
+			}
+			return nil, err
+		}
+	}
+
+	return &api.CreateSuppressionListResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Id:      list.ID,
+	}, nil
+}
+
+func (b business) ProcessGetListSuppressionLists(ctx context.Context, request *api.GetListSuppressionListsRequest, accountUuid string) (*api.GetListSuppressionListsResponse, error) {
+	logger := b.log.WithName("ProcessGetListSuppressionLists")
+
+	lists, err := b.repository.SuppressionListRepository.ListSuppressionLists(ctx, uuid.MustParse(accountUuid))
+	if err != nil {
+		logger.Error(err, "cannot list suppression lists")
+		return nil, err
+	}
+	entryCounts := map[int64]int64{}
+	if len(lists) > 0 {
+		entryCounts, err = b.repository.SuppressionListRepository.CountSuppressionEntries(ctx, utils.Map(lists, func(list model.SuppressionList) int64 {
+			return list.ID
+		}))
+		if err != nil {
+			logger.Error(err, "cannot count suppression entries")
+			return nil, err
+		}
+	}
+
+	return &api.GetListSuppressionListsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		SuppressionLists: utils.Map(lists, func(list model.SuppressionList) *api.SuppressionList {
+			return &api.SuppressionList{
+				Id:                list.ID,
+				Name:              list.Name,
+				Channel:           string(list.Channel),
+				IdentifierColumn:  list.IdentifierColumn,
+				SourceDataTableId: list.SourceDataTableId,
+				SourceColumn:      list.SourceColumn,
+				EntryCount:        entryCounts[list.ID],
+				CreatedAt:         list.CreatedAt.String(),
+				UpdatedAt:         list.UpdatedAt.String(),
+			}
+		}),
+	}, nil
+}
+
+func (b business) ProcessUploadSuppressionEntries(ctx context.Context, request *api.UploadSuppressionEntriesRequest, accountUuid string) (*api.UploadSuppressionEntriesResponse, error) {
+	logger := b.log.WithName("ProcessUploadSuppressionEntries").WithValues("id", request.Id, "replace", request.Replace)
+
+	list, err := b.getSuppressionList(ctx, accountUuid, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get suppression list")
+		return nil, err
+	}
+	if list.SourceDataTableId > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "entries of a list synced from a data table cannot be uploaded")
+	}
+	values, err := normalizeSuppressionValues(request.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	entryCounts, err := b.repository.SuppressionListRepository.CountSuppressionEntries(ctx, []int64{list.ID})
+	if err != nil {
+		logger.Error(err, "cannot count suppression entries")
+		return nil, err
+	}
+	entryCount := int64(len(values))
+	if !request.Replace {
+		// values already in the list are counted twice, the check only rejects uploads that may exceed the cap
+		entryCount += entryCounts[list.ID]
+	}
+	if entryCount > model.SuppressionList_MaxEntries {
+		return nil, status.Errorf(codes.InvalidArgument, "a suppression list holds at most %d entries, sync larger lists from a data table", model.SuppressionList_MaxEntries)
+	}
+
+	err = b.repository.SuppressionListRepository.AddSuppressionEntries(ctx, &repository.AddSuppressionEntriesParams{
+		SuppressionListId: list.ID,
+		Values:            values,
+		Replace:           request.Replace,
+	})
+	if err != nil {
+		logger.Error(err, "cannot add suppression entries")
+		return nil, err
+	}
+	err = b.syncSuppressionEntries(ctx, accountUuid, list)
+	if err != nil {
+		logger.Error(err, "cannot sync suppression entries")
+		return nil, err
+	}
+	entryCounts, err = b.repository.SuppressionListRepository.CountSuppressionEntries(ctx, []int64{list.ID})
+	if err != nil {
+		logger.Error(err, "cannot count suppression entries")
+		return nil, err
+	}
+
+	return &api.UploadSuppressionEntriesResponse{
+		Code:       int32(code.Code_OK),
+		Message:    "Success",
+		EntryCount: entryCounts[list.ID],
+	}, nil
+}
+
+func (b business) ProcessDeleteSuppressionList(ctx context.Context, request *api.DeleteSuppressionListRequest, accountUuid string) (*api.DeleteSuppressionListResponse, error) {
+	logger := b.log.WithName("ProcessDeleteSuppressionList").WithValues("id", request.Id)
+
+	list, err := b.getSuppressionList(ctx, accountUuid, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get suppression list")
+		return nil, err
+	}
+	if list.SourceDataTableId == 0 {
+		path, err := b.createSuppressionTable(ctx, accountUuid)
+		if err != nil {
+			logger.Error(err, "cannot create suppression table")
+			return nil, err
+		}
+		_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+			Query: fmt.Sprintf("DELETE FROM delta.`%s` WHERE %s = %d", path, model.SuppressionColumn_SuppressionListId, list.ID),
+		})
+		if err != nil {
+			logger.Error(err, "cannot delete suppression entries")
+			return nil, err
+		}
+	}
+	err = b.repository.SuppressionListRepository.DeleteSuppressionList(ctx, list.ID)
+	if err != nil {
+		logger.Error(err, "cannot delete suppression list")
+		return nil, err
+	}
+
+	return &api.DeleteSuppressionListResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) getSuppressionList(ctx context.Context, accountUuid string, id int64) (*model.SuppressionList, error) {
+	list, err := b.repository.SuppressionListRepository.GetSuppressionList(ctx, uuid.MustParse(accountUuid), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "Not found suppression list")
+		}
+		return nil, err
+	}
+	return list, nil
+}
+
+// syncSuppressionEntries replaces the rows of the list in the suppression delta table with its uploaded entries
+func (b business) syncSuppressionEntries(ctx context.Context, accountUuid string, list *model.SuppressionList) error {
+	path, err := b.createSuppressionTable(ctx, accountUuid)
+	if err != nil {
+		return err
+	}
+	values, err := b.repository.SuppressionListRepository.ListSuppressionEntryValues(ctx, list.ID)
+	if err != nil {
+		return err
+	}
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("DELETE FROM delta.`%s` WHERE %s = %d", path, model.SuppressionColumn_SuppressionListId, list.ID),
+	})
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	rows := utils.Map(values, func(value string) string {
+		return fmt.Sprintf("(%d, %s, %s, %s)", list.ID, utils.QuoteSparkString(string(list.Channel)),
+			utils.QuoteSparkString(list.IdentifierColumn), utils.QuoteSparkString(value))
+	})
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("INSERT INTO delta.`%s` VALUES %s", path, strings.Join(rows, ", ")),
+	})
+	return err
+}
+
+// createSuppressionTable returns the location of the suppression delta table of the account, created when missing
+func (b business) createSuppressionTable(ctx context.Context, accountUuid string) (string, error) {
+	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSuppressionPath(accountUuid))
+	_, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("CREATE TABLE IF NOT EXISTS delta.`%s` (%s BIGINT, %s STRING, %s STRING, %s STRING) USING DELTA",
+			path, model.SuppressionColumn_SuppressionListId, model.SuppressionColumn_Channel,
+			model.SuppressionColumn_IdentifierColumn, model.SuppressionColumn_Value),
+	})
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// normalizeSuppressionValues trims and lower-cases the values the way the identifiers of the exported rows are compared
+func normalizeSuppressionValues(entries []string) ([]string, error) {
+	values := make([]string, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		value := strings.ToLower(strings.TrimSpace(entry))
+		if value == "" || seen[value] {
+			continue
+		}
+		if len(value) > 255 {
+			return nil, status.Error(codes.InvalidArgument, "suppression entries are at most 255 characters")
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// exportSuppression is the part of an export filtered out for the channel of its destination
+type exportSuppression struct {
+	// Condition selects the exported rows, empty exports every row
+	Condition       string
+	SuppressedCount int64
+}
+
+// suppressDataTableExport applies the suppression lists of the channel, data tables have no consent columns
+func (b business) suppressDataTableExport(ctx context.Context, accountUuid string, channel string, dataTable *model.DataTable) (*exportSuppression, error) {
+	schema, err := data_table.ParseSchema(dataTable.Schema)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaTablePath(accountUuid, dataTable.Name))
+	return b.suppressExport(ctx, accountUuid, channel, path, schemaColumnNames(schema), nil, "")
+}
+
+// suppressAudienceExport applies the suppression lists and the consent column of the channel to the audience rows selected by condition
+func (b business) suppressAudienceExport(ctx context.Context, accountUuid string, channel string, masterSegmentId int64, condition string) (*exportSuppression, error) {
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegmentId})
+	if err != nil {
+		return nil, err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	if audienceTable.BuildConfiguration.Valid {
+		err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+		if err != nil {
+			return nil, err
+		}
+	}
+	schema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegmentId))
+	return b.suppressExport(ctx, accountUuid, channel, path, schemaColumnNames(schema), configuration.ConsentColumns, condition)
+}
+
+func (b business) suppressExport(ctx context.Context, accountUuid string, channel string, path string, columns []string, consentColumns []*api.ConsentColumn, condition string) (*exportSuppression, error) {
+	suppressed, err := b.suppressedCondition(ctx, accountUuid, channel, columns, consentColumns)
+	if err != nil {
+		return nil, err
+	}
+	if suppressed == "" {
+		return &exportSuppression{Condition: condition}, nil
+	}
+
+	selected := "true"
+	if condition != "" {
+		selected = "(" + condition + ")"
+	}
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT COUNT(*) AS count FROM delta.`%s` WHERE %s AND (%s);", path, selected, suppressed),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var suppressedCount int64
+	if len(queryResponse.Data) > 0 {
+		if count, ok := queryResponse.Data[0]["count"].(float64); ok {
+			suppressedCount = int64(count)
+		}
+	}
+
+	return &exportSuppression{
+		Condition:       fmt.Sprintf("%s AND NOT (%s)", selected, suppressed),
+		SuppressedCount: suppressedCount,
+	}, nil
+}
+
+// suppressedCondition selects the rows in a suppression list of the channel or without its consent,
+// lists without a channel apply to every export and lists on a column missing from the export are skipped.
+// The condition reads the entries from delta tables, every run of the export applies the lists as they are at the run
+func (b business) suppressedCondition(ctx context.Context, accountUuid string, channel string, columns []string, consentColumns []*api.ConsentColumn) (string, error) {
+	lists, err := b.repository.SuppressionListRepository.ListSuppressionLists(ctx, uuid.MustParse(accountUuid))
+	if err != nil {
+		return "", err
+	}
+
+	var conditions, uploadedColumns []string
+	for _, list := range lists {
+		if list.Channel != "" && string(list.Channel) != channel {
+			continue
+		}
+		if !slices.Contains(columns, list.IdentifierColumn) {
+			continue
+		}
+		column := utils.QuoteSparkIdentifier(list.IdentifierColumn)
+		if list.SourceDataTableId == 0 {
+			// the uploaded lists on a column share one lookup, lists uploaded after the export apply too
+			if !slices.Contains(uploadedColumns, list.IdentifierColumn) {
+				uploadedColumns = append(uploadedColumns, list.IdentifierColumn)
+			}
+			continue
+		}
+		// a deleted source table fails the export rather than export the profiles it suppressed
+		sourceTable, err := b.repository.DataTableRepository.GetDataTable(ctx, list.SourceDataTableId)
+		if err != nil {
+			return "", err
+		}
+		sourceColumn := utils.QuoteSparkIdentifier(list.SourceColumn)
+		values := fmt.Sprintf("SELECT lower(trim(CAST(%s AS STRING))) FROM delta.`s3a://%s/%s` WHERE %s IS NOT NULL",
+			sourceColumn, b.config.S3StorageConfig.Bucket, utils.GenerateDeltaTablePath(accountUuid, sourceTable.Name), sourceColumn)
+		conditions = append(conditions, fmt.Sprintf("(%s IS NOT NULL AND lower(trim(CAST(%s AS STRING))) IN (%s))", column, column, values))
+	}
+
+	if len(uploadedColumns) > 0 {
+		path, err := b.createSuppressionTable(ctx, accountUuid)
+		if err != nil {
+			return "", err
+		}
+		for _, identifierColumn := range uploadedColumns {
+			column := utils.QuoteSparkIdentifier(identifierColumn)
+			values := fmt.Sprintf("SELECT %s FROM delta.`%s` WHERE %s = %s AND %s IN ('', %s)",
+				model.SuppressionColumn_Value, path, model.SuppressionColumn_IdentifierColumn, utils.QuoteSparkString(identifierColumn),
+				model.SuppressionColumn_Channel, utils.QuoteSparkString(channel))
+			conditions = append(conditions, fmt.Sprintf("(%s IS NOT NULL AND lower(trim(CAST(%s AS STRING))) IN (%s))", column, column, values))
+		}
+	}
+
+	for _, consentColumn := range consentColumns {
+		if consentColumn.Channel != channel || !slices.Contains(columns, consentColumn.ColumnName) {
+			continue
+		}
+		// profiles without a consent value have not consented, only an explicit opt-in exports them
+		conditions = append(conditions, fmt.Sprintf("NOT coalesce(CAST(%s AS BOOLEAN), false)", utils.QuoteSparkIdentifier(consentColumn.ColumnName)))
+	}
+
+	return strings.Join(conditions, " OR "), nil
+}
+
+func schemaColumnNames(schema []model.SchemaUnit) []string {
+	return utils.Map(schema, func(column model.SchemaUnit) string {
+		return column.ColumnName
+	})
+}
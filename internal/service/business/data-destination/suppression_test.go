@@ -0,0 +1,108 @@
+package data_destination
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalizeSuppressionValues(t *testing.T) {
+	values, err := normalizeSuppressionValues([]string{" An@CDP.io", "an@cdp.io ", "", "   ", "0901234567"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(values, []string{"an@cdp.io", "0901234567"}) {
+		t.Errorf("normalizeSuppressionValues() = %q", values)
+	}
+	if _, err := normalizeSuppressionValues([]string{strings.Repeat("a", 256)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("a value of 256 characters: got %v, want InvalidArgument", err)
+	}
+}
+
+type suppressionListRepository struct {
+	repository.SuppressionListRepository
+	lists []model.SuppressionList
+}
+
+func (r suppressionListRepository) ListSuppressionLists(ctx context.Context, accountUuid uuid.UUID) ([]model.SuppressionList, error) {
+	return r.lists, nil
+}
+
+type sourceTableRepository struct {
+	repository.DataTableRepository
+}
+
+func (r sourceTableRepository) GetDataTable(ctx context.Context, id int64) (*model.DataTable, error) {
+	return &model.DataTable{ID: id, Name: "unsubscribed"}, nil
+}
+
+type suppressionQueryAdapter struct {
+	query.QueryAdapter
+	queries []string
+}
+
+func (a *suppressionQueryAdapter) QueryRawSQL(ctx context.Context, request *query.QueryRawSQLRequest) (*query.QueryRawSQLResponse, error) {
+	a.queries = append(a.queries, request.Query)
+	return &query.QueryRawSQLResponse{Data: []map[string]any{{"count": float64(3)}}}, nil
+}
+
+func TestSuppressExport(t *testing.T) {
+	accountUuid := uuid.NewString()
+	adapter := &suppressionQueryAdapter{}
+	b := business{
+		repository: &repository.Repository{
+			SuppressionListRepository: suppressionListRepository{lists: []model.SuppressionList{
+				{ID: 1, Channel: model.Channel_Email, IdentifierColumn: "email"},
+				{ID: 2, IdentifierColumn: "email"},
+				{ID: 3, Channel: model.Channel_Sms, IdentifierColumn: "phone"},
+				{ID: 4, Channel: model.Channel_Email, IdentifierColumn: "device_id"},
+				{ID: 5, IdentifierColumn: "phone", SourceDataTableId: 9, SourceColumn: "mobile"},
+			}},
+			DataTableRepository: sourceTableRepository{},
+		},
+		queryAdapter: adapter,
+		config:       &config.Config{S3StorageConfig: config.S3StorageConfig{Bucket: "bucket"}},
+	}
+	consentColumns := []*api.ConsentColumn{
+		{Channel: string(model.Channel_Email), ColumnName: "email_opt_in"},
+		{Channel: string(model.Channel_Sms), ColumnName: "sms_opt_in"},
+	}
+
+	suppression, err := b.suppressExport(context.Background(), accountUuid, string(model.Channel_Email), "s3a://bucket/audience",
+		[]string{"email", "phone", "email_opt_in", "sms_opt_in"}, consentColumns, "`age` > 18")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := "s3a://bucket/suppression/" + accountUuid + "/entries"
+	suppressed := []string{
+		// the list of a source table reads its rows at every export
+		"(`phone` IS NOT NULL AND lower(trim(CAST(`phone` AS STRING))) IN (SELECT lower(trim(CAST(`mobile` AS STRING))) FROM delta.`s3a://bucket/data/bronze/" + accountUuid + "/unsubscribed` WHERE `mobile` IS NOT NULL))",
+		// the email lists of the channel and of every channel share one lookup, the sms and device lists do not apply
+		"(`email` IS NOT NULL AND lower(trim(CAST(`email` AS STRING))) IN (SELECT value FROM delta.`" + entries + "` WHERE identifier_column = 'email' AND channel IN ('', 'email')))",
+		"NOT coalesce(CAST(`email_opt_in` AS BOOLEAN), false)",
+	}
+	want := "(`age` > 18) AND NOT (" + strings.Join(suppressed, " OR ") + ")"
+	if suppression.Condition != want {
+		t.Errorf("export condition =\n%s\nwant\n%s", suppression.Condition, want)
+	}
+	if suppression.SuppressedCount != 3 || !strings.HasPrefix(adapter.queries[len(adapter.queries)-1], "SELECT COUNT(*) AS count FROM delta.`s3a://bucket/audience` WHERE (`age` > 18) AND (") {
+		t.Errorf("suppressed %d rows by %q", suppression.SuppressedCount, adapter.queries)
+	}
+
+	// an export without list nor consent on its columns is not counted
+	adapter.queries = nil
+	suppression, err = b.suppressExport(context.Background(), accountUuid, string(model.Channel_Push), "s3a://bucket/audience", []string{"device_id"}, consentColumns, "")
+	if err != nil || suppression.Condition != "" || suppression.SuppressedCount != 0 || len(adapter.queries) != 0 {
+		t.Errorf("push export = %+v, %v after %q", suppression, err, adapter.queries)
+	}
+}
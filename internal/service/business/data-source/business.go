@@ -24,6 +24,7 @@ type Business interface {
 
 	ProcessImportCsvFromS3(ctx context.Context, request *api.ImportCsvFromS3Request, accountUuid string, dateTime string) error
 	ProcessImportFromMySQLSource(ctx context.Context, request *api.ImportFromMySQLSourceRequest, accountUuid uuid.UUID) error
+	ProcessPreviewFile(ctx context.Context, request *api.PreviewFileRequest, accountUuid string) (*api.PreviewFileResponse, error)
 
 	GetListSourceTableMappings(ctx context.Context, request *api.GetListSourceTableMapRequest) (*api.GetListSourceTableMapResponse, error)
 
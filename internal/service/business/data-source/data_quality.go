@@ -0,0 +1,85 @@
+package data_source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+)
+
+// buildDagDataQuality returns nil when the table has no data quality rules
+func buildDagDataQuality(dataTable *model.DataTable) (*airflow.DagDataQualityConfiguration, error) {
+	if dataTable == nil || !dataTable.QualityConfiguration.Valid || dataTable.QualityConfiguration.RawMessage == nil {
+		return nil, nil
+	}
+	var qualityConfiguration model.DataQualityConfiguration
+	err := json.Unmarshal(dataTable.QualityConfiguration.RawMessage, &qualityConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if len(qualityConfiguration.Rules) == 0 {
+		return nil, nil
+	}
+
+	accountUuid := dataTable.AccountUuid.String()
+	return &airflow.DagDataQualityConfiguration{
+		Rules:          qualityConfiguration.Rules,
+		ErrorThreshold: qualityConfiguration.ErrorThreshold,
+		QuarantineKey:  utils.GenerateDeltaQuarantinePath(accountUuid, dataTable.Name),
+		ReportKey:      utils.GenerateDeltaQualityReportPath(accountUuid, dataTable.Name),
+	}, nil
+}
+
+// syncImportQualityReport copies the passed/failed counts written by the dag to the latest run of the action
+func (b business) syncImportQualityReport(ctx context.Context, dataActionId int64, tableId int64) error {
+	logger := b.log.WithName("syncImportQualityReport").WithValues("dataActionId", dataActionId, "tableId", tableId)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, tableId)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return err
+	}
+	dataQuality, err := buildDagDataQuality(dataTable)
+	if err != nil {
+		logger.Error(err, "cannot parse quality configuration")
+		return err
+	}
+	if dataQuality == nil {
+		return nil
+	}
+
+	dataActionRun, err := b.repository.DataActionRunRepository.GetLatestDataActionRun(ctx, dataActionId)
+	if err != nil {
+		logger.Error(err, "cannot get latest data action run")
+		return err
+	}
+	if dataActionRun.DagRunId == "" {
+		return nil
+	}
+
+	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, dataQuality.ReportKey)
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT passed_count, failed_count FROM delta.`%s` WHERE dag_run_id = '%s'", path, dataActionRun.DagRunId),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query quality report")
+		return err
+	}
+	if len(queryResponse.Data) == 0 {
+		return nil
+	}
+
+	passedCount, _ := queryResponse.Data[0]["passed_count"].(float64)
+	failedCount, _ := queryResponse.Data[0]["failed_count"].(float64)
+	err = b.repository.DataActionRunRepository.UpdateDataActionRunQualityCounts(ctx, dataActionRun.ID, int64(passedCount), int64(failedCount))
+	if err != nil {
+		logger.Error(err, "cannot update data action run quality counts")
+		return err
+	}
+
+	return nil
+}
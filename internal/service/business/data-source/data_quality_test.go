@@ -0,0 +1,53 @@
+package data_source
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+func TestBuildDagDataQuality(t *testing.T) {
+	accountUuid := uuid.MustParse("6f1c2a3e-7d1b-4a51-9e0f-1c2d3e4f5a6b")
+	dataTable := func(configuration *model.DataQualityConfiguration) *model.DataTable {
+		table := &model.DataTable{Name: "customers", AccountUuid: accountUuid}
+		if configuration != nil {
+			raw, err := json.Marshal(configuration)
+			if err != nil {
+				t.Fatal(err)
+			}
+			table.QualityConfiguration = pqtype.NullRawMessage{RawMessage: raw, Valid: true}
+		}
+		return table
+	}
+
+	for _, table := range []*model.DataTable{nil, dataTable(nil), dataTable(&model.DataQualityConfiguration{ErrorThreshold: 0.1})} {
+		dataQuality, err := buildDagDataQuality(table)
+		if err != nil || dataQuality != nil {
+			t.Errorf("a table without rules gives %+v, %v, want no data quality", dataQuality, err)
+		}
+	}
+
+	rules := []*api.DataQualityRule{{Type: string(model.DataQualityRuleType_NotNull), ColumnName: "email"}}
+	dataQuality, err := buildDagDataQuality(dataTable(&model.DataQualityConfiguration{Rules: rules, ErrorThreshold: 0.05}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dataQuality.Rules) != 1 || dataQuality.Rules[0].ColumnName != "email" || dataQuality.ErrorThreshold != 0.05 {
+		t.Errorf("rules %v and threshold %v are not the configured ones", dataQuality.Rules, dataQuality.ErrorThreshold)
+	}
+	// the quarantine and the report sit next to the delta table of the data table
+	tablePath := utils.GenerateDeltaTablePath(accountUuid.String(), "customers")
+	if dataQuality.QuarantineKey != tablePath+"_quarantine" || dataQuality.ReportKey != tablePath+"_quality_report" {
+		t.Errorf("quarantine key %s and report key %s are not next to %s", dataQuality.QuarantineKey, dataQuality.ReportKey, tablePath)
+	}
+
+	_, err = buildDagDataQuality(&model.DataTable{QualityConfiguration: pqtype.NullRawMessage{RawMessage: []byte("{"), Valid: true}})
+	if err == nil {
+		t.Error("a malformed quality configuration is accepted")
+	}
+}
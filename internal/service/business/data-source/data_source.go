@@ -7,6 +7,7 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 	"google.golang.org/genproto/googleapis/rpc/code"
@@ -116,6 +117,14 @@ func (b business) GetListSourceTableMappings(ctx context.Context, request *api.G
 				return nil, err
 			}
 		}
+		var deduplication *api.DeduplicationConfiguration
+		if modelMap.DedupeConfiguration.Valid {
+			err := json.Unmarshal(modelMap.DedupeConfiguration.RawMessage, &deduplication)
+			if err != nil {
+				b.log.WithName("GetListSourceTableMappings").Error(err, "cannot unmarshal dedupe configuration", "sourceTableMapId", modelMap.ID)
+				return nil, err
+			}
+		}
 		returnMaps = append(returnMaps, &api.SourceTableMap{
 			Id: modelMap.ID,
 			Table: &api.EnrichedTable{
@@ -128,6 +137,8 @@ func (b business) GetListSourceTableMappings(ctx context.Context, request *api.G
 				Type: string(modelMap.SourceType),
 			},
 			Mappings: mappingOptions,
+			// deduplication applied to each import
+			Deduplication: deduplication,
 		})
 	}
 
@@ -185,6 +196,37 @@ func (b business) SyncOnImportFromSourceSuccess(ctx context.Context, dataActionI
 		return err
 	}
 
+	err = data_table.RecordSchemaVersion(ctx, b.repository, sourceTableMap.TableId, schema, dataActionId)
+	if err != nil {
+		b.log.WithName("SyncOnImportFromSourceSuccess").Error(err, "cannot record schema version", "TableId", sourceTableMap.TableId)
+		// not return this error, the data table is up to date
+	}
+
+	err = b.syncImportQualityReport(ctx, dataActionId, sourceTableMap.TableId)
+	if err != nil {
+		b.log.WithName("SyncOnImportFromSourceSuccess").Error(err, "cannot sync data quality report", "TableId", sourceTableMap.TableId)
+		// not return this error, the data table is up to date
+	}
+
+	err = b.syncImportDeduplicationReport(ctx, dataActionId, sourceTableMap)
+	if err != nil {
+		b.log.WithName("SyncOnImportFromSourceSuccess").Error(err, "cannot sync deduplication report", "TableId", sourceTableMap.TableId)
+		// not return this error, the data table is up to date
+	}
+
+	// profiling is best-effort, ProfileDataTable refreshes the profile on demand
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, sourceTableMap.TableId)
+	if err != nil {
+		b.log.WithName("SyncOnImportFromSourceSuccess").Error(err, "cannot get data table", "TableId", sourceTableMap.TableId)
+		// not return this error, the data table is up to date
+		return nil
+	}
+	_, err = data_table.RefreshDataTableProfile(ctx, b.repository, b.queryAdapter, b.config.S3StorageConfig.Bucket, dataTable)
+	if err != nil {
+		b.log.WithName("SyncOnImportFromSourceSuccess").Error(err, "cannot refresh data table profile", "TableId", sourceTableMap.TableId)
+		// not return this error, the data table is up to date
+	}
+
 	return nil
 }
 
@@ -206,5 +248,11 @@ func (b business) SynOnImportFromSourceFailed(ctx context.Context, dataActionId
 		b.log.WithName("SynOnImportFromSourceFailed").Error(err, "failed to update source status", "sourceTableMapId", dataAction.ObjectId)
 		return err
 	}
+	// the run may have failed because rejected rows are above the error threshold
+	err = b.syncImportQualityReport(ctx, dataActionId, sourceTableMap.TableId)
+	if err != nil {
+		b.log.WithName("SynOnImportFromSourceFailed").Error(err, "cannot sync data quality report", "sourceTableMapId", dataAction.ObjectId)
+		// not return this error, the data source is marked failed
+	}
 	return nil
 }
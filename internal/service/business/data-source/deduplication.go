@@ -0,0 +1,49 @@
+package data_source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// buildImportDeduplication validates the dedupe on import setting against the imported columns,
+// it returns nil when the source table map does not dedupe on import
+func buildImportDeduplication(configuration *api.DeduplicationConfiguration, mappingOptions []*api.MappingOptionItem, accountUuid string, tableName string) (*airflow.DagDeduplicationConfiguration, pqtype.NullRawMessage, error) {
+	if configuration == nil {
+		return nil, pqtype.NullRawMessage{}, nil
+	}
+	columns := utils.Map(mappingOptions, func(mappingOption *api.MappingOptionItem) string { return mappingOption.DestinationFieldName })
+	err := data_table.ValidateDeduplicationConfiguration(configuration, columns)
+	if err != nil {
+		return nil, pqtype.NullRawMessage{}, err
+	}
+	jsonConfiguration, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, pqtype.NullRawMessage{}, err
+	}
+
+	return data_table.BuildDagDeduplication(accountUuid, tableName, configuration),
+		pqtype.NullRawMessage{RawMessage: jsonConfiguration, Valid: true}, nil
+}
+
+// syncImportDeduplicationReport copies the row counts before/after the dedupe on import to the latest run of the action
+func (b business) syncImportDeduplicationReport(ctx context.Context, dataActionId int64, sourceTableMap *model.SourceTableMap) error {
+	if !sourceTableMap.DedupeConfiguration.Valid || sourceTableMap.DedupeConfiguration.RawMessage == nil {
+		return nil
+	}
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, sourceTableMap.TableId)
+	if err != nil {
+		return err
+	}
+
+	return data_table.SyncDeduplicationReport(ctx, b.repository, b.queryAdapter,
+		fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaDeduplicationReportPath(dataTable.AccountUuid.String(), dataTable.Name)),
+		dataActionId)
+}
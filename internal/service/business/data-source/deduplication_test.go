@@ -0,0 +1,56 @@
+package data_source
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBuildImportDeduplication(t *testing.T) {
+	mappingOptions := []*api.MappingOptionItem{
+		{SourceFieldName: "Email", DestinationFieldName: "email"},
+		{SourceFieldName: "Updated", DestinationFieldName: "updated_at"},
+		{SourceFieldName: "Phone", DestinationFieldName: "phone"},
+	}
+
+	deduplication, stored, err := buildImportDeduplication(nil, mappingOptions, "account", "customers")
+	if err != nil || deduplication != nil || stored.Valid {
+		t.Fatalf("an import without dedupe gives %+v, %+v, %v", deduplication, stored, err)
+	}
+
+	configuration := &api.DeduplicationConfiguration{KeyColumns: []string{"email", "phone"}, Survivorship: "latest", TimestampColumn: "updated_at"}
+	deduplication, stored, err = buildImportDeduplication(configuration, mappingOptions, "account", "customers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(deduplication.KeyColumns, []string{"email", "phone"}) || deduplication.Survivorship != "latest" || deduplication.TimestampColumn != "updated_at" {
+		t.Errorf("dag deduplication %+v is not the configuration", deduplication)
+	}
+	if deduplication.ReportKey != utils.GenerateDeltaDeduplicationReportPath("account", "customers") {
+		t.Errorf("report key %s is not the report of the table", deduplication.ReportKey)
+	}
+	// the source table map keeps the configuration to show it and to read the report of the next imports
+	var storedConfiguration api.DeduplicationConfiguration
+	if !stored.Valid || json.Unmarshal(stored.RawMessage, &storedConfiguration) != nil || storedConfiguration.TimestampColumn != "updated_at" {
+		t.Errorf("stored configuration %s is not the configuration", stored.RawMessage)
+	}
+
+	// the columns are the destination columns of the mapping, not the source ones
+	_, _, err = buildImportDeduplication(&api.DeduplicationConfiguration{KeyColumns: []string{"Email"}, Survivorship: "first_seen"}, mappingOptions, "account", "customers")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("key of a source column name: got %v, want InvalidArgument", err)
+	}
+	_, _, err = buildImportDeduplication(&api.DeduplicationConfiguration{KeyColumns: []string{"email"}, Survivorship: "latest"}, mappingOptions, "account", "customers")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("latest survivorship without timestamp: got %v, want InvalidArgument", err)
+	}
+	_, _, err = buildImportDeduplication(&api.DeduplicationConfiguration{KeyColumns: []string{"email"}, Survivorship: "latest", TimestampColumn: "created_at"}, mappingOptions, "account", "customers")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("timestamp column not imported: got %v, want InvalidArgument", err)
+	}
+}
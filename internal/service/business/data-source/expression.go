@@ -0,0 +1,183 @@
+package data_source
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// expressionFunctions are the scalar functions an expression transform may call, none of them reads a table or a file
+var expressionFunctions = []string{
+	"abs", "ceil", "ceiling", "floor", "round", "bround", "sqrt", "pow", "power", "exp", "ln", "log", "log10", "log2",
+	"mod", "pmod", "sign", "signum", "greatest", "least", "isnan", "nanvl",
+	"coalesce", "nullif", "nvl", "nvl2", "ifnull", "if", "isnull", "isnotnull",
+	"cast", "try_cast", "string", "int", "bigint", "double", "float", "boolean", "date", "timestamp", "decimal",
+	"concat", "concat_ws", "substring", "substr", "left", "right", "length", "char_length", "character_length",
+	"lower", "upper", "lcase", "ucase", "initcap", "trim", "ltrim", "rtrim", "btrim", "lpad", "rpad", "replace",
+	"regexp_replace", "regexp_extract", "split", "split_part", "instr", "locate", "position", "reverse", "repeat",
+	"translate", "format_number", "format_string", "printf", "startswith", "endswith", "contains",
+	"md5", "sha1", "sha2", "crc32", "hash", "xxhash64", "base64", "unbase64", "hex", "unhex",
+	"to_date", "to_timestamp", "date_format", "date_add", "date_sub", "datediff", "months_between", "add_months",
+	"year", "quarter", "month", "day", "dayofmonth", "dayofweek", "dayofyear", "weekofyear", "hour", "minute", "second",
+	"current_date", "current_timestamp", "unix_timestamp", "from_unixtime", "to_unix_timestamp", "date_trunc", "trunc",
+	"make_date", "last_day", "next_day",
+	"element_at", "size", "array", "array_contains", "array_join", "get_json_object",
+}
+
+// expressionKeywords may appear in an expression outside of a function call
+var expressionKeywords = []string{
+	"and", "or", "not", "is", "null", "true", "false", "in", "between", "like", "ilike", "rlike", "regexp",
+	"case", "when", "then", "else", "end", "as", "distinct", "interval", "current_date", "current_timestamp",
+	"string", "int", "integer", "bigint", "smallint", "tinyint", "double", "float", "boolean", "date", "timestamp", "decimal",
+	"year", "years", "month", "months", "week", "weeks", "day", "days", "hour", "hours", "minute", "minutes", "second", "seconds",
+}
+
+// expressionReservedWords start a query or a table reference, they are rejected even as column names
+var expressionReservedWords = []string{
+	"select", "from", "where", "with", "join", "table", "values", "exists", "lateral", "union", "intersect", "except",
+	"insert", "update", "delete", "merge", "create", "drop", "alter", "truncate", "describe", "show", "using", "over",
+	"delta", "parquet", "csv", "json", "orc", "text",
+}
+
+// expressionPathLiteral matches the string literals naming a storage path or a delta table
+var expressionPathLiteral = regexp.MustCompile(`(?i)([a-z0-9]+://|delta\.|^\s*/)`)
+
+type expressionToken struct {
+	kind  string
+	value string
+}
+
+const (
+	expressionToken_Identifier = "identifier"
+	expressionToken_Quoted     = "quoted"
+	expressionToken_String     = "string"
+	expressionToken_Number     = "number"
+	expressionToken_Symbol     = "symbol"
+)
+
+// validateExpression accepts a spark sql scalar expression of whitelisted functions, literals and references to columns.
+// A subquery, a qualified name or a path literal is rejected, so the expression cannot read another table.
+func validateExpression(expression string, columns []string) error {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("empty expression")
+	}
+	for idx, token := range tokens {
+		next := expressionToken{}
+		if idx+1 < len(tokens) {
+			next = tokens[idx+1]
+		}
+		switch token.kind {
+		case expressionToken_String:
+			if expressionPathLiteral.MatchString(token.value) {
+				return fmt.Errorf("path literal %s is not allowed", token.value)
+			}
+		case expressionToken_Identifier, expressionToken_Quoted:
+			name := strings.ToLower(token.value)
+			if next.kind == expressionToken_Symbol && next.value == "." {
+				return fmt.Errorf("qualified name %s is not allowed", token.value)
+			}
+			if token.kind == expressionToken_Identifier && slices.Contains(expressionReservedWords, name) {
+				return fmt.Errorf("%s is not allowed", token.value)
+			}
+			if next.kind == expressionToken_Symbol && next.value == "(" {
+				if token.kind == expressionToken_Quoted || !slices.Contains(expressionFunctions, name) {
+					return fmt.Errorf("function %s is not allowed", token.value)
+				}
+				continue
+			}
+			if slices.Contains(columns, token.value) {
+				continue
+			}
+			if token.kind == expressionToken_Identifier && slices.Contains(expressionKeywords, name) {
+				continue
+			}
+			return fmt.Errorf("%s is not a column", token.value)
+		}
+	}
+	return nil
+}
+
+// tokenizeExpression splits expression into identifiers, quoted identifiers, string literals, numbers and symbols,
+// comments and statement separators are rejected
+func tokenizeExpression(expression string) ([]expressionToken, error) {
+	symbols := []string{"<=>", "<>", "<=", ">=", "!=", "==", "||", "+", "-", "*", "/", "%", "=", "<", ">", "!", "&", "|", "^", "~", "(", ")", ",", ".", "[", "]"}
+	tokens := make([]expressionToken, 0)
+	runes := []rune(expression)
+	for idx := 0; idx < len(runes); {
+		char := runes[idx]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			idx++
+		case strings.HasPrefix(string(runes[idx:]), "--") || strings.HasPrefix(string(runes[idx:]), "/*"):
+			return nil, fmt.Errorf("comments are not allowed")
+		case strings.HasPrefix(string(runes[idx:]), "->"):
+			return nil, fmt.Errorf("lambda functions are not allowed")
+		case char == '\'' || char == '"' || char == '`':
+			end := idx + 1
+			var value strings.Builder
+			for ; end < len(runes); end++ {
+				if runes[end] == '\\' && char != '`' && end+1 < len(runes) {
+					end++
+					value.WriteRune(runes[end])
+					continue
+				}
+				if runes[end] == char {
+					// a doubled backtick is a backtick of the identifier
+					if char == '`' && end+1 < len(runes) && runes[end+1] == '`' {
+						end++
+						value.WriteRune(char)
+						continue
+					}
+					break
+				}
+				value.WriteRune(runes[end])
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated %c", char)
+			}
+			kind := expressionToken_String
+			if char == '`' {
+				kind = expressionToken_Quoted
+			}
+			tokens = append(tokens, expressionToken{kind: kind, value: value.String()})
+			idx = end + 1
+		case char == '_' || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z'):
+			end := idx + 1
+			for end < len(runes) && (runes[end] == '_' || (runes[end] >= 'a' && runes[end] <= 'z') ||
+				(runes[end] >= 'A' && runes[end] <= 'Z') || (runes[end] >= '0' && runes[end] <= '9')) {
+				end++
+			}
+			tokens = append(tokens, expressionToken{kind: expressionToken_Identifier, value: string(runes[idx:end])})
+			idx = end
+		case (char >= '0' && char <= '9') || (char == '.' && idx+1 < len(runes) && runes[idx+1] >= '0' && runes[idx+1] <= '9'):
+			end := idx + 1
+			for end < len(runes) && (runes[end] == '.' || (runes[end] >= '0' && runes[end] <= '9') ||
+				(runes[end] >= 'a' && runes[end] <= 'z') || (runes[end] >= 'A' && runes[end] <= 'Z') ||
+				((runes[end] == '+' || runes[end] == '-') && (runes[end-1] == 'e' || runes[end-1] == 'E'))) {
+				end++
+			}
+			tokens = append(tokens, expressionToken{kind: expressionToken_Number, value: string(runes[idx:end])})
+			idx = end
+		default:
+			symbol := ""
+			for _, each := range symbols {
+				if strings.HasPrefix(string(runes[idx:]), each) {
+					symbol = each
+					break
+				}
+			}
+			if symbol == "" {
+				return nil, fmt.Errorf("character %c is not allowed", char)
+			}
+			tokens = append(tokens, expressionToken{kind: expressionToken_Symbol, value: symbol})
+			idx += len([]rune(symbol))
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,77 @@
+package data_source
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateExpressionAcceptsScalarExpressions(t *testing.T) {
+	columns := []string{"first_name", "last_name", "amount", "order date"}
+	for _, expression := range []string{
+		"concat_ws(' ', first_name, last_name)",
+		"round(amount * 1.1e2, 2) - 0.5",
+		"CASE WHEN amount > 100 THEN 'high' ELSE 'low' END",
+		"CAST(amount AS DOUBLE)",
+		"to_date(`order date`)",
+		"amount IS NOT NULL AND first_name <> ''",
+		`concat(first_name, 'it\'s')`,
+		"date_add(current_date(), 7)",
+	} {
+		if err := validateExpression(expression, columns); err != nil {
+			t.Errorf("validateExpression(%q) = %v, want nil", expression, err)
+		}
+	}
+}
+
+// TestValidateExpressionRejectsReads checks every way an expression could read outside of its row is rejected with its reason
+func TestValidateExpressionRejectsReads(t *testing.T) {
+	columns := []string{"amount", "email"}
+	rejected := map[string][]string{
+		"is not a column":    {"upper(phone)", "amount + values2"},
+		"function":           {"reflect('java.lang.Runtime', 'getRuntime')", "`upper`(email)", "input_file_name()"},
+		"is not allowed":     {"(SELECT max(amount) FROM orders)", "amount + values", "amount OVER (ORDER BY amount)"},
+		"qualified name":     {"orders.amount", "delta.`s3a://bucket/table`"},
+		"path literal":       {"concat(email, 's3a://bucket/key')", "concat(email, '/etc/passwd')", "concat(email, 'delta.x')"},
+		"comments":           {"amount -- comment", "amount /* comment */"},
+		"lambda":             {"transform(array(amount), x -> x + 1)"},
+		"character ; is not": {"amount; DROP TABLE users"},
+		"unterminated":       {"concat(email, 'x)", "`amount"},
+		"empty expression":   {"", "   "},
+	}
+	for reason, expressions := range rejected {
+		for _, expression := range expressions {
+			err := validateExpression(expression, columns)
+			if err == nil {
+				t.Errorf("validateExpression(%q) accepted the expression, want an error with %q", expression, reason)
+				continue
+			}
+			if !strings.Contains(err.Error(), reason) {
+				t.Errorf("validateExpression(%q) = %v, want an error with %q", expression, err, reason)
+			}
+		}
+	}
+}
+
+func TestTokenizeExpressionUnquotesIdentifiersAndStrings(t *testing.T) {
+	tokens, err := tokenizeExpression("`a``b` = 'it\\'s' AND x<=>1.5e-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []expressionToken{
+		{kind: expressionToken_Quoted, value: "a`b"},
+		{kind: expressionToken_Symbol, value: "="},
+		{kind: expressionToken_String, value: "it's"},
+		{kind: expressionToken_Identifier, value: "AND"},
+		{kind: expressionToken_Identifier, value: "x"},
+		{kind: expressionToken_Symbol, value: "<=>"},
+		{kind: expressionToken_Number, value: "1.5e-3"},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("tokenizeExpression() = %v, want %v", tokens, want)
+	}
+	for idx := range want {
+		if tokens[idx] != want[idx] {
+			t.Errorf("token %d = %v, want %v", idx, tokens[idx], want[idx])
+		}
+	}
+}
@@ -26,8 +26,15 @@ func (b business) ProcessImportCsv(ctx context.Context, request *api.ImportCsvRe
 
 	var headers []string
 	for _, mapping := range request.MappingOptions {
+		if mapping.Derived {
+			continue
+		}
 		headers = append(headers, mapping.DestinationFieldName)
 	}
+	transformations, err := buildColumnTransformations(request.MappingOptions)
+	if err != nil {
+		return err
+	}
 
 	configurations, err := json.Marshal(model.CsvConfigurations{
 		FileName:      request.FileName,
@@ -48,28 +55,36 @@ func (b business) ProcessImportCsv(ctx context.Context, request *api.ImportCsvRe
 		return err
 	}
 
-	schema := make([]model.SchemaUnit, 0, len(request.MappingOptions))
-	for _, mappingOption := range request.MappingOptions {
-		schema = append(schema, model.SchemaUnit{
-			ColumnName: mappingOption.DestinationFieldName,
-		})
-	}
+	schema, columnTypes := buildCsvSchema(request.MappingOptions)
 	rawSchema, err := json.Marshal(schema)
 	if err != nil {
 		b.log.WithName("ProcessImportCsv").Error(err, "Cannot parse schema to JSON")
 		return err
 	}
 
+	var dataQuality *airflow.DagDataQualityConfiguration
+	tableName := request.NewTableName
 	if request.TableId > 0 {
 		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.TableId)
 		if err != nil {
 			b.log.WithName("ProcessImportCsv").WithValues("tableId", request.TableId).Error(err, "Cannot get data table")
 			return err
 		}
+		tableName = dataTable.Name
 		if dataTable.AccountUuid.String() != accountUuid {
 			b.log.WithName("ProcessImportCsv").WithValues("tableId", request.TableId).Error(err, "No have permission with dataTable")
 			return status.Error(codes.PermissionDenied, "No have permission with dataTable")
 		}
+		dataQuality, err = buildDagDataQuality(dataTable)
+		if err != nil {
+			b.log.WithName("ProcessImportCsv").WithValues("tableId", request.TableId).Error(err, "Cannot parse data quality configuration")
+			return err
+		}
+		err = checkImportSchemaPolicy(dataTable, schema)
+		if err != nil {
+			b.log.WithName("ProcessImportCsv").WithValues("tableId", request.TableId).Error(err, "Schema policy rejects import")
+			return err
+		}
 	} else {
 		err = b.repository.DataTableRepository.CheckExistsDataTableName(ctx, request.NewTableName, accountUuid)
 		if err != nil {
@@ -77,6 +92,11 @@ func (b business) ProcessImportCsv(ctx context.Context, request *api.ImportCsvRe
 			return err
 		}
 	}
+	deduplication, dedupeConfiguration, err := buildImportDeduplication(request.Deduplication, request.MappingOptions, accountUuid, tableName)
+	if err != nil {
+		b.log.WithName("ProcessImportCsv").Error(err, "Invalid deduplication")
+		return err
+	}
 
 	err = b.repository.TransactionRepository.ImportCsvTransaction(ctx, &repository.ImportCsvTransactionParams{
 		DataSourceName:           request.Name,
@@ -94,6 +114,11 @@ func (b business) ProcessImportCsv(ctx context.Context, request *api.ImportCsvRe
 		WriteMode:                airflow.DeltaWriteMode(request.WriteMode),
 		CsvReadOptions:           request.Configurations,
 		Headers:                  headers,
+		ColumnTypes:              columnTypes,
+		Transformations:          transformations,
+		DataQuality:              dataQuality,
+		Deduplication:            deduplication,
+		DedupeConfiguration:      dedupeConfiguration,
 		Schema:                   pqtype.NullRawMessage{RawMessage: rawSchema, Valid: true},
 	}, b.airflowAdapter)
 
@@ -135,8 +160,15 @@ func (b business) ProcessImportCsvFromS3(ctx context.Context, request *api.Impor
 
 	var headers []string
 	for _, mapping := range request.MappingOptions {
+		if mapping.Derived {
+			continue
+		}
 		headers = append(headers, mapping.DestinationFieldName)
 	}
+	transformations, err := buildColumnTransformations(request.MappingOptions)
+	if err != nil {
+		return err
+	}
 
 	configurations, err := json.Marshal(model.CsvConfigurations{
 		FileName:      request.FileName,
@@ -157,28 +189,36 @@ func (b business) ProcessImportCsvFromS3(ctx context.Context, request *api.Impor
 		return err
 	}
 
-	schema := make([]model.SchemaUnit, 0, len(request.MappingOptions))
-	for _, mappingOption := range request.MappingOptions {
-		schema = append(schema, model.SchemaUnit{
-			ColumnName: mappingOption.DestinationFieldName,
-		})
-	}
+	schema, columnTypes := buildCsvSchema(request.MappingOptions)
 	rawSchema, err := json.Marshal(schema)
 	if err != nil {
 		b.log.WithName("ProcessImportCsvFromS3").Error(err, "Cannot parse schema to JSON")
 		return err
 	}
 
+	var dataQuality *airflow.DagDataQualityConfiguration
+	tableName := request.NewTableName
 	if request.TableId > 0 {
 		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.TableId)
 		if err != nil {
 			b.log.WithName("ProcessImportCsvFromS3").WithValues("tableId", request.TableId).Error(err, "Cannot get data table")
 			return err
 		}
+		tableName = dataTable.Name
 		if dataTable.AccountUuid.String() != accountUuid {
 			b.log.WithName("ProcessImportCsvFromS3").WithValues("tableId", request.TableId).Error(err, "No have permission with dataTable")
 			return status.Error(codes.PermissionDenied, "No have permission with dataTable")
 		}
+		dataQuality, err = buildDagDataQuality(dataTable)
+		if err != nil {
+			b.log.WithName("ProcessImportCsvFromS3").WithValues("tableId", request.TableId).Error(err, "Cannot parse data quality configuration")
+			return err
+		}
+		err = checkImportSchemaPolicy(dataTable, schema)
+		if err != nil {
+			b.log.WithName("ProcessImportCsvFromS3").WithValues("tableId", request.TableId).Error(err, "Schema policy rejects import")
+			return err
+		}
 	} else {
 		err = b.repository.DataTableRepository.CheckExistsDataTableName(ctx, request.NewTableName, accountUuid)
 		if err != nil {
@@ -186,6 +226,11 @@ func (b business) ProcessImportCsvFromS3(ctx context.Context, request *api.Impor
 			return err
 		}
 	}
+	deduplication, dedupeConfiguration, err := buildImportDeduplication(request.Deduplication, request.MappingOptions, accountUuid, tableName)
+	if err != nil {
+		b.log.WithName("ProcessImportCsvFromS3").Error(err, "Invalid deduplication")
+		return err
+	}
 
 	err = b.repository.TransactionRepository.ImportCsvTransaction(ctx, &repository.ImportCsvTransactionParams{
 		DataSourceName:           request.Name,
@@ -203,6 +248,11 @@ func (b business) ProcessImportCsvFromS3(ctx context.Context, request *api.Impor
 		WriteMode:                airflow.DeltaWriteMode(request.WriteMode),
 		CsvReadOptions:           request.Configurations,
 		Headers:                  headers,
+		ColumnTypes:              columnTypes,
+		Transformations:          transformations,
+		DataQuality:              dataQuality,
+		Deduplication:            deduplication,
+		DedupeConfiguration:      dedupeConfiguration,
 		Schema:                   pqtype.NullRawMessage{RawMessage: rawSchema, Valid: true},
 		ConnectionId:             request.ConnectionId,
 	}, b.airflowAdapter)
@@ -216,3 +266,33 @@ func (b business) ProcessImportCsvFromS3(ctx context.Context, request *api.Impor
 
 	return nil
 }
+
+// buildCsvSchema uses the data types chosen in the mapping options, columns without a type are kept as string.
+// Derived columns are part of the schema but not of the file, so they have no column type.
+func buildCsvSchema(mappingOptions []*api.MappingOptionItem) ([]model.SchemaUnit, map[string]airflow.DagColumnType) {
+	schema := make([]model.SchemaUnit, 0, len(mappingOptions))
+	columnTypes := make(map[string]airflow.DagColumnType, len(mappingOptions))
+	for _, mappingOption := range mappingOptions {
+		dataType := mappingOption.DataType
+		if dataType == "" {
+			dataType = string(model.ColumnDataType_String)
+		}
+		schemaDataType := dataType
+		if transformed := transformedDataType(mappingOption); transformed != "" {
+			schemaDataType = transformed
+		}
+		schema = append(schema, model.SchemaUnit{
+			ColumnName: mappingOption.DestinationFieldName,
+			DataType:   schemaDataType,
+			Format:     mappingOption.Format,
+		})
+		if mappingOption.Derived {
+			continue
+		}
+		columnTypes[mappingOption.DestinationFieldName] = airflow.DagColumnType{
+			DataType: dataType,
+			Format:   mappingOption.Format,
+		}
+	}
+	return schema, columnTypes
+}
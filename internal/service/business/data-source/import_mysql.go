@@ -47,6 +47,17 @@ func (b business) ProcessImportFromMySQLSource(ctx context.Context, request *api
 		return err
 	}
 
+	transformations, err := buildColumnTransformations(request.MappingOptions)
+	if err != nil {
+		return err
+	}
+	var headers []*api.MappingOptionItem
+	for _, mappingOption := range request.MappingOptions {
+		if !mappingOption.Derived {
+			headers = append(headers, mappingOption)
+		}
+	}
+
 	tx := b.db.Begin()
 
 	dataSource, err := b.repository.DataSourceRepository.CreateDataSource(ctx, &repository.CreateDataSourceParams{
@@ -65,6 +76,7 @@ func (b business) ProcessImportFromMySQLSource(ctx context.Context, request *api
 	}
 
 	var dataTable *model.DataTable
+	var dataQuality *airflow.DagDataQualityConfiguration
 	if request.DeltaTableId > 0 {
 		dataTable, err = b.repository.UpdateDataTable(ctx, &repository.UpdateDataTableParams{
 			Tx:     tx,
@@ -76,6 +88,18 @@ func (b business) ProcessImportFromMySQLSource(ctx context.Context, request *api
 			tx.Rollback()
 			return err
 		}
+		dataQuality, err = buildDagDataQuality(dataTable)
+		if err != nil {
+			logger.Error(err, "cannot parse data quality configuration")
+			tx.Rollback()
+			return err
+		}
+		err = checkImportSchemaPolicy(dataTable, buildMappingSchema(request.MappingOptions))
+		if err != nil {
+			logger.Error(err, "schema policy rejects import")
+			tx.Rollback()
+			return err
+		}
 	} else {
 		err := b.repository.DataTableRepository.CheckExistsDataTableName(ctx, request.DeltaTableName, accountUuid.String())
 		if err != nil {
@@ -96,11 +120,20 @@ func (b business) ProcessImportFromMySQLSource(ctx context.Context, request *api
 		}
 	}
 
+	deduplication, dedupeConfiguration, err := buildImportDeduplication(request.Deduplication, request.MappingOptions, accountUuid.String(), dataTable.Name)
+	if err != nil {
+		logger.Error(err, "invalid deduplication")
+		tx.Rollback()
+		return err
+	}
+
 	sourceTableMap, err := b.repository.SourceTableMapRepository.CreateSourceTableMap(ctx, &repository.CreateSourceTableMapParams{
 		Tx:             tx,
 		TableId:        dataTable.ID,
 		SourceId:       dataSource.ID,
 		MappingOptions: pqtype.NullRawMessage{RawMessage: jsonMappingOptions, Valid: jsonMappingOptions != nil},
+		// deduplication applied to each import
+		DedupeConfiguration: dedupeConfiguration,
 	})
 	if err != nil {
 		logger.Error(err, "cannot create source table map")
@@ -141,9 +174,13 @@ func (b business) ProcessImportFromMySQLSource(ctx context.Context, request *api
 
 	_, err = b.airflowAdapter.TriggerGenerateDagImportMySQL(ctx, &airflow.TriggerGenerateDagImportMySQLRequest{
 		Conf: airflow.DagImportMySQLConfig{
-			DagId:         dagId,
-			DeltaTableKey: utils.GenerateDeltaTablePath(accountUuid.String(), request.DeltaTableName),
-			Headers:       request.MappingOptions,
+			DagId:           dagId,
+			DeltaTableKey:   utils.GenerateDeltaTablePath(accountUuid.String(), request.DeltaTableName),
+			Headers:         headers,
+			Transformations: transformations,
+			DataQuality:     dataQuality,
+			Deduplication:   deduplication,
+			SchemaPolicy:    string(dataTable.SchemaPolicy),
 			DatabaseConfiguration: airflow.DagImportMySQLDatabaseConfiguration{
 				Host:     dbConfiguration.Host,
 				Port:     dbConfiguration.Port,
@@ -0,0 +1,214 @@
+package data_source
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PreviewFile_DefaultSampleSize = 100
+	PreviewFile_MaxSampleValues   = 5
+	// PreviewFile_MaxReadBytes limits how much of an S3 object is downloaded for sampling
+	PreviewFile_MaxReadBytes = 1 << 20
+)
+
+func (b business) ProcessPreviewFile(ctx context.Context, request *api.PreviewFileRequest, accountUuid string) (*api.PreviewFileResponse, error) {
+	logger := b.log.WithName("ProcessPreviewFile").WithValues("fileName", request.FileName, "connectionId", request.ConnectionId)
+
+	content := request.FileContent
+	if request.ConnectionId > 0 {
+		connection, err := b.repository.ConnectionRepository.GetConnection(ctx, request.ConnectionId)
+		if err != nil {
+			logger.Error(err, "cannot get connection")
+			return nil, err
+		}
+		if connection.AccountUuid != uuid.MustParse(accountUuid) {
+			return nil, status.Error(codes.PermissionDenied, "No have permission with connection")
+		}
+		if connection.Type != model.ConnectionType_S3 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid connection")
+		}
+		var configuration model.S3Configurations
+		err = json.Unmarshal(connection.Configurations.RawMessage, &configuration)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal s3 configuration")
+			return nil, err
+		}
+		content, err = readS3ObjectHead(ctx, configuration, request.Key, PreviewFile_MaxReadBytes)
+		if err != nil {
+			logger.Error(err, "cannot read s3 object")
+			return nil, status.Error(codes.InvalidArgument, "cannot read file from s3")
+		}
+	}
+	if len(content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "require file_content or connection_id and key")
+	}
+
+	sampleSize := int(request.SampleSize)
+	if sampleSize == 0 {
+		sampleSize = PreviewFile_DefaultSampleSize
+	}
+	headers, rows, err := sampleCsv(content, request.Configurations, sampleSize)
+	if err != nil {
+		logger.Error(err, "cannot parse csv")
+		return nil, status.Error(codes.InvalidArgument, "cannot parse csv: "+err.Error())
+	}
+
+	columns := make([]*api.DetectedColumn, 0, len(headers))
+	for idx, header := range headers {
+		values := make([]string, 0, len(rows))
+		for _, row := range rows {
+			if idx < len(row) {
+				values = append(values, row[idx])
+			} else {
+				values = append(values, "")
+			}
+		}
+		inferred := utils.InferColumnType(values)
+
+		var samples []string
+		for _, value := range values {
+			if len(samples) >= PreviewFile_MaxSampleValues {
+				break
+			}
+			if value != "" {
+				samples = append(samples, value)
+			}
+		}
+
+		columns = append(columns, &api.DetectedColumn{
+			ColumnName:   header,
+			DataType:     string(inferred.DataType),
+			Format:       inferred.Format,
+			Confidence:   inferred.Confidence,
+			NullCount:    inferred.NullCount,
+			SampleValues: samples,
+		})
+	}
+
+	return &api.PreviewFileResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		SampledRows: int64(len(rows)),
+		Columns:     columns,
+	}, nil
+}
+
+// sampleCsv reads the header and up to sampleSize records, honoring the delimiter, skip_rows and multi_line options
+func sampleCsv(content []byte, configurations *api.ImportCsvConfigurations, sampleSize int) ([]string, [][]string, error) {
+	delimiter := ','
+	var skipRows int64
+	multiLine := false
+	if configurations != nil {
+		if configurations.Delimiter != "" {
+			delimiter = []rune(configurations.Delimiter)[0]
+		}
+		skipRows = configurations.SkipRows
+		multiLine = configurations.MultiLine
+	}
+
+	bufReader := bufio.NewReader(bytes.NewReader(content))
+	for i := int64(0); i < skipRows; i++ {
+		if _, err := bufReader.ReadString('\n'); err != nil {
+			return nil, nil, fmt.Errorf("file has less than %d rows", skipRows)
+		}
+	}
+
+	newReader := func(r io.Reader) *csv.Reader {
+		reader := csv.NewReader(r)
+		reader.Comma = delimiter
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+		return reader
+	}
+
+	var records [][]string
+	if multiLine {
+		reader := newReader(bufReader)
+		for len(records) <= sampleSize {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				if len(records) > 0 {
+					// the sample may end in the middle of a record
+					break
+				}
+				return nil, nil, err
+			}
+			records = append(records, record)
+		}
+	} else {
+		scanner := bufio.NewScanner(bufReader)
+		scanner.Buffer(make([]byte, 0, 64*1024), PreviewFile_MaxReadBytes)
+		for len(records) <= sampleSize && scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				continue
+			}
+			record, err := newReader(bytes.NewBufferString(line)).Read()
+			if err != nil {
+				return nil, nil, err
+			}
+			records = append(records, record)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("file is empty")
+	}
+	return records[0], records[1:], nil
+}
+
+// readS3ObjectHead downloads at most limit bytes of the object and drops the trailing partial line
+func readS3ObjectHead(ctx context.Context, configuration model.S3Configurations, key string, limit int64) ([]byte, error) {
+	s3Session, err := session.NewSession(&aws.Config{
+		Region:      aws.String(configuration.Region),
+		Credentials: credentials.NewStaticCredentials(configuration.AccessKeyId, configuration.SecretAccessKey, ""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := s3.New(s3Session).GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(configuration.BucketName),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=0-%d", limit-1)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	content, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) >= limit {
+		if idx := bytes.LastIndexByte(content, '\n'); idx > 0 {
+			content = content[:idx]
+		}
+	}
+	return content, nil
+}
@@ -0,0 +1,36 @@
+package data_source
+
+import (
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+)
+
+// checkImportSchemaPolicy compares the imported columns to the current schema of the table,
+// it is skipped when the table has no schema yet
+func checkImportSchemaPolicy(dataTable *model.DataTable, schema []model.SchemaUnit) error {
+	currentSchema, err := data_table.ParseSchema(dataTable.Schema)
+	if err != nil {
+		return err
+	}
+	if len(currentSchema) == 0 {
+		return nil
+	}
+	return data_table.CheckSchemaPolicy(dataTable.SchemaPolicy, data_table.DiffSchema(currentSchema, schema))
+}
+
+// buildMappingSchema lists the imported columns of a database source, columns without a data type keep the source type
+func buildMappingSchema(mappingOptions []*api.MappingOptionItem) []model.SchemaUnit {
+	schema := make([]model.SchemaUnit, 0, len(mappingOptions))
+	for _, mappingOption := range mappingOptions {
+		dataType := mappingOption.DataType
+		if transformed := transformedDataType(mappingOption); transformed != "" {
+			dataType = transformed
+		}
+		schema = append(schema, model.SchemaUnit{
+			ColumnName: mappingOption.DestinationFieldName,
+			DataType:   dataType,
+		})
+	}
+	return schema
+}
@@ -0,0 +1,143 @@
+package data_source
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ColumnTransform_Cast         = "cast"
+	ColumnTransform_Trim         = "trim"
+	ColumnTransform_Lower        = "lower"
+	ColumnTransform_Upper        = "upper"
+	ColumnTransform_RegexReplace = "regex_replace"
+	ColumnTransform_ParseDate    = "parse_date"
+	ColumnTransform_Default      = "default"
+	ColumnTransform_Sha256       = "sha256"
+	ColumnTransform_Expression   = "expression"
+)
+
+var sparkDataTypes = map[string]string{
+	string(model.ColumnDataType_Int):       "BIGINT",
+	string(model.ColumnDataType_Float):     "DOUBLE",
+	string(model.ColumnDataType_Bool):      "BOOLEAN",
+	string(model.ColumnDataType_Date):      "DATE",
+	string(model.ColumnDataType_Timestamp): "TIMESTAMP",
+	string(model.ColumnDataType_String):    "STRING",
+}
+
+// buildColumnTransformations compiles the transforms of every mapping option into spark sql expressions.
+// Source columns come first so derived columns can reference their transformed values.
+func buildColumnTransformations(mappingOptions []*api.MappingOptionItem) ([]airflow.DagColumnTransformation, error) {
+	var transformations, derivedTransformations []airflow.DagColumnTransformation
+	columns := make([]string, 0, len(mappingOptions))
+	for _, mappingOption := range mappingOptions {
+		columns = append(columns, mappingOption.DestinationFieldName)
+	}
+	for _, mappingOption := range mappingOptions {
+		if len(mappingOption.Transforms) == 0 {
+			if mappingOption.Derived {
+				return nil, status.Errorf(codes.InvalidArgument, "derived column %s requires an expression transform", mappingOption.DestinationFieldName)
+			}
+			continue
+		}
+		expression, err := compileColumnTransforms(mappingOption, columns)
+		if err != nil {
+			return nil, err
+		}
+		transformation := airflow.DagColumnTransformation{
+			ColumnName: mappingOption.DestinationFieldName,
+			Expression: expression,
+		}
+		if mappingOption.Derived {
+			derivedTransformations = append(derivedTransformations, transformation)
+		} else {
+			transformations = append(transformations, transformation)
+		}
+	}
+	return append(transformations, derivedTransformations...), nil
+}
+
+// compileColumnTransforms chains the transforms of mappingOption, an expression may only reference columns
+func compileColumnTransforms(mappingOption *api.MappingOptionItem, columns []string) (string, error) {
+	expression := utils.QuoteSparkIdentifier(mappingOption.DestinationFieldName)
+	for idx, transform := range mappingOption.Transforms {
+		invalid := func(reason string) error {
+			return status.Errorf(codes.InvalidArgument, "column %s, transform %d (%s): %s", mappingOption.DestinationFieldName, idx, transform.Type, reason)
+		}
+		if mappingOption.Derived && idx == 0 && transform.Type != ColumnTransform_Expression {
+			return "", invalid("derived column must start with an expression transform")
+		}
+
+		switch transform.Type {
+		case ColumnTransform_Cast:
+			sparkType, ok := sparkDataTypes[transform.DataType]
+			if !ok {
+				return "", invalid("require data_type")
+			}
+			expression = fmt.Sprintf("CAST(%s AS %s)", expression, sparkType)
+		case ColumnTransform_Trim:
+			expression = fmt.Sprintf("trim(%s)", expression)
+		case ColumnTransform_Lower:
+			expression = fmt.Sprintf("lower(%s)", expression)
+		case ColumnTransform_Upper:
+			expression = fmt.Sprintf("upper(%s)", expression)
+		case ColumnTransform_RegexReplace:
+			if _, err := regexp.Compile(transform.Pattern); err != nil || transform.Pattern == "" {
+				return "", invalid("invalid pattern")
+			}
+			expression = fmt.Sprintf("regexp_replace(%s, %s, %s)", expression, utils.QuoteSparkString(transform.Pattern), utils.QuoteSparkString(transform.Replacement))
+		case ColumnTransform_ParseDate:
+			if transform.Format == "" {
+				return "", invalid("require format")
+			}
+			function := "to_date"
+			if transform.DataType == string(model.ColumnDataType_Timestamp) {
+				function = "to_timestamp"
+			}
+			expression = fmt.Sprintf("%s(%s, %s)", function, expression, utils.QuoteSparkString(transform.Format))
+		case ColumnTransform_Default:
+			expression = fmt.Sprintf("coalesce(%s, %s)", expression, utils.QuoteSparkString(transform.Value))
+		case ColumnTransform_Sha256:
+			expression = fmt.Sprintf("sha2(CAST(%s AS STRING), 256)", expression)
+		case ColumnTransform_Expression:
+			// an expression replaces the column, the transforms before it would be dropped
+			if idx > 0 {
+				return "", invalid("expression must be the first transform")
+			}
+			if err := validateExpression(transform.Expression, columns); err != nil {
+				return "", invalid(err.Error())
+			}
+			expression = "(" + transform.Expression + ")"
+		default:
+			return "", invalid("unsupported transform")
+		}
+	}
+	return expression, nil
+}
+
+// transformedDataType returns the type of the column after its transforms, empty when the transforms keep the type
+func transformedDataType(mappingOption *api.MappingOptionItem) string {
+	dataType := ""
+	for _, transform := range mappingOption.Transforms {
+		switch transform.Type {
+		case ColumnTransform_Cast:
+			dataType = transform.DataType
+		case ColumnTransform_ParseDate:
+			dataType = string(model.ColumnDataType_Date)
+			if transform.DataType == string(model.ColumnDataType_Timestamp) {
+				dataType = string(model.ColumnDataType_Timestamp)
+			}
+		case ColumnTransform_Trim, ColumnTransform_Lower, ColumnTransform_Upper, ColumnTransform_RegexReplace, ColumnTransform_Sha256:
+			dataType = string(model.ColumnDataType_String)
+		}
+	}
+	return dataType
+}
@@ -0,0 +1,99 @@
+package data_source
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+)
+
+func TestBuildColumnTransformationsOrdersDerivedColumnsLast(t *testing.T) {
+	transformations, err := buildColumnTransformations([]*api.MappingOptionItem{
+		{
+			DestinationFieldName: "full_name",
+			Derived:              true,
+			Transforms:           []*api.ColumnTransform{{Type: ColumnTransform_Expression, Expression: "concat_ws(' ', first_name, last_name)"}},
+		},
+		{DestinationFieldName: "first_name", Transforms: []*api.ColumnTransform{{Type: ColumnTransform_Trim}, {Type: ColumnTransform_Upper}}},
+		{DestinationFieldName: "last_name"},
+		{
+			DestinationFieldName: "email",
+			Transforms:           []*api.ColumnTransform{{Type: ColumnTransform_Trim}, {Type: ColumnTransform_Lower}, {Type: ColumnTransform_Sha256}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the derived column reads the transformed first_name, so it comes after the source columns, a column without transforms is kept as is
+	got := make([]string, 0, len(transformations))
+	for _, transformation := range transformations {
+		got = append(got, transformation.ColumnName+" = "+transformation.Expression)
+	}
+	want := []string{
+		"first_name = upper(trim(`first_name`))",
+		"email = sha2(CAST(lower(trim(`email`)) AS STRING), 256)",
+		"full_name = (concat_ws(' ', first_name, last_name))",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("buildColumnTransformations() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompileColumnTransformsQuotesArguments(t *testing.T) {
+	expression, err := compileColumnTransforms(&api.MappingOptionItem{
+		DestinationFieldName: "note`s",
+		Transforms: []*api.ColumnTransform{
+			{Type: ColumnTransform_RegexReplace, Pattern: `\s+`, Replacement: "' OR '1"},
+			{Type: ColumnTransform_Default, Value: "n/a"},
+			{Type: ColumnTransform_ParseDate, DataType: "timestamp", Format: "yyyy-MM-dd HH:mm"},
+			{Type: ColumnTransform_Cast, DataType: "string"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "CAST(to_timestamp(coalesce(regexp_replace(`note``s`, '\\\\s+', '\\' OR \\'1'), 'n/a'), 'yyyy-MM-dd HH:mm') AS STRING)"
+	if expression != want {
+		t.Errorf("compileColumnTransforms() = %s, want %s", expression, want)
+	}
+}
+
+func TestCompileColumnTransformsRejects(t *testing.T) {
+	columns := []string{"email", "full_name"}
+	for reason, mappingOption := range map[string]*api.MappingOptionItem{
+		"require data_type": {DestinationFieldName: "email", Transforms: []*api.ColumnTransform{{Type: ColumnTransform_Cast, DataType: "uuid"}}},
+		"invalid pattern":   {DestinationFieldName: "email", Transforms: []*api.ColumnTransform{{Type: ColumnTransform_RegexReplace, Pattern: "("}}},
+		"require format":    {DestinationFieldName: "email", Transforms: []*api.ColumnTransform{{Type: ColumnTransform_ParseDate}}},
+		"must start with":   {DestinationFieldName: "full_name", Derived: true, Transforms: []*api.ColumnTransform{{Type: ColumnTransform_Upper}}},
+		"must be the first": {DestinationFieldName: "email", Transforms: []*api.ColumnTransform{{Type: ColumnTransform_Trim}, {Type: ColumnTransform_Expression, Expression: "lower(email)"}}},
+		"is not a column":   {DestinationFieldName: "full_name", Derived: true, Transforms: []*api.ColumnTransform{{Type: ColumnTransform_Expression, Expression: "upper(phone)"}}},
+		"unsupported":       {DestinationFieldName: "email", Transforms: []*api.ColumnTransform{{Type: "reverse"}}},
+	} {
+		_, err := compileColumnTransforms(mappingOption, columns)
+		if err == nil || !strings.Contains(err.Error(), reason) {
+			t.Errorf("compileColumnTransforms(%s) error = %v, want %q", mappingOption.DestinationFieldName, err, reason)
+		}
+	}
+
+	// a derived column without transforms has no expression to compute it
+	_, err := buildColumnTransformations([]*api.MappingOptionItem{{DestinationFieldName: "full_name", Derived: true}})
+	if err == nil {
+		t.Error("buildColumnTransformations() accepted a derived column without transforms")
+	}
+}
+
+func TestTransformedDataType(t *testing.T) {
+	transforms := func(transforms ...*api.ColumnTransform) *api.MappingOptionItem {
+		return &api.MappingOptionItem{DestinationFieldName: "column", Transforms: transforms}
+	}
+	if got := transformedDataType(transforms(&api.ColumnTransform{Type: ColumnTransform_Default, Value: "0"})); got != "" {
+		t.Errorf("default keeps the type, got %q", got)
+	}
+	if got := transformedDataType(transforms(&api.ColumnTransform{Type: ColumnTransform_Cast, DataType: "int"}, &api.ColumnTransform{Type: ColumnTransform_Sha256})); got != "string" {
+		t.Errorf("the last transform gives the type, got %q", got)
+	}
+	if got := transformedDataType(transforms(&api.ColumnTransform{Type: ColumnTransform_ParseDate, DataType: "timestamp", Format: "yyyy"})); got != "timestamp" {
+		t.Errorf("parse_date to a timestamp, got %q", got)
+	}
+}
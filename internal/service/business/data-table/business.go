@@ -3,11 +3,13 @@ package data_table
 import (
 	"context"
 	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
 	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
 	"github.com/go-logr/logr"
+	"gorm.io/gorm"
 )
 
 type Business interface {
@@ -17,20 +19,45 @@ type Business interface {
 	GetListDataTables(ctx context.Context, request *api.GetListDataTablesRequest, accountUuid string) ([]*api.GetListDataTablesResponse_DataTable, int64, error)
 	GetListFileExportRecords(ctx context.Context, request *api.GetListFileExportRecordsRequest, accountUuid string) ([]*api.GetListFileExportRecordsResponse_FileExportRecord, error)
 	GetQueryDataTable(ctx context.Context, request *api.GetQueryDataTableRequest, accountUuid string) (*api.GetQueryDataTableResponse, error)
+	UpdateDataTableQualityRules(ctx context.Context, request *api.UpdateDataTableQualityRulesRequest, accountUuid string) (*api.UpdateDataTableQualityRulesResponse, error)
+	DeduplicateDataTable(ctx context.Context, request *api.DeduplicateDataTableRequest, accountUuid string) (*api.DeduplicateDataTableResponse, error)
+	UpdateDataTableSchemaPolicy(ctx context.Context, request *api.UpdateDataTableSchemaPolicyRequest, accountUuid string) (*api.UpdateDataTableSchemaPolicyResponse, error)
+	GetListDataTableSchemaVersions(ctx context.Context, request *api.GetListDataTableSchemaVersionsRequest, accountUuid string) (*api.GetListDataTableSchemaVersionsResponse, error)
+	GetListDataTableVersions(ctx context.Context, request *api.GetListDataTableVersionsRequest, accountUuid string) (*api.GetListDataTableVersionsResponse, error)
+	RestoreDataTable(ctx context.Context, request *api.RestoreDataTableRequest, accountUuid string) (*api.RestoreDataTableResponse, error)
+	DiffDataTableVersions(ctx context.Context, request *api.DiffDataTableVersionsRequest, accountUuid string) (*api.DiffDataTableVersionsResponse, error)
+	ProfileDataTable(ctx context.Context, request *api.ProfileDataTableRequest, accountUuid string) (*api.ProfileDataTableResponse, error)
+	GetDataTableStatistics(ctx context.Context, request *api.GetDataTableStatisticsRequest, accountUuid string) (*api.GetDataTableStatisticsResponse, error)
+	SuggestColumnTags(ctx context.Context, request *api.SuggestColumnTagsRequest, accountUuid string) (*api.SuggestColumnTagsResponse, error)
+	UpdateColumnTags(ctx context.Context, request *api.UpdateColumnTagsRequest, accountUuid string) (*api.UpdateColumnTagsResponse, error)
+	GetColumnTags(ctx context.Context, request *api.GetColumnTagsRequest, accountUuid string) (*api.GetColumnTagsResponse, error)
+	UpdateMaskingPolicies(ctx context.Context, request *api.UpdateMaskingPoliciesRequest, accountUuid string) (*api.UpdateMaskingPoliciesResponse, error)
+	GetMaskingPolicies(ctx context.Context, request *api.GetMaskingPoliciesRequest, accountUuid string) (*api.GetMaskingPoliciesResponse, error)
+	SetRetentionPolicy(ctx context.Context, request *api.SetRetentionPolicyRequest, accountUuid string) (*api.SetRetentionPolicyResponse, error)
+	GetListRetentionPolicies(ctx context.Context, request *api.GetListRetentionPoliciesRequest, accountUuid string) (*api.GetListRetentionPoliciesResponse, error)
+	DeleteRetentionPolicy(ctx context.Context, request *api.DeleteRetentionPolicyRequest, accountUuid string) (*api.DeleteRetentionPolicyResponse, error)
+
+	SyncOnDeduplicateDataTable(ctx context.Context, dataActionId int64) error
+	SyncOnRestoreDataTable(ctx context.Context, dataActionId int64) error
+	ApplyRetentionPolicies(ctx context.Context) error
 }
 
 type business struct {
+	db             *gorm.DB
 	log            logr.Logger
 	repository     *repository.Repository
 	airflowAdapter airflow.AirflowAdapter
 	queryAdapter   query.QueryAdapter
+	config         *config.Config
 }
 
-func NewDataTableBusiness(log logr.Logger, repository *repository.Repository, airflowAdapter airflow.AirflowAdapter, queryAdapter query.QueryAdapter) Business {
+func NewDataTableBusiness(db *gorm.DB, log logr.Logger, repository *repository.Repository, airflowAdapter airflow.AirflowAdapter, queryAdapter query.QueryAdapter, config *config.Config) Business {
 	return &business{
+		db:             db,
 		log:            log.WithName("DataTableBiz"),
 		repository:     repository,
 		airflowAdapter: airflowAdapter,
 		queryAdapter:   queryAdapter,
+		config:         config,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/APCS20-Thesis/Backend/api"
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
 	"github.com/APCS20-Thesis/Backend/internal/model"
@@ -125,14 +126,32 @@ func (b business) GetDataTable(ctx context.Context, request *api.GetDataTableReq
 			return nil, err
 		}
 	}
+	var qualityConfiguration model.DataQualityConfiguration
+	if dataTable.QualityConfiguration.Valid && dataTable.QualityConfiguration.RawMessage != nil {
+		err = json.Unmarshal(dataTable.QualityConfiguration.RawMessage, &qualityConfiguration)
+		if err != nil {
+			return nil, err
+		}
+	}
+	retentionPolicies, err := RetentionPoliciesOfObjects(ctx, b.repository, model.RetentionObjectType_DataTable, []int64{dataTable.ID})
+	if err != nil {
+		b.log.WithName("GetDataTable").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get retention policy")
+		return nil, err
+	}
 
 	return &api.GetDataTableResponse{
-		Code:      int32(code.Code_OK),
-		Id:        dataTable.ID,
-		Name:      dataTable.Name,
-		CreatedAt: dataTable.CreatedAt.String(),
-		UpdatedAt: dataTable.UpdatedAt.String(),
-		Schema:    schema,
+		Code:            int32(code.Code_OK),
+		Id:              dataTable.ID,
+		Name:            dataTable.Name,
+		CreatedAt:       dataTable.CreatedAt.String(),
+		UpdatedAt:       dataTable.UpdatedAt.String(),
+		Schema:          schema,
+		QualityRules:    qualityConfiguration.Rules,
+		ErrorThreshold:  qualityConfiguration.ErrorThreshold,
+		SchemaPolicy:    string(dataTable.SchemaPolicy),
+		RetentionPolicy: retentionPolicies[dataTable.ID],
 	}, nil
 }
 
@@ -171,6 +190,54 @@ func (b business) GetQueryDataTable(ctx context.Context, request *api.GetQueryDa
 			Info("Only owner can access data_table")
 		return nil, status.Error(codes.PermissionDenied, "Only owner can access data_table")
 	}
+	clause, err := timeTravelClause(request.Version, request.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	masks, err := DataTableColumnMasks(ctx, b.repository, accountUuid, dataTable.ID)
+	if err != nil {
+		b.log.WithName("GetQueryDataTable").Error(err, "cannot get column masks", "id", dataTable.ID)
+		return nil, err
+	}
+	if request.Query != nil {
+		err = masks.CheckQuery(request.Query)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := ParseSchema(dataTable.Schema)
+		if err != nil {
+			b.log.WithName("GetQueryDataTable").Error(err, "cannot parse schema", "id", dataTable.ID)
+			return nil, err
+		}
+		res, err := QueryTable(ctx, b.queryAdapter, fmt.Sprintf("delta.`%s`%s", b.deltaTableLocation(dataTable), clause), schema, request.Query, "")
+		if err != nil {
+			b.log.WithName("GetQueryDataTable").Error(err, "cannot query table", "id", dataTable.ID)
+			return nil, err
+		}
+		data, err := masks.MaskRecords(res.Data)
+		if err != nil {
+			b.log.WithName("GetQueryDataTable").Error(err, "cannot mask records", "id", dataTable.ID)
+			return nil, err
+		}
+		return &api.GetQueryDataTableResponse{Code: int32(codes.OK),
+			Count:      res.Count,
+			Data:       data,
+			NextCursor: res.NextCursor,
+		}, nil
+	}
+	if clause != "" {
+		res, err := b.queryDataTableAt(ctx, dataTable, clause, request.Limit)
+		if err != nil {
+			b.log.WithName("GetQueryDataTable").Error(err, "cannot query table at version", "id", dataTable.ID)
+			return nil, err
+		}
+		res.Data, err = masks.MaskRecords(res.Data)
+		if err != nil {
+			b.log.WithName("GetQueryDataTable").Error(err, "cannot mask records", "id", dataTable.ID)
+			return nil, err
+		}
+		return res, nil
+	}
 
 	res, err := b.queryAdapter.GetDataTableV2(ctx, &query.GetQueryDataTableV2Request{
 		Offset:    0,
@@ -182,9 +249,14 @@ func (b business) GetQueryDataTable(ctx context.Context, request *api.GetQueryDa
 		b.log.WithName("GetQueryDataTable").Error(err, "cannot get query table", "id", dataTable.ID)
 		return nil, err
 	}
+	data, err := masks.MaskRecords(res.Data)
+	if err != nil {
+		b.log.WithName("GetQueryDataTable").Error(err, "cannot mask records", "id", dataTable.ID)
+		return nil, err
+	}
 
 	return &api.GetQueryDataTableResponse{Code: int32(codes.OK),
 		Count: res.Count,
-		Data:  res.Data,
+		Data:  data,
 	}, nil
 }
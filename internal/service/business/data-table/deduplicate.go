@@ -0,0 +1,184 @@
+package data_table
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (b business) DeduplicateDataTable(ctx context.Context, request *api.DeduplicateDataTableRequest, accountUuid string) (*api.DeduplicateDataTableResponse, error) {
+	logger := b.log.WithName("DeduplicateDataTable").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can deduplicate data_table")
+	}
+
+	var columns []string
+	if dataTable.Schema.Valid && dataTable.Schema.RawMessage != nil {
+		var schema []model.SchemaUnit
+		err = json.Unmarshal(dataTable.Schema.RawMessage, &schema)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal data table schema")
+			return nil, err
+		}
+		columns = utils.Map(schema, func(unit model.SchemaUnit) string { return unit.ColumnName })
+	}
+	err = ValidateDeduplicationConfiguration(request.Configuration, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	dagId := utils.GenerateDagId(accountUuid, model.ActionType_DeduplicateDataTable)
+	var dataActionId int64
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Call airflow
+		payload := airflow.TriggerGenerateDagDeduplicateDataTableRequest{Conf: airflow.DagDeduplicateDataTableConfig{
+			DagId:         dagId,
+			TableKey:      utils.GenerateDeltaTablePath(accountUuid, dataTable.Name),
+			Deduplication: *BuildDagDeduplication(accountUuid, dataTable.Name, request.Configuration),
+		}}
+		payloadJson, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		_, err = b.airflowAdapter.TriggerGenerateDagDeduplicateDataTable(ctx, &payload)
+		if err != nil {
+			logger.Error(err, "cannot trigger dag generate deduplicate data table")
+			return err
+		}
+
+		// 2. Save data action
+		dataAction, err := b.repository.DataActionRepository.CreateDataAction(ctx, &repository.CreateDataActionParams{
+			Tx:          tx,
+			TargetTable: model.TargetTable_DataTable,
+			ActionType:  model.ActionType_DeduplicateDataTable,
+			Schedule:    "",
+			AccountUuid: uuid.MustParse(accountUuid),
+			DagId:       dagId,
+			Status:      model.DataActionStatus_Pending,
+			ObjectId:    dataTable.ID,
+			Payload:     pqtype.NullRawMessage{RawMessage: payloadJson, Valid: payloadJson != nil},
+		})
+		if err != nil {
+			logger.Error(err, "cannot create data action")
+			return err
+		}
+		dataActionId = dataAction.ID
+
+		// 3. Create data action run
+		_, err = b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+			Tx:          tx,
+			ActionId:    dataAction.ID,
+			RunId:       0,
+			Status:      model.DataActionRunStatus_Creating,
+			AccountUuid: uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create data action run")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DeduplicateDataTableResponse{
+		Code:         int32(code.Code_OK),
+		Message:      "Success",
+		DataActionId: dataActionId,
+	}, nil
+}
+
+// SyncOnDeduplicateDataTable copies the row counts written by the dag to the latest run of the action
+func (b business) SyncOnDeduplicateDataTable(ctx context.Context, dataActionId int64) error {
+	logger := b.log.WithName("SyncOnDeduplicateDataTable").WithValues("dataActionId", dataActionId)
+
+	dataAction, err := b.repository.DataActionRepository.GetDataAction(ctx, dataActionId)
+	if err != nil {
+		logger.Error(err, "cannot get data action")
+		return err
+	}
+	if dataAction.Status != model.DataActionStatus_Success {
+		return nil
+	}
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, dataAction.ObjectId)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return err
+	}
+
+	return SyncDeduplicationReport(ctx, b.repository, b.queryAdapter,
+		fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaDeduplicationReportPath(dataTable.AccountUuid.String(), dataTable.Name)),
+		dataActionId)
+}
+
+// ValidateDeduplicationConfiguration checks the columns against the table columns, skipped when the columns are unknown
+func ValidateDeduplicationConfiguration(configuration *api.DeduplicationConfiguration, columns []string) error {
+	dedupeColumns := append([]string{}, configuration.KeyColumns...)
+	if configuration.TimestampColumn != "" {
+		dedupeColumns = append(dedupeColumns, configuration.TimestampColumn)
+	}
+	for _, column := range dedupeColumns {
+		if len(columns) > 0 && !slices.Contains(columns, column) {
+			return status.Errorf(codes.InvalidArgument, "column %s does not exist in data table", column)
+		}
+	}
+	if model.DeduplicationSurvivorship(configuration.Survivorship) == model.DeduplicationSurvivorship_Latest && configuration.TimestampColumn == "" {
+		return status.Error(codes.InvalidArgument, "latest survivorship requires timestamp_column")
+	}
+	return nil
+}
+
+func BuildDagDeduplication(accountUuid string, tableName string, configuration *api.DeduplicationConfiguration) *airflow.DagDeduplicationConfiguration {
+	return &airflow.DagDeduplicationConfiguration{
+		KeyColumns:      configuration.KeyColumns,
+		Survivorship:    configuration.Survivorship,
+		TimestampColumn: configuration.TimestampColumn,
+		ReportKey:       utils.GenerateDeltaDeduplicationReportPath(accountUuid, tableName),
+	}
+}
+
+// SyncDeduplicationReport reads the before/after row counts of the latest run of the action from the report at path
+func SyncDeduplicationReport(ctx context.Context, repo *repository.Repository, queryAdapter query.QueryAdapter, path string, dataActionId int64) error {
+	dataActionRun, err := repo.DataActionRunRepository.GetLatestDataActionRun(ctx, dataActionId)
+	if err != nil {
+		return err
+	}
+	if dataActionRun.DagRunId == "" {
+		return nil
+	}
+
+	queryResponse, err := queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT row_count_before, row_count_after FROM delta.`%s` WHERE dag_run_id = '%s'", path, dataActionRun.DagRunId),
+	})
+	if err != nil {
+		return err
+	}
+	if len(queryResponse.Data) == 0 {
+		return nil
+	}
+
+	rowCountBefore, _ := queryResponse.Data[0]["row_count_before"].(float64)
+	rowCountAfter, _ := queryResponse.Data[0]["row_count_after"].(float64)
+	return repo.DataActionRunRepository.UpdateDataActionRunRowCounts(ctx, dataActionRun.ID, int64(rowCountBefore), int64(rowCountAfter))
+}
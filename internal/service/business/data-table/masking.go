@@ -0,0 +1,380 @@
+package data_table
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/constants"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type ColumnMask struct {
+	Tag    model.PiiTag
+	Method model.MaskingMethod
+}
+
+// ColumnMasks are the masking methods of the tagged columns of a table for the role of the request,
+// columns read in clear are left out. The same masking is done on json rows here and by spark in the export dags.
+type ColumnMasks map[string]ColumnMask
+
+// DataTableColumnMasks masks the tagged columns of a data table
+func DataTableColumnMasks(ctx context.Context, repo *repository.Repository, accountUuid string, dataTableId int64) (ColumnMasks, error) {
+	tags, err := DataTableColumnTags(ctx, repo, []int64{dataTableId})
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnMasks(ctx, repo, accountUuid, tags[dataTableId])
+}
+
+// AudienceColumnMasks masks the audience columns inheriting a tag, segments and prediction results share them
+func AudienceColumnMasks(ctx context.Context, repo *repository.Repository, accountUuid string, masterSegmentId int64) (ColumnMasks, error) {
+	tags, err := AudienceColumnTags(ctx, repo, masterSegmentId)
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnMasks(ctx, repo, accountUuid, tags)
+}
+
+// BehaviorColumnMasks masks the behavior columns by the tags of the columns of the same name of its data table
+func BehaviorColumnMasks(ctx context.Context, repo *repository.Repository, accountUuid string, behaviorTable *model.BehaviorTable) (ColumnMasks, error) {
+	tags, err := DataTableColumnTags(ctx, repo, []int64{behaviorTable.DataTableId})
+	if err != nil {
+		return nil, err
+	}
+	return NewColumnMasks(ctx, repo, accountUuid, tags[behaviorTable.DataTableId])
+}
+
+// NewColumnMasks applies the masking policies of the account for the role of the request to the tagged columns
+func NewColumnMasks(ctx context.Context, repo *repository.Repository, accountUuid string, tags map[string]model.PiiTag) (ColumnMasks, error) {
+	masks := ColumnMasks{}
+	if len(tags) == 0 {
+		return masks, nil
+	}
+	role := roleFromContext(ctx)
+	policies, err := repo.MaskingPolicyRepository.ListMaskingPolicies(ctx, &repository.ListMaskingPoliciesParams{
+		AccountUuid: uuid.MustParse(accountUuid),
+		Role:        role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	methods := make(map[model.PiiTag]model.MaskingMethod, len(policies))
+	for _, policy := range policies {
+		methods[policy.Tag] = policy.Method
+	}
+
+	for column, tag := range tags {
+		method, ok := methods[tag]
+		if !ok {
+			method = model.MaskingMethod_Default
+			if role == model.Role_Admin {
+				method = model.MaskingMethod_None
+			}
+		}
+		if method != model.MaskingMethod_None {
+			masks[column] = ColumnMask{Tag: tag, Method: method}
+		}
+	}
+	return masks, nil
+}
+
+// roleFromContext is the role of the token of the request, set by the auth interceptor
+func roleFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(constants.KeyRole)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// DataTableColumnTags maps the data tables to the tags of their columns
+func DataTableColumnTags(ctx context.Context, repo *repository.Repository, dataTableIds []int64) (map[int64]map[string]model.PiiTag, error) {
+	columnTags, err := repo.ColumnTagRepository.ListColumnTags(ctx, dataTableIds)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[int64]map[string]model.PiiTag, len(dataTableIds))
+	for _, columnTag := range columnTags {
+		if tags[columnTag.DataTableId] == nil {
+			tags[columnTag.DataTableId] = map[string]model.PiiTag{}
+		}
+		tags[columnTag.DataTableId][columnTag.ColumnName] = columnTag.Tag
+	}
+	return tags, nil
+}
+
+// AudienceColumnTags propagates the tags of the main and attribute tables to the audience columns through their
+// TransferredColumn mappings. A computed attribute keeping values of a tagged column (min, max, first_value,
+// last_value) inherits its tag.
+func AudienceColumnTags(ctx context.Context, repo *repository.Repository, masterSegmentId int64) (map[string]model.PiiTag, error) {
+	audienceTable, err := repo.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegmentId})
+	if err != nil {
+		return nil, err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		return nil, err
+	}
+	behaviorTables, err := repo.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{MasterSegmentId: masterSegmentId})
+	if err != nil {
+		return nil, err
+	}
+
+	dataTableIds := []int64{configuration.MainTableId}
+	for _, attributeTable := range configuration.AttributeTables {
+		dataTableIds = append(dataTableIds, attributeTable.TableId)
+	}
+	behaviorDataTableIds := make(map[string]int64, len(behaviorTables))
+	for _, behaviorTable := range behaviorTables {
+		dataTableIds = append(dataTableIds, behaviorTable.DataTableId)
+		behaviorDataTableIds[behaviorTable.Name] = behaviorTable.DataTableId
+	}
+	tagsByTable, err := DataTableColumnTags(ctx, repo, dataTableIds)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]model.PiiTag{}
+	transfer := func(dataTableId int64, columns []*api.TransferredColumn) {
+		for _, column := range columns {
+			if tag, ok := tagsByTable[dataTableId][column.TableColumnName]; ok {
+				tags[column.NewTableColumnName] = tag
+			}
+		}
+	}
+	if len(configuration.SelectedColumns) == 0 {
+		for column, tag := range tagsByTable[configuration.MainTableId] {
+			tags[column] = tag
+		}
+	}
+	transfer(configuration.MainTableId, configuration.SelectedColumns)
+	for _, attributeTable := range configuration.AttributeTables {
+		transfer(attributeTable.TableId, attributeTable.SelectedColumns)
+	}
+	for _, attribute := range configuration.ComputedAttributes {
+		if !slices.Contains([]string{
+			string(model.ComputedAttributeAggregation_Min),
+			string(model.ComputedAttributeAggregation_Max),
+			string(model.ComputedAttributeAggregation_FirstValue),
+			string(model.ComputedAttributeAggregation_LastValue),
+		}, attribute.Aggregation) {
+			continue
+		}
+		if tag, ok := tagsByTable[behaviorDataTableIds[attribute.BehaviorTable]][attribute.Column]; ok {
+			tags[attribute.Name] = tag
+		}
+	}
+	return tags, nil
+}
+
+// MaskRecords masks the json rows of a query, the rows are returned as is when no column is masked
+func (m ColumnMasks) MaskRecords(records []string) ([]string, error) {
+	if len(m) == 0 {
+		return records, nil
+	}
+	masked := make([]string, 0, len(records))
+	for _, record := range records {
+		decoder := json.NewDecoder(bytes.NewReader([]byte(record)))
+		// numbers of the columns in clear keep their text
+		decoder.UseNumber()
+		var row map[string]any
+		err := decoder.Decode(&row)
+		if err != nil {
+			return nil, err
+		}
+		m.MaskRow(row)
+		maskedRecord, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		masked = append(masked, string(maskedRecord))
+	}
+	return masked, nil
+}
+
+// MaskRow masks the values of row in place
+func (m ColumnMasks) MaskRow(row map[string]any) {
+	for column, value := range row {
+		if _, ok := m[column]; ok {
+			row[column] = m.MaskValue(column, value)
+		}
+	}
+}
+
+// MaskValue masks a value of column, null stays null
+func (m ColumnMasks) MaskValue(column string, value any) any {
+	mask, ok := m[column]
+	if !ok || value == nil {
+		return value
+	}
+	text := maskValueString(value)
+	switch mask.Method {
+	case model.MaskingMethod_Full:
+		return "****"
+	case model.MaskingMethod_Hashed:
+		hash := sha256.Sum256([]byte(text))
+		return hex.EncodeToString(hash[:])
+	case model.MaskingMethod_Null:
+		return nil
+	case model.MaskingMethod_Partial:
+		return partialMask(mask.Tag, text)
+	}
+	return value
+}
+
+// MaskString masks a value of column known as a string, a null mask gives an empty string
+func (m ColumnMasks) MaskString(column string, value string) string {
+	masked, _ := m.MaskValue(column, value).(string)
+	return masked
+}
+
+// MaskProfile masks the values of the masked columns kept by a profile. The mean and the histogram of a masked
+// column are removed, the mean and the bucket bounds tell its values as well as its min and max.
+func (m ColumnMasks) MaskProfile(profile *api.DataProfile) {
+	if profile == nil {
+		return
+	}
+	for _, column := range profile.Columns {
+		if _, ok := m[column.ColumnName]; !ok {
+			continue
+		}
+		column.Min = m.MaskString(column.ColumnName, column.Min)
+		column.Max = m.MaskString(column.ColumnName, column.Max)
+		for _, topValue := range column.TopValues {
+			topValue.Value = m.MaskString(column.ColumnName, topValue.Value)
+		}
+		column.Mean = nil
+		column.Histogram = nil
+	}
+}
+
+// CheckQuery rejects the filters and sorts of a table query on masked columns
+func (m ColumnMasks) CheckQuery(tableQuery *api.TableQuery) error {
+	if tableQuery == nil {
+		return nil
+	}
+	for _, sort := range tableQuery.Sorts {
+		err := m.CheckColumn(sort.Column)
+		if err != nil {
+			return err
+		}
+	}
+	return m.CheckRule(tableQuery.Filter)
+}
+
+// CheckRule rejects the conditions of rule and of its nested rules on masked columns
+func (m ColumnMasks) CheckRule(rule *api.Rule) error {
+	if rule == nil {
+		return nil
+	}
+	for _, child := range rule.Rules {
+		err := m.CheckRule(child)
+		if err != nil {
+			return err
+		}
+	}
+	return m.CheckColumn(rule.Field)
+}
+
+// CheckColumn rejects filtering, sorting or searching a masked column, the raw value would be recovered one character
+// at a time from the rows selected or their order
+func (m ColumnMasks) CheckColumn(column string) error {
+	if _, ok := m[column]; ok {
+		return status.Errorf(codes.PermissionDenied, "column %s is masked for your role, it cannot be filtered, sorted or searched", column)
+	}
+	return nil
+}
+
+// SparkColumns are the masks as spark sql expressions for the payload of the export dags
+func (m ColumnMasks) SparkColumns() []airflow.MaskedColumn {
+	columns := make([]airflow.MaskedColumn, 0, len(m))
+	for column, mask := range m {
+		value := fmt.Sprintf("CAST(%s AS STRING)", utils.QuoteSparkIdentifier(column))
+		var expression string
+		switch mask.Method {
+		case model.MaskingMethod_Full:
+			expression = fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE '****' END", value)
+		case model.MaskingMethod_Hashed:
+			expression = fmt.Sprintf("sha2(%s, 256)", value)
+		case model.MaskingMethod_Null:
+			expression = "CAST(NULL AS STRING)"
+		case model.MaskingMethod_Partial:
+			expression = partialMaskExpression(mask.Tag, value)
+		default:
+			continue
+		}
+		columns = append(columns, airflow.MaskedColumn{ColumnName: column, Expression: expression})
+	}
+	sort.Slice(columns, func(i, j int) bool { return columns[i].ColumnName < columns[j].ColumnName })
+	return columns
+}
+
+// partialMask keeps the first character and the domain of an email, the last 4 characters of a phone or
+// national id, the first character of the other values
+func partialMask(tag model.PiiTag, value string) string {
+	runes := []rune(value)
+	switch tag {
+	case model.PiiTag_Email:
+		if idx := strings.Index(value, "@"); idx > 0 {
+			return string(runes[:1]) + "***" + value[idx:]
+		}
+	case model.PiiTag_Phone, model.PiiTag_NationalId:
+		if len(runes) > 4 {
+			return "***" + string(runes[len(runes)-4:])
+		}
+		return "***"
+	}
+	if len(runes) == 0 {
+		return "***"
+	}
+	return string(runes[:1]) + "***"
+}
+
+// partialMaskExpression is partialMask in spark sql
+func partialMaskExpression(tag model.PiiTag, value string) string {
+	switch tag {
+	case model.PiiTag_Email:
+		return fmt.Sprintf("CASE WHEN instr(%s, '@') > 1 THEN concat(substring(%s, 1, 1), '***', substring(%s, instr(%s, '@'))) ELSE concat(substring(%s, 1, 1), '***') END",
+			value, value, value, value, value)
+	case model.PiiTag_Phone, model.PiiTag_NationalId:
+		return fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL WHEN char_length(%s) > 4 THEN concat('***', right(%s, 4)) ELSE '***' END",
+			value, value, value)
+	}
+	return fmt.Sprintf("concat(substring(%s, 1, 1), '***')", value)
+}
+
+func maskValueString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	text, _ := json.Marshal(value)
+	return string(text)
+}
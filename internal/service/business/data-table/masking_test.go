@@ -0,0 +1,72 @@
+package data_table
+
+import (
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMaskProfileHidesNumericDistribution(t *testing.T) {
+	masks := ColumnMasks{
+		"national_id": {Tag: model.PiiTag_NationalId, Method: model.MaskingMethod_Partial},
+		"phone":       {Tag: model.PiiTag_Phone, Method: model.MaskingMethod_Null},
+	}
+	histogram := []*api.HistogramBucket{{Lower: 79201001000, Upper: 79201001500, Count: 3}, {Lower: 79201001500, Upper: 79201002000, Count: 1}}
+	profile := &api.DataProfile{RowCount: 4, Columns: []*api.ColumnProfile{
+		{
+			ColumnName: "national_id",
+			DataType:   "bigint",
+			Min:        "79201001000",
+			Max:        "79201002000",
+			Mean:       wrapperspb.Double(79201001234),
+			Histogram:  histogram,
+			TopValues:  []*api.ValueCount{{Value: "79201001234", Count: 2}},
+			NullRatio:  0.25,
+		},
+		{ColumnName: "phone", DataType: "string", Min: "0901234567", Max: "0987654321"},
+		{ColumnName: "age", DataType: "int", Min: "18", Max: "60", Mean: wrapperspb.Double(31.5), Histogram: histogram},
+	}}
+	masks.MaskProfile(profile)
+
+	nationalId, phone, age := profile.Columns[0], profile.Columns[1], profile.Columns[2]
+	if nationalId.Min != "***1000" || nationalId.Max != "***2000" || nationalId.TopValues[0].Value != "***1234" {
+		t.Errorf("national_id values = %s, %s, %v", nationalId.Min, nationalId.Max, nationalId.TopValues)
+	}
+	// the mean and the bucket bounds are the values the min and max hide
+	if nationalId.Mean != nil || nationalId.Histogram != nil {
+		t.Errorf("national_id keeps mean %v and histogram %v", nationalId.Mean, nationalId.Histogram)
+	}
+	// the counts of the column do not tell its values
+	if nationalId.NullRatio != 0.25 || nationalId.TopValues[0].Count != 2 {
+		t.Errorf("national_id counts = %v, %v", nationalId.NullRatio, nationalId.TopValues)
+	}
+	if phone.Min != "" || phone.Max != "" {
+		t.Errorf("phone values = %s, %s", phone.Min, phone.Max)
+	}
+	if age.Mean.GetValue() != 31.5 || len(age.Histogram) != 2 || age.Min != "18" {
+		t.Errorf("a column without mask is masked: %v", age)
+	}
+
+	// a table without profile yet has nothing to mask
+	masks.MaskProfile(nil)
+}
+
+func TestPartialMask(t *testing.T) {
+	// the first character stays, with the domain of an email and the last 4 digits of a number
+	masked := map[model.PiiTag]map[string]string{
+		model.PiiTag_Email:      {"alice@example.com": "a***@example.com", "ánh@example.com": "á***@example.com", "@example.com": "@***", "alice": "a***"},
+		model.PiiTag_Phone:      {"+84901234567": "***4567", "1234": "***"},
+		model.PiiTag_NationalId: {"079201001234": "***1234"},
+		model.PiiTag_Name:       {"Nguyễn": "N***", "": "***"},
+		model.PiiTag_Address:    {"1 Main St": "1***"},
+	}
+	for tag, values := range masked {
+		for value, want := range values {
+			if got := partialMask(tag, value); got != want {
+				t.Errorf("partialMask(%s, %q) = %q, want %q", tag, value, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package data_table
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// piiColumnNames are the words of column names suggesting a tag, column names are split on non alphanumeric characters
+var piiColumnNames = map[model.PiiTag][]string{
+	model.PiiTag_Email:      {"email", "mail", "emailaddress"},
+	model.PiiTag_Phone:      {"phone", "mobile", "tel", "telephone", "cellphone", "msisdn", "phonenumber"},
+	model.PiiTag_Name:       {"name", "firstname", "lastname", "fullname", "surname", "givenname", "middlename"},
+	model.PiiTag_Address:    {"address", "street", "addr", "postcode", "postalcode", "zipcode", "zip"},
+	model.PiiTag_NationalId: {"ssn", "nationalid", "passport", "cccd", "cmnd", "taxid", "idcard", "identitynumber"},
+}
+
+// piiValuePatterns recognize tags from sampled values, the tags without a reliable pattern are only suggested by name
+var piiValuePatterns = map[model.PiiTag]*regexp.Regexp{
+	model.PiiTag_Email: regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`),
+	model.PiiTag_Phone: regexp.MustCompile(`^(\+|0)[0-9][0-9 ().\-]{6,18}[0-9]$`),
+}
+
+// piiTags orders the tags when several match
+var piiTags = []model.PiiTag{model.PiiTag_Email, model.PiiTag_Phone, model.PiiTag_NationalId, model.PiiTag_Address, model.PiiTag_Name}
+
+func (b business) SuggestColumnTags(ctx context.Context, request *api.SuggestColumnTagsRequest, accountUuid string) (*api.SuggestColumnTagsResponse, error) {
+	logger := b.log.WithName("SuggestColumnTags").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can tag data_table")
+	}
+	schema, err := ParseSchema(dataTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse schema")
+		return nil, err
+	}
+	tags, err := DataTableColumnTags(ctx, b.repository, []int64{dataTable.ID})
+	if err != nil {
+		logger.Error(err, "cannot list column tags")
+		return nil, err
+	}
+
+	samples, err := b.sampleColumnValues(ctx, dataTable, schema)
+	if err != nil {
+		logger.Error(err, "cannot sample column values")
+		return nil, err
+	}
+
+	suggestions := make([]*api.ColumnTagSuggestion, 0)
+	for _, column := range schema {
+		suggestion := suggestColumnTag(column.ColumnName, samples[column.ColumnName])
+		if suggestion == nil {
+			continue
+		}
+		suggestion.CurrentTag = string(tags[dataTable.ID][column.ColumnName])
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return &api.SuggestColumnTagsResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		Suggestions: suggestions,
+	}, nil
+}
+
+func (b business) UpdateColumnTags(ctx context.Context, request *api.UpdateColumnTagsRequest, accountUuid string) (*api.UpdateColumnTagsResponse, error) {
+	logger := b.log.WithName("UpdateColumnTags").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can tag data_table")
+	}
+	schema, err := ParseSchema(dataTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse schema")
+		return nil, err
+	}
+
+	columnTags := make([]model.ColumnTag, 0, len(request.Tags))
+	tagged := map[string]bool{}
+	for _, tag := range request.Tags {
+		if _, ok := SchemaColumnType(schema, tag.ColumnName); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "column %s does not exist", tag.ColumnName)
+		}
+		if tagged[tag.ColumnName] {
+			return nil, status.Errorf(codes.InvalidArgument, "column %s is tagged twice", tag.ColumnName)
+		}
+		tagged[tag.ColumnName] = true
+		columnTags = append(columnTags, model.ColumnTag{
+			DataTableId: dataTable.ID,
+			ColumnName:  tag.ColumnName,
+			Tag:         model.PiiTag(tag.Tag),
+		})
+	}
+	err = b.repository.ColumnTagRepository.ReplaceColumnTags(ctx, dataTable.ID, columnTags)
+	if err != nil {
+		logger.Error(err, "cannot replace column tags")
+		return nil, err
+	}
+
+	return &api.UpdateColumnTagsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) GetColumnTags(ctx context.Context, request *api.GetColumnTagsRequest, accountUuid string) (*api.GetColumnTagsResponse, error) {
+	logger := b.log.WithName("GetColumnTags").WithValues("request", request)
+
+	var tags map[string]model.PiiTag
+	switch {
+	case request.DataTableId != 0:
+		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.DataTableId)
+		if err != nil {
+			logger.Error(err, "cannot get data table")
+			return nil, err
+		}
+		if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+			return nil, status.Error(codes.PermissionDenied, "Only owner can get data_table")
+		}
+		tagsByTable, err := DataTableColumnTags(ctx, b.repository, []int64{dataTable.ID})
+		if err != nil {
+			logger.Error(err, "cannot list column tags")
+			return nil, err
+		}
+		tags = tagsByTable[dataTable.ID]
+	case request.MasterSegmentId != 0:
+		masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.MasterSegmentId)
+		if err != nil {
+			logger.Error(err, "cannot get master segment")
+			return nil, err
+		}
+		if masterSegment.AccountUuid.String() != accountUuid {
+			return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+		}
+		tags, err = AudienceColumnTags(ctx, b.repository, masterSegment.ID)
+		if err != nil {
+			logger.Error(err, "cannot get audience column tags")
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "data_table_id or master_segment_id is required")
+	}
+
+	columnTags := make([]*api.ColumnTag, 0, len(tags))
+	for column, tag := range tags {
+		columnTags = append(columnTags, &api.ColumnTag{ColumnName: column, Tag: string(tag)})
+	}
+	sort.Slice(columnTags, func(i, j int) bool { return columnTags[i].ColumnName < columnTags[j].ColumnName })
+
+	return &api.GetColumnTagsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Tags:    columnTags,
+	}, nil
+}
+
+func (b business) UpdateMaskingPolicies(ctx context.Context, request *api.UpdateMaskingPoliciesRequest, accountUuid string) (*api.UpdateMaskingPoliciesResponse, error) {
+	logger := b.log.WithName("UpdateMaskingPolicies").WithValues("request", request)
+
+	policies := make([]model.MaskingPolicy, 0, len(request.Policies))
+	for _, policy := range request.Policies {
+		if slices.ContainsFunc(policies, func(other model.MaskingPolicy) bool {
+			return other.Role == policy.Role && string(other.Tag) == policy.Tag
+		}) {
+			return nil, status.Errorf(codes.InvalidArgument, "role %s has two policies for tag %s", policy.Role, policy.Tag)
+		}
+		policies = append(policies, model.MaskingPolicy{
+			AccountUuid: uuid.MustParse(accountUuid),
+			Role:        policy.Role,
+			Tag:         model.PiiTag(policy.Tag),
+			Method:      model.MaskingMethod(policy.Method),
+		})
+	}
+	err := b.repository.MaskingPolicyRepository.ReplaceMaskingPolicies(ctx, uuid.MustParse(accountUuid), policies)
+	if err != nil {
+		logger.Error(err, "cannot replace masking policies")
+		return nil, err
+	}
+
+	return &api.UpdateMaskingPoliciesResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) GetMaskingPolicies(ctx context.Context, request *api.GetMaskingPoliciesRequest, accountUuid string) (*api.GetMaskingPoliciesResponse, error) {
+	logger := b.log.WithName("GetMaskingPolicies")
+
+	policies, err := b.repository.MaskingPolicyRepository.ListMaskingPolicies(ctx, &repository.ListMaskingPoliciesParams{
+		AccountUuid: uuid.MustParse(accountUuid),
+	})
+	if err != nil {
+		logger.Error(err, "cannot list masking policies")
+		return nil, err
+	}
+
+	return &api.GetMaskingPoliciesResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Policies: utils.Map(policies, func(policy model.MaskingPolicy) *api.MaskingPolicy {
+			return &api.MaskingPolicy{
+				Role:   policy.Role,
+				Tag:    string(policy.Tag),
+				Method: string(policy.Method),
+			}
+		}),
+	}, nil
+}
+
+// sampleColumnValues reads the non null values of the first rows of the data table as strings
+func (b business) sampleColumnValues(ctx context.Context, dataTable *model.DataTable, schema []model.SchemaUnit) (map[string][]string, error) {
+	samples := make(map[string][]string, len(schema))
+	if len(schema) == 0 {
+		return samples, nil
+	}
+	projection := make([]string, 0, len(schema))
+	for idx, column := range schema {
+		projection = append(projection, fmt.Sprintf("CAST(%s AS STRING) AS value_%d", utils.QuoteSparkIdentifier(column.ColumnName), idx))
+	}
+	response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT %s FROM delta.`%s` LIMIT %d", strings.Join(projection, ", "), b.deltaTableLocation(dataTable), model.ColumnTag_SampleSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range response.Data {
+		for idx, column := range schema {
+			if value, ok := row[fmt.Sprintf("value_%d", idx)].(string); ok && strings.TrimSpace(value) != "" {
+				samples[column.ColumnName] = append(samples[column.ColumnName], strings.TrimSpace(value))
+			}
+		}
+	}
+	return samples, nil
+}
+
+// suggestColumnTag prefers the values to the name of the column, nil if neither suggests a tag
+func suggestColumnTag(columnName string, values []string) *api.ColumnTagSuggestion {
+	if len(values) > 0 {
+		for _, tag := range piiTags {
+			pattern, ok := piiValuePatterns[tag]
+			if !ok {
+				continue
+			}
+			matched := 0
+			for _, value := range values {
+				if pattern.MatchString(value) {
+					matched++
+				}
+			}
+			ratio := float64(matched) / float64(len(values))
+			if ratio >= model.ColumnTag_MinMatchRatio {
+				return &api.ColumnTagSuggestion{ColumnName: columnName, Tag: string(tag), Reason: "sample_values", Confidence: ratio}
+			}
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(columnName), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+	// snake, kebab and camel case names give the same joined name
+	joined := strings.Join(words, "")
+	for _, tag := range piiTags {
+		for _, name := range piiColumnNames[tag] {
+			if joined == name || slices.Contains(words, name) {
+				return &api.ColumnTagSuggestion{ColumnName: columnName, Tag: string(tag), Reason: "column_name", Confidence: 1}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package data_table
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (b business) ProfileDataTable(ctx context.Context, request *api.ProfileDataTableRequest, accountUuid string) (*api.ProfileDataTableResponse, error) {
+	logger := b.log.WithName("ProfileDataTable").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can profile data_table")
+	}
+
+	profile, err := RefreshDataTableProfile(ctx, b.repository, b.queryAdapter, b.config.S3StorageConfig.Bucket, dataTable)
+	if err != nil {
+		logger.Error(err, "cannot profile data table")
+		return nil, err
+	}
+	masks, err := DataTableColumnMasks(ctx, b.repository, accountUuid, dataTable.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return nil, err
+	}
+	masks.MaskProfile(profile)
+
+	return &api.ProfileDataTableResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Profile: profile,
+	}, nil
+}
+
+func (b business) GetDataTableStatistics(ctx context.Context, request *api.GetDataTableStatisticsRequest, accountUuid string) (*api.GetDataTableStatisticsResponse, error) {
+	logger := b.log.WithName("GetDataTableStatistics").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can get data_table")
+	}
+
+	dataProfile, err := b.repository.DataProfileRepository.GetDataProfile(ctx, model.DataProfileObjectType_DataTable, dataTable.ID)
+	if err != nil {
+		logger.Error(err, "cannot get data profile")
+		return nil, err
+	}
+	profile, err := ParseDataProfile(dataProfile)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal data profile")
+		return nil, err
+	}
+	masks, err := DataTableColumnMasks(ctx, b.repository, accountUuid, dataTable.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return nil, err
+	}
+	masks.MaskProfile(profile)
+
+	return &api.GetDataTableStatisticsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Profile: profile,
+	}, nil
+}
+
+// RefreshDataTableProfile profiles the delta table of the data table with its current schema and saves the result
+func RefreshDataTableProfile(ctx context.Context, repo *repository.Repository, queryAdapter query.QueryAdapter, bucket string, dataTable *model.DataTable) (*api.DataProfile, error) {
+	schema, err := ParseSchema(dataTable.Schema)
+	if err != nil {
+		return nil, err
+	}
+	location := fmt.Sprintf("s3a://%s/%s", bucket, utils.GenerateDeltaTablePath(dataTable.AccountUuid.String(), dataTable.Name))
+	profile, err := ProfileDeltaTable(ctx, queryAdapter, location, schema)
+	if err != nil {
+		return nil, err
+	}
+	return profile, SaveDataProfile(ctx, repo, model.DataProfileObjectType_DataTable, dataTable.ID, profile)
+}
+
+func SaveDataProfile(ctx context.Context, repo *repository.Repository, objectType model.DataProfileObjectType, objectId int64, profile *api.DataProfile) error {
+	jsonColumns, err := json.Marshal(profile.Columns)
+	if err != nil {
+		return err
+	}
+	_, err = repo.DataProfileRepository.SaveDataProfile(ctx, &repository.SaveDataProfileParams{
+		ObjectType: objectType,
+		ObjectId:   objectId,
+		RowCount:   profile.RowCount,
+		Columns:    pqtype.NullRawMessage{RawMessage: jsonColumns, Valid: true},
+	})
+	return err
+}
+
+// ParseDataProfile returns nil when the object has not been profiled yet
+func ParseDataProfile(dataProfile *model.DataProfile) (*api.DataProfile, error) {
+	if dataProfile == nil {
+		return nil, nil
+	}
+	var columns []*api.ColumnProfile
+	if dataProfile.Columns.Valid && dataProfile.Columns.RawMessage != nil {
+		err := json.Unmarshal(dataProfile.Columns.RawMessage, &columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &api.DataProfile{
+		RowCount:   dataProfile.RowCount,
+		Columns:    columns,
+		ProfiledAt: dataProfile.UpdatedAt.String(),
+	}, nil
+}
+
+// RemoveProfileValue removes value from the top values, min and max of every column of profile, it returns the number
+// of values removed
+func RemoveProfileValue(profile *api.DataProfile, value string) int64 {
+	var removed int64
+	for _, column := range profile.Columns {
+		if len(column.TopValues) > 0 {
+			topValues := make([]*api.ValueCount, 0, len(column.TopValues))
+			for _, topValue := range column.TopValues {
+				if topValue.Value == value {
+					removed++
+					continue
+				}
+				topValues = append(topValues, topValue)
+			}
+			column.TopValues = topValues
+		}
+		if column.Min == value {
+			column.Min = ""
+			removed++
+		}
+		if column.Max == value {
+			column.Max = ""
+			removed++
+		}
+	}
+	return removed
+}
+
+// ProfileDeltaTable computes the statistics of every column of schema in one aggregation over the delta table at location,
+// then one query per column for its top values or histogram
+func ProfileDeltaTable(ctx context.Context, queryAdapter query.QueryAdapter, location string, schema []model.SchemaUnit) (*api.DataProfile, error) {
+	table := fmt.Sprintf("delta.`%s`", location)
+
+	aggregations := []string{"COUNT(*) AS row_count"}
+	for idx, column := range schema {
+		name := utils.QuoteSparkIdentifier(column.ColumnName)
+		aggregations = append(aggregations,
+			fmt.Sprintf("COUNT(%s) AS non_null_%d", name, idx),
+			fmt.Sprintf("approx_count_distinct(%s) AS distinct_%d", name, idx))
+		dataType := normalizeDataType(column.DataType)
+		if isOrderedDataType(dataType) {
+			aggregations = append(aggregations,
+				fmt.Sprintf("CAST(MIN(%s) AS STRING) AS min_%d", name, idx),
+				fmt.Sprintf("CAST(MAX(%s) AS STRING) AS max_%d", name, idx))
+		}
+		if isNumericDataType(dataType) {
+			aggregations = append(aggregations, fmt.Sprintf("AVG(%s) AS mean_%d", name, idx))
+		}
+	}
+	aggregateResponse, err := queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT %s FROM %s", strings.Join(aggregations, ", "), table),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(aggregateResponse.Data) == 0 {
+		return nil, status.Error(codes.Internal, "profile query returned no row")
+	}
+	aggregate := aggregateResponse.Data[0]
+
+	rowCount := toInt64(aggregate["row_count"])
+	columns := make([]*api.ColumnProfile, 0, len(schema))
+	for idx, column := range schema {
+		columnProfile := &api.ColumnProfile{
+			ColumnName:    column.ColumnName,
+			DataType:      column.DataType,
+			DistinctCount: toInt64(aggregate[fmt.Sprintf("distinct_%d", idx)]),
+		}
+		if rowCount > 0 {
+			columnProfile.NullRatio = float64(rowCount-toInt64(aggregate[fmt.Sprintf("non_null_%d", idx)])) / float64(rowCount)
+		}
+		columnProfile.Min, _ = aggregate[fmt.Sprintf("min_%d", idx)].(string)
+		columnProfile.Max, _ = aggregate[fmt.Sprintf("max_%d", idx)].(string)
+		if mean, ok := aggregate[fmt.Sprintf("mean_%d", idx)].(float64); ok {
+			columnProfile.Mean = wrapperspb.Double(mean)
+		}
+
+		dataType := normalizeDataType(column.DataType)
+		if dataType != string(model.ColumnDataType_Float) {
+			columnProfile.TopValues, err = queryTopValues(ctx, queryAdapter, table, column.ColumnName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if isNumericDataType(dataType) {
+			columnProfile.Histogram, err = queryHistogram(ctx, queryAdapter, table, column.ColumnName, columnProfile.Min, columnProfile.Max)
+			if err != nil {
+				return nil, err
+			}
+		}
+		columns = append(columns, columnProfile)
+	}
+
+	return &api.DataProfile{
+		RowCount:   rowCount,
+		Columns:    columns,
+		ProfiledAt: time.Now().UTC().String(),
+	}, nil
+}
+
+func queryTopValues(ctx context.Context, queryAdapter query.QueryAdapter, table string, columnName string) ([]*api.ValueCount, error) {
+	name := utils.QuoteSparkIdentifier(columnName)
+	response, err := queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT CAST(%s AS STRING) AS value, COUNT(*) AS count FROM %s WHERE %s IS NOT NULL GROUP BY %s ORDER BY count DESC LIMIT %d",
+			name, table, name, name, model.DataProfile_TopValuesSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.Map(response.Data, func(row map[string]any) *api.ValueCount {
+		value, _ := row["value"].(string)
+		return &api.ValueCount{Value: value, Count: toInt64(row["count"])}
+	}), nil
+}
+
+// queryHistogram splits [min, max] in equal width buckets, max falls in the last bucket
+func queryHistogram(ctx context.Context, queryAdapter query.QueryAdapter, table string, columnName string, min string, max string) ([]*api.HistogramBucket, error) {
+	lower, err := strconv.ParseFloat(min, 64)
+	if err != nil {
+		return nil, nil
+	}
+	upper, err := strconv.ParseFloat(max, 64)
+	if err != nil || upper <= lower {
+		return nil, nil
+	}
+	width := (upper - lower) / model.DataProfile_HistogramBuckets
+
+	name := utils.QuoteSparkIdentifier(columnName)
+	response, err := queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT LEAST(CAST(FLOOR((%s - %v) / %v) AS INT), %d) AS bucket, COUNT(*) AS count FROM %s WHERE %s IS NOT NULL GROUP BY 1",
+			name, lower, width, model.DataProfile_HistogramBuckets-1, table, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	histogram := make([]*api.HistogramBucket, 0, model.DataProfile_HistogramBuckets)
+	for idx := 0; idx < model.DataProfile_HistogramBuckets; idx++ {
+		histogram = append(histogram, &api.HistogramBucket{
+			Lower: lower + float64(idx)*width,
+			Upper: lower + float64(idx+1)*width,
+		})
+	}
+	for _, row := range response.Data {
+		bucket := toInt64(row["bucket"])
+		if bucket >= 0 && bucket < int64(len(histogram)) {
+			histogram[bucket].Count = toInt64(row["count"])
+		}
+	}
+	return histogram, nil
+}
+
+func isNumericDataType(dataType string) bool {
+	return dataType == string(model.ColumnDataType_Int) || dataType == string(model.ColumnDataType_Float)
+}
+
+func isOrderedDataType(dataType string) bool {
+	return slices.Contains([]string{
+		string(model.ColumnDataType_Int),
+		string(model.ColumnDataType_Float),
+		string(model.ColumnDataType_Date),
+		string(model.ColumnDataType_Timestamp),
+		string(model.ColumnDataType_String),
+	}, dataType)
+}
+
+func toInt64(value any) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case string:
+		number, _ := strconv.ParseInt(v, 10, 64)
+		return number
+	}
+	return 0
+}
@@ -0,0 +1,81 @@
+package data_table
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+)
+
+// rawSQLQueryAdapter answers every raw sql query with rows
+type rawSQLQueryAdapter struct {
+	query.QueryAdapter
+	rows    []map[string]any
+	queries []string
+}
+
+func (a *rawSQLQueryAdapter) QueryRawSQL(ctx context.Context, request *query.QueryRawSQLRequest) (*query.QueryRawSQLResponse, error) {
+	a.queries = append(a.queries, request.Query)
+	return &query.QueryRawSQLResponse{Data: a.rows}, nil
+}
+
+func TestQueryHistogram(t *testing.T) {
+	// the query returns only the buckets having values, max is counted in the last bucket by LEAST
+	adapter := &rawSQLQueryAdapter{rows: []map[string]any{
+		{"bucket": float64(0), "count": float64(4)},
+		{"bucket": float64(model.DataProfile_HistogramBuckets - 1), "count": float64(2)},
+	}}
+	histogram, err := queryHistogram(context.Background(), adapter, "delta.`t`", "age", "10", "30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(histogram) != model.DataProfile_HistogramBuckets {
+		t.Fatalf("histogram has %d buckets, want %d", len(histogram), model.DataProfile_HistogramBuckets)
+	}
+	var total int64
+	for idx, bucket := range histogram {
+		if idx > 0 && bucket.Lower != histogram[idx-1].Upper {
+			t.Errorf("bucket %d starts at %v, the previous ends at %v", idx, bucket.Lower, histogram[idx-1].Upper)
+		}
+		total += bucket.Count
+	}
+	if histogram[0].Lower != 10 || histogram[len(histogram)-1].Upper != 30 || total != 6 || histogram[0].Count != 4 {
+		t.Errorf("histogram = %v", histogram)
+	}
+	if len(adapter.queries) != 1 || !strings.Contains(adapter.queries[0], "(`age` - 10) / 2") {
+		t.Errorf("histogram query = %q", adapter.queries)
+	}
+
+	// a constant or not numeric column has no histogram and is not queried
+	adapter = &rawSQLQueryAdapter{}
+	for _, bounds := range [][2]string{{"5", "5"}, {"", ""}, {"2024-01-01", "2024-02-01"}} {
+		histogram, err := queryHistogram(context.Background(), adapter, "delta.`t`", "age", bounds[0], bounds[1])
+		if err != nil || histogram != nil {
+			t.Errorf("queryHistogram(%q, %q) = %v, %v", bounds[0], bounds[1], histogram, err)
+		}
+	}
+	if len(adapter.queries) != 0 {
+		t.Errorf("queried %q", adapter.queries)
+	}
+}
+
+func TestRemoveProfileValue(t *testing.T) {
+	profile := &api.DataProfile{Columns: []*api.ColumnProfile{
+		{ColumnName: "email", Min: "a@b.c", Max: "z@b.c", TopValues: []*api.ValueCount{{Value: "a@b.c", Count: 2}, {Value: "x@b.c", Count: 1}}},
+		{ColumnName: "referrer", Min: "a@b.c", Max: "a@b.c"},
+		{ColumnName: "age", Min: "1", Max: "90"},
+	}}
+	if removed := RemoveProfileValue(profile, "a@b.c"); removed != 4 {
+		t.Errorf("RemoveProfileValue() = %d, want 4", removed)
+	}
+	email, referrer, age := profile.Columns[0], profile.Columns[1], profile.Columns[2]
+	if email.Min != "" || email.Max != "z@b.c" || len(email.TopValues) != 1 || email.TopValues[0].Value != "x@b.c" {
+		t.Errorf("email profile = %v", email)
+	}
+	if referrer.Min != "" || referrer.Max != "" || age.Min != "1" || age.Max != "90" {
+		t.Errorf("referrer profile = %v, age profile = %v", referrer, age)
+	}
+}
@@ -0,0 +1,95 @@
+package data_table
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (b business) UpdateDataTableQualityRules(ctx context.Context, request *api.UpdateDataTableQualityRulesRequest, accountUuid string) (*api.UpdateDataTableQualityRulesResponse, error) {
+	logger := b.log.WithName("UpdateDataTableQualityRules").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can update data_table")
+	}
+
+	var columns []string
+	if dataTable.Schema.Valid && dataTable.Schema.RawMessage != nil {
+		var schema []model.SchemaUnit
+		err = json.Unmarshal(dataTable.Schema.RawMessage, &schema)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal data table schema")
+			return nil, err
+		}
+		for _, column := range schema {
+			columns = append(columns, column.ColumnName)
+		}
+	}
+	for _, rule := range request.Rules {
+		err = validateDataQualityRule(rule, columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	jsonQualityConfiguration, err := json.Marshal(model.DataQualityConfiguration{
+		Rules:          request.Rules,
+		ErrorThreshold: request.ErrorThreshold,
+	})
+	if err != nil {
+		logger.Error(err, "cannot marshal quality configuration")
+		return nil, err
+	}
+	_, err = b.repository.DataTableRepository.UpdateDataTable(ctx, &repository.UpdateDataTableParams{
+		ID:                   dataTable.ID,
+		QualityConfiguration: pqtype.NullRawMessage{RawMessage: jsonQualityConfiguration, Valid: true},
+	})
+	if err != nil {
+		logger.Error(err, "cannot update data table quality configuration")
+		return nil, err
+	}
+
+	return &api.UpdateDataTableQualityRulesResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func validateDataQualityRule(rule *api.DataQualityRule, columns []string) error {
+	if len(columns) > 0 && !slices.Contains(columns, rule.ColumnName) {
+		return status.Errorf(codes.InvalidArgument, "column %s does not exist in data table", rule.ColumnName)
+	}
+	switch model.DataQualityRuleType(rule.Type) {
+	case model.DataQualityRuleType_Regex:
+		if _, err := regexp.Compile(rule.Pattern); err != nil || rule.Pattern == "" {
+			return status.Errorf(codes.InvalidArgument, "invalid pattern of regex rule on column %s", rule.ColumnName)
+		}
+	case model.DataQualityRuleType_AllowedValues:
+		if len(rule.AllowedValues) == 0 {
+			return status.Errorf(codes.InvalidArgument, "allowed_values rule on column %s requires allowed_values", rule.ColumnName)
+		}
+	case model.DataQualityRuleType_Range:
+		if rule.Min == nil && rule.Max == nil {
+			return status.Errorf(codes.InvalidArgument, "range rule on column %s requires min or max", rule.ColumnName)
+		}
+		if rule.Min != nil && rule.Max != nil && rule.Min.Value > rule.Max.Value {
+			return status.Errorf(codes.InvalidArgument, "range rule on column %s has min greater than max", rule.ColumnName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package data_table
+
+import (
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestValidateDataQualityRule(t *testing.T) {
+	columns := []string{"email", "age", "country"}
+	rule := func(ruleType model.DataQualityRuleType, column string) *api.DataQualityRule {
+		return &api.DataQualityRule{Type: string(ruleType), ColumnName: column}
+	}
+
+	valid := []*api.DataQualityRule{
+		rule(model.DataQualityRuleType_NotNull, "email"),
+		rule(model.DataQualityRuleType_Unique, "email"),
+		rule(model.DataQualityRuleType_Email, "email"),
+		{Type: string(model.DataQualityRuleType_Regex), ColumnName: "country", Pattern: "^[A-Z]{2}$"},
+		{Type: string(model.DataQualityRuleType_AllowedValues), ColumnName: "country", AllowedValues: []string{"VN", "US"}},
+		{Type: string(model.DataQualityRuleType_Range), ColumnName: "age", Min: wrapperspb.Double(0)},
+		{Type: string(model.DataQualityRuleType_Range), ColumnName: "age", Min: wrapperspb.Double(18), Max: wrapperspb.Double(18)},
+	}
+	for _, each := range valid {
+		if err := validateDataQualityRule(each, columns); err != nil {
+			t.Errorf("%s rule on %s: %v", each.Type, each.ColumnName, err)
+		}
+	}
+
+	invalid := []*api.DataQualityRule{
+		rule(model.DataQualityRuleType_NotNull, "phone"),
+		{Type: string(model.DataQualityRuleType_Regex), ColumnName: "country"},
+		{Type: string(model.DataQualityRuleType_Regex), ColumnName: "country", Pattern: "[A-Z"},
+		rule(model.DataQualityRuleType_AllowedValues, "country"),
+		rule(model.DataQualityRuleType_Range, "age"),
+		{Type: string(model.DataQualityRuleType_Range), ColumnName: "age", Min: wrapperspb.Double(65), Max: wrapperspb.Double(18)},
+	}
+	for _, each := range invalid {
+		err := validateDataQualityRule(each, columns)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s rule on %s: got %v, want InvalidArgument", each.Type, each.ColumnName, err)
+		}
+	}
+
+	// a table without a known schema takes rules on any column
+	if err := validateDataQualityRule(rule(model.DataQualityRuleType_NotNull, "phone"), nil); err != nil {
+		t.Errorf("rule on a table without schema: %v", err)
+	}
+}
@@ -0,0 +1,594 @@
+package data_table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// retentionObject is the delta table a retention policy applies to
+type retentionObject struct {
+	AccountUuid uuid.UUID
+	Path        string
+	Schema      []model.SchemaUnit
+	// Clickstream objects are the event source tables and the behavior tables built from them
+	Clickstream bool
+}
+
+func (b business) SetRetentionPolicy(ctx context.Context, request *api.SetRetentionPolicyRequest, accountUuid string) (*api.SetRetentionPolicyResponse, error) {
+	logger := b.log.WithName("SetRetentionPolicy").WithValues("request", request)
+
+	objectType := model.RetentionObjectType(request.ObjectType)
+	object, err := b.getRetentionObject(ctx, objectType, request.ObjectId)
+	if err != nil {
+		logger.Error(err, "cannot get retention object")
+		return nil, err
+	}
+	if object.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can set retention policy")
+	}
+
+	timestampColumn, err := validateRetentionPolicy(object, request.TimestampColumn, request.MaxAgeDays, request.MaxVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := b.repository.RetentionPolicyRepository.GetRetentionPolicyOfObject(ctx, objectType, request.ObjectId)
+	if err != nil {
+		logger.Error(err, "cannot get retention policy")
+		return nil, err
+	}
+	if policy != nil {
+		policy.TimestampColumn = timestampColumn
+		policy.MaxAgeDays = request.MaxAgeDays
+		policy.MaxVersions = request.MaxVersions
+		policy.Clickstream = object.Clickstream
+		err = b.repository.RetentionPolicyRepository.UpdateRetentionPolicy(ctx, policy)
+		if err != nil {
+			logger.Error(err, "cannot update retention policy")
+			return nil, err
+		}
+	} else {
+		policy, err = b.createRetentionPolicy(ctx, &repository.CreateRetentionPolicyParams{
+			AccountUuid:     object.AccountUuid,
+			ObjectType:      objectType,
+			ObjectId:        request.ObjectId,
+			TimestampColumn: timestampColumn,
+			MaxAgeDays:      request.MaxAgeDays,
+			MaxVersions:     request.MaxVersions,
+			Clickstream:     object.Clickstream,
+		})
+		if err != nil {
+			logger.Error(err, "cannot create retention policy")
+			return nil, err
+		}
+	}
+
+	retentionPolicy, err := toApiRetentionPolicy(ctx, b.repository, policy)
+	if err != nil {
+		logger.Error(err, "cannot get last retention run")
+		return nil, err
+	}
+
+	return &api.SetRetentionPolicyResponse{
+		Code:            int32(code.Code_OK),
+		Message:         "Success",
+		RetentionPolicy: retentionPolicy,
+	}, nil
+}
+
+func (b business) GetListRetentionPolicies(ctx context.Context, request *api.GetListRetentionPoliciesRequest, accountUuid string) (*api.GetListRetentionPoliciesResponse, error) {
+	logger := b.log.WithName("GetListRetentionPolicies")
+
+	policies, err := b.repository.RetentionPolicyRepository.ListRetentionPolicies(ctx, &repository.ListRetentionPoliciesParams{
+		AccountUuid: uuid.MustParse(accountUuid),
+	})
+	if err != nil {
+		logger.Error(err, "cannot list retention policies")
+		return nil, err
+	}
+
+	retentionPolicies := make([]*api.RetentionPolicy, 0, len(policies))
+	for i := range policies {
+		retentionPolicy, err := toApiRetentionPolicy(ctx, b.repository, &policies[i])
+		if err != nil {
+			logger.Error(err, "cannot get last retention run", "policyId", policies[i].ID)
+			return nil, err
+		}
+		retentionPolicies = append(retentionPolicies, retentionPolicy)
+	}
+
+	return &api.GetListRetentionPoliciesResponse{
+		Code:              int32(code.Code_OK),
+		Message:           "Success",
+		RetentionPolicies: retentionPolicies,
+	}, nil
+}
+
+func (b business) DeleteRetentionPolicy(ctx context.Context, request *api.DeleteRetentionPolicyRequest, accountUuid string) (*api.DeleteRetentionPolicyResponse, error) {
+	logger := b.log.WithName("DeleteRetentionPolicy").WithValues("request", request)
+
+	policy, err := b.repository.RetentionPolicyRepository.GetRetentionPolicy(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get retention policy")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "Not found retention policy")
+		}
+		return nil, err
+	}
+	if policy.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can delete retention policy")
+	}
+	if policy.Clickstream {
+		return nil, status.Errorf(codes.FailedPrecondition, "clickstream data is kept at most %d days, its retention policy cannot be removed", model.RetentionPolicy_ClickstreamMaxAgeDays)
+	}
+
+	// the maintenance action is kept for its run history
+	err = b.repository.RetentionPolicyRepository.DeleteRetentionPolicy(ctx, policy.ID)
+	if err != nil {
+		logger.Error(err, "cannot delete retention policy")
+		return nil, err
+	}
+
+	return &api.DeleteRetentionPolicyResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+// ApplyRetentionPolicies runs the maintenance action of every policy, the clickstream objects without a policy get the legal cap first.
+// A failing policy does not stop the others, its run records the error
+func (b business) ApplyRetentionPolicies(ctx context.Context) error {
+	logger := b.log.WithName("ApplyRetentionPolicies")
+
+	err := b.enforceClickstreamRetention(ctx)
+	if err != nil {
+		logger.Error(err, "cannot enforce clickstream retention")
+		return err
+	}
+
+	policies, err := b.repository.RetentionPolicyRepository.ListRetentionPolicies(ctx, &repository.ListRetentionPoliciesParams{})
+	if err != nil {
+		logger.Error(err, "cannot list retention policies")
+		return err
+	}
+	var errs []error
+	for i := range policies {
+		err = b.applyRetentionPolicy(ctx, &policies[i])
+		if err != nil {
+			logger.Error(err, "cannot apply retention policy", "policyId", policies[i].ID)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// enforceClickstreamRetention creates the capped policy of the clickstream objects without one and caps the policies set before their object became clickstream
+func (b business) enforceClickstreamRetention(ctx context.Context) error {
+	eventTableIds, err := b.repository.EventSourceRepository.ListEventTableIds(ctx)
+	if err != nil {
+		return err
+	}
+	if len(eventTableIds) == 0 {
+		return nil
+	}
+	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{DataTableIds: eventTableIds})
+	if err != nil {
+		return err
+	}
+
+	objectIds := map[model.RetentionObjectType][]int64{
+		model.RetentionObjectType_DataTable:     eventTableIds,
+		model.RetentionObjectType_BehaviorTable: utils.Map(behaviorTables, func(table model.BehaviorTable) int64 { return table.ID }),
+	}
+	for objectType, ids := range objectIds {
+		if len(ids) == 0 {
+			continue
+		}
+		policies, err := b.repository.RetentionPolicyRepository.ListRetentionPolicies(ctx, &repository.ListRetentionPoliciesParams{
+			ObjectType: objectType,
+			ObjectIds:  ids,
+		})
+		if err != nil {
+			return err
+		}
+		policyOfObject := make(map[int64]*model.RetentionPolicy, len(policies))
+		for i := range policies {
+			policyOfObject[policies[i].ObjectId] = &policies[i]
+		}
+
+		for _, id := range ids {
+			policy := policyOfObject[id]
+			if policy != nil && policy.Clickstream && policy.MaxAgeDays > 0 && policy.MaxAgeDays <= model.RetentionPolicy_ClickstreamMaxAgeDays {
+				continue
+			}
+			object, err := b.getRetentionObject(ctx, objectType, id)
+			if err != nil {
+				return err
+			}
+			timestampColumn := TimeColumn(object.Schema)
+			if objectType == model.RetentionObjectType_DataTable && slices.Contains(schemaColumnNames(object.Schema), model.EventColumn_Timestamp) {
+				timestampColumn = model.EventColumn_Timestamp
+			}
+			if policy != nil && policy.TimestampColumn != "" {
+				timestampColumn = policy.TimestampColumn
+			}
+			if timestampColumn == "" {
+				b.log.WithName("enforceClickstreamRetention").Info("clickstream object has no timestamp column", "objectType", objectType, "objectId", id)
+				continue
+			}
+
+			if policy == nil {
+				_, err = b.createRetentionPolicy(ctx, &repository.CreateRetentionPolicyParams{
+					AccountUuid:     object.AccountUuid,
+					ObjectType:      objectType,
+					ObjectId:        id,
+					TimestampColumn: timestampColumn,
+					MaxAgeDays:      model.RetentionPolicy_ClickstreamMaxAgeDays,
+					Clickstream:     true,
+				})
+			} else {
+				policy.TimestampColumn = timestampColumn
+				if policy.MaxAgeDays <= 0 || policy.MaxAgeDays > model.RetentionPolicy_ClickstreamMaxAgeDays {
+					policy.MaxAgeDays = model.RetentionPolicy_ClickstreamMaxAgeDays
+				}
+				policy.Clickstream = true
+				err = b.repository.RetentionPolicyRepository.UpdateRetentionPolicy(ctx, policy)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// createRetentionPolicy creates the policy with its scheduled maintenance action
+func (b business) createRetentionPolicy(ctx context.Context, params *repository.CreateRetentionPolicyParams) (*model.RetentionPolicy, error) {
+	targetTable := model.TargetTable_DataTable
+	if params.ObjectType == model.RetentionObjectType_BehaviorTable {
+		targetTable = model.TargetTable_BehaviorTable
+	}
+
+	var policy *model.RetentionPolicy
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		// the action runs in the backend, it has no dag and is never PENDING so the dag jobs skip it
+		dataAction, err := b.repository.DataActionRepository.CreateDataAction(ctx, &repository.CreateDataActionParams{
+			Tx:          tx,
+			TargetTable: targetTable,
+			ActionType:  model.ActionType_ApplyRetention,
+			Schedule:    model.RetentionPolicy_Schedule,
+			AccountUuid: params.AccountUuid,
+			Status:      model.DataActionStatus_Processing,
+			ObjectId:    params.ObjectId,
+		})
+		if err != nil {
+			return err
+		}
+
+		params.Tx = tx
+		params.DataActionId = dataAction.ID
+		policy, err = b.repository.RetentionPolicyRepository.CreateRetentionPolicy(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// applyRetentionPolicy runs the maintenance action of the policy once and records the run
+func (b business) applyRetentionPolicy(ctx context.Context, policy *model.RetentionPolicy) error {
+	dataAction, err := b.repository.DataActionRepository.GetDataAction(ctx, policy.DataActionId)
+	if err != nil {
+		return err
+	}
+	if dataAction == nil {
+		return fmt.Errorf("retention policy %d has no maintenance action", policy.ID)
+	}
+
+	// CREATING keeps the run away from the job syncing the dag runs, it has no dag run
+	dataActionRun, err := b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+		ActionId:    dataAction.ID,
+		RunId:       dataAction.RunCount + 1,
+		Status:      model.DataActionRunStatus_Creating,
+		AccountUuid: dataAction.AccountUuid,
+	})
+	if err != nil {
+		return err
+	}
+	err = b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+		ID:       dataAction.ID,
+		RunCount: dataAction.RunCount + 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	rowCountBefore, rowCountAfter, runErr := b.runRetention(ctx, policy)
+	if runErr != nil {
+		err = b.repository.DataActionRunRepository.UpdateDataActionRunError(ctx, dataActionRun.ID, runErr.Error())
+		if err != nil {
+			return err
+		}
+		err = b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+			ID:     dataAction.ID,
+			Status: model.DataActionStatus_Failed,
+		})
+		if err != nil {
+			return err
+		}
+		return runErr
+	}
+
+	err = b.repository.DataActionRunRepository.UpdateDataActionRunRowCounts(ctx, dataActionRun.ID, rowCountBefore, rowCountAfter)
+	if err != nil {
+		return err
+	}
+	err = b.repository.DataActionRunRepository.UpdateDataActionRunStatus(ctx, dataActionRun.ID, model.DataActionRunStatus_Success)
+	if err != nil {
+		return err
+	}
+	return b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+		ID:     dataAction.ID,
+		Status: model.DataActionStatus_Success,
+	})
+}
+
+// runRetention deletes the rows older than the policy then vacuums the files of the versions it does not keep
+func (b business) runRetention(ctx context.Context, policy *model.RetentionPolicy) (int64, int64, error) {
+	object, err := b.getRetentionObject(ctx, policy.ObjectType, policy.ObjectId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rowCountBefore, err := b.countDeltaRows(ctx, object.Path)
+	if err != nil {
+		return 0, 0, err
+	}
+	rowCountAfter := rowCountBefore
+	if policy.MaxAgeDays > 0 {
+		if !slices.Contains(schemaColumnNames(object.Schema), policy.TimestampColumn) {
+			return 0, 0, fmt.Errorf("timestamp column %s is not a column of the table", policy.TimestampColumn)
+		}
+		_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+			Query: fmt.Sprintf("DELETE FROM delta.`%s` WHERE CAST(%s AS TIMESTAMP) < current_timestamp() - INTERVAL %d DAYS",
+				object.Path, utils.QuoteSparkIdentifier(policy.TimestampColumn), policy.MaxAgeDays),
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+		rowCountAfter, err = b.countDeltaRows(ctx, object.Path)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	retainHours, err := b.vacuumRetainHours(ctx, object.Path, policy.MaxVersions)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("VACUUM delta.`%s` RETAIN %d HOURS", object.Path, retainHours),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return rowCountBefore, rowCountAfter, nil
+}
+
+// vacuumRetainHours keeps the files of the last maxVersions versions, and never of less than the delta safety interval
+func (b business) vacuumRetainHours(ctx context.Context, path string, maxVersions int32) (int64, error) {
+	retainHours := int64(model.RetentionPolicy_MinVacuumRetainHours)
+	if maxVersions <= 0 {
+		return retainHours, nil
+	}
+
+	historyResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("DESCRIBE HISTORY delta.`%s` LIMIT %d", path, maxVersions),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(historyResponse.Data) < int(maxVersions) {
+		return retainHours, nil
+	}
+	// the history is ordered from the latest version, the last row is the oldest version kept
+	timestamp, _ := historyResponse.Data[len(historyResponse.Data)-1]["timestamp"].(string)
+	oldestKept, err := parseDeltaTimestamp(timestamp)
+	if err != nil {
+		return 0, err
+	}
+	hours := int64(math.Ceil(time.Since(oldestKept).Hours()))
+	if hours > retainHours {
+		retainHours = hours
+	}
+	return retainHours, nil
+}
+
+func (b business) countDeltaRows(ctx context.Context, path string) (int64, error) {
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT COUNT(*) AS count FROM delta.`%s`", path),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(queryResponse.Data) == 0 {
+		return 0, nil
+	}
+	count, _ := queryResponse.Data[0]["count"].(float64)
+	return int64(count), nil
+}
+
+func (b business) getRetentionObject(ctx context.Context, objectType model.RetentionObjectType, objectId int64) (*retentionObject, error) {
+	eventTableIds, err := b.repository.EventSourceRepository.ListEventTableIds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch objectType {
+	case model.RetentionObjectType_DataTable:
+		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, objectId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Error(codes.NotFound, "Not exists data table")
+			}
+			return nil, err
+		}
+		schema, err := ParseSchema(dataTable.Schema)
+		if err != nil {
+			return nil, err
+		}
+		return &retentionObject{
+			AccountUuid: dataTable.AccountUuid,
+			Path:        b.deltaTableLocation(dataTable),
+			Schema:      schema,
+			Clickstream: slices.Contains(eventTableIds, dataTable.ID),
+		}, nil
+	case model.RetentionObjectType_BehaviorTable:
+		behaviorTable, err := b.repository.SegmentRepository.GetBehaviorTable(ctx, &repository.GetBehaviorTableParams{Id: objectId})
+		if err != nil {
+			return nil, err
+		}
+		if behaviorTable.ID == 0 {
+			return nil, status.Error(codes.NotFound, "Not exists behavior table")
+		}
+		masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, behaviorTable.MasterSegmentId)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := ParseSchema(behaviorTable.Schema)
+		if err != nil {
+			return nil, err
+		}
+		return &retentionObject{
+			AccountUuid: masterSegment.AccountUuid,
+			Path:        fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaBehaviorPath(behaviorTable.MasterSegmentId, behaviorTable.Name)),
+			Schema:      schema,
+			Clickstream: slices.Contains(eventTableIds, behaviorTable.DataTableId),
+		}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "unknown retention object type %s", objectType)
+}
+
+// validateRetentionPolicy returns the timestamp column of the policy, the time column of the table when none is given
+func validateRetentionPolicy(object *retentionObject, timestampColumn string, maxAgeDays int32, maxVersions int32) (string, error) {
+	if maxAgeDays <= 0 && maxVersions <= 0 {
+		return "", status.Error(codes.InvalidArgument, "retention policy requires max_age_days or max_versions")
+	}
+	if object.Clickstream && (maxAgeDays <= 0 || maxAgeDays > model.RetentionPolicy_ClickstreamMaxAgeDays) {
+		return "", status.Errorf(codes.InvalidArgument, "clickstream data is kept at most %d days", model.RetentionPolicy_ClickstreamMaxAgeDays)
+	}
+	if maxAgeDays <= 0 {
+		return timestampColumn, nil
+	}
+
+	if timestampColumn == "" {
+		timestampColumn = TimeColumn(object.Schema)
+		if timestampColumn == "" {
+			return "", status.Error(codes.InvalidArgument, "max_age_days requires timestamp_column")
+		}
+	}
+	dataType, ok := SchemaColumnType(object.Schema, timestampColumn)
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "timestamp column %s is not a column of the table", timestampColumn)
+	}
+	if dataType != string(model.ColumnDataType_Timestamp) && dataType != string(model.ColumnDataType_Date) {
+		return "", status.Errorf(codes.InvalidArgument, "timestamp column %s is not a timestamp or date column", timestampColumn)
+	}
+	return timestampColumn, nil
+}
+
+// RetentionPoliciesOfObjects returns the policies of the objects by object id, with their last run
+func RetentionPoliciesOfObjects(ctx context.Context, repo *repository.Repository, objectType model.RetentionObjectType, objectIds []int64) (map[int64]*api.RetentionPolicy, error) {
+	result := make(map[int64]*api.RetentionPolicy, len(objectIds))
+	if len(objectIds) == 0 {
+		return result, nil
+	}
+	policies, err := repo.RetentionPolicyRepository.ListRetentionPolicies(ctx, &repository.ListRetentionPoliciesParams{
+		ObjectType: objectType,
+		ObjectIds:  objectIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range policies {
+		retentionPolicy, err := toApiRetentionPolicy(ctx, repo, &policies[i])
+		if err != nil {
+			return nil, err
+		}
+		result[policies[i].ObjectId] = retentionPolicy
+	}
+	return result, nil
+}
+
+func toApiRetentionPolicy(ctx context.Context, repo *repository.Repository, policy *model.RetentionPolicy) (*api.RetentionPolicy, error) {
+	retentionPolicy := &api.RetentionPolicy{
+		Id:              policy.ID,
+		ObjectType:      string(policy.ObjectType),
+		ObjectId:        policy.ObjectId,
+		TimestampColumn: policy.TimestampColumn,
+		MaxAgeDays:      policy.MaxAgeDays,
+		MaxVersions:     policy.MaxVersions,
+		Clickstream:     policy.Clickstream,
+		DataActionId:    policy.DataActionId,
+		CreatedAt:       policy.CreatedAt.String(),
+		UpdatedAt:       policy.UpdatedAt.String(),
+	}
+
+	lastRun, err := repo.DataActionRunRepository.GetLatestDataActionRun(ctx, policy.DataActionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return retentionPolicy, nil
+		}
+		return nil, err
+	}
+	retentionPolicy.LastRun = &api.DataActionRun{
+		Id:             lastRun.ID,
+		ActionId:       lastRun.ActionId,
+		ActionType:     string(model.ActionType_ApplyRetention),
+		Status:         string(lastRun.Status),
+		CreatedAt:      lastRun.CreatedAt.String(),
+		UpdatedAt:      lastRun.UpdatedAt.String(),
+		RowCountBefore: lastRun.RowCountBefore,
+		RowCountAfter:  lastRun.RowCountAfter,
+		Error:          lastRun.Error,
+	}
+	return retentionPolicy, nil
+}
+
+// parseDeltaTimestamp parses the commit timestamps of DESCRIBE HISTORY
+func parseDeltaTimestamp(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999"} {
+		timestamp, err := time.Parse(layout, value)
+		if err == nil {
+			return timestamp, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse delta commit timestamp %q", value)
+}
+
+func schemaColumnNames(schema []model.SchemaUnit) []string {
+	return utils.Map(schema, func(column model.SchemaUnit) string {
+		return column.ColumnName
+	})
+}
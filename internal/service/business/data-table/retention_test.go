@@ -0,0 +1,101 @@
+package data_table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateRetentionPolicy(t *testing.T) {
+	events := &retentionObject{
+		Schema: []model.SchemaUnit{
+			{ColumnName: "event", DataType: "string"},
+			{ColumnName: "day", DataType: "date"},
+			{ColumnName: "timestamp", DataType: "timestamp"},
+		},
+		Clickstream: true,
+	}
+	// the first timestamp column is the time of a row when the policy does not name one
+	timestampColumn, err := validateRetentionPolicy(events, "", 365, 0)
+	if err != nil || timestampColumn != "timestamp" {
+		t.Errorf("default timestamp column = %q, %v", timestampColumn, err)
+	}
+	if timestampColumn, err = validateRetentionPolicy(events, "day", model.RetentionPolicy_ClickstreamMaxAgeDays, 5); err != nil || timestampColumn != "day" {
+		t.Errorf("date column at the clickstream cap = %q, %v", timestampColumn, err)
+	}
+
+	// a table of other data may keep its rows forever and only bound its versions
+	customers := &retentionObject{Schema: []model.SchemaUnit{{ColumnName: "email", DataType: "string"}}}
+	if timestampColumn, err = validateRetentionPolicy(customers, "", 0, 10); err != nil || timestampColumn != "" {
+		t.Errorf("versions only = %q, %v", timestampColumn, err)
+	}
+
+	for _, policy := range []struct {
+		object          *retentionObject
+		timestampColumn string
+		maxAgeDays      int32
+		maxVersions     int32
+	}{
+		{customers, "", 0, 0},
+		{events, "", 0, 10},
+		{events, "", model.RetentionPolicy_ClickstreamMaxAgeDays + 1, 0},
+		{customers, "", 30, 0},
+		{events, "created_at", 30, 0},
+		{events, "event", 30, 0},
+	} {
+		_, err := validateRetentionPolicy(policy.object, policy.timestampColumn, policy.maxAgeDays, policy.maxVersions)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("validateRetentionPolicy(%q, %d days, %d versions) = %v, want InvalidArgument", policy.timestampColumn, policy.maxAgeDays, policy.maxVersions, err)
+		}
+	}
+}
+
+func TestVacuumRetainHours(t *testing.T) {
+	history := func(oldestKept time.Time) []map[string]any {
+		return []map[string]any{
+			{"version": float64(9), "timestamp": time.Now().UTC().Format("2006-01-02 15:04:05.999")},
+			{"version": float64(8), "timestamp": oldestKept.UTC().Format("2006-01-02 15:04:05.999")},
+		}
+	}
+
+	// vacuum keeps the files of the versions since the oldest version kept
+	adapter := &rawSQLQueryAdapter{rows: history(time.Now().Add(-300*time.Hour + time.Minute))}
+	retainHours, err := business{queryAdapter: adapter}.vacuumRetainHours(context.Background(), "s3a://bucket/t", 2)
+	if err != nil || retainHours != 300 {
+		t.Errorf("retain hours of versions of 300 hours = %d, %v", retainHours, err)
+	}
+	if adapter.queries[0] != "DESCRIBE HISTORY delta.`s3a://bucket/t` LIMIT 2" {
+		t.Errorf("history query = %s", adapter.queries[0])
+	}
+
+	// never less than the delta safety interval, even when the versions are recent or fewer than kept
+	for name, test := range map[string]struct {
+		rows        []map[string]any
+		maxVersions int32
+	}{
+		"recent versions":       {history(time.Now().Add(-10 * time.Hour)), 2},
+		"fewer versions":        {history(time.Now().Add(-1000 * time.Hour)), 3},
+		"every version is kept": {nil, 0},
+	} {
+		retainHours, err := business{queryAdapter: &rawSQLQueryAdapter{rows: test.rows}}.vacuumRetainHours(context.Background(), "s3a://bucket/t", test.maxVersions)
+		if err != nil || retainHours != model.RetentionPolicy_MinVacuumRetainHours {
+			t.Errorf("%s: retain hours = %d, %v", name, retainHours, err)
+		}
+	}
+}
+
+func TestParseDeltaTimestamp(t *testing.T) {
+	want := time.Date(2024, 8, 17, 9, 0, 0, 500000000, time.UTC)
+	for _, value := range []string{"2024-08-17T09:00:00.5Z", "2024-08-17 09:00:00.5", "2024-08-17T09:00:00.500"} {
+		if timestamp, err := parseDeltaTimestamp(value); err != nil || !timestamp.Equal(want) {
+			t.Errorf("parseDeltaTimestamp(%q) = %v, %v", value, timestamp, err)
+		}
+	}
+	if _, err := parseDeltaTimestamp("17/08/2024"); err == nil {
+		t.Error("parseDeltaTimestamp() accepted 17/08/2024")
+	}
+}
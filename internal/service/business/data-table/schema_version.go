@@ -0,0 +1,377 @@
+package data_table
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (b business) UpdateDataTableSchemaPolicy(ctx context.Context, request *api.UpdateDataTableSchemaPolicyRequest, accountUuid string) (*api.UpdateDataTableSchemaPolicyResponse, error) {
+	logger := b.log.WithName("UpdateDataTableSchemaPolicy").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can update data_table")
+	}
+
+	_, err = b.repository.DataTableRepository.UpdateDataTable(ctx, &repository.UpdateDataTableParams{
+		ID:           dataTable.ID,
+		SchemaPolicy: model.DataTableSchemaPolicy(request.SchemaPolicy),
+	})
+	if err != nil {
+		logger.Error(err, "cannot update data table schema policy")
+		return nil, err
+	}
+
+	return &api.UpdateDataTableSchemaPolicyResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) GetListDataTableSchemaVersions(ctx context.Context, request *api.GetListDataTableSchemaVersionsRequest, accountUuid string) (*api.GetListDataTableSchemaVersionsResponse, error) {
+	logger := b.log.WithName("GetListDataTableSchemaVersions").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can get data_table")
+	}
+
+	queryResult, err := b.repository.DataTableSchemaVersionRepository.ListDataTableSchemaVersions(ctx, &repository.ListDataTableSchemaVersionsParams{
+		TableId:  dataTable.ID,
+		Page:     int(request.Page),
+		PageSize: int(request.PageSize),
+	})
+	if err != nil {
+		logger.Error(err, "cannot get list schema versions")
+		return nil, err
+	}
+
+	results := make([]*api.DataTableSchemaVersion, 0, len(queryResult.SchemaVersions))
+	for _, schemaVersion := range queryResult.SchemaVersions {
+		var (
+			schema  []*api.SchemaColumn
+			changes []*api.SchemaChange
+		)
+		if schemaVersion.Schema.Valid && schemaVersion.Schema.RawMessage != nil {
+			err = json.Unmarshal(schemaVersion.Schema.RawMessage, &schema)
+			if err != nil {
+				logger.Error(err, "cannot unmarshal schema", "version", schemaVersion.Version)
+				return nil, err
+			}
+		}
+		if schemaVersion.Changes.Valid && schemaVersion.Changes.RawMessage != nil {
+			err = json.Unmarshal(schemaVersion.Changes.RawMessage, &changes)
+			if err != nil {
+				logger.Error(err, "cannot unmarshal changes", "version", schemaVersion.Version)
+				return nil, err
+			}
+		}
+		results = append(results, &api.DataTableSchemaVersion{
+			Version:      schemaVersion.Version,
+			Schema:       schema,
+			Changes:      changes,
+			IsBreaking:   schemaVersion.IsBreaking,
+			DataActionId: schemaVersion.DataActionId,
+			CreatedAt:    schemaVersion.CreatedAt.String(),
+		})
+	}
+
+	return &api.GetListDataTableSchemaVersionsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Count:   queryResult.Count,
+		Results: results,
+	}, nil
+}
+
+// ParseSchema returns the columns of a stored schema, nil when the schema is not known yet
+func ParseSchema(rawSchema pqtype.NullRawMessage) ([]model.SchemaUnit, error) {
+	if !rawSchema.Valid || rawSchema.RawMessage == nil {
+		return nil, nil
+	}
+	var schema []model.SchemaUnit
+	err := json.Unmarshal(rawSchema.RawMessage, &schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// normalizeDataType maps the spark types read from delta and the types of the mapping options to the same names
+func normalizeDataType(dataType string) string {
+	dataType = strings.ToLower(strings.TrimSpace(dataType))
+	switch {
+	case slices.Contains([]string{"int", "integer", "bigint", "long", "short", "smallint", "tinyint", "byte"}, dataType):
+		return string(model.ColumnDataType_Int)
+	case slices.Contains([]string{"float", "double", "real"}, dataType), strings.HasPrefix(dataType, "decimal"):
+		return string(model.ColumnDataType_Float)
+	case slices.Contains([]string{"bool", "boolean"}, dataType):
+		return string(model.ColumnDataType_Bool)
+	case strings.HasPrefix(dataType, "timestamp"):
+		return string(model.ColumnDataType_Timestamp)
+	case strings.HasPrefix(dataType, "varchar"), strings.HasPrefix(dataType, "char"):
+		return string(model.ColumnDataType_String)
+	}
+	return dataType
+}
+
+// DiffSchema lists the columns added, removed and retyped from previous to current.
+// A column without a data type on either side is not compared by type.
+func DiffSchema(previous []model.SchemaUnit, current []model.SchemaUnit) []*api.SchemaChange {
+	previousTypes := make(map[string]string, len(previous))
+	for _, column := range previous {
+		previousTypes[column.ColumnName] = column.DataType
+	}
+	currentTypes := make(map[string]string, len(current))
+	for _, column := range current {
+		currentTypes[column.ColumnName] = column.DataType
+	}
+
+	var changes []*api.SchemaChange
+	for _, column := range current {
+		previousType, ok := previousTypes[column.ColumnName]
+		if !ok {
+			changes = append(changes, &api.SchemaChange{
+				ChangeType:  string(model.SchemaChangeType_Added),
+				ColumnName:  column.ColumnName,
+				NewDataType: column.DataType,
+			})
+			continue
+		}
+		if previousType != "" && column.DataType != "" && normalizeDataType(previousType) != normalizeDataType(column.DataType) {
+			changes = append(changes, &api.SchemaChange{
+				ChangeType:  string(model.SchemaChangeType_Retyped),
+				ColumnName:  column.ColumnName,
+				OldDataType: previousType,
+				NewDataType: column.DataType,
+			})
+		}
+	}
+	for _, column := range previous {
+		if _, ok := currentTypes[column.ColumnName]; !ok {
+			changes = append(changes, &api.SchemaChange{
+				ChangeType:  string(model.SchemaChangeType_Removed),
+				ColumnName:  column.ColumnName,
+				OldDataType: column.DataType,
+			})
+		}
+	}
+	return changes
+}
+
+func IsBreakingSchemaChange(changes []*api.SchemaChange) bool {
+	for _, change := range changes {
+		if model.SchemaChangeType(change.ChangeType) != model.SchemaChangeType_Added {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckSchemaPolicy rejects the changes of an import the schema policy of the table does not allow
+func CheckSchemaPolicy(policy model.DataTableSchemaPolicy, changes []*api.SchemaChange) error {
+	if policy == model.DataTableSchemaPolicy_AutoEvolve {
+		return nil
+	}
+	for _, change := range changes {
+		switch model.SchemaChangeType(change.ChangeType) {
+		case model.SchemaChangeType_Retyped:
+			return status.Errorf(codes.FailedPrecondition, "schema policy %s does not allow column %s to change type from %s to %s",
+				policy, change.ColumnName, change.OldDataType, change.NewDataType)
+		case model.SchemaChangeType_Removed:
+			if policy == model.DataTableSchemaPolicy_BlockBreaking {
+				return status.Errorf(codes.FailedPrecondition, "schema policy %s does not allow column %s to be removed", policy, change.ColumnName)
+			}
+		}
+	}
+	return nil
+}
+
+// RecordSchemaVersion saves schema as a new version of the table when it differs from the latest version,
+// then updates the schema issues of the master segments built from the table
+func RecordSchemaVersion(ctx context.Context, repo *repository.Repository, tableId int64, schema []model.SchemaUnit, dataActionId int64) error {
+	latestVersion, err := repo.DataTableSchemaVersionRepository.GetLatestDataTableSchemaVersion(ctx, tableId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		version int32 = 1
+		changes []*api.SchemaChange
+	)
+	if latestVersion != nil {
+		previousSchema, err := ParseSchema(latestVersion.Schema)
+		if err != nil {
+			return err
+		}
+		changes = DiffSchema(previousSchema, schema)
+		if len(changes) == 0 {
+			return nil
+		}
+		version = latestVersion.Version + 1
+	}
+
+	jsonSchema, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	jsonChanges, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	_, err = repo.DataTableSchemaVersionRepository.CreateDataTableSchemaVersion(ctx, &repository.CreateDataTableSchemaVersionParams{
+		TableId:      tableId,
+		Version:      version,
+		Schema:       pqtype.NullRawMessage{RawMessage: jsonSchema, Valid: true},
+		Changes:      pqtype.NullRawMessage{RawMessage: jsonChanges, Valid: true},
+		IsBreaking:   IsBreakingSchemaChange(changes),
+		DataActionId: dataActionId,
+	})
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return syncMasterSegmentSchemaIssues(ctx, repo, tableId, version, changes)
+}
+
+// syncMasterSegmentSchemaIssues flags the master segments using a removed column of the table
+// and clears the issues of columns the table has again
+func syncMasterSegmentSchemaIssues(ctx context.Context, repo *repository.Repository, tableId int64, version int32, changes []*api.SchemaChange) error {
+	var removedColumns, addedColumns []string
+	for _, change := range changes {
+		switch model.SchemaChangeType(change.ChangeType) {
+		case model.SchemaChangeType_Removed:
+			removedColumns = append(removedColumns, change.ColumnName)
+		case model.SchemaChangeType_Added:
+			addedColumns = append(addedColumns, change.ColumnName)
+		}
+	}
+
+	dataTable, err := repo.DataTableRepository.GetDataTable(ctx, tableId)
+	if err != nil {
+		return err
+	}
+
+	// master segment id -> removed columns of the table it uses
+	affectedColumns := make(map[int64][]string)
+	audienceTables, err := repo.SegmentRepository.ListAudienceTablesByDataTableId(ctx, tableId)
+	if err != nil {
+		return err
+	}
+	for _, audienceTable := range audienceTables {
+		var buildConfiguration repository.AudienceBuildConfiguration
+		err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &buildConfiguration)
+		if err != nil {
+			return err
+		}
+		if _, ok := affectedColumns[audienceTable.MasterSegmentId]; !ok {
+			affectedColumns[audienceTable.MasterSegmentId] = nil
+		}
+		if buildConfiguration.MainTableId == tableId {
+			keys := utils.Map(buildConfiguration.AttributeTables, func(table *repository.AttributeTableInfo) string { return table.JoinKey })
+			affectedColumns[audienceTable.MasterSegmentId] = append(affectedColumns[audienceTable.MasterSegmentId],
+				usedColumns(removedColumns, buildConfiguration.SelectedColumns, keys)...)
+		}
+		for _, attributeTable := range buildConfiguration.AttributeTables {
+			if attributeTable.TableId == tableId {
+				affectedColumns[audienceTable.MasterSegmentId] = append(affectedColumns[audienceTable.MasterSegmentId],
+					usedColumns(removedColumns, attributeTable.SelectedColumns, []string{attributeTable.ForeignKey})...)
+			}
+		}
+	}
+	// behavior tables copy every column of the table
+	behaviorTables, err := repo.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{DataTableId: tableId})
+	if err != nil {
+		return err
+	}
+	for _, behaviorTable := range behaviorTables {
+		affectedColumns[behaviorTable.MasterSegmentId] = append(affectedColumns[behaviorTable.MasterSegmentId], removedColumns...)
+	}
+
+	for masterSegmentId, columns := range affectedColumns {
+		masterSegment, err := repo.SegmentRepository.GetMasterSegment(ctx, masterSegmentId)
+		if err != nil {
+			return err
+		}
+		var issues []*api.SchemaIssue
+		if masterSegment.SchemaIssues.Valid && masterSegment.SchemaIssues.RawMessage != nil {
+			err = json.Unmarshal(masterSegment.SchemaIssues.RawMessage, &issues)
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedIssues := make([]*api.SchemaIssue, 0, len(issues)+len(columns))
+		for _, issue := range issues {
+			if issue.TableId == tableId && (slices.Contains(addedColumns, issue.ColumnName) || slices.Contains(columns, issue.ColumnName)) {
+				continue
+			}
+			updatedIssues = append(updatedIssues, issue)
+		}
+		for _, column := range columns {
+			if slices.ContainsFunc(updatedIssues, func(issue *api.SchemaIssue) bool {
+				return issue.TableId == tableId && issue.ColumnName == column
+			}) {
+				continue
+			}
+			updatedIssues = append(updatedIssues, &api.SchemaIssue{
+				TableId:       tableId,
+				TableName:     dataTable.Name,
+				ColumnName:    column,
+				ChangeType:    string(model.SchemaChangeType_Removed),
+				SchemaVersion: version,
+			})
+		}
+		if len(updatedIssues) == 0 && len(issues) == 0 {
+			continue
+		}
+
+		jsonIssues, err := json.Marshal(updatedIssues)
+		if err != nil {
+			return err
+		}
+		err = repo.SegmentRepository.UpdateMasterSegment(ctx, &repository.UpdateMasterSegmentParams{
+			Id:           masterSegmentId,
+			SchemaIssues: pqtype.NullRawMessage{RawMessage: jsonIssues, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// usedColumns returns the removed columns that are keys or selected, an empty selection selects every column
+func usedColumns(removedColumns []string, selectedColumns []*api.TransferredColumn, keys []string) []string {
+	var columns []string
+	for _, column := range removedColumns {
+		if len(selectedColumns) == 0 || slices.Contains(keys, column) ||
+			slices.ContainsFunc(selectedColumns, func(selected *api.TransferredColumn) bool { return selected.TableColumnName == column }) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
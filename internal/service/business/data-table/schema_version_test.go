@@ -0,0 +1,79 @@
+package data_table
+
+import (
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDiffSchema(t *testing.T) {
+	previous := []model.SchemaUnit{
+		{ColumnName: "id", DataType: "bigint"},
+		{ColumnName: "age", DataType: "int"},
+		{ColumnName: "score", DataType: "decimal(10,2)"},
+		{ColumnName: "note", DataType: ""},
+		{ColumnName: "fax", DataType: "string"},
+	}
+	// the imported schema uses the mapping names of the types: int is the bigint of the table, float the decimal
+	current := []model.SchemaUnit{
+		{ColumnName: "email", DataType: "string"},
+		{ColumnName: "id", DataType: "int"},
+		{ColumnName: "age", DataType: "string"},
+		{ColumnName: "score", DataType: "float"},
+		{ColumnName: "note", DataType: "string"},
+	}
+
+	changes := DiffSchema(previous, current)
+	want := []*api.SchemaChange{
+		{ChangeType: string(model.SchemaChangeType_Added), ColumnName: "email", NewDataType: "string"},
+		{ChangeType: string(model.SchemaChangeType_Retyped), ColumnName: "age", OldDataType: "int", NewDataType: "string"},
+		{ChangeType: string(model.SchemaChangeType_Removed), ColumnName: "fax", OldDataType: "string"},
+	}
+	if !slices.EqualFunc(changes, want, func(a, b *api.SchemaChange) bool { return proto.Equal(a, b) }) {
+		t.Errorf("DiffSchema() = %v, want %v", changes, want)
+	}
+
+	if changes := DiffSchema(current, current); len(changes) != 0 {
+		t.Errorf("the same schema has changes %v", changes)
+	}
+}
+
+func TestCheckSchemaPolicy(t *testing.T) {
+	added := &api.SchemaChange{ChangeType: string(model.SchemaChangeType_Added), ColumnName: "email", NewDataType: "string"}
+	removed := &api.SchemaChange{ChangeType: string(model.SchemaChangeType_Removed), ColumnName: "fax", OldDataType: "string"}
+	retyped := &api.SchemaChange{ChangeType: string(model.SchemaChangeType_Retyped), ColumnName: "age", OldDataType: "int", NewDataType: "string"}
+
+	if IsBreakingSchemaChange([]*api.SchemaChange{added}) || !IsBreakingSchemaChange([]*api.SchemaChange{added, removed}) {
+		t.Error("only an added column is not a breaking change")
+	}
+
+	allowed := map[model.DataTableSchemaPolicy][][]*api.SchemaChange{
+		model.DataTableSchemaPolicy_AutoEvolve:    {{added, removed, retyped}},
+		model.DataTableSchemaPolicy_AllowAdditive: {{added}, {added, removed}},
+		model.DataTableSchemaPolicy_BlockBreaking: {{added}, nil},
+	}
+	for policy, changesList := range allowed {
+		for _, changes := range changesList {
+			if err := CheckSchemaPolicy(policy, changes); err != nil {
+				t.Errorf("CheckSchemaPolicy(%s, %v) = %v, want nil", policy, changes, err)
+			}
+		}
+	}
+
+	rejected := map[model.DataTableSchemaPolicy][][]*api.SchemaChange{
+		model.DataTableSchemaPolicy_AllowAdditive: {{added, retyped}},
+		model.DataTableSchemaPolicy_BlockBreaking: {{removed}, {added, retyped}},
+	}
+	for policy, changesList := range rejected {
+		for _, changes := range changesList {
+			if err := CheckSchemaPolicy(policy, changes); status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("CheckSchemaPolicy(%s, %v) = %v, want FailedPrecondition", policy, changes, err)
+			}
+		}
+	}
+}
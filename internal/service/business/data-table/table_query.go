@@ -0,0 +1,494 @@
+package data_table
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TableQueryResult struct {
+	Count      int64
+	Data       []string
+	NextCursor string
+}
+
+// QueryTable reads a page of the structured query over table, a delta table expression such as delta.`path`.
+// Columns and values are checked against schema and written to the sql as quoted identifiers and typed literals.
+// keyColumn, a unique column of the table, is appended to the sorts so pages never skip rows tied on the sorts.
+// A table without keyColumn is not paginated, rows tied at the end of a page would be skipped by the next one.
+func QueryTable(ctx context.Context, queryAdapter query.QueryAdapter, table string, schema []model.SchemaUnit, tableQuery *api.TableQuery, keyColumn string) (*TableQueryResult, error) {
+	builder := newTableQueryBuilder(schema)
+
+	filter, err := builder.condition(tableQuery.Filter)
+	if err != nil {
+		return nil, err
+	}
+	sorts, err := builder.sorts(tableQuery.Sorts, keyColumn)
+	if err != nil {
+		return nil, err
+	}
+	projection, err := builder.projection(tableQuery.Columns, sorts)
+	if err != nil {
+		return nil, err
+	}
+	limit := tableQuery.Limit
+	if limit <= 0 {
+		limit = model.TableQuery_DefaultLimit
+	}
+	if limit > model.TableQuery_MaxLimit {
+		limit = model.TableQuery_MaxLimit
+	}
+
+	conditions := make([]string, 0, 2)
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+	countQuery := "SELECT COUNT(*) AS count FROM " + table
+	if len(conditions) > 0 {
+		countQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if tableQuery.Cursor != "" {
+		if keyColumn == "" {
+			return nil, status.Error(codes.InvalidArgument, "table has no unique key, its rows cannot be paged with a cursor")
+		}
+		if len(sorts) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "cursor needs at least one sort")
+		}
+		keyset, err := builder.keyset(sorts, tableQuery.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, keyset)
+	}
+	rowsQuery := fmt.Sprintf("SELECT %s FROM %s", projection, table)
+	if len(conditions) > 0 {
+		rowsQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if len(sorts) > 0 {
+		rowsQuery += " ORDER BY " + strings.Join(utils.Map(sorts, func(sort tableQuerySort) string {
+			if sort.Descending {
+				return sort.Name + " DESC NULLS LAST"
+			}
+			return sort.Name + " ASC NULLS LAST"
+		}), ", ")
+	}
+	// One more row than the page tells whether there is a next page
+	rowsQuery += fmt.Sprintf(" LIMIT %d", limit+1)
+
+	countResponse, err := queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{Query: countQuery})
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	if len(countResponse.Data) > 0 {
+		count = toInt64(countResponse.Data[0]["count"])
+	}
+	rowsResponse, err := queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{Query: rowsQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TableQueryResult{Count: count, Data: rowsResponse.Data}
+	if len(rowsResponse.Data) > int(limit) {
+		result.Data = rowsResponse.Data[:limit]
+		if keyColumn != "" {
+			result.NextCursor, err = encodeCursor(sorts, result.Data[limit-1])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// RuleCondition translates rule into a sql condition over a table of schema, empty if the rule has no condition
+func RuleCondition(schema []model.SchemaUnit, rule *api.Rule) (string, error) {
+	return newTableQueryBuilder(schema).condition(rule)
+}
+
+// SchemaColumnType returns the normalized type of a column of schema
+func SchemaColumnType(schema []model.SchemaUnit, columnName string) (string, bool) {
+	dataType, ok := newTableQueryBuilder(schema).columnTypes[columnName]
+	return dataType, ok
+}
+
+// TimeColumn returns the first timestamp column of schema, or its first date column when it has no timestamp
+func TimeColumn(schema []model.SchemaUnit) string {
+	var dateColumn string
+	for _, column := range schema {
+		dataType, _ := SchemaColumnType(schema, column.ColumnName)
+		if dataType == string(model.ColumnDataType_Timestamp) {
+			return column.ColumnName
+		}
+		if dataType == string(model.ColumnDataType_Date) && dateColumn == "" {
+			dateColumn = column.ColumnName
+		}
+	}
+	return dateColumn
+}
+
+type tableQuerySort struct {
+	Column     string
+	Name       string
+	DataType   string
+	Descending bool
+}
+
+type tableQueryBuilder struct {
+	columnTypes map[string]string
+}
+
+func newTableQueryBuilder(schema []model.SchemaUnit) tableQueryBuilder {
+	columnTypes := make(map[string]string, len(schema))
+	for _, column := range schema {
+		columnTypes[column.ColumnName] = normalizeDataType(column.DataType)
+	}
+	return tableQueryBuilder{columnTypes: columnTypes}
+}
+
+var comparableDataTypes = []string{
+	string(model.ColumnDataType_Int),
+	string(model.ColumnDataType_Float),
+	string(model.ColumnDataType_Bool),
+	string(model.ColumnDataType_Date),
+	string(model.ColumnDataType_Timestamp),
+	string(model.ColumnDataType_String),
+}
+
+// column returns the quoted identifier and the normalized type of a column of the schema
+func (t tableQueryBuilder) column(columnName string) (string, string, error) {
+	dataType, ok := t.columnTypes[columnName]
+	if !ok {
+		return "", "", status.Errorf(codes.InvalidArgument, "column %s does not exist", columnName)
+	}
+	return utils.QuoteSparkIdentifier(columnName), dataType, nil
+}
+
+func (t tableQueryBuilder) comparableColumn(columnName string) (string, string, error) {
+	name, dataType, err := t.column(columnName)
+	if err != nil {
+		return "", "", err
+	}
+	if !slices.Contains(comparableDataTypes, dataType) {
+		return "", "", status.Errorf(codes.InvalidArgument, "column %s of type %s cannot be compared", columnName, dataType)
+	}
+	return name, dataType, nil
+}
+
+func (t tableQueryBuilder) projection(columns []string, sorts []tableQuerySort) (string, error) {
+	if len(columns) == 0 {
+		return "*", nil
+	}
+	names := make([]string, 0, len(columns)+len(sorts))
+	for _, column := range columns {
+		name, _, err := t.column(column)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	for _, sort := range sorts {
+		if !slices.Contains(names, sort.Name) {
+			names = append(names, sort.Name)
+		}
+	}
+	return strings.Join(names, ", "), nil
+}
+
+func (t tableQueryBuilder) sorts(requestSorts []*api.TableQuerySort, keyColumn string) ([]tableQuerySort, error) {
+	sorts := make([]tableQuerySort, 0, len(requestSorts)+1)
+	for _, requestSort := range requestSorts {
+		name, dataType, err := t.comparableColumn(requestSort.Column)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(sorts, func(sort tableQuerySort) bool { return sort.Column == requestSort.Column }) {
+			return nil, status.Errorf(codes.InvalidArgument, "column %s is sorted twice", requestSort.Column)
+		}
+		sorts = append(sorts, tableQuerySort{Column: requestSort.Column, Name: name, DataType: dataType, Descending: requestSort.Descending})
+	}
+	if keyColumn != "" && !slices.ContainsFunc(sorts, func(sort tableQuerySort) bool { return sort.Column == keyColumn }) {
+		name, dataType, err := t.comparableColumn(keyColumn)
+		if err != nil {
+			return nil, err
+		}
+		sorts = append(sorts, tableQuerySort{Column: keyColumn, Name: name, DataType: dataType})
+	}
+	return sorts, nil
+}
+
+// condition translates a rule or a group of rules, an empty rule has no condition
+func (t tableQueryBuilder) condition(rule *api.Rule) (string, error) {
+	if rule == nil {
+		return "", nil
+	}
+	if len(rule.Rules) > 0 {
+		combinator := strings.ToLower(rule.Combinator)
+		if combinator == "" {
+			combinator = string(model.RuleCombinator_And)
+		}
+		if combinator != string(model.RuleCombinator_And) && combinator != string(model.RuleCombinator_Or) {
+			return "", status.Errorf(codes.InvalidArgument, "unsupported combinator %s", rule.Combinator)
+		}
+		conditions := make([]string, 0, len(rule.Rules))
+		for _, child := range rule.Rules {
+			condition, err := t.condition(child)
+			if err != nil {
+				return "", err
+			}
+			if condition != "" {
+				conditions = append(conditions, condition)
+			}
+		}
+		if len(conditions) == 0 {
+			return "", nil
+		}
+		return "(" + strings.Join(conditions, " "+strings.ToUpper(combinator)+" ") + ")", nil
+	}
+	if rule.Field == "" {
+		return "", nil
+	}
+
+	operator := model.RuleOperator(rule.Operator)
+	switch operator {
+	case model.RuleOperator_Null, model.RuleOperator_NotNull:
+		name, _, err := t.column(rule.Field)
+		if err != nil {
+			return "", err
+		}
+		if operator == model.RuleOperator_Null {
+			return name + " IS NULL", nil
+		}
+		return name + " IS NOT NULL", nil
+
+	case model.RuleOperator_Contains, model.RuleOperator_DoesNotContain,
+		model.RuleOperator_BeginsWith, model.RuleOperator_DoesNotBeginWith,
+		model.RuleOperator_EndsWith, model.RuleOperator_DoesNotEndWith:
+		name, dataType, err := t.comparableColumn(rule.Field)
+		if err != nil {
+			return "", err
+		}
+		if dataType != string(model.ColumnDataType_String) {
+			name = fmt.Sprintf("CAST(%s AS STRING)", name)
+		}
+		pattern := escapeLikePattern(rule.Value)
+		switch operator {
+		case model.RuleOperator_Contains, model.RuleOperator_DoesNotContain:
+			pattern = "%" + pattern + "%"
+		case model.RuleOperator_BeginsWith, model.RuleOperator_DoesNotBeginWith:
+			pattern = pattern + "%"
+		default:
+			pattern = "%" + pattern
+		}
+		if operator == model.RuleOperator_Contains || operator == model.RuleOperator_BeginsWith || operator == model.RuleOperator_EndsWith {
+			return fmt.Sprintf("%s LIKE %s", name, utils.QuoteSparkString(pattern)), nil
+		}
+		return fmt.Sprintf("%s NOT LIKE %s", name, utils.QuoteSparkString(pattern)), nil
+
+	case model.RuleOperator_Equal, model.RuleOperator_NotEqual,
+		model.RuleOperator_Less, model.RuleOperator_Greater,
+		model.RuleOperator_LessOrEqual, model.RuleOperator_GreaterOrEqual:
+		name, dataType, err := t.comparableColumn(rule.Field)
+		if err != nil {
+			return "", err
+		}
+		literal, err := typedLiteral(rule.Field, rule.Value, dataType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s %s %s", name, operator, literal), nil
+
+	case model.RuleOperator_In, model.RuleOperator_NotIn:
+		name, dataType, err := t.comparableColumn(rule.Field)
+		if err != nil {
+			return "", err
+		}
+		literals, err := typedLiterals(rule.Field, rule.Value, dataType)
+		if err != nil {
+			return "", err
+		}
+		if operator == model.RuleOperator_In {
+			return fmt.Sprintf("%s IN (%s)", name, strings.Join(literals, ", ")), nil
+		}
+		return fmt.Sprintf("%s NOT IN (%s)", name, strings.Join(literals, ", ")), nil
+
+	case model.RuleOperator_Between, model.RuleOperator_NotBetween:
+		name, dataType, err := t.comparableColumn(rule.Field)
+		if err != nil {
+			return "", err
+		}
+		literals, err := typedLiterals(rule.Field, rule.Value, dataType)
+		if err != nil {
+			return "", err
+		}
+		if len(literals) != 2 {
+			return "", status.Errorf(codes.InvalidArgument, "%s of column %s takes 2 values", rule.Operator, rule.Field)
+		}
+		if operator == model.RuleOperator_Between {
+			return fmt.Sprintf("%s BETWEEN %s AND %s", name, literals[0], literals[1]), nil
+		}
+		return fmt.Sprintf("%s NOT BETWEEN %s AND %s", name, literals[0], literals[1]), nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unsupported operator %s", rule.Operator)
+}
+
+// keyset returns the condition of the rows after the cursor in the order of sorts, nulls last:
+// (s1 > v1) OR (s1 = v1 AND s2 > v2) OR ...
+func (t tableQueryBuilder) keyset(sorts []tableQuerySort, cursor string) (string, error) {
+	values, err := decodeCursor(cursor)
+	if err != nil || len(values) != len(sorts) {
+		return "", status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	literals := make([]string, len(sorts))
+	for idx, sort := range sorts {
+		if values[idx] == nil {
+			continue
+		}
+		literals[idx], err = typedLiteral(sort.Column, cursorValueString(values[idx]), sort.DataType)
+		if err != nil {
+			return "", status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	disjuncts := make([]string, 0, len(sorts))
+	for idx, sort := range sorts {
+		// No row comes after a null, nulls are last
+		if literals[idx] == "" {
+			continue
+		}
+		conjuncts := make([]string, 0, idx+1)
+		for prev := 0; prev < idx; prev++ {
+			if literals[prev] == "" {
+				conjuncts = append(conjuncts, sorts[prev].Name+" IS NULL")
+			} else {
+				conjuncts = append(conjuncts, fmt.Sprintf("%s = %s", sorts[prev].Name, literals[prev]))
+			}
+		}
+		comparison := ">"
+		if sort.Descending {
+			comparison = "<"
+		}
+		conjuncts = append(conjuncts, fmt.Sprintf("(%s %s %s OR %s IS NULL)", sort.Name, comparison, literals[idx], sort.Name))
+		disjuncts = append(disjuncts, "("+strings.Join(conjuncts, " AND ")+")")
+	}
+	// The cursor row has null in every sort column, nothing comes after it
+	if len(disjuncts) == 0 {
+		return "FALSE", nil
+	}
+	return "(" + strings.Join(disjuncts, " OR ") + ")", nil
+}
+
+// typedLiteral parses value as dataType, so only well formed literals are written to the sql
+func typedLiteral(columnName string, value string, dataType string) (string, error) {
+	invalid := status.Errorf(codes.InvalidArgument, "value %s of column %s is not %s", value, columnName, dataType)
+	value = strings.TrimSpace(value)
+	switch dataType {
+	case string(model.ColumnDataType_Int):
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", invalid
+		}
+		return strconv.FormatInt(number, 10), nil
+	case string(model.ColumnDataType_Float):
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return "", invalid
+		}
+		return strconv.FormatFloat(number, 'g', -1, 64), nil
+	case string(model.ColumnDataType_Bool):
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", invalid
+		}
+		return strconv.FormatBool(boolean), nil
+	case string(model.ColumnDataType_Date):
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return "", invalid
+		}
+		return fmt.Sprintf("DATE '%s'", date.Format("2006-01-02")), nil
+	case string(model.ColumnDataType_Timestamp):
+		timestamp, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return "", invalid
+		}
+		return fmt.Sprintf("TIMESTAMP '%s'", timestamp.UTC().Format("2006-01-02 15:04:05.999999Z07:00")), nil
+	}
+	return utils.QuoteSparkString(value), nil
+}
+
+// typedLiterals parses comma separated values
+func typedLiterals(columnName string, value string, dataType string) ([]string, error) {
+	values := strings.Split(value, ",")
+	literals := make([]string, 0, len(values))
+	for _, each := range values {
+		literal, err := typedLiteral(columnName, each, dataType)
+		if err != nil {
+			return nil, err
+		}
+		literals = append(literals, literal)
+	}
+	return literals, nil
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// encodeCursor keeps the values of the sort columns of the last row of a page, a missing column is null
+func encodeCursor(sorts []tableQuerySort, row string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(row))
+	decoder.UseNumber()
+	var record map[string]any
+	err := decoder.Decode(&record)
+	if err != nil {
+		return "", err
+	}
+	values := utils.Map(sorts, func(sort tableQuerySort) any { return record[sort.Column] })
+	jsonValues, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(jsonValues), nil
+}
+
+func decodeCursor(cursor string) ([]any, error) {
+	jsonValues, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(jsonValues))
+	decoder.UseNumber()
+	var values []any
+	err = decoder.Decode(&values)
+	return values, err
+}
+
+func cursorValueString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,152 @@
+package data_table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pageQueryAdapter answers the count query with rows and the rows query with records
+type pageQueryAdapter struct {
+	rawSQLQueryAdapter
+	records []string
+}
+
+func (a *pageQueryAdapter) QueryRawSQLV2(ctx context.Context, request *query.QueryRawSQLV2Request) (*query.QueryRawSQLV2Response, error) {
+	a.queries = append(a.queries, request.Query)
+	return &query.QueryRawSQLV2Response{Data: a.records}, nil
+}
+
+func TestQueryTablePagesWithCursor(t *testing.T) {
+	schema := []model.SchemaUnit{
+		{ColumnName: "id", DataType: "bigint"},
+		{ColumnName: "name", DataType: "string"},
+		{ColumnName: "score", DataType: "double"},
+	}
+	tableQuery := &api.TableQuery{
+		Filter: &api.Rule{Field: "name", Operator: string(model.RuleOperator_Contains), Value: "o'b"},
+		Sorts:  []*api.TableQuerySort{{Column: "score", Descending: true}},
+		Limit:  2,
+	}
+
+	// the page reads one more row than its limit to know there is a next page
+	adapter := &pageQueryAdapter{
+		rawSQLQueryAdapter: rawSQLQueryAdapter{rows: []map[string]any{{"count": float64(5)}}},
+		records:            []string{`{"id":3,"score":2.5}`, `{"id":7,"score":1.5}`, `{"id":8,"score":1.5}`},
+	}
+	result, err := QueryTable(context.Background(), adapter, "delta.`t`", schema, tableQuery, "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Count != 5 || len(result.Data) != 2 || result.NextCursor == "" {
+		t.Fatalf("first page = %+v", result)
+	}
+	want := "SELECT * FROM delta.`t` WHERE `name` LIKE '%o\\'b%' ORDER BY `score` DESC NULLS LAST, `id` ASC NULLS LAST LIMIT 3"
+	if adapter.queries[1] != want {
+		t.Errorf("first page query = %s, want %s", adapter.queries[1], want)
+	}
+
+	// the next page starts after the last row of the page, the row of id 8 tied on score is not skipped
+	tableQuery.Cursor = result.NextCursor
+	adapter = &pageQueryAdapter{records: []string{`{"id":8,"score":1.5}`, `{"id":9}`}}
+	result, err = QueryTable(context.Background(), adapter, "delta.`t`", schema, tableQuery, "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Data) != 2 || result.NextCursor != "" {
+		t.Errorf("last page = %+v", result)
+	}
+	want = "SELECT * FROM delta.`t` WHERE `name` LIKE '%o\\'b%' AND " +
+		"(((`score` < 1.5 OR `score` IS NULL)) OR (`score` = 1.5 AND (`id` > 7 OR `id` IS NULL))) " +
+		"ORDER BY `score` DESC NULLS LAST, `id` ASC NULLS LAST LIMIT 3"
+	if adapter.queries[1] != want {
+		t.Errorf("next page query = %s, want %s", adapter.queries[1], want)
+	}
+	if adapter.queries[0] != "SELECT COUNT(*) AS count FROM delta.`t` WHERE `name` LIKE '%o\\'b%'" {
+		t.Errorf("the count of the next page = %s, it counts every filtered row", adapter.queries[0])
+	}
+
+	// a row without score sorts last, only the rows without score and a greater id come after it
+	cursor, err := encodeCursor([]tableQuerySort{{Column: "score"}, {Column: "id"}}, `{"id":9}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyset, err := newTableQueryBuilder(schema).keyset([]tableQuerySort{
+		{Column: "score", Name: "`score`", DataType: string(model.ColumnDataType_Float), Descending: true},
+		{Column: "id", Name: "`id`", DataType: string(model.ColumnDataType_Int)},
+	}, cursor)
+	if err != nil || keyset != "((`score` IS NULL AND (`id` > 9 OR `id` IS NULL)))" {
+		t.Errorf("keyset after a null = %s, %v", keyset, err)
+	}
+
+	// without a unique key the rows tied at the end of a page would be skipped, so there is no cursor
+	adapter = &pageQueryAdapter{records: []string{`{"id":1}`, `{"id":2}`, `{"id":3}`}}
+	tableQuery.Cursor = ""
+	result, err = QueryTable(context.Background(), adapter, "delta.`t`", schema, tableQuery, "")
+	if err != nil || result.NextCursor != "" {
+		t.Errorf("page without key = %+v, %v", result, err)
+	}
+	tableQuery.Cursor = cursor
+	if _, err = QueryTable(context.Background(), adapter, "delta.`t`", schema, tableQuery, ""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("cursor without key: got %v, want InvalidArgument", err)
+	}
+}
+
+func TestKeysetRejectsForgedCursor(t *testing.T) {
+	builder := newTableQueryBuilder([]model.SchemaUnit{{ColumnName: "id", DataType: "bigint"}})
+	sorts := []tableQuerySort{{Column: "id", Name: "`id`", DataType: string(model.ColumnDataType_Int)}}
+	forged := func(row string, sorts ...tableQuerySort) string {
+		cursor, err := encodeCursor(sorts, row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cursor
+	}
+	for _, cursor := range []string{
+		"not a cursor!",
+		forged(`{"id":"1 OR 1=1"}`, tableQuerySort{Column: "id"}),
+		forged(`{"id":1,"name":"a"}`, tableQuerySort{Column: "id"}, tableQuerySort{Column: "name"}),
+	} {
+		if _, err := builder.keyset(sorts, cursor); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("keyset(%q) = %v, want InvalidArgument", cursor, err)
+		}
+	}
+}
+
+func TestTypedLiteral(t *testing.T) {
+	accepted := map[model.ColumnDataType]map[string]string{
+		model.ColumnDataType_Int:       {" 42 ": "42", "-7": "-7"},
+		model.ColumnDataType_Float:     {"1.50": "1.5", "1e3": "1000"},
+		model.ColumnDataType_Bool:      {"TRUE": "true", "0": "false"},
+		model.ColumnDataType_Date:      {"2024-02-29": "DATE '2024-02-29'"},
+		model.ColumnDataType_Timestamp: {"2024-03-01T07:00:00+07:00": "TIMESTAMP '2024-03-01 00:00:00Z'", "2024-03-01T00:00:00.25Z": "TIMESTAMP '2024-03-01 00:00:00.25Z'"},
+		model.ColumnDataType_String:    {`o'brien\`: `'o\'brien\\'`},
+	}
+	for dataType, literals := range accepted {
+		for value, want := range literals {
+			if got, err := typedLiteral("column", value, string(dataType)); err != nil || got != want {
+				t.Errorf("typedLiteral(%q, %s) = %s, %v, want %s", value, dataType, got, err, want)
+			}
+		}
+	}
+
+	rejected := map[model.ColumnDataType][]string{
+		model.ColumnDataType_Int:       {"4.2", "1 OR 1=1"},
+		model.ColumnDataType_Float:     {"NaN", "Inf"},
+		model.ColumnDataType_Bool:      {"yes"},
+		model.ColumnDataType_Date:      {"2023-02-29"},
+		model.ColumnDataType_Timestamp: {"2024-03-01 00:00:00"},
+	}
+	for dataType, values := range rejected {
+		for _, value := range values {
+			if _, err := typedLiteral("column", value, string(dataType)); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("typedLiteral(%q, %s) = %v, want InvalidArgument", value, dataType, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package data_table
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gorm.io/gorm"
+)
+
+// defaultTimeTravelLimit is the number of versions or rows returned when the request has no limit
+const defaultTimeTravelLimit = 100
+
+func (b business) deltaTableLocation(dataTable *model.DataTable) string {
+	return fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaTablePath(dataTable.AccountUuid.String(), dataTable.Name))
+}
+
+// timeTravelClause returns the delta clause reading a table at version or as of timestamp, empty for the latest version
+func timeTravelClause(version *wrapperspb.Int64Value, timestamp string) (string, error) {
+	if version != nil && timestamp != "" {
+		return "", status.Error(codes.InvalidArgument, "version and timestamp cannot be used together")
+	}
+	if version != nil {
+		if version.Value < 0 {
+			return "", status.Error(codes.InvalidArgument, "version must be greater than or equal to 0")
+		}
+		return fmt.Sprintf(" VERSION AS OF %d", version.Value), nil
+	}
+	if timestamp != "" {
+		asOf, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid timestamp %s", timestamp)
+		}
+		return fmt.Sprintf(" TIMESTAMP AS OF '%s'", asOf.UTC().Format("2006-01-02 15:04:05")), nil
+	}
+	return "", nil
+}
+
+// queryDataTableAt reads the first limit rows of the table at a past version
+func (b business) queryDataTableAt(ctx context.Context, dataTable *model.DataTable, clause string, limit int32) (*api.GetQueryDataTableResponse, error) {
+	if limit <= 0 {
+		limit = defaultTimeTravelLimit
+	}
+	table := fmt.Sprintf("delta.`%s`%s", b.deltaTableLocation(dataTable), clause)
+
+	countResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT COUNT(*) AS count FROM %s", table),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var count float64
+	if len(countResponse.Data) > 0 {
+		count, _ = countResponse.Data[0]["count"].(float64)
+	}
+	rowsResponse, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{
+		Query: fmt.Sprintf("SELECT * FROM %s LIMIT %d", table, limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetQueryDataTableResponse{
+		Code:  int32(codes.OK),
+		Count: int64(count),
+		Data:  rowsResponse.Data,
+	}, nil
+}
+
+// GetListDataTableVersions lists the delta commits of the table. The dags write their dag run id as the
+// userMetadata of their commits, which links a commit to the data action run that made it.
+func (b business) GetListDataTableVersions(ctx context.Context, request *api.GetListDataTableVersionsRequest, accountUuid string) (*api.GetListDataTableVersionsResponse, error) {
+	logger := b.log.WithName("GetListDataTableVersions").WithValues("request", request)
+
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can get data_table")
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultTimeTravelLimit
+	}
+	historyResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("DESCRIBE HISTORY delta.`%s` LIMIT %d", b.deltaTableLocation(dataTable), limit),
+	})
+	if err != nil {
+		logger.Error(err, "cannot get delta history")
+		return nil, err
+	}
+
+	var dagRunIds []string
+	for _, commit := range historyResponse.Data {
+		if dagRunId, _ := commit["userMetadata"].(string); dagRunId != "" {
+			dagRunIds = append(dagRunIds, dagRunId)
+		}
+	}
+	dataActionRuns := make(map[string]repository.DataActionRunWithExtraInfo, len(dagRunIds))
+	if len(dagRunIds) > 0 {
+		runs, err := b.repository.DataActionRunRepository.GetListDataActionRunsWithExtraInfo(ctx, &repository.GetListDataActionRunsWithExtraInfoParams{
+			DagRunIds:   dagRunIds,
+			AccountUuid: dataTable.AccountUuid,
+		})
+		if err != nil {
+			logger.Error(err, "cannot get data action runs of commits")
+			return nil, err
+		}
+		for _, run := range runs {
+			dataActionRuns[run.DagRunId] = run
+		}
+	}
+
+	results := make([]*api.DataTableVersion, 0, len(historyResponse.Data))
+	for _, commit := range historyResponse.Data {
+		version, _ := commit["version"].(float64)
+		timestamp, _ := commit["timestamp"].(string)
+		operation, _ := commit["operation"].(string)
+		dataTableVersion := &api.DataTableVersion{
+			Version:   int64(version),
+			Timestamp: timestamp,
+			Operation: operation,
+		}
+		if metrics, ok := commit["operationMetrics"].(map[string]any); ok {
+			numOutputRows, _ := metrics["numOutputRows"].(string)
+			dataTableVersion.NumOutputRows, _ = strconv.ParseInt(numOutputRows, 10, 64)
+		}
+		dagRunId, _ := commit["userMetadata"].(string)
+		if run, ok := dataActionRuns[dagRunId]; ok && dagRunId != "" {
+			dataTableVersion.DataActionId = run.ActionId
+			dataTableVersion.DataActionRunId = run.ID
+			dataTableVersion.ActionType = string(run.ActionType)
+		}
+		results = append(results, dataTableVersion)
+	}
+
+	return &api.GetListDataTableVersionsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Results: results,
+	}, nil
+}
+
+func (b business) RestoreDataTable(ctx context.Context, request *api.RestoreDataTableRequest, accountUuid string) (*api.RestoreDataTableResponse, error) {
+	logger := b.log.WithName("RestoreDataTable").WithValues("request", request)
+
+	if request.Version == nil && request.Timestamp == "" {
+		return nil, status.Error(codes.InvalidArgument, "version or timestamp is required")
+	}
+	_, err := timeTravelClause(request.Version, request.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can restore data_table")
+	}
+
+	dagId := utils.GenerateDagId(accountUuid, model.ActionType_RestoreDataTable)
+	var dataActionId int64
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Call airflow
+		payload := airflow.TriggerGenerateDagRestoreDataTableRequest{Conf: airflow.DagRestoreDataTableConfig{
+			DagId:     dagId,
+			TableKey:  utils.GenerateDeltaTablePath(accountUuid, dataTable.Name),
+			Timestamp: request.Timestamp,
+		}}
+		if request.Version != nil {
+			payload.Conf.Version = &request.Version.Value
+		}
+		payloadJson, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		_, err = b.airflowAdapter.TriggerGenerateDagRestoreDataTable(ctx, &payload)
+		if err != nil {
+			logger.Error(err, "cannot trigger dag generate restore data table")
+			return err
+		}
+
+		// 2. Save data action
+		dataAction, err := b.repository.DataActionRepository.CreateDataAction(ctx, &repository.CreateDataActionParams{
+			Tx:          tx,
+			TargetTable: model.TargetTable_DataTable,
+			ActionType:  model.ActionType_RestoreDataTable,
+			Schedule:    "",
+			AccountUuid: uuid.MustParse(accountUuid),
+			DagId:       dagId,
+			Status:      model.DataActionStatus_Pending,
+			ObjectId:    dataTable.ID,
+			Payload:     pqtype.NullRawMessage{RawMessage: payloadJson, Valid: payloadJson != nil},
+		})
+		if err != nil {
+			logger.Error(err, "cannot create data action")
+			return err
+		}
+		dataActionId = dataAction.ID
+
+		// 3. Create data action run
+		_, err = b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+			Tx:          tx,
+			ActionId:    dataAction.ID,
+			RunId:       0,
+			Status:      model.DataActionRunStatus_Creating,
+			AccountUuid: uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create data action run")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RestoreDataTableResponse{
+		Code:         int32(code.Code_OK),
+		Message:      "Success",
+		DataActionId: dataActionId,
+	}, nil
+}
+
+// SyncOnRestoreDataTable reads the schema of the restored table, which may differ from the schema before the restore
+func (b business) SyncOnRestoreDataTable(ctx context.Context, dataActionId int64) error {
+	logger := b.log.WithName("SyncOnRestoreDataTable").WithValues("dataActionId", dataActionId)
+
+	dataAction, err := b.repository.DataActionRepository.GetDataAction(ctx, dataActionId)
+	if err != nil {
+		logger.Error(err, "cannot get data action")
+		return err
+	}
+	if dataAction.Status != model.DataActionStatus_Success {
+		return nil
+	}
+	path, err := b.repository.DataTableRepository.GetDataTableDeltaPath(ctx, dataAction.ObjectId)
+	if err != nil {
+		logger.Error(err, "cannot get table delta path")
+		return err
+	}
+	response, err := b.queryAdapter.GetSchemaTable(ctx, &query.GetSchemaDataTableRequest{TablePath: path})
+	if err != nil {
+		logger.Error(err, "cannot get schema of restored table")
+		return err
+	}
+
+	schema := utils.Map(response.Schema, func(column query.FieldSchema) model.SchemaUnit {
+		return model.SchemaUnit{ColumnName: column.Name, DataType: column.Type}
+	})
+	jsonSchema, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	_, err = b.repository.DataTableRepository.UpdateDataTable(ctx, &repository.UpdateDataTableParams{
+		ID:     dataAction.ObjectId,
+		Schema: pqtype.NullRawMessage{Valid: true, RawMessage: jsonSchema},
+		Status: model.DataTableStatus_UP_TO_DATE,
+	})
+	if err != nil {
+		logger.Error(err, "cannot update data table schema")
+		return err
+	}
+
+	return RecordSchemaVersion(ctx, b.repository, dataAction.ObjectId, schema, dataActionId)
+}
+
+// DiffDataTableVersions compares whole rows, a changed row is both removed from from_version and added in to_version
+func (b business) DiffDataTableVersions(ctx context.Context, request *api.DiffDataTableVersionsRequest, accountUuid string) (*api.DiffDataTableVersionsResponse, error) {
+	logger := b.log.WithName("DiffDataTableVersions").WithValues("request", request)
+
+	toClause, err := timeTravelClause(request.ToVersion, "")
+	if err != nil {
+		return nil, err
+	}
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get data table")
+		return nil, err
+	}
+	if dataTable.AccountUuid != uuid.MustParse(accountUuid) {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can get data_table")
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = defaultTimeTravelLimit
+	}
+	location := b.deltaTableLocation(dataTable)
+	fromTable := fmt.Sprintf("delta.`%s` VERSION AS OF %d", location, request.FromVersion)
+	toTable := fmt.Sprintf("delta.`%s`%s", location, toClause)
+
+	addedCount, added, err := b.exceptRows(ctx, toTable, fromTable, limit)
+	if err != nil {
+		logger.Error(err, "cannot get added rows")
+		return nil, err
+	}
+	removedCount, removed, err := b.exceptRows(ctx, fromTable, toTable, limit)
+	if err != nil {
+		logger.Error(err, "cannot get removed rows")
+		return nil, err
+	}
+
+	masks, err := DataTableColumnMasks(ctx, b.repository, accountUuid, dataTable.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return nil, err
+	}
+	added, err = masks.MaskRecords(added)
+	if err != nil {
+		logger.Error(err, "cannot mask added rows")
+		return nil, err
+	}
+	removed, err = masks.MaskRecords(removed)
+	if err != nil {
+		logger.Error(err, "cannot mask removed rows")
+		return nil, err
+	}
+
+	return &api.DiffDataTableVersionsResponse{
+		Code:         int32(code.Code_OK),
+		Message:      "Success",
+		AddedCount:   addedCount,
+		RemovedCount: removedCount,
+		Added:        added,
+		Removed:      removed,
+	}, nil
+}
+
+// exceptRows counts the rows of table that are not in other and returns the first limit of them as json
+func (b business) exceptRows(ctx context.Context, table string, other string, limit int32) (int64, []string, error) {
+	except := fmt.Sprintf("SELECT * FROM %s EXCEPT ALL SELECT * FROM %s", table, other)
+
+	countResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT COUNT(*) AS count FROM (%s)", except),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	var count float64
+	if len(countResponse.Data) > 0 {
+		count, _ = countResponse.Data[0]["count"].(float64)
+	}
+	if count == 0 {
+		return 0, nil, nil
+	}
+	rowsResponse, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{
+		Query: fmt.Sprintf("%s LIMIT %d", except, limit),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return int64(count), rowsResponse.Data, nil
+}
@@ -0,0 +1,80 @@
+package data_table
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestTimeTravelClause(t *testing.T) {
+	clause, err := timeTravelClause(nil, "")
+	if err != nil || clause != "" {
+		t.Errorf("the latest version: got %q, %v", clause, err)
+	}
+	clause, err = timeTravelClause(wrapperspb.Int64(0), "")
+	if err != nil || clause != " VERSION AS OF 0" {
+		t.Errorf("the first version: got %q, %v", clause, err)
+	}
+	// delta reads the timestamp in the session time zone, which is utc
+	clause, err = timeTravelClause(nil, "2024-08-10T09:15:00+07:00")
+	if err != nil || clause != " TIMESTAMP AS OF '2024-08-10 02:15:00'" {
+		t.Errorf("as of a timestamp: got %q, %v", clause, err)
+	}
+
+	for _, test := range []struct {
+		version   *wrapperspb.Int64Value
+		timestamp string
+	}{
+		{wrapperspb.Int64(1), "2024-08-10T09:15:00Z"},
+		{wrapperspb.Int64(-1), ""},
+		{nil, "2024-08-10"},
+		{nil, "'; DROP TABLE users; --"},
+	} {
+		if _, err := timeTravelClause(test.version, test.timestamp); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("timeTravelClause(%v, %q) = %v, want InvalidArgument", test.version, test.timestamp, err)
+		}
+	}
+}
+
+type exceptQueryAdapter struct {
+	query.QueryAdapter
+	count   float64
+	queries []string
+}
+
+func (a *exceptQueryAdapter) QueryRawSQL(ctx context.Context, request *query.QueryRawSQLRequest) (*query.QueryRawSQLResponse, error) {
+	a.queries = append(a.queries, request.Query)
+	return &query.QueryRawSQLResponse{Data: []map[string]any{{"count": a.count}}}, nil
+}
+
+func (a *exceptQueryAdapter) QueryRawSQLV2(ctx context.Context, request *query.QueryRawSQLV2Request) (*query.QueryRawSQLV2Response, error) {
+	a.queries = append(a.queries, request.Query)
+	return &query.QueryRawSQLV2Response{Data: []string{`{"id":1}`}}, nil
+}
+
+func TestExceptRows(t *testing.T) {
+	adapter := &exceptQueryAdapter{count: 3}
+	b := business{queryAdapter: adapter}
+	count, rows, err := b.exceptRows(context.Background(), "delta.`t` VERSION AS OF 2", "delta.`t` VERSION AS OF 1", 10)
+	if err != nil || count != 3 || len(rows) != 1 {
+		t.Fatalf("exceptRows() = %d, %v, %v", count, rows, err)
+	}
+	// EXCEPT ALL keeps the duplicated rows, a row added twice is counted twice
+	want := "SELECT * FROM delta.`t` VERSION AS OF 2 EXCEPT ALL SELECT * FROM delta.`t` VERSION AS OF 1 LIMIT 10"
+	if len(adapter.queries) != 2 || adapter.queries[1] != want || !strings.Contains(adapter.queries[0], "COUNT(*)") {
+		t.Errorf("exceptRows() queries = %q", adapter.queries)
+	}
+
+	// the rows are not read when nothing differs
+	adapter = &exceptQueryAdapter{}
+	b = business{queryAdapter: adapter}
+	count, rows, err = b.exceptRows(context.Background(), "a", "b", 10)
+	if err != nil || count != 0 || rows != nil || len(adapter.queries) != 1 {
+		t.Errorf("exceptRows() without difference = %d, %v, %v after %q", count, rows, err, adapter.queries)
+	}
+}
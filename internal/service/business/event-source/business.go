@@ -0,0 +1,39 @@
+package event_source
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/go-logr/logr"
+	"gorm.io/gorm"
+)
+
+type Business interface {
+	CreateEventSource(ctx context.Context, request *api.CreateEventSourceRequest, accountUuid string) (*api.CreateEventSourceResponse, error)
+	GetListEventSources(ctx context.Context, request *api.GetListEventSourcesRequest, accountUuid string) (*api.GetListEventSourcesResponse, error)
+	Track(ctx context.Context, request *api.TrackRequest, writeKey string) (*api.IngestEventResponse, error)
+	Identify(ctx context.Context, request *api.IdentifyRequest, writeKey string) (*api.IngestEventResponse, error)
+	BatchTrack(ctx context.Context, request *api.BatchTrackRequest, writeKey string) (*api.IngestEventResponse, error)
+
+	FlushEventBuffers(ctx context.Context) error
+}
+
+type business struct {
+	db           *gorm.DB
+	log          logr.Logger
+	repository   *repository.Repository
+	queryAdapter query.QueryAdapter
+	config       *config.Config
+}
+
+func NewEventSourceBusiness(db *gorm.DB, log logr.Logger, repository *repository.Repository, queryAdapter query.QueryAdapter, config *config.Config) Business {
+	return &business{
+		db:           db,
+		log:          log.WithName("EventSourceBiz"),
+		repository:   repository,
+		queryAdapter: queryAdapter,
+		config:       config,
+	}
+}
@@ -0,0 +1,209 @@
+package event_source
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var reservedEventColumns = []string{
+	model.EventColumn_MessageId,
+	model.EventColumn_Type,
+	model.EventColumn_Event,
+	model.EventColumn_UserId,
+	model.EventColumn_AnonymousId,
+	model.EventColumn_Timestamp,
+	model.EventColumn_ReceivedAt,
+	model.EventColumn_Traits,
+}
+
+func (b business) CreateEventSource(ctx context.Context, request *api.CreateEventSourceRequest, accountUuid string) (*api.CreateEventSourceResponse, error) {
+	logger := b.log.WithName("CreateEventSource").WithValues("request", request)
+
+	err := validateEventSchemaFields(request.Properties, true)
+	if err != nil {
+		return nil, err
+	}
+	err = validateEventSchemaFields(request.Traits, false)
+	if err != nil {
+		return nil, err
+	}
+	err = b.repository.DataTableRepository.CheckExistsDataTableName(ctx, request.TableName, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := &model.EventSourceConfiguration{
+		Properties: request.Properties,
+		Traits:     request.Traits,
+	}
+	jsonConfigurations, err := json.Marshal(configuration)
+	if err != nil {
+		logger.Error(err, "cannot marshal configurations")
+		return nil, err
+	}
+	jsonSchema, err := json.Marshal(eventTableSchema(configuration))
+	if err != nil {
+		logger.Error(err, "cannot marshal event table schema")
+		return nil, err
+	}
+	writeKey, err := generateWriteKey()
+	if err != nil {
+		logger.Error(err, "cannot generate write key")
+		return nil, err
+	}
+
+	var eventSource *model.EventSource
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Create behavior data table
+		dataTable, err := b.repository.DataTableRepository.CreateDataTable(ctx, &repository.CreateDataTableParams{
+			Tx:          tx,
+			Name:        request.TableName,
+			Schema:      pqtype.NullRawMessage{RawMessage: jsonSchema, Valid: true},
+			AccountUuid: uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create event data table")
+			return err
+		}
+
+		// 2. Create event source
+		eventSource, err = b.repository.EventSourceRepository.CreateEventSource(ctx, &repository.CreateEventSourceParams{
+			Tx:             tx,
+			Name:           request.Name,
+			WriteKey:       writeKey,
+			TableId:        dataTable.ID,
+			Configurations: pqtype.NullRawMessage{RawMessage: jsonConfigurations, Valid: true},
+			AccountUuid:    uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create event source")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateEventSourceResponse{
+		Code:     int32(code.Code_OK),
+		Message:  "Success",
+		Id:       eventSource.ID,
+		WriteKey: eventSource.WriteKey,
+		TableId:  eventSource.TableId,
+	}, nil
+}
+
+func (b business) GetListEventSources(ctx context.Context, request *api.GetListEventSourcesRequest, accountUuid string) (*api.GetListEventSourcesResponse, error) {
+	logger := b.log.WithName("GetListEventSources")
+
+	queryResult, err := b.repository.EventSourceRepository.ListEventSources(ctx, &repository.ListEventSourcesParams{
+		AccountUuid: uuid.MustParse(accountUuid),
+		Page:        int(request.Page),
+		PageSize:    int(request.PageSize),
+	})
+	if err != nil {
+		logger.Error(err, "cannot get list event sources")
+		return nil, err
+	}
+	if len(queryResult.EventSources) == 0 {
+		return &api.GetListEventSourcesResponse{
+			Code:    int32(code.Code_OK),
+			Message: "Success",
+			Count:   queryResult.Count,
+		}, nil
+	}
+
+	tableIds := utils.Map(queryResult.EventSources, func(eventSource model.EventSource) int64 { return eventSource.TableId })
+	queryTables, err := b.repository.DataTableRepository.ListDataTables(ctx, &repository.ListDataTablesFilters{DataTableIds: tableIds})
+	if err != nil {
+		logger.Error(err, "cannot get event data tables")
+		return nil, err
+	}
+	tableNames := make(map[int64]string, len(queryTables.DataTables))
+	for _, dataTable := range queryTables.DataTables {
+		tableNames[dataTable.ID] = dataTable.Name
+	}
+	pendingCounts, err := b.repository.EventBufferRepository.CountEventBuffers(ctx,
+		utils.Map(queryResult.EventSources, func(eventSource model.EventSource) int64 { return eventSource.ID }))
+	if err != nil {
+		logger.Error(err, "cannot count buffered events")
+		return nil, err
+	}
+
+	results := make([]*api.EventSource, 0, len(queryResult.EventSources))
+	for _, eventSource := range queryResult.EventSources {
+		configuration, err := parseEventSourceConfiguration(&eventSource)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal configurations", "eventSourceId", eventSource.ID)
+			return nil, err
+		}
+		results = append(results, &api.EventSource{
+			Id:           eventSource.ID,
+			Name:         eventSource.Name,
+			WriteKey:     eventSource.WriteKey,
+			TableId:      eventSource.TableId,
+			TableName:    tableNames[eventSource.TableId],
+			Properties:   configuration.Properties,
+			Traits:       configuration.Traits,
+			PendingCount: pendingCounts[eventSource.ID],
+			CreatedAt:    eventSource.CreatedAt.String(),
+			UpdatedAt:    eventSource.UpdatedAt.String(),
+		})
+	}
+
+	return &api.GetListEventSourcesResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Count:   queryResult.Count,
+		Results: results,
+	}, nil
+}
+
+func validateEventSchemaFields(fields []*api.EventSchemaField, isProperty bool) error {
+	var names []string
+	for _, field := range fields {
+		if slices.Contains(names, field.Name) {
+			return status.Errorf(codes.InvalidArgument, "field %s is declared more than once", field.Name)
+		}
+		if isProperty && slices.Contains(reservedEventColumns, field.Name) {
+			return status.Errorf(codes.InvalidArgument, "property %s is a reserved column of the event table", field.Name)
+		}
+		names = append(names, field.Name)
+	}
+	return nil
+}
+
+func parseEventSourceConfiguration(eventSource *model.EventSource) (*model.EventSourceConfiguration, error) {
+	var configuration model.EventSourceConfiguration
+	if eventSource.Configurations.Valid {
+		err := json.Unmarshal(eventSource.Configurations.RawMessage, &configuration)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &configuration, nil
+}
+
+func generateWriteKey() (string, error) {
+	key := make([]byte, 24)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
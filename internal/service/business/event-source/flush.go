@@ -0,0 +1,158 @@
+package event_source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+)
+
+// eventFlushBatchSize is the max number of buffered events of an event source written by one flush
+const eventFlushBatchSize = 1000
+
+var eventFieldSparkTypes = map[model.EventFieldDataType]string{
+	model.EventFieldDataType_String:    "string",
+	model.EventFieldDataType_Int:       "bigint",
+	model.EventFieldDataType_Float:     "double",
+	model.EventFieldDataType_Bool:      "boolean",
+	model.EventFieldDataType_Timestamp: "timestamp",
+}
+
+// eventTableSchema lists the fixed event columns followed by the declared properties
+func eventTableSchema(configuration *model.EventSourceConfiguration) []model.SchemaUnit {
+	schema := []model.SchemaUnit{
+		{ColumnName: model.EventColumn_MessageId, DataType: "string"},
+		{ColumnName: model.EventColumn_Type, DataType: "string"},
+		{ColumnName: model.EventColumn_Event, DataType: "string"},
+		{ColumnName: model.EventColumn_UserId, DataType: "string"},
+		{ColumnName: model.EventColumn_AnonymousId, DataType: "string"},
+		{ColumnName: model.EventColumn_Timestamp, DataType: "timestamp"},
+		{ColumnName: model.EventColumn_ReceivedAt, DataType: "timestamp"},
+		{ColumnName: model.EventColumn_Traits, DataType: "string"},
+	}
+	for _, property := range configuration.Properties {
+		schema = append(schema, model.SchemaUnit{
+			ColumnName: property.Name,
+			DataType:   eventFieldSparkTypes[model.EventFieldDataType(property.DataType)],
+		})
+	}
+	return schema
+}
+
+// FlushEventBuffers writes a micro-batch of the buffered events of every event source to its data table
+func (b business) FlushEventBuffers(ctx context.Context) error {
+	logger := b.log.WithName("FlushEventBuffers")
+
+	eventSourceIds, err := b.repository.EventBufferRepository.GetBufferedEventSourceIds(ctx)
+	if err != nil {
+		logger.Error(err, "cannot get buffered event sources")
+		return err
+	}
+
+	var flushErr error
+	for _, eventSourceId := range eventSourceIds {
+		err = b.flushEventSource(ctx, eventSourceId)
+		if err != nil {
+			logger.Error(err, "cannot flush event source", "eventSourceId", eventSourceId)
+			flushErr = err
+		}
+	}
+	return flushErr
+}
+
+// flushEventSource merges on message_id, so events written by a flush whose buffer could not be deleted are not duplicated
+func (b business) flushEventSource(ctx context.Context, eventSourceId int64) error {
+	eventSource, err := b.repository.EventSourceRepository.GetEventSource(ctx, eventSourceId)
+	if err != nil {
+		return err
+	}
+	configuration, err := parseEventSourceConfiguration(eventSource)
+	if err != nil {
+		return err
+	}
+	dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, eventSource.TableId)
+	if err != nil {
+		return err
+	}
+	eventBuffers, err := b.repository.EventBufferRepository.ListEventBuffers(ctx, eventSourceId, eventFlushBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(eventBuffers) == 0 {
+		return nil
+	}
+
+	schema := eventTableSchema(configuration)
+	columnDefinitions := make([]string, 0, len(schema))
+	columnNames := make([]string, 0, len(schema))
+	for _, column := range schema {
+		columnDefinitions = append(columnDefinitions, utils.QuoteSparkIdentifier(column.ColumnName)+" "+column.DataType)
+		columnNames = append(columnNames, utils.QuoteSparkIdentifier(column.ColumnName))
+	}
+	rows := make([]string, 0, len(eventBuffers))
+	for _, eventBuffer := range eventBuffers {
+		var record map[string]any
+		err = json.Unmarshal(eventBuffer.Record.RawMessage, &record)
+		if err != nil {
+			return err
+		}
+		values := make([]string, 0, len(schema))
+		for _, column := range schema {
+			values = append(values, toSparkLiteral(record[column.ColumnName], column.DataType))
+		}
+		rows = append(rows, "("+strings.Join(values, ", ")+")")
+	}
+
+	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaTablePath(eventSource.AccountUuid.String(), dataTable.Name))
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("CREATE TABLE IF NOT EXISTS delta.`%s` (%s) USING DELTA", path, strings.Join(columnDefinitions, ", ")),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("MERGE INTO delta.`%s` AS target USING (SELECT * FROM VALUES %s AS source(%s)) AS source "+
+			"ON target.%s = source.%s WHEN NOT MATCHED THEN INSERT *",
+			path, strings.Join(rows, ", "), strings.Join(columnNames, ", "),
+			model.EventColumn_MessageId, model.EventColumn_MessageId),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = b.repository.EventBufferRepository.DeleteEventBuffers(ctx,
+		utils.Map(eventBuffers, func(eventBuffer model.EventBuffer) int64 { return eventBuffer.ID }))
+	if err != nil {
+		return err
+	}
+	if dataTable.Status != model.DataTableStatus_UP_TO_DATE {
+		return b.repository.DataTableRepository.UpdateStatusDataTable(ctx, dataTable.ID, model.DataTableStatus_UP_TO_DATE)
+	}
+	return nil
+}
+
+func toSparkLiteral(value any, dataType string) string {
+	var literal string
+	switch v := value.(type) {
+	case nil:
+		literal = "NULL"
+	case string:
+		if v == "" && dataType != "string" {
+			literal = "NULL"
+		} else {
+			literal = utils.QuoteSparkString(v)
+		}
+	case float64:
+		literal = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		literal = strconv.FormatBool(v)
+	default:
+		literal = utils.QuoteSparkString(fmt.Sprint(v))
+	}
+	return fmt.Sprintf("CAST(%s AS %s)", literal, dataType)
+}
@@ -0,0 +1,230 @@
+package event_source
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+)
+
+type eventMessage struct {
+	Type        model.EventType
+	Event       string
+	UserId      string
+	AnonymousId string
+	Values      *structpb.Struct
+	Timestamp   string
+	MessageId   string
+}
+
+func (b business) Track(ctx context.Context, request *api.TrackRequest, writeKey string) (*api.IngestEventResponse, error) {
+	return b.ingestEvents(ctx, writeKey, []eventMessage{{
+		Type:        model.EventType_Track,
+		Event:       request.Event,
+		UserId:      request.UserId,
+		AnonymousId: request.AnonymousId,
+		Values:      request.Properties,
+		Timestamp:   request.Timestamp,
+		MessageId:   request.MessageId,
+	}})
+}
+
+func (b business) Identify(ctx context.Context, request *api.IdentifyRequest, writeKey string) (*api.IngestEventResponse, error) {
+	return b.ingestEvents(ctx, writeKey, []eventMessage{{
+		Type:        model.EventType_Identify,
+		UserId:      request.UserId,
+		AnonymousId: request.AnonymousId,
+		Values:      request.Traits,
+		Timestamp:   request.Timestamp,
+		MessageId:   request.MessageId,
+	}})
+}
+
+func (b business) BatchTrack(ctx context.Context, request *api.BatchTrackRequest, writeKey string) (*api.IngestEventResponse, error) {
+	messages := make([]eventMessage, 0, len(request.Batch))
+	for _, event := range request.Batch {
+		messages = append(messages, eventMessage{
+			Type:        model.EventType_Track,
+			Event:       event.Event,
+			UserId:      event.UserId,
+			AnonymousId: event.AnonymousId,
+			Values:      event.Properties,
+			Timestamp:   event.Timestamp,
+			MessageId:   event.MessageId,
+		})
+	}
+	return b.ingestEvents(ctx, writeKey, messages)
+}
+
+// ingestEvents validates every message before buffering any, so a batch is accepted or rejected as a whole
+func (b business) ingestEvents(ctx context.Context, writeKey string, messages []eventMessage) (*api.IngestEventResponse, error) {
+	logger := b.log.WithName("ingestEvents")
+
+	eventSource, err := b.repository.EventSourceRepository.GetEventSourceByWriteKey(ctx, writeKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "Invalid write key")
+		}
+		logger.Error(err, "cannot get event source")
+		return nil, err
+	}
+	configuration, err := parseEventSourceConfiguration(eventSource)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal configurations", "eventSourceId", eventSource.ID)
+		return nil, err
+	}
+
+	receivedAt := time.Now().UTC()
+	eventBuffers := make([]model.EventBuffer, 0, len(messages))
+	for idx, message := range messages {
+		record, err := buildEventRecord(message, configuration, receivedAt)
+		if err != nil {
+			if len(messages) > 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "event %d: %s", idx, status.Convert(err).Message())
+			}
+			return nil, err
+		}
+		jsonRecord, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		eventBuffers = append(eventBuffers, model.EventBuffer{
+			EventSourceId: eventSource.ID,
+			Record:        pqtype.NullRawMessage{RawMessage: jsonRecord, Valid: true},
+		})
+	}
+
+	err = b.repository.EventBufferRepository.CreateEventBuffers(ctx, eventBuffers)
+	if err != nil {
+		logger.Error(err, "cannot buffer events", "eventSourceId", eventSource.ID)
+		return nil, err
+	}
+
+	return &api.IngestEventResponse{
+		Code:     int32(code.Code_OK),
+		Message:  "Success",
+		Accepted: int32(len(eventBuffers)),
+	}, nil
+}
+
+// buildEventRecord returns the row of the event table, keyed by column
+func buildEventRecord(message eventMessage, configuration *model.EventSourceConfiguration, receivedAt time.Time) (map[string]any, error) {
+	if message.UserId == "" && message.AnonymousId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id or anonymous_id is required")
+	}
+	timestamp := receivedAt
+	if message.Timestamp != "" {
+		var err error
+		timestamp, err = time.Parse(time.RFC3339, message.Timestamp)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp %s", message.Timestamp)
+		}
+	}
+	messageId := message.MessageId
+	if messageId == "" {
+		messageId = uuid.NewString()
+	}
+
+	fields := configuration.Properties
+	if message.Type == model.EventType_Identify {
+		fields = configuration.Traits
+	}
+	values, err := validateEventValues(message.Values, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	record := map[string]any{
+		model.EventColumn_MessageId:   messageId,
+		model.EventColumn_Type:        string(message.Type),
+		model.EventColumn_Event:       message.Event,
+		model.EventColumn_UserId:      message.UserId,
+		model.EventColumn_AnonymousId: message.AnonymousId,
+		model.EventColumn_Timestamp:   timestamp.UTC().Format(time.RFC3339Nano),
+		model.EventColumn_ReceivedAt:  receivedAt.Format(time.RFC3339Nano),
+	}
+	if message.Type == model.EventType_Identify {
+		jsonTraits, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		record[model.EventColumn_Traits] = string(jsonTraits)
+		return record, nil
+	}
+	for name, value := range values {
+		record[name] = value
+	}
+	return record, nil
+}
+
+// validateEventValues checks the values against the declared fields and converts them to the declared types
+func validateEventValues(values *structpb.Struct, fields []*api.EventSchemaField) (map[string]any, error) {
+	declaredFields := make(map[string]*api.EventSchemaField, len(fields))
+	for _, field := range fields {
+		declaredFields[field.Name] = field
+	}
+
+	result := make(map[string]any, len(values.GetFields()))
+	for name, value := range values.GetFields() {
+		field, ok := declaredFields[name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s is not declared in the event schema", name)
+		}
+		if _, isNull := value.GetKind().(*structpb.Value_NullValue); isNull {
+			continue
+		}
+		converted, ok := convertEventValue(value, model.EventFieldDataType(field.DataType))
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s must be %s", name, field.DataType)
+		}
+		result[name] = converted
+	}
+	for _, field := range fields {
+		if _, ok := result[field.Name]; field.Required && !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %s is required", field.Name)
+		}
+	}
+	return result, nil
+}
+
+func convertEventValue(value *structpb.Value, dataType model.EventFieldDataType) (any, bool) {
+	switch kind := value.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		switch dataType {
+		case model.EventFieldDataType_String:
+			return kind.StringValue, true
+		case model.EventFieldDataType_Timestamp:
+			timestamp, err := time.Parse(time.RFC3339, kind.StringValue)
+			if err != nil {
+				return nil, false
+			}
+			return timestamp.UTC().Format(time.RFC3339Nano), true
+		}
+	case *structpb.Value_NumberValue:
+		switch dataType {
+		case model.EventFieldDataType_Float:
+			return kind.NumberValue, true
+		case model.EventFieldDataType_Int:
+			if kind.NumberValue != math.Trunc(kind.NumberValue) {
+				return nil, false
+			}
+			return int64(kind.NumberValue), true
+		}
+	case *structpb.Value_BoolValue:
+		if dataType == model.EventFieldDataType_Bool {
+			return kind.BoolValue, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,122 @@
+package event_source
+
+import (
+	"testing"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestBuildEventRecord(t *testing.T) {
+	configuration := &model.EventSourceConfiguration{
+		Properties: []*api.EventSchemaField{
+			{Name: "amount", DataType: "float", Required: true},
+			{Name: "quantity", DataType: "int"},
+			{Name: "paid_at", DataType: "timestamp"},
+		},
+		Traits: []*api.EventSchemaField{{Name: "email", DataType: "string", Required: true}},
+	}
+	receivedAt := time.Date(2024, 8, 9, 10, 0, 0, 0, time.UTC)
+	values := func(fields map[string]any) *structpb.Struct {
+		value, err := structpb.NewStruct(fields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	record, err := buildEventRecord(eventMessage{
+		Type:      model.EventType_Track,
+		Event:     "Order Completed",
+		UserId:    "user-1",
+		Values:    values(map[string]any{"amount": 12.5, "quantity": 3, "paid_at": "2024-08-09T17:00:00+07:00"}),
+		Timestamp: "2024-08-09T16:30:00+07:00",
+		MessageId: "message-1",
+	}, configuration, receivedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a property is a column of the event table, the timestamps are stored in utc
+	for column, want := range map[string]any{
+		model.EventColumn_MessageId:  "message-1",
+		model.EventColumn_Event:      "Order Completed",
+		model.EventColumn_Timestamp:  "2024-08-09T09:30:00Z",
+		model.EventColumn_ReceivedAt: "2024-08-09T10:00:00Z",
+		"amount":                     12.5,
+		"quantity":                   int64(3),
+		"paid_at":                    "2024-08-09T10:00:00Z",
+	} {
+		if record[column] != want {
+			t.Errorf("track record[%s] = %v, want %v", column, record[column], want)
+		}
+	}
+	if _, ok := record[model.EventColumn_Traits]; ok {
+		t.Error("a track event has no traits")
+	}
+
+	record, err = buildEventRecord(eventMessage{
+		Type:        model.EventType_Identify,
+		AnonymousId: "anonymous-1",
+		Values:      values(map[string]any{"email": "a@b.c"}),
+	}, configuration, receivedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an identify event without timestamp or message id happens when it is received, under a generated message id
+	if record[model.EventColumn_Traits] != `{"email":"a@b.c"}` || record[model.EventColumn_Timestamp] != "2024-08-09T10:00:00Z" || record[model.EventColumn_MessageId] == "" {
+		t.Errorf("identify record = %v", record)
+	}
+}
+
+func TestBuildEventRecordRejects(t *testing.T) {
+	configuration := &model.EventSourceConfiguration{
+		Properties: []*api.EventSchemaField{{Name: "amount", DataType: "float", Required: true}, {Name: "quantity", DataType: "int"}},
+	}
+	property := func(name string, value *structpb.Value) *structpb.Struct {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{"amount": structpb.NewNumberValue(1), name: value}}
+	}
+	for _, test := range []struct {
+		message eventMessage
+		want    string
+	}{
+		{eventMessage{Values: property("quantity", structpb.NewNumberValue(1))}, "user_id or anonymous_id is required"},
+		{eventMessage{UserId: "user-1", Timestamp: "2024-08-09", Values: property("quantity", structpb.NewNumberValue(1))}, "invalid timestamp 2024-08-09"},
+		{eventMessage{UserId: "user-1", Values: property("coupon", structpb.NewStringValue("x"))}, "field coupon is not declared in the event schema"},
+		{eventMessage{UserId: "user-1", Values: property("quantity", structpb.NewNumberValue(1.5))}, "field quantity must be int"},
+		{eventMessage{UserId: "user-1", Values: property("quantity", structpb.NewStringValue("1"))}, "field quantity must be int"},
+		{eventMessage{UserId: "user-1", Values: property("quantity", structpb.NewBoolValue(true))}, "field quantity must be int"},
+		// a null value is a missing value
+		{eventMessage{UserId: "user-1", Values: &structpb.Struct{Fields: map[string]*structpb.Value{"amount": structpb.NewNullValue()}}}, "field amount is required"},
+	} {
+		test.message.Type = model.EventType_Track
+		_, err := buildEventRecord(test.message, configuration, time.Now())
+		if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != test.want {
+			t.Errorf("buildEventRecord(%+v) = %v, want %q", test.message, err, test.want)
+		}
+	}
+}
+
+func TestToSparkLiteral(t *testing.T) {
+	// the buffered records are json, so the numbers come back as float64
+	for _, test := range []struct {
+		value    any
+		dataType string
+		want     string
+	}{
+		{nil, "bigint", "CAST(NULL AS bigint)"},
+		{"", "timestamp", "CAST(NULL AS timestamp)"},
+		{"", "string", "CAST('' AS string)"},
+		{"it's", "string", `CAST('it\'s' AS string)`},
+		{float64(3), "bigint", "CAST(3 AS bigint)"},
+		{0.000001, "double", "CAST(0.000001 AS double)"},
+		{true, "boolean", "CAST(true AS boolean)"},
+	} {
+		if got := toSparkLiteral(test.value, test.dataType); got != test.want {
+			t.Errorf("toSparkLiteral(%v, %s) = %s, want %s", test.value, test.dataType, got, test.want)
+		}
+	}
+}
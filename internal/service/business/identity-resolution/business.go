@@ -0,0 +1,42 @@
+package identity_resolution
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/go-logr/logr"
+	"gorm.io/gorm"
+)
+
+type Business interface {
+	CreateIdentityResolution(ctx context.Context, request *api.CreateIdentityResolutionRequest, accountUuid string) (*api.CreateIdentityResolutionResponse, error)
+	GetListIdentityResolutions(ctx context.Context, request *api.GetListIdentityResolutionsRequest, accountUuid string) (*api.GetListIdentityResolutionsResponse, error)
+	GetIdentityResolutionDetail(ctx context.Context, request *api.GetIdentityResolutionDetailRequest, accountUuid string) (*api.GetIdentityResolutionDetailResponse, error)
+	GetIdentityProfileHistory(ctx context.Context, request *api.GetIdentityProfileHistoryRequest, accountUuid string) (*api.GetIdentityProfileHistoryResponse, error)
+
+	SyncOnIdentityResolution(ctx context.Context, identityResolutionId int64, actionStatus model.DataActionStatus) error
+}
+
+type business struct {
+	db             *gorm.DB
+	log            logr.Logger
+	repository     *repository.Repository
+	airflowAdapter airflow.AirflowAdapter
+	queryAdapter   query.QueryAdapter
+	config         *config.Config
+}
+
+func NewIdentityResolutionBusiness(db *gorm.DB, log logr.Logger, repository *repository.Repository, airflowAdapter airflow.AirflowAdapter, queryAdapter query.QueryAdapter, config *config.Config) Business {
+	return &business{
+		db:             db,
+		log:            log.WithName("IdentityResolutionBiz"),
+		repository:     repository,
+		airflowAdapter: airflowAdapter,
+		queryAdapter:   queryAdapter,
+		config:         config,
+	}
+}
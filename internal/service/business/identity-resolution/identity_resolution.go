@@ -0,0 +1,445 @@
+package identity_resolution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (b business) CreateIdentityResolution(ctx context.Context, request *api.CreateIdentityResolutionRequest, accountUuid string) (*api.CreateIdentityResolutionResponse, error) {
+	logger := b.log.WithName("CreateIdentityResolution").WithValues("request", request)
+
+	err := b.repository.DataTableRepository.CheckExistsDataTableName(ctx, request.OutputTableName, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	dagSources := make([]airflow.DagIdentityResolutionSource, 0, len(request.Sources))
+	for _, source := range request.Sources {
+		dataTable, err := b.repository.DataTableRepository.GetDataTable(ctx, source.TableId)
+		if err != nil {
+			logger.Error(err, "cannot get data table", "tableId", source.TableId)
+			return nil, err
+		}
+		if dataTable.AccountUuid.String() != accountUuid {
+			return nil, status.Error(codes.PermissionDenied, "No have permission with data table")
+		}
+		err = validateIdentitySource(dataTable, source)
+		if err != nil {
+			return nil, err
+		}
+		dagSources = append(dagSources, airflow.DagIdentityResolutionSource{
+			TableKey: utils.GenerateDeltaTablePath(accountUuid, dataTable.Name),
+			Source:   source,
+		})
+	}
+	for _, rule := range request.Rules {
+		if !isMatchRuleApplicable(rule, request.Sources) {
+			return nil, status.Errorf(codes.InvalidArgument, "no source has the columns required by rule %s", rule.Type)
+		}
+		if rule.Type == string(model.IdentityMatchRuleType_FuzzyNameAddress) && rule.Threshold == 0 {
+			rule.Threshold = model.IdentityResolution_DefaultFuzzyThreshold
+		}
+	}
+
+	jsonConfigurations, err := json.Marshal(model.IdentityResolutionConfiguration{
+		Sources: request.Sources,
+		Rules:   request.Rules,
+	})
+	if err != nil {
+		logger.Error(err, "cannot marshal configurations")
+		return nil, err
+	}
+
+	dagId := utils.GenerateDagId(accountUuid, model.ActionType_IdentityResolution)
+	var identityResolutionId int64
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Create output data table
+		outputTable, err := b.repository.DataTableRepository.CreateDataTable(ctx, &repository.CreateDataTableParams{
+			Tx:          tx,
+			Name:        request.OutputTableName,
+			AccountUuid: uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create output data table")
+			return err
+		}
+
+		// 2. Create identity resolution
+		identityResolution, err := b.repository.IdentityResolutionRepository.CreateIdentityResolution(ctx, &repository.CreateIdentityResolutionParams{
+			Tx:             tx,
+			Name:           request.Name,
+			Description:    request.Description,
+			Configurations: pqtype.NullRawMessage{RawMessage: jsonConfigurations, Valid: jsonConfigurations != nil},
+			OutputTableId:  outputTable.ID,
+			AccountUuid:    uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create identity resolution")
+			return err
+		}
+		identityResolutionId = identityResolution.ID
+
+		// 3. Call airflow
+		payload := airflow.TriggerGenerateDagIdentityResolutionRequest{Conf: airflow.DagIdentityResolutionConfig{
+			DagId:                  dagId,
+			IdentityResolutionId:   identityResolution.ID,
+			Sources:                dagSources,
+			Rules:                  request.Rules,
+			UnifiedProfileIdColumn: model.IdentityResolution_UnifiedProfileIdColumn,
+			GraphKey:               utils.GenerateDeltaIdentityGraphPath(accountUuid, identityResolution.ID),
+			HistoryKey:             utils.GenerateDeltaIdentityHistoryPath(accountUuid, identityResolution.ID),
+			OutputTableKey:         utils.GenerateDeltaTablePath(accountUuid, outputTable.Name),
+		}}
+		payloadJson, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		_, err = b.airflowAdapter.TriggerGenerateDagIdentityResolution(ctx, &payload)
+		if err != nil {
+			logger.Error(err, "cannot trigger dag generate identity resolution")
+			return err
+		}
+
+		// 4. Save data action
+		dataAction, err := b.repository.DataActionRepository.CreateDataAction(ctx, &repository.CreateDataActionParams{
+			Tx:          tx,
+			TargetTable: model.TargetTable_IdentityResolution,
+			ActionType:  model.ActionType_IdentityResolution,
+			Schedule:    "",
+			AccountUuid: uuid.MustParse(accountUuid),
+			DagId:       dagId,
+			Status:      model.DataActionStatus_Pending,
+			ObjectId:    identityResolution.ID,
+			Payload:     pqtype.NullRawMessage{RawMessage: payloadJson, Valid: payloadJson != nil},
+		})
+		if err != nil {
+			logger.Error(err, "cannot create data action")
+			return err
+		}
+
+		// 5. Create data action run
+		_, err = b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+			Tx:          tx,
+			ActionId:    dataAction.ID,
+			RunId:       0,
+			Status:      model.DataActionRunStatus_Creating,
+			AccountUuid: uuid.MustParse(accountUuid),
+		})
+		if err != nil {
+			logger.Error(err, "cannot create data action run")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateIdentityResolutionResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Id:      identityResolutionId,
+	}, nil
+}
+
+func validateIdentitySource(dataTable *model.DataTable, source *api.IdentitySource) error {
+	if !dataTable.Schema.Valid || dataTable.Schema.RawMessage == nil {
+		return nil
+	}
+	var schema []model.SchemaUnit
+	err := json.Unmarshal(dataTable.Schema.RawMessage, &schema)
+	if err != nil {
+		return err
+	}
+	columns := utils.Map(schema, func(unit model.SchemaUnit) string { return unit.ColumnName })
+
+	sourceColumns := append([]string{
+		source.RecordKeyColumn,
+		source.EmailColumn,
+		source.PhoneColumn,
+		source.CustomerIdColumn,
+		source.NameColumn,
+		source.AddressColumn,
+	}, source.AttributeColumns...)
+	for _, column := range sourceColumns {
+		if column != "" && !slices.Contains(columns, column) {
+			return status.Errorf(codes.InvalidArgument, "column %s does not exist in table %s", column, dataTable.Name)
+		}
+	}
+	return nil
+}
+
+func isMatchRuleApplicable(rule *api.IdentityMatchRule, sources []*api.IdentitySource) bool {
+	for _, source := range sources {
+		switch model.IdentityMatchRuleType(rule.Type) {
+		case model.IdentityMatchRuleType_ExactEmail:
+			if source.EmailColumn != "" {
+				return true
+			}
+		case model.IdentityMatchRuleType_NormalizedPhone:
+			if source.PhoneColumn != "" {
+				return true
+			}
+		case model.IdentityMatchRuleType_CustomerId:
+			if source.CustomerIdColumn != "" {
+				return true
+			}
+		case model.IdentityMatchRuleType_FuzzyNameAddress:
+			if source.NameColumn != "" && source.AddressColumn != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b business) GetListIdentityResolutions(ctx context.Context, request *api.GetListIdentityResolutionsRequest, accountUuid string) (*api.GetListIdentityResolutionsResponse, error) {
+	queryResult, err := b.repository.IdentityResolutionRepository.ListIdentityResolutions(ctx, &repository.ListIdentityResolutionsParams{
+		AccountUuid: uuid.MustParse(accountUuid),
+		Page:        int(request.Page),
+		PageSize:    int(request.PageSize),
+	})
+	if err != nil {
+		b.log.WithName("GetListIdentityResolutions").Error(err, "cannot get list identity resolutions")
+		return nil, err
+	}
+
+	tableIds := utils.Map(queryResult.IdentityResolutions, func(identityResolution model.IdentityResolution) int64 {
+		return identityResolution.OutputTableId
+	})
+	tableNames := make(map[int64]string, len(tableIds))
+	if len(tableIds) > 0 {
+		queryTables, err := b.repository.DataTableRepository.ListDataTables(ctx, &repository.ListDataTablesFilters{DataTableIds: tableIds})
+		if err != nil {
+			b.log.WithName("GetListIdentityResolutions").Error(err, "cannot get output data tables")
+			return nil, err
+		}
+		for _, dataTable := range queryTables.DataTables {
+			tableNames[dataTable.ID] = dataTable.Name
+		}
+	}
+
+	return &api.GetListIdentityResolutionsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Count:   queryResult.Count,
+		Results: utils.Map(queryResult.IdentityResolutions, func(identityResolution model.IdentityResolution) *api.IdentityResolution {
+			return toApiIdentityResolution(&identityResolution, tableNames[identityResolution.OutputTableId])
+		}),
+	}, nil
+}
+
+func (b business) GetIdentityResolutionDetail(ctx context.Context, request *api.GetIdentityResolutionDetailRequest, accountUuid string) (*api.GetIdentityResolutionDetailResponse, error) {
+	logger := b.log.WithName("GetIdentityResolutionDetail").WithValues("request", request)
+
+	identityResolution, err := b.getOwnedIdentityResolution(ctx, request.Id, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	var configurations model.IdentityResolutionConfiguration
+	if identityResolution.Configurations.Valid {
+		err = json.Unmarshal(identityResolution.Configurations.RawMessage, &configurations)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal configurations")
+			return nil, err
+		}
+	}
+	outputTable, err := b.repository.DataTableRepository.GetDataTable(ctx, identityResolution.OutputTableId)
+	if err != nil {
+		logger.Error(err, "cannot get output data table")
+		return nil, err
+	}
+
+	return &api.GetIdentityResolutionDetailResponse{
+		Code:               int32(code.Code_OK),
+		Message:            "Success",
+		IdentityResolution: toApiIdentityResolution(identityResolution, outputTable.Name),
+		Sources:            configurations.Sources,
+		Rules:              configurations.Rules,
+	}, nil
+}
+
+type identityProfileEventRecord struct {
+	UnifiedProfileId  string   `json:"unified_profile_id"`
+	EventType         string   `json:"event_type"`
+	RelatedProfileIds []string `json:"related_profile_ids"`
+	DagRunId          string   `json:"dag_run_id"`
+	CreatedAt         string   `json:"created_at"`
+}
+
+func (b business) GetIdentityProfileHistory(ctx context.Context, request *api.GetIdentityProfileHistoryRequest, accountUuid string) (*api.GetIdentityProfileHistoryResponse, error) {
+	logger := b.log.WithName("GetIdentityProfileHistory").WithValues("request", request)
+
+	identityResolution, err := b.getOwnedIdentityResolution(ctx, request.Id, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := ""
+	if request.UnifiedProfileId != "" {
+		if _, err = uuid.Parse(request.UnifiedProfileId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid unified_profile_id")
+		}
+		condition = fmt.Sprintf(" WHERE unified_profile_id = '%s' OR array_contains(related_profile_ids, '%s')", request.UnifiedProfileId, request.UnifiedProfileId)
+	}
+
+	page, pageSize := request.Page, request.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 100
+	}
+	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaIdentityHistoryPath(accountUuid, identityResolution.ID))
+	countResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT COUNT(*) AS count FROM delta.`%s`%s", path, condition),
+	})
+	if err != nil {
+		logger.Error(err, "cannot count identity history")
+		return nil, err
+	}
+	var count int64
+	if len(countResponse.Data) > 0 {
+		if value, ok := countResponse.Data[0]["count"].(float64); ok {
+			count = int64(value)
+		}
+	}
+	queryResponse, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{
+		Query: fmt.Sprintf("SELECT * FROM delta.`%s`%s ORDER BY created_at DESC LIMIT %d OFFSET %d", path, condition, pageSize, (page-1)*pageSize),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query identity history")
+		return nil, err
+	}
+
+	events := make([]*api.IdentityProfileEvent, 0, len(queryResponse.Data))
+	for _, data := range queryResponse.Data {
+		var record identityProfileEventRecord
+		err = json.Unmarshal([]byte(data), &record)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal identity history record")
+			return nil, err
+		}
+		events = append(events, &api.IdentityProfileEvent{
+			UnifiedProfileId:  record.UnifiedProfileId,
+			EventType:         record.EventType,
+			RelatedProfileIds: record.RelatedProfileIds,
+			DagRunId:          record.DagRunId,
+			CreatedAt:         record.CreatedAt,
+		})
+	}
+
+	return &api.GetIdentityProfileHistoryResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Count:   count,
+		Results: events,
+	}, nil
+}
+
+func (b business) SyncOnIdentityResolution(ctx context.Context, identityResolutionId int64, actionStatus model.DataActionStatus) error {
+	logger := b.log.WithName("SyncOnIdentityResolution").WithValues("identityResolutionId", identityResolutionId)
+
+	if actionStatus == model.DataActionStatus_Failed {
+		err := b.repository.IdentityResolutionRepository.UpdateIdentityResolution(ctx, &repository.UpdateIdentityResolutionParams{
+			Id:     identityResolutionId,
+			Status: model.IdentityResolutionStatus_FAILED,
+		})
+		if err != nil {
+			logger.Error(err, "cannot update identity resolution status")
+			return err
+		}
+		return nil
+	} else if actionStatus != model.DataActionStatus_Success {
+		return nil
+	}
+
+	identityResolution, err := b.repository.IdentityResolutionRepository.GetIdentityResolution(ctx, identityResolutionId)
+	if err != nil {
+		logger.Error(err, "cannot get identity resolution")
+		return err
+	}
+	path, err := b.repository.DataTableRepository.GetDataTableDeltaPath(ctx, identityResolution.OutputTableId)
+	if err != nil {
+		logger.Error(err, "cannot get output table delta path")
+		return err
+	}
+	response, err := b.queryAdapter.GetSchemaTable(ctx, &query.GetSchemaDataTableRequest{TablePath: path})
+	if err != nil {
+		logger.Error(err, "cannot query output table schema")
+		return err
+	}
+	jsonSchema, err := json.Marshal(utils.Map(response.Schema, func(unit query.FieldSchema) model.SchemaUnit {
+		return model.SchemaUnit{
+			ColumnName: unit.Name,
+			DataType:   unit.Type,
+		}
+	}))
+	if err != nil {
+		logger.Error(err, "cannot marshal output table schema")
+		return err
+	}
+
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		_, err := b.repository.DataTableRepository.UpdateDataTable(ctx, &repository.UpdateDataTableParams{
+			Tx:     tx,
+			ID:     identityResolution.OutputTableId,
+			Schema: pqtype.NullRawMessage{RawMessage: jsonSchema, Valid: true},
+			Status: model.DataTableStatus_UP_TO_DATE,
+		})
+		if err != nil {
+			return err
+		}
+		return b.repository.IdentityResolutionRepository.UpdateIdentityResolution(ctx, &repository.UpdateIdentityResolutionParams{
+			Tx:     tx,
+			Id:     identityResolutionId,
+			Status: model.IdentityResolutionStatus_UP_TO_DATE,
+		})
+	})
+	if err != nil {
+		logger.Error(err, "cannot update identity resolution and output table")
+		return err
+	}
+
+	return nil
+}
+
+func (b business) getOwnedIdentityResolution(ctx context.Context, id int64, accountUuid string) (*model.IdentityResolution, error) {
+	identityResolution, err := b.repository.IdentityResolutionRepository.GetIdentityResolution(ctx, id)
+	if err != nil {
+		b.log.WithName("getOwnedIdentityResolution").Error(err, "cannot get identity resolution", "id", id)
+		return nil, err
+	}
+	if identityResolution.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with identity resolution")
+	}
+	return identityResolution, nil
+}
+
+func toApiIdentityResolution(identityResolution *model.IdentityResolution, outputTableName string) *api.IdentityResolution {
+	return &api.IdentityResolution{
+		Id:              identityResolution.ID,
+		Name:            identityResolution.Name,
+		Description:     identityResolution.Description,
+		Status:          string(identityResolution.Status),
+		OutputTableId:   identityResolution.OutputTableId,
+		OutputTableName: outputTableName,
+		CreatedAt:       utils.ToTimeString(identityResolution.CreatedAt),
+		UpdatedAt:       utils.ToTimeString(identityResolution.UpdatedAt),
+	}
+}
@@ -0,0 +1,67 @@
+package identity_resolution
+
+import (
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsMatchRuleApplicable(t *testing.T) {
+	crm := &api.IdentitySource{TableId: 1, RecordKeyColumn: "id", EmailColumn: "email", NameColumn: "name"}
+	pos := &api.IdentitySource{TableId: 2, RecordKeyColumn: "id", PhoneColumn: "phone", AddressColumn: "address"}
+	rule := func(ruleType model.IdentityMatchRuleType) *api.IdentityMatchRule {
+		return &api.IdentityMatchRule{Type: string(ruleType)}
+	}
+
+	// a rule applies when one source has its columns
+	if !isMatchRuleApplicable(rule(model.IdentityMatchRuleType_ExactEmail), []*api.IdentitySource{pos, crm}) {
+		t.Error("exact_email does not apply to a source with an email column")
+	}
+	if !isMatchRuleApplicable(rule(model.IdentityMatchRuleType_NormalizedPhone), []*api.IdentitySource{crm, pos}) {
+		t.Error("normalized_phone does not apply to a source with a phone column")
+	}
+	if isMatchRuleApplicable(rule(model.IdentityMatchRuleType_CustomerId), []*api.IdentitySource{crm, pos}) {
+		t.Error("customer_id applies to sources without a customer id column")
+	}
+	// name and address must come from the same source, one source with each is not enough
+	if isMatchRuleApplicable(rule(model.IdentityMatchRuleType_FuzzyNameAddress), []*api.IdentitySource{crm, pos}) {
+		t.Error("fuzzy_name_address applies to sources with the name and the address in different tables")
+	}
+	crm.AddressColumn = "address"
+	if !isMatchRuleApplicable(rule(model.IdentityMatchRuleType_FuzzyNameAddress), []*api.IdentitySource{crm, pos}) {
+		t.Error("fuzzy_name_address does not apply to a source with a name and an address")
+	}
+	if isMatchRuleApplicable(rule("ssn"), []*api.IdentitySource{crm, pos}) {
+		t.Error("an unknown rule applies")
+	}
+}
+
+func TestValidateIdentitySource(t *testing.T) {
+	dataTable := &model.DataTable{
+		Name:   "crm_customers",
+		Schema: pqtype.NullRawMessage{RawMessage: []byte(`[{"column_name":"id"},{"column_name":"email"},{"column_name":"tier"}]`), Valid: true},
+	}
+
+	err := validateIdentitySource(dataTable, &api.IdentitySource{RecordKeyColumn: "id", EmailColumn: "email", AttributeColumns: []string{"tier"}})
+	if err != nil {
+		t.Errorf("source of existing columns: %v", err)
+	}
+	err = validateIdentitySource(dataTable, &api.IdentitySource{RecordKeyColumn: "id", EmailColumn: "email", AttributeColumns: []string{"tier", "segment"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("attribute column missing from the table: got %v, want InvalidArgument", err)
+	}
+	err = validateIdentitySource(dataTable, &api.IdentitySource{RecordKeyColumn: "customer_id"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("record key missing from the table: got %v, want InvalidArgument", err)
+	}
+
+	// the columns of a table not imported yet are not known
+	err = validateIdentitySource(&model.DataTable{Name: "draft"}, &api.IdentitySource{RecordKeyColumn: "anything"})
+	if err != nil {
+		t.Errorf("table without schema: %v", err)
+	}
+}
@@ -24,11 +24,39 @@ type Business interface {
 	ProcessGetResultPredictionActions(ctx context.Context, request *api.GetResultPredictionActionsRequest, accountUuid string) (*api.GetResultPredictionActionsResponse, error)
 
 	GetMasterSegmentDetail(ctx context.Context, request *api.GetMasterSegmentDetailRequest, accountUuid string) (*api.MasterSegmentDetail, error)
-	ListMasterSegmentProfiles(ctx context.Context, request *api.GetListMasterSegmentProfilesRequest, accountUuid string) (int64, []string, error)
+	ListMasterSegmentProfiles(ctx context.Context, request *api.GetListMasterSegmentProfilesRequest, accountUuid string) (*MasterSegmentProfileRecords, error)
 	GetMasterSegmentProfile(ctx context.Context, request *api.GetMasterSegmentProfileRequest, accountUuid string) (string, error)
 	GetBehaviorProfile(ctx context.Context, request *api.GetBehaviorProfileRequest, accountUuid string) (*BehaviorProfileRecords, error)
 	TotalProfilesMasterSegment(ctx context.Context, request *api.TotalProfilesMasterSegmentRequest, accountUuid string) (int64, error)
 	SyncOnCreateMasterSegment(ctx context.Context, masterSegmentId int64, actionStatus model.DataActionStatus) error
+	ProfileMasterSegment(ctx context.Context, request *api.ProfileMasterSegmentRequest, accountUuid string) (*api.ProfileMasterSegmentResponse, error)
+	GetMasterSegmentStatistics(ctx context.Context, request *api.GetMasterSegmentStatisticsRequest, accountUuid string) (*api.GetMasterSegmentStatisticsResponse, error)
+	UpdateMasterSegmentComputedAttributes(ctx context.Context, request *api.UpdateMasterSegmentComputedAttributesRequest, accountUuid string) (*api.UpdateMasterSegmentComputedAttributesResponse, error)
+	RefreshMasterSegment(ctx context.Context, request *api.RefreshMasterSegmentRequest, accountUuid string) (*api.RefreshMasterSegmentResponse, error)
+	GetCustomerProfile(ctx context.Context, request *api.GetCustomerProfileRequest, accountUuid string) (*api.GetCustomerProfileResponse, error)
+	UpdateMasterSegmentSearchableColumns(ctx context.Context, request *api.UpdateMasterSegmentSearchableColumnsRequest, accountUuid string) (*api.UpdateMasterSegmentSearchableColumnsResponse, error)
+	UpdateMasterSegmentConsentColumns(ctx context.Context, request *api.UpdateMasterSegmentConsentColumnsRequest, accountUuid string) (*api.UpdateMasterSegmentConsentColumnsResponse, error)
+	SearchProfiles(ctx context.Context, request *api.SearchProfilesRequest, accountUuid string) (*api.SearchProfilesResponse, error)
+	ErasePerson(ctx context.Context, request *api.ErasePersonRequest, accountUuid string) (*api.ErasePersonResponse, error)
+	GetListErasureCertificates(ctx context.Context, request *api.GetListErasureCertificatesRequest, accountUuid string) (*api.GetListErasureCertificatesResponse, error)
+	GetErasureCertificate(ctx context.Context, request *api.GetErasureCertificateRequest, accountUuid string) (*api.GetErasureCertificateResponse, error)
+	GetListSegmentSnapshots(ctx context.Context, request *api.GetListSegmentSnapshotsRequest, accountUuid string) (*api.GetListSegmentSnapshotsResponse, error)
+	GetSegmentMembershipChanges(ctx context.Context, request *api.GetSegmentMembershipChangesRequest, accountUuid string) (*api.GetSegmentMembershipChangesResponse, error)
+	GetSegmentSizeHistory(ctx context.Context, request *api.GetSegmentSizeHistoryRequest, accountUuid string) (*api.GetSegmentSizeHistoryResponse, error)
+	AnalyzeSegmentOverlap(ctx context.Context, request *api.AnalyzeSegmentOverlapRequest, accountUuid string) (*api.AnalyzeSegmentOverlapResponse, error)
+	GetSegmentInsights(ctx context.Context, request *api.GetSegmentInsightsRequest, accountUuid string) (*api.GetSegmentInsightsResponse, error)
+	CreateRFMAnalysis(ctx context.Context, request *api.CreateRFMAnalysisRequest, accountUuid string) (*api.CreateRFMAnalysisResponse, error)
+	GetListRFMAnalyses(ctx context.Context, request *api.GetListRFMAnalysesRequest, accountUuid string) (*api.GetListRFMAnalysesResponse, error)
+	GetCohortRetention(ctx context.Context, request *api.GetCohortRetentionRequest, accountUuid string) (*api.GetCohortRetentionResponse, error)
+	CreateFunnel(ctx context.Context, request *api.CreateFunnelRequest, accountUuid string) (*api.CreateFunnelResponse, error)
+	GetListFunnels(ctx context.Context, request *api.GetListFunnelsRequest, accountUuid string) (*api.GetListFunnelsResponse, error)
+	DeleteFunnel(ctx context.Context, request *api.DeleteFunnelRequest, accountUuid string) (*api.DeleteFunnelResponse, error)
+	ComputeFunnel(ctx context.Context, request *api.ComputeFunnelRequest, accountUuid string) (*api.ComputeFunnelResponse, error)
+	CreateFunnelDropOffSegment(ctx context.Context, request *api.CreateFunnelDropOffSegmentRequest, accountUuid string) (*api.CreateFunnelDropOffSegmentResponse, error)
+	CreateSegmentSplit(ctx context.Context, request *api.CreateSegmentSplitRequest, accountUuid string) (*api.CreateSegmentSplitResponse, error)
+	GetListSegmentSplits(ctx context.Context, request *api.GetListSegmentSplitsRequest, accountUuid string) (*api.GetListSegmentSplitsResponse, error)
+	CompareSegmentSplitArms(ctx context.Context, request *api.CompareSegmentSplitArmsRequest, accountUuid string) (*api.CompareSegmentSplitArmsResponse, error)
+	SyncOnBuildSegment(ctx context.Context, dataActionId int64) error
 }
 
 type business struct {
@@ -0,0 +1,200 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const cohortRetention_DefaultPeriods = 12
+
+// GetCohortRetention groups the profiles by the period of their first event in the behavior table and counts
+// the profiles of each cohort returning in each period after it
+func (b business) GetCohortRetention(ctx context.Context, request *api.GetCohortRetentionRequest, accountUuid string) (*api.GetCohortRetentionResponse, error) {
+	logger := b.log.WithName("GetCohortRetention").WithValues("request", request)
+
+	masterSegment, _, configuration, err := b.getRefreshableMasterSegment(ctx, request.MasterSegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	behaviorTable, err := b.repository.SegmentRepository.GetBehaviorTable(ctx, &repository.GetBehaviorTableParams{Id: request.BehaviorTableId})
+	if err != nil {
+		logger.Error(err, "cannot get behavior table")
+		return nil, err
+	}
+	if behaviorTable.ID == 0 || behaviorTable.MasterSegmentId != masterSegment.ID {
+		return nil, status.Error(codes.NotFound, "Behavior table not found in master segment")
+	}
+	behaviorSchema, err := data_table.ParseSchema(behaviorTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse behavior schema")
+		return nil, err
+	}
+	timeColumn := request.TimeColumn
+	if timeColumn == "" {
+		timeColumn = data_table.TimeColumn(behaviorSchema)
+		if timeColumn == "" {
+			return nil, status.Error(codes.InvalidArgument, "behavior table has no date or timestamp column, time_column is required")
+		}
+	}
+	timeType, _ := data_table.SchemaColumnType(behaviorSchema, timeColumn)
+	if timeType != string(model.ColumnDataType_Date) && timeType != string(model.ColumnDataType_Timestamp) {
+		return nil, status.Errorf(codes.InvalidArgument, "time column %s must be a date or timestamp column of the behavior table", timeColumn)
+	}
+	interval := request.Interval
+	if interval == "" {
+		interval = "week"
+	}
+	periods := int(request.Periods)
+	if periods == 0 {
+		periods = cohortRetention_DefaultPeriods
+	}
+
+	foreignKey := utils.QuoteSparkIdentifier(behaviorTable.ForeignKey)
+	conditions := []string{fmt.Sprintf("%s IS NOT NULL", foreignKey), fmt.Sprintf("%s IS NOT NULL", utils.QuoteSparkIdentifier(timeColumn))}
+	eventFilter, err := data_table.RuleCondition(behaviorSchema, request.EventFilter)
+	if err != nil {
+		return nil, err
+	}
+	if eventFilter != "" {
+		conditions = append(conditions, eventFilter)
+	}
+	if request.SegmentId != 0 {
+		segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+		if err != nil {
+			logger.Error(err, "cannot get segment")
+			return nil, err
+		}
+		if segment.MasterSegmentId != masterSegment.ID {
+			return nil, status.Error(codes.InvalidArgument, "segment does not belong to the master segment")
+		}
+		if segment.Status != model.SegmentStatus_UP_TO_DATE {
+			return nil, status.Error(codes.FailedPrecondition, "Segment is not up to date")
+		}
+		joinColumn := behaviorJoinColumn(configuration, behaviorTable.JoinKey)
+		if joinColumn == "" {
+			return nil, status.Error(codes.FailedPrecondition, "the join key of the behavior table is not a column of the audience")
+		}
+		conditions = append(conditions, fmt.Sprintf("CAST(%s AS STRING) IN (SELECT CAST(%s AS STRING) FROM delta.`s3a://%s/%s`)",
+			foreignKey, utils.QuoteSparkIdentifier(joinColumn), b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(segment.MasterSegmentId, segment.ID)))
+	}
+
+	cohortConditions := make([]string, 0, 2)
+	if request.From != "" {
+		cohortConditions = append(cohortConditions, fmt.Sprintf("cohorts.cohort >= DATE_TRUNC('%s', TIMESTAMP %s)", interval, utils.QuoteSparkString(request.From)))
+	}
+	if request.To != "" {
+		cohortConditions = append(cohortConditions, fmt.Sprintf("cohorts.cohort <= TIMESTAMP %s", utils.QuoteSparkString(request.To)))
+	}
+	cohortFilter := ""
+	if len(cohortConditions) > 0 {
+		cohortFilter = " AND " + strings.Join(cohortConditions, " AND ")
+	}
+
+	events := fmt.Sprintf("SELECT DISTINCT CAST(%s AS STRING) AS customer_key, DATE_TRUNC('%s', CAST(%s AS TIMESTAMP)) AS period FROM delta.`s3a://%s/%s` WHERE %s",
+		foreignKey, interval, utils.QuoteSparkIdentifier(timeColumn), b.config.S3StorageConfig.Bucket,
+		utils.GenerateDeltaBehaviorPath(masterSegment.ID, behaviorTable.Name), strings.Join(conditions, " AND "))
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT date_format(cohort, 'yyyy-MM-dd') AS cohort, period_index, "+
+			"date_format(DATE_TRUNC('%s', current_timestamp()), 'yyyy-MM-dd') AS current_period, COUNT(*) AS count FROM ("+
+			"SELECT cohorts.cohort, %s AS period_index FROM (%s) AS events "+
+			"JOIN (SELECT customer_key, MIN(period) AS cohort FROM (%s) AS first_events GROUP BY customer_key) AS cohorts "+
+			"ON events.customer_key = cohorts.customer_key WHERE 1 = 1%s) AS retained "+
+			"WHERE period_index <= %d GROUP BY 1, 2, 3",
+			interval, cohortPeriodIndex(interval, "cohorts.cohort", "events.period"), events, events, cohortFilter, periods),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query cohort retention")
+		return nil, err
+	}
+
+	cohortsByPeriod := make(map[string]*api.Cohort)
+	for _, row := range queryResponse.Data {
+		period, _ := row["cohort"].(string)
+		periodIndex, _ := row["period_index"].(float64)
+		count, _ := row["count"].(float64)
+		cohort, ok := cohortsByPeriod[period]
+		if !ok {
+			start, err := time.Parse(time.DateOnly, period)
+			if err != nil {
+				logger.Error(err, "cannot parse cohort period", "period", period)
+				return nil, err
+			}
+			// the current period comes from spark so that both are truncated in the same time zone
+			currentPeriod, err := time.Parse(time.DateOnly, fmt.Sprint(row["current_period"]))
+			if err != nil {
+				logger.Error(err, "cannot parse current period", "period", row["current_period"])
+				return nil, err
+			}
+			// the periods not started yet are left out of the matrix, as well as the cohorts of future dated events
+			elapsed := periodsBetween(start, currentPeriod, interval)
+			if elapsed < 0 {
+				continue
+			}
+			if elapsed > periods {
+				elapsed = periods
+			}
+			cohort = &api.Cohort{Period: period, Returning: make([]int64, elapsed+1)}
+			cohortsByPeriod[period] = cohort
+		}
+		if int(periodIndex) < len(cohort.Returning) {
+			cohort.Returning[int(periodIndex)] = int64(count)
+		}
+	}
+	cohorts := make([]*api.Cohort, 0, len(cohortsByPeriod))
+	for _, cohort := range cohortsByPeriod {
+		cohort.Size = cohort.Returning[0]
+		cohort.Retention = utils.Map(cohort.Returning, func(returning int64) float64 {
+			if cohort.Size == 0 {
+				return 0
+			}
+			return float64(returning) / float64(cohort.Size)
+		})
+		cohorts = append(cohorts, cohort)
+	}
+	sort.Slice(cohorts, func(i, j int) bool {
+		return cohorts[i].Period < cohorts[j].Period
+	})
+
+	return &api.GetCohortRetentionResponse{
+		Code:     int32(code.Code_OK),
+		Message:  "Success",
+		Interval: interval,
+		Cohorts:  cohorts,
+	}, nil
+}
+
+// cohortPeriodIndex is the number of intervals from the cohort period to the event period, both truncated to the interval
+func cohortPeriodIndex(interval string, cohort string, period string) string {
+	switch interval {
+	case "week":
+		return fmt.Sprintf("CAST(DATEDIFF(%s, %s) / 7 AS INT)", period, cohort)
+	case "month":
+		return fmt.Sprintf("CAST(MONTHS_BETWEEN(%s, %s) AS INT)", period, cohort)
+	}
+	return fmt.Sprintf("DATEDIFF(%s, %s)", period, cohort)
+}
+
+// periodsBetween is the Go side of cohortPeriodIndex
+func periodsBetween(from time.Time, to time.Time, interval string) int {
+	switch interval {
+	case "week":
+		return int(to.Sub(from).Hours()) / (24 * 7)
+	case "month":
+		return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	}
+	return int(to.Sub(from).Hours()) / 24
+}
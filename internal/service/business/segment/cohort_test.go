@@ -0,0 +1,42 @@
+package segment
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodsBetween(t *testing.T) {
+	date := func(value string) time.Time {
+		parsed, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		interval string
+		want     int
+	}{
+		{name: "same day", from: "2024-03-10", to: "2024-03-10", interval: "day", want: 0},
+		{name: "days", from: "2024-02-27", to: "2024-03-02", interval: "day", want: 4},
+		{name: "days across a year", from: "2023-12-31", to: "2024-01-01", interval: "day", want: 1},
+		{name: "weeks", from: "2024-03-04", to: "2024-03-25", interval: "week", want: 3},
+		{name: "part of a week", from: "2024-03-04", to: "2024-03-10", interval: "week", want: 0},
+		{name: "months", from: "2024-01-01", to: "2024-04-01", interval: "month", want: 3},
+		{name: "months across a year", from: "2023-11-01", to: "2024-02-01", interval: "month", want: 3},
+		{name: "future day", from: "2024-03-12", to: "2024-03-10", interval: "day", want: -2},
+		{name: "future week", from: "2024-03-11", to: "2024-03-04", interval: "week", want: -1},
+		{name: "future month", from: "2024-02-01", to: "2024-01-01", interval: "month", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := periodsBetween(date(tt.from), date(tt.to), tt.interval); got != tt.want {
+				t.Errorf("periodsBetween(%s, %s, %s) = %d, want %d", tt.from, tt.to, tt.interval, got, tt.want)
+			}
+		})
+	}
+}
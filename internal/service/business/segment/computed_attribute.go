@@ -0,0 +1,350 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type computedAttributeSource struct {
+	DataTableId int64
+	ForeignKey  string
+	JoinKey     string
+}
+
+func (b business) UpdateMasterSegmentComputedAttributes(ctx context.Context, request *api.UpdateMasterSegmentComputedAttributesRequest, accountUuid string) (*api.UpdateMasterSegmentComputedAttributesResponse, error) {
+	logger := b.log.WithName("UpdateMasterSegmentComputedAttributes").WithValues("request", request)
+
+	masterSegment, audienceTable, configuration, err := b.getRefreshableMasterSegment(ctx, request.Id, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	configuration.ComputedAttributes = request.ComputedAttributes
+
+	err = b.refreshMasterSegment(ctx, masterSegment, audienceTable, configuration)
+	if err != nil {
+		logger.Error(err, "cannot refresh master segment")
+		return nil, err
+	}
+
+	return &api.UpdateMasterSegmentComputedAttributesResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) RefreshMasterSegment(ctx context.Context, request *api.RefreshMasterSegmentRequest, accountUuid string) (*api.RefreshMasterSegmentResponse, error) {
+	logger := b.log.WithName("RefreshMasterSegment").WithValues("request", request)
+
+	masterSegment, audienceTable, configuration, err := b.getRefreshableMasterSegment(ctx, request.Id, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+
+	err = b.refreshMasterSegment(ctx, masterSegment, audienceTable, configuration)
+	if err != nil {
+		logger.Error(err, "cannot refresh master segment")
+		return nil, err
+	}
+
+	return &api.RefreshMasterSegmentResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) getRefreshableMasterSegment(ctx context.Context, masterSegmentId int64, accountUuid string) (*model.MasterSegment, *model.AudienceTable, *repository.AudienceBuildConfiguration, error) {
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, masterSegmentId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, nil, nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	if masterSegment.Status == model.MasterSegmentStatus_DRAFT || masterSegment.Status == model.MasterSegmentStatus_UPDATING {
+		return nil, nil, nil, status.Error(codes.FailedPrecondition, "Master segment is being built")
+	}
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegmentId})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return &masterSegment, &audienceTable, &configuration, nil
+}
+
+// refreshMasterSegment regenerates the dag of the master segment with the computed attributes of configuration and
+// runs it again. The payload of the last build keeps the main, attribute and behavior tables.
+func (b business) refreshMasterSegment(ctx context.Context, masterSegment *model.MasterSegment, audienceTable *model.AudienceTable, configuration *repository.AudienceBuildConfiguration) error {
+	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		return err
+	}
+	sources := make(map[string]computedAttributeSource, len(behaviorTables))
+	for _, behaviorTable := range behaviorTables {
+		sources[behaviorTable.Name] = computedAttributeSource{
+			DataTableId: behaviorTable.DataTableId,
+			ForeignKey:  behaviorTable.ForeignKey,
+			JoinKey:     behaviorTable.JoinKey,
+		}
+	}
+	audienceColumns, err := b.audienceColumnNames(ctx, configuration)
+	if err != nil {
+		return err
+	}
+	computedAttributes, err := b.buildComputedAttributes(ctx, masterSegment.AccountUuid.String(), configuration.ComputedAttributes, sources, audienceColumns)
+	if err != nil {
+		return err
+	}
+	jsonComputedAttributes, err := json.Marshal(computedAttributes)
+	if err != nil {
+		return err
+	}
+
+	dataActions, err := b.repository.DataActionRepository.GetListDataActions(ctx, &repository.GetListDataActionsParams{
+		ActionTypes: []string{string(model.ActionType_CreateMasterSegment)},
+		TargetTable: model.TargetTable_MasterSegment,
+		ObjectId:    masterSegment.ID,
+		Page:        1,
+		PageSize:    1,
+	})
+	if err != nil {
+		return err
+	}
+	if len(dataActions.DataActions) == 0 {
+		return status.Error(codes.FailedPrecondition, "Master segment has never been built")
+	}
+	var payload airflow.TriggerGenerateDagCreateMasterSegmentRequest
+	err = json.Unmarshal(dataActions.DataActions[0].Payload.RawMessage, &payload)
+	if err != nil {
+		return err
+	}
+	payload.Config.ComputedAttributes = string(jsonComputedAttributes)
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	jsonConfiguration, err := json.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Save build configuration
+		err := b.repository.SegmentRepository.UpdateAudienceTable(ctx, &repository.UpdateAudienceTableParams{
+			Tx:                 tx,
+			Id:                 audienceTable.ID,
+			BuildConfiguration: pqtype.NullRawMessage{RawMessage: jsonConfiguration, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		err = b.repository.SegmentRepository.UpdateMasterSegment(ctx, &repository.UpdateMasterSegmentParams{
+			Tx:     tx,
+			Id:     masterSegment.ID,
+			Status: model.MasterSegmentStatus_UPDATING,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 2. Call airflow
+		err = b.airflowAdapter.TriggerGenerateDagCreateMasterSegment(ctx, &payload)
+		if err != nil {
+			return err
+		}
+
+		// 3. Save data action
+		dataAction, err := b.repository.DataActionRepository.CreateDataAction(ctx, &repository.CreateDataActionParams{
+			Tx:          tx,
+			TargetTable: model.TargetTable_MasterSegment,
+			ActionType:  model.ActionType_CreateMasterSegment,
+			Schedule:    "",
+			AccountUuid: masterSegment.AccountUuid,
+			DagId:       payload.Config.DagId,
+			Status:      model.DataActionStatus_Pending,
+			ObjectId:    masterSegment.ID,
+			Payload:     pqtype.NullRawMessage{RawMessage: payloadJson, Valid: payloadJson != nil},
+		})
+		if err != nil {
+			return err
+		}
+
+		// 4. Create data action run
+		_, err = b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+			Tx:          tx,
+			ActionId:    dataAction.ID,
+			RunId:       0,
+			Status:      model.DataActionRunStatus_Creating,
+			AccountUuid: masterSegment.AccountUuid,
+		})
+		return err
+	})
+}
+
+// audienceColumnNames lists the columns copied to the audience from the main and attribute tables
+func (b business) audienceColumnNames(ctx context.Context, configuration *repository.AudienceBuildConfiguration) ([]string, error) {
+	names := []string{model.AudienceColumn_CdpSystemUuid}
+	if len(configuration.SelectedColumns) == 0 {
+		mainTable, err := b.repository.DataTableRepository.GetDataTable(ctx, configuration.MainTableId)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := data_table.ParseSchema(mainTable.Schema)
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range schema {
+			names = append(names, column.ColumnName)
+		}
+	}
+	for _, column := range configuration.SelectedColumns {
+		names = append(names, column.NewTableColumnName)
+	}
+	for _, attributeTable := range configuration.AttributeTables {
+		for _, column := range attributeTable.SelectedColumns {
+			names = append(names, column.NewTableColumnName)
+		}
+	}
+	return names, nil
+}
+
+// buildComputedAttributes checks the computed attributes against the raw tables of their behavior tables and
+// translates them into the aggregations run by the master segment dag
+func (b business) buildComputedAttributes(ctx context.Context, accountUuid string, attributes []*api.ComputedAttribute, sources map[string]computedAttributeSource, audienceColumns []string) ([]airflow.CreateMasterSegmentConfig_ComputedAttribute, error) {
+	computedAttributes := make([]airflow.CreateMasterSegmentConfig_ComputedAttribute, 0, len(attributes))
+	names := slices.Clone(audienceColumns)
+	dataTables := make(map[int64]*model.DataTable)
+	for _, attribute := range attributes {
+		if slices.Contains(names, attribute.Name) {
+			return nil, status.Errorf(codes.InvalidArgument, "column %s already exists in the audience", attribute.Name)
+		}
+		names = append(names, attribute.Name)
+
+		source, ok := sources[attribute.BehaviorTable]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "behavior table %s does not exist", attribute.BehaviorTable)
+		}
+		dataTable, ok := dataTables[source.DataTableId]
+		if !ok {
+			var err error
+			dataTable, err = b.repository.DataTableRepository.GetDataTable(ctx, source.DataTableId)
+			if err != nil {
+				return nil, err
+			}
+			if dataTable.AccountUuid.String() != accountUuid {
+				return nil, status.Error(codes.PermissionDenied, "user not have access to one behavior table")
+			}
+			dataTables[source.DataTableId] = dataTable
+		}
+		schema, err := data_table.ParseSchema(dataTable.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		expression, err := computedAttributeExpression(attribute, schema)
+		if err != nil {
+			return nil, err
+		}
+		condition, err := computedAttributeCondition(attribute, schema)
+		if err != nil {
+			return nil, err
+		}
+		computedAttributes = append(computedAttributes, airflow.CreateMasterSegmentConfig_ComputedAttribute{
+			AudienceColumnName: attribute.Name,
+			TableName:          dataTable.Name,
+			JoinKey:            source.JoinKey,
+			ForeignKey:         source.ForeignKey,
+			Expression:         expression,
+			Condition:          condition,
+		})
+	}
+	return computedAttributes, nil
+}
+
+func computedAttributeExpression(attribute *api.ComputedAttribute, schema []model.SchemaUnit) (string, error) {
+	aggregation := model.ComputedAttributeAggregation(attribute.Aggregation)
+	if aggregation == model.ComputedAttributeAggregation_Count && attribute.Column == "" {
+		return "COUNT(*)", nil
+	}
+	dataType, ok := data_table.SchemaColumnType(schema, attribute.Column)
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "column %s does not exist in behavior table %s", attribute.Column, attribute.BehaviorTable)
+	}
+	column := utils.QuoteSparkIdentifier(attribute.Column)
+
+	switch aggregation {
+	case model.ComputedAttributeAggregation_Count:
+		return fmt.Sprintf("COUNT(%s)", column), nil
+	case model.ComputedAttributeAggregation_Sum, model.ComputedAttributeAggregation_Avg:
+		if dataType != string(model.ColumnDataType_Int) && dataType != string(model.ColumnDataType_Float) {
+			return "", status.Errorf(codes.InvalidArgument, "%s of computed attribute %s needs a numeric column", attribute.Aggregation, attribute.Name)
+		}
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(attribute.Aggregation), column), nil
+	case model.ComputedAttributeAggregation_Min, model.ComputedAttributeAggregation_Max:
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(attribute.Aggregation), column), nil
+	case model.ComputedAttributeAggregation_FirstValue, model.ComputedAttributeAggregation_LastValue:
+		timeColumn, err := computedAttributeTimeColumn(attribute, schema)
+		if err != nil {
+			return "", err
+		}
+		if aggregation == model.ComputedAttributeAggregation_FirstValue {
+			return fmt.Sprintf("MIN_BY(%s, %s)", column, timeColumn), nil
+		}
+		return fmt.Sprintf("MAX_BY(%s, %s)", column, timeColumn), nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unsupported aggregation %s", attribute.Aggregation)
+}
+
+// computedAttributeCondition returns the condition on the rows of the window and the filter, empty for all rows
+func computedAttributeCondition(attribute *api.ComputedAttribute, schema []model.SchemaUnit) (string, error) {
+	conditions := make([]string, 0, 2)
+	if attribute.WindowDays > 0 {
+		timeColumn, err := computedAttributeTimeColumn(attribute, schema)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, fmt.Sprintf("%s >= current_timestamp() - INTERVAL %d DAYS", timeColumn, attribute.WindowDays))
+	}
+	filter, err := data_table.RuleCondition(schema, attribute.Filter)
+	if err != nil {
+		return "", err
+	}
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+func computedAttributeTimeColumn(attribute *api.ComputedAttribute, schema []model.SchemaUnit) (string, error) {
+	if attribute.TimeColumn == "" {
+		return "", status.Errorf(codes.InvalidArgument, "computed attribute %s needs a time column", attribute.Name)
+	}
+	dataType, ok := data_table.SchemaColumnType(schema, attribute.TimeColumn)
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "column %s does not exist in behavior table %s", attribute.TimeColumn, attribute.BehaviorTable)
+	}
+	if dataType != string(model.ColumnDataType_Date) && dataType != string(model.ColumnDataType_Timestamp) {
+		return "", status.Errorf(codes.InvalidArgument, "time column %s must be a date or a timestamp", attribute.TimeColumn)
+	}
+	return utils.QuoteSparkIdentifier(attribute.TimeColumn), nil
+}
@@ -0,0 +1,79 @@
+package segment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+)
+
+var ordersSchema = []model.SchemaUnit{
+	{ColumnName: "customer_id", DataType: "string"},
+	{ColumnName: "amount", DataType: "double"},
+	{ColumnName: "status", DataType: "string"},
+	{ColumnName: "ordered_at", DataType: "timestamp"},
+	{ColumnName: "order day", DataType: "date"},
+}
+
+func TestComputedAttributeAggregations(t *testing.T) {
+	// the lifetime value of a customer is the sum of the amount of its paid orders of the last 90 days
+	lifetimeValue := &api.ComputedAttribute{
+		Name:          "ltv_90d",
+		BehaviorTable: "orders",
+		Aggregation:   string(model.ComputedAttributeAggregation_Sum),
+		Column:        "amount",
+		TimeColumn:    "ordered_at",
+		WindowDays:    90,
+		Filter:        &api.Rule{Field: "status", Operator: string(model.RuleOperator_Equal), Value: "paid"},
+	}
+	expression, err := computedAttributeExpression(lifetimeValue, ordersSchema)
+	if err != nil || expression != "SUM(`amount`)" {
+		t.Errorf("lifetime value expression = %s, %v", expression, err)
+	}
+	condition, err := computedAttributeCondition(lifetimeValue, ordersSchema)
+	if err != nil || condition != "`ordered_at` >= current_timestamp() - INTERVAL 90 DAYS AND `status` = 'paid'" {
+		t.Errorf("lifetime value condition = %s, %v", condition, err)
+	}
+
+	for attribute, want := range map[*api.ComputedAttribute]string{
+		{Aggregation: "count"}:                                                  "COUNT(*)",
+		{Aggregation: "count", Column: "status"}:                                "COUNT(`status`)",
+		{Aggregation: "avg", Column: "amount"}:                                  "AVG(`amount`)",
+		{Aggregation: "max", Column: "ordered_at"}:                              "MAX(`ordered_at`)",
+		{Aggregation: "first_value", Column: "status", TimeColumn: "order day"}: "MIN_BY(`status`, `order day`)",
+		{Aggregation: "last_value", Column: "amount", TimeColumn: "ordered_at"}: "MAX_BY(`amount`, `ordered_at`)",
+	} {
+		if expression, err := computedAttributeExpression(attribute, ordersSchema); err != nil || expression != want {
+			t.Errorf("computedAttributeExpression(%s of %s) = %s, %v, want %s", attribute.Aggregation, attribute.Column, expression, err, want)
+		}
+	}
+
+	// an attribute without window nor filter aggregates every row of the customer
+	if condition, err := computedAttributeCondition(&api.ComputedAttribute{Aggregation: "count"}, ordersSchema); err != nil || condition != "" {
+		t.Errorf("condition of every row = %q, %v", condition, err)
+	}
+}
+
+func TestComputedAttributeRejects(t *testing.T) {
+	for _, test := range []struct {
+		attribute *api.ComputedAttribute
+		want      string
+	}{
+		{&api.ComputedAttribute{Name: "a", Aggregation: "sum", Column: "discount"}, "column discount does not exist"},
+		{&api.ComputedAttribute{Name: "a", Aggregation: "sum", Column: "status"}, "needs a numeric column"},
+		{&api.ComputedAttribute{Name: "a", Aggregation: "last_value", Column: "status"}, "needs a time column"},
+		{&api.ComputedAttribute{Name: "a", Aggregation: "first_value", Column: "status", TimeColumn: "amount"}, "must be a date"},
+		{&api.ComputedAttribute{Name: "a", Aggregation: "median", Column: "amount"}, "unsupported aggregation"},
+		{&api.ComputedAttribute{Name: "a", Aggregation: "count", WindowDays: 30}, "needs a time column"},
+		{&api.ComputedAttribute{Name: "a", Aggregation: "count", Filter: &api.Rule{Field: "coupon", Operator: "null"}}, "column coupon does not exist"},
+	} {
+		_, err := computedAttributeExpression(test.attribute, ordersSchema)
+		if err == nil {
+			_, err = computedAttributeCondition(test.attribute, ordersSchema)
+		}
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("computed attribute %v: got %v, want %q", test.attribute, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (b business) UpdateMasterSegmentConsentColumns(ctx context.Context, request *api.UpdateMasterSegmentConsentColumnsRequest, accountUuid string) (*api.UpdateMasterSegmentConsentColumnsResponse, error) {
+	logger := b.log.WithName("UpdateMasterSegmentConsentColumns").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		logger.Error(err, "cannot get audience table")
+		return nil, err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal audience build configuration")
+		return nil, err
+	}
+
+	audienceColumns, err := b.audienceColumnNames(ctx, &configuration)
+	if err != nil {
+		logger.Error(err, "cannot get audience columns")
+		return nil, err
+	}
+	schema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse audience schema")
+		return nil, err
+	}
+	for _, column := range schema {
+		audienceColumns = append(audienceColumns, column.ColumnName)
+	}
+	err = validateConsentColumns(request.ConsentColumns, audienceColumns, configuration.ComputedAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	// consent is read from the audience at every export, the audience does not need to be rebuilt
+	configuration.ConsentColumns = request.ConsentColumns
+	jsonConfiguration, err := json.Marshal(configuration)
+	if err != nil {
+		logger.Error(err, "cannot marshal audience build configuration")
+		return nil, err
+	}
+	err = b.repository.SegmentRepository.UpdateAudienceTable(ctx, &repository.UpdateAudienceTableParams{
+		Id:                 audienceTable.ID,
+		BuildConfiguration: pqtype.NullRawMessage{RawMessage: jsonConfiguration, Valid: true},
+	})
+	if err != nil {
+		logger.Error(err, "cannot update audience table")
+		return nil, err
+	}
+
+	return &api.UpdateMasterSegmentConsentColumnsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func validateConsentColumns(consentColumns []*api.ConsentColumn, audienceColumns []string, computedAttributes []*api.ComputedAttribute) error {
+	for _, attribute := range computedAttributes {
+		audienceColumns = append(audienceColumns, attribute.Name)
+	}
+	channels := make(map[string]bool, len(consentColumns))
+	for _, consentColumn := range consentColumns {
+		if channels[consentColumn.Channel] {
+			return status.Errorf(codes.InvalidArgument, "channel %s has more than one consent column", consentColumn.Channel)
+		}
+		channels[consentColumn.Channel] = true
+		if !slices.Contains(audienceColumns, consentColumn.ColumnName) {
+			return status.Errorf(codes.InvalidArgument, "consent column %s is not a column of the audience", consentColumn.ColumnName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// customerEventTimeColumn is added to the behavior rows of the timeline to merge them in time order
+const customerEventTimeColumn = "cdp_event_micros"
+
+// GetCustomerProfile gathers everything known about one profile of the master segment: its audience attributes,
+// the events of the behavior tables, the segments containing it, the predictions and the exports of these segments
+func (b business) GetCustomerProfile(ctx context.Context, request *api.GetCustomerProfileRequest, accountUuid string) (*api.GetCustomerProfileResponse, error) {
+	logger := b.log.WithName("GetCustomerProfile").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	if masterSegment.Status == model.MasterSegmentStatus_DRAFT {
+		return nil, status.Error(codes.FailedPrecondition, "Master segment has not been built")
+	}
+
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		logger.Error(err, "cannot get audience table")
+		return nil, err
+	}
+	audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse audience schema")
+		return nil, err
+	}
+	var buildConfiguration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &buildConfiguration)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal audience build configuration")
+		return nil, err
+	}
+
+	customerCondition := fmt.Sprintf("%s = %s", model.AudienceColumn_CdpSystemUuid, utils.QuoteSparkString(request.CdpSystemUuid))
+	audiencePath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegment.ID))
+	audienceResponse, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{
+		Query: fmt.Sprintf("SELECT * FROM delta.`%s` WHERE %s LIMIT 1", audiencePath, customerCondition),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query audience table")
+		return nil, err
+	}
+	if len(audienceResponse.Data) == 0 {
+		return nil, status.Error(codes.NotFound, "Profile not found")
+	}
+	var audienceRow map[string]any
+	err = json.Unmarshal([]byte(audienceResponse.Data[0]), &audienceRow)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal audience row")
+		return nil, err
+	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, masterSegment.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return nil, err
+	}
+	// the timeline is joined with the values in clear, only the returned attributes are masked
+	maskedRow := make(map[string]any, len(audienceRow))
+	for name, value := range audienceRow {
+		maskedRow[name] = value
+	}
+	masks.MaskRow(maskedRow)
+	attributes, err := customerAttributes(audienceSchema, maskedRow)
+	if err != nil {
+		logger.Error(err, "cannot convert audience attributes")
+		return nil, err
+	}
+
+	timeline, err := b.customerTimeline(ctx, masterSegment.ID, accountUuid, &buildConfiguration, audienceRow, request.TimelineLimit)
+	if err != nil {
+		logger.Error(err, "cannot get customer timeline")
+		return nil, err
+	}
+
+	segments, err := b.customerSegments(ctx, masterSegment.ID, accountUuid, customerCondition)
+	if err != nil {
+		logger.Error(err, "cannot get customer segments")
+		return nil, err
+	}
+	segmentIds := utils.Map(segments, func(segment *api.CustomerSegmentMembership) int64 { return segment.SegmentId })
+
+	predictions, resultPaths, err := b.customerPredictions(ctx, accountUuid, segmentIds)
+	if err != nil {
+		logger.Error(err, "cannot get customer predictions")
+		return nil, err
+	}
+	for _, prediction := range predictions {
+		prediction.Result, err = b.customerPredictionResult(ctx, resultPaths[prediction.ActionId], customerCondition, masks)
+		if err != nil {
+			logger.Error(err, "cannot query prediction result", "actionId", prediction.ActionId)
+			return nil, err
+		}
+	}
+	// the first prediction is the latest
+	var predictionResult string
+	if len(predictions) > 0 {
+		predictionResult = predictions[0].Result
+	}
+
+	exportDestinations, err := b.customerExportDestinations(ctx, masterSegment.ID, segmentIds)
+	if err != nil {
+		logger.Error(err, "cannot get customer export destinations")
+		return nil, err
+	}
+
+	return &api.GetCustomerProfileResponse{
+		Code:               int32(code.Code_OK),
+		Message:            "Success",
+		Attributes:         attributes,
+		Timeline:           timeline,
+		Segments:           segments,
+		Predictions:        predictions,
+		PredictionResult:   predictionResult,
+		ExportDestinations: exportDestinations,
+	}, nil
+}
+
+// customerAttributes lists the columns of the audience schema in order, then the columns of the row missing from the schema
+func customerAttributes(schema []model.SchemaUnit, row map[string]any) ([]*api.CustomerAttribute, error) {
+	attributes := make([]*api.CustomerAttribute, 0, len(row)+1)
+	listed := map[string]bool{}
+	addAttribute := func(name string, dataType string) error {
+		value, err := structpb.NewValue(row[name])
+		if err != nil {
+			return err
+		}
+		attributes = append(attributes, &api.CustomerAttribute{Name: name, DataType: dataType, Value: value})
+		listed[name] = true
+		return nil
+	}
+
+	err := addAttribute(model.AudienceColumn_CdpSystemUuid, string(model.ColumnDataType_String))
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range schema {
+		if listed[column.ColumnName] {
+			continue
+		}
+		err = addAttribute(column.ColumnName, column.DataType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	remaining := make([]string, 0)
+	for name := range row {
+		if !listed[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	sort.Strings(remaining)
+	for _, name := range remaining {
+		err = addAttribute(name, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return attributes, nil
+}
+
+type customerEvent struct {
+	micros  int64
+	hasTime bool
+	event   *api.CustomerEvent
+}
+
+// customerTimeline merges the latest rows of every behavior table joined to the profile, latest first.
+// The time of a row is its first timestamp column, else its first date column, rows without time come last.
+func (b business) customerTimeline(ctx context.Context, masterSegmentId int64, accountUuid string, configuration *repository.AudienceBuildConfiguration, audienceRow map[string]any, limit int32) ([]*api.CustomerEvent, error) {
+	if limit <= 0 {
+		limit = model.TableQuery_DefaultLimit
+	}
+
+	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{MasterSegmentId: masterSegmentId})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]customerEvent, 0)
+	for _, behaviorTable := range behaviorTables {
+		joinValue, ok := audienceRow[behaviorJoinColumn(configuration, behaviorTable.JoinKey)]
+		if !ok || joinValue == nil {
+			continue
+		}
+		schema, err := data_table.ParseSchema(behaviorTable.Schema)
+		if err != nil {
+			return nil, err
+		}
+		condition, err := data_table.RuleCondition(schema, &api.Rule{
+			Field:    behaviorTable.ForeignKey,
+			Operator: string(model.RuleOperator_Equal),
+			Value:    customerValueString(joinValue),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaBehaviorPath(masterSegmentId, behaviorTable.Name))
+		sql := fmt.Sprintf("SELECT * FROM delta.`%s` WHERE %s LIMIT %d", path, condition, limit)
+		if timeColumn := data_table.TimeColumn(schema); timeColumn != "" {
+			quoted := utils.QuoteSparkIdentifier(timeColumn)
+			sql = fmt.Sprintf("SELECT *, unix_micros(CAST(%s AS TIMESTAMP)) AS %s FROM delta.`%s` WHERE %s ORDER BY %s DESC NULLS LAST LIMIT %d",
+				quoted, customerEventTimeColumn, path, condition, quoted, limit)
+		}
+		response, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{Query: sql})
+		if err != nil {
+			return nil, err
+		}
+		masks, err := data_table.BehaviorColumnMasks(ctx, b.repository, accountUuid, &behaviorTable)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, data := range response.Data {
+			var row map[string]any
+			err = json.Unmarshal([]byte(data), &row)
+			if err != nil {
+				return nil, err
+			}
+			event := customerEvent{event: &api.CustomerEvent{
+				BehaviorTableId:   behaviorTable.ID,
+				BehaviorTableName: behaviorTable.Name,
+			}}
+			if micros, ok := row[customerEventTimeColumn].(float64); ok {
+				event.micros = int64(micros)
+				event.hasTime = true
+				event.event.EventTime = time.UnixMicro(event.micros).UTC().Format(time.RFC3339Nano)
+			}
+			delete(row, customerEventTimeColumn)
+			masks.MaskRow(row)
+			record, err := json.Marshal(row)
+			if err != nil {
+				return nil, err
+			}
+			event.event.Record = string(record)
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].hasTime != events[j].hasTime {
+			return events[i].hasTime
+		}
+		return events[i].micros > events[j].micros
+	})
+	if len(events) > int(limit) {
+		events = events[:limit]
+	}
+	return utils.Map(events, func(event customerEvent) *api.CustomerEvent { return event.event }), nil
+}
+
+// behaviorJoinColumn returns the audience column of the join key of the main table, kept with its name
+// when the main table is copied whole
+func behaviorJoinColumn(configuration *repository.AudienceBuildConfiguration, joinKey string) string {
+	if len(configuration.SelectedColumns) == 0 {
+		return joinKey
+	}
+	for _, column := range configuration.SelectedColumns {
+		if column.TableColumnName == joinKey {
+			return column.NewTableColumnName
+		}
+	}
+	return ""
+}
+
+func customerValueString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(value)
+}
+
+// customerSegments checks the up to date segments of the master segment for the profile in one query
+func (b business) customerSegments(ctx context.Context, masterSegmentId int64, accountUuid string, customerCondition string) ([]*api.CustomerSegmentMembership, error) {
+	segments, err := b.repository.SegmentRepository.ListSegments(ctx, &repository.ListSegmentFilter{
+		AccountUuid:      accountUuid,
+		MasterSegmentIds: []int64{masterSegmentId},
+		Statuses:         []string{string(model.SegmentStatus_UP_TO_DATE)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return []*api.CustomerSegmentMembership{}, nil
+	}
+
+	lookups := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(masterSegmentId, segment.ID))
+		lookups = append(lookups, fmt.Sprintf("SELECT %d AS segment_id FROM delta.`%s` WHERE %s", segment.ID, path, customerCondition))
+	}
+	response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT DISTINCT segment_id FROM (%s) AS memberships", strings.Join(lookups, " UNION ALL ")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	memberOf := make(map[int64]bool, len(response.Data))
+	for _, row := range response.Data {
+		if segmentId, ok := row["segment_id"].(float64); ok {
+			memberOf[int64(segmentId)] = true
+		}
+	}
+
+	memberships := make([]*api.CustomerSegmentMembership, 0, len(memberOf))
+	for _, segment := range segments {
+		if memberOf[segment.ID] {
+			memberships = append(memberships, &api.CustomerSegmentMembership{SegmentId: segment.ID, Name: segment.Name})
+		}
+	}
+	return memberships, nil
+}
+
+// customerPredictions keeps the latest successful apply of each predict model over the segments of the profile,
+// with the result table of each apply by action id
+func (b business) customerPredictions(ctx context.Context, accountUuid string, segmentIds []int64) ([]*api.CustomerPrediction, map[int64]string, error) {
+	if len(segmentIds) == 0 {
+		return []*api.CustomerPrediction{}, nil, nil
+	}
+	actions, err := b.repository.DataActionRepository.GetListDataActions(ctx, &repository.GetListDataActionsParams{
+		ActionTypes: []string{string(model.ActionType_ApplyPredictModel)},
+		Statuses:    []model.DataActionStatus{model.DataActionStatus_Success},
+		AccountUuid: uuid.MustParse(accountUuid),
+		TargetTable: model.TargetTable_Segment,
+		ObjectIds:   segmentIds,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	predictions := make([]*api.CustomerPrediction, 0)
+	resultPaths := make(map[int64]string)
+	modelIds := make([]int64, 0)
+	latest := make(map[int64]bool)
+	for _, action := range actions.DataActions {
+		var config ApplyPredictModelConfig
+		err = json.Unmarshal(action.Payload.RawMessage, &config)
+		if err != nil {
+			return nil, nil, err
+		}
+		// the actions are the most recently updated first
+		if latest[config.PredictModelId] {
+			continue
+		}
+		latest[config.PredictModelId] = true
+		modelIds = append(modelIds, config.PredictModelId)
+		resultPaths[action.ID] = config.DagConfig.ResultPath
+		predictions = append(predictions, &api.CustomerPrediction{
+			PredictModelId: config.PredictModelId,
+			SegmentId:      config.SegmentId,
+			ActionId:       action.ID,
+			AppliedAt:      action.UpdatedAt.String(),
+		})
+	}
+	if len(modelIds) == 0 {
+		return predictions, resultPaths, nil
+	}
+
+	predictModels, err := b.repository.PredictModelRepository.ListPredictModels(ctx, &repository.ListPredictModelsParams{Ids: modelIds})
+	if err != nil {
+		return nil, nil, err
+	}
+	predictModelMap := make(map[int64]model.PredictModel, len(predictModels.PredictModels))
+	for _, predictModel := range predictModels.PredictModels {
+		predictModelMap[predictModel.ID] = predictModel
+	}
+	for _, prediction := range predictions {
+		predictModel, ok := predictModelMap[prediction.PredictModelId]
+		if !ok {
+			continue
+		}
+		prediction.PredictModelName = predictModel.Name
+		var trainConfig model.PredictModelTrainConfiguration
+		err = json.Unmarshal(predictModel.TrainConfigurations.RawMessage, &trainConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		prediction.Label_1 = trainConfig.Label1
+		prediction.Label_2 = trainConfig.Label2
+	}
+	return predictions, resultPaths, nil
+}
+
+// customerPredictionResult reads the row of the profile in the result table of a prediction, masked as the audience
+func (b business) customerPredictionResult(ctx context.Context, resultPath string, customerCondition string, masks data_table.ColumnMasks) (string, error) {
+	if resultPath == "" {
+		return "", nil
+	}
+	resultResponse, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{
+		Query: fmt.Sprintf("SELECT * FROM delta.`s3a://%s/%s` WHERE %s LIMIT 1", b.config.S3StorageConfig.Bucket, resultPath, customerCondition),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(resultResponse.Data) == 0 {
+		return "", nil
+	}
+	results, err := masks.MaskRecords(resultResponse.Data[:1])
+	if err != nil {
+		return "", err
+	}
+	return results[0], nil
+}
+
+// customerExportDestinations lists the destinations of the successful exports of the master segment and of the segments the profile is in now,
+// the profile may have joined them after an export or left one it was exported with
+func (b business) customerExportDestinations(ctx context.Context, masterSegmentId int64, segmentIds []int64) ([]*api.CustomerExport, error) {
+	exports := make([]*api.CustomerExport, 0)
+
+	masterSegmentMaps, err := b.repository.DestMasterSegmentMapRepository.ListDestinationMasterSegmentMaps(ctx, &repository.ListDestinationMasterSegmentMapsParams{
+		MasterSegmentIds: []int64{masterSegmentId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range masterSegmentMaps {
+		if mapping.DataActionStatus != string(model.DataActionStatus_Success) {
+			continue
+		}
+		exports = append(exports, &api.CustomerExport{
+			DestinationId:   mapping.DestinationId,
+			DestinationName: mapping.DestinationName,
+			ObjectType:      string(model.TargetTable_MasterSegment),
+			ObjectId:        mapping.MasterSegmentId,
+			ObjectName:      mapping.MasterSegmentName,
+			DataActionId:    mapping.DataActionId,
+		})
+	}
+
+	if len(segmentIds) == 0 {
+		return exports, nil
+	}
+	segmentMaps, err := b.repository.DestSegmentMapRepository.ListDestinationSegmentMaps(ctx, &repository.ListDestinationSegmentMapsParams{
+		SegmentIds: segmentIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range segmentMaps {
+		if mapping.DataActionStatus != string(model.DataActionStatus_Success) {
+			continue
+		}
+		exports = append(exports, &api.CustomerExport{
+			DestinationId:   mapping.DestinationId,
+			DestinationName: mapping.DestinationName,
+			ObjectType:      string(model.TargetTable_Segment),
+			ObjectId:        mapping.SegmentId,
+			ObjectName:      mapping.SegmentName,
+			DataActionId:    mapping.DataActionId,
+		})
+	}
+	return exports, nil
+}
@@ -0,0 +1,124 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+type applyActionRepository struct {
+	repository.DataActionRepository
+	actions []model.DataAction
+}
+
+func (r applyActionRepository) GetListDataActions(ctx context.Context, params *repository.GetListDataActionsParams) (*repository.GetListDataActionsResult, error) {
+	return &repository.GetListDataActionsResult{DataActions: r.actions, Count: int64(len(r.actions))}, nil
+}
+
+type predictModelRepository struct {
+	repository.PredictModelRepository
+	predictModels []model.PredictModel
+}
+
+func (r predictModelRepository) ListPredictModels(ctx context.Context, params *repository.ListPredictModelsParams) (*repository.ListPredictModelsResult, error) {
+	return &repository.ListPredictModelsResult{PredictModels: r.predictModels}, nil
+}
+
+// resultQueryAdapter returns the row of the result table named in the query
+type resultQueryAdapter struct {
+	query.QueryAdapter
+	rows map[string]string
+}
+
+func (a resultQueryAdapter) QueryRawSQLV2(ctx context.Context, request *query.QueryRawSQLV2Request) (*query.QueryRawSQLV2Response, error) {
+	for path, row := range a.rows {
+		if strings.Contains(request.Query, "delta.`s3a://bucket/"+path+"`") {
+			return &query.QueryRawSQLV2Response{Data: []string{row}}, nil
+		}
+	}
+	return &query.QueryRawSQLV2Response{}, nil
+}
+
+func TestCustomerPredictionsReadTheirOwnResults(t *testing.T) {
+	applyAction := func(id int64, predictModelId int64, resultPath string) model.DataAction {
+		payload, err := json.Marshal(ApplyPredictModelConfig{
+			DagConfig:      airflow.DagApplyPredictModelConfig{ResultPath: resultPath},
+			PredictModelId: predictModelId,
+			SegmentId:      7,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return model.DataAction{ID: id, Payload: pqtype.NullRawMessage{RawMessage: payload, Valid: true}}
+	}
+	predictModel := func(id int64, name string, label1 string, label2 string) model.PredictModel {
+		trainConfig, err := json.Marshal(model.PredictModelTrainConfiguration{Label1: label1, Label2: label2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return model.PredictModel{ID: id, Name: name, TrainConfigurations: pqtype.NullRawMessage{RawMessage: trainConfig, Valid: true}}
+	}
+
+	b := business{
+		repository: &repository.Repository{
+			// the actions are the most recently updated first, the churn model was applied twice
+			DataActionRepository: applyActionRepository{actions: []model.DataAction{
+				applyAction(30, 1, "segments/1/predict-output/churn-v2"),
+				applyAction(20, 2, "segments/1/predict-output/upsell"),
+				applyAction(10, 1, "segments/1/predict-output/churn-v1"),
+			}},
+			PredictModelRepository: predictModelRepository{predictModels: []model.PredictModel{
+				predictModel(1, "churn", "churned", "retained"),
+				predictModel(2, "upsell", "buyer", "not buyer"),
+			}},
+		},
+		queryAdapter: resultQueryAdapter{rows: map[string]string{
+			"segments/1/predict-output/churn-v1": `{"cdp_system_uuid":"p","email":"a@b.c","prediction":"retained"}`,
+			"segments/1/predict-output/churn-v2": `{"cdp_system_uuid":"p","email":"a@b.c","prediction":"churned"}`,
+			"segments/1/predict-output/upsell":   `{"cdp_system_uuid":"p","email":"a@b.c","prediction":"buyer"}`,
+		}},
+		config: &config.Config{S3StorageConfig: config.S3StorageConfig{Bucket: "bucket"}},
+	}
+
+	predictions, resultPaths, err := b.customerPredictions(context.Background(), uuid.NewString(), []int64{7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(predictions) != 2 {
+		t.Fatalf("customerPredictions() = %v, want the latest of each model", predictions)
+	}
+	masks := data_table.ColumnMasks{"email": {Tag: model.PiiTag_Email, Method: model.MaskingMethod_Null}}
+	for _, prediction := range predictions {
+		prediction.Result, err = b.customerPredictionResult(context.Background(), resultPaths[prediction.ActionId], "cdp_system_uuid = 'p'", masks)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	churn, upsell := predictions[0], predictions[1]
+	if churn.ActionId != 30 || churn.PredictModelName != "churn" || churn.Label_1 != "churned" {
+		t.Errorf("churn prediction = %v", churn)
+	}
+	if churn.Result != `{"cdp_system_uuid":"p","email":null,"prediction":"churned"}` {
+		t.Errorf("churn result = %s, want the row of its latest apply masked", churn.Result)
+	}
+	if upsell.ActionId != 20 || upsell.Label_2 != "not buyer" || !strings.Contains(upsell.Result, `"prediction":"buyer"`) {
+		t.Errorf("upsell prediction = %v", upsell)
+	}
+
+	// a profile that joined the segment after the prediction has no row in its result table
+	result, err := b.customerPredictionResult(context.Background(), "segments/1/predict-output/other", "cdp_system_uuid = 'p'", masks)
+	if err != nil || result != "" {
+		t.Errorf("result of a profile not predicted = %q, %v", result, err)
+	}
+}
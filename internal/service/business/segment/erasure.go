@@ -0,0 +1,961 @@
+package segment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErasePerson deletes the rows of the person from the identity graphs, every data table of the account and its quarantine,
+// then from the audience, behavior tables, segments and prediction results of the master segments built from them, the
+// buffered events and the top values of the data profiles. The history of every erased delta table is vacuumed so time
+// travel cannot read the rows anymore. Only up to date objects are erased, the others are listed as skipped in the
+// certificate with the destinations which received the person.
+func (b business) ErasePerson(ctx context.Context, request *api.ErasePersonRequest, accountUuid string) (*api.ErasePersonResponse, error) {
+	logger := b.log.WithName("ErasePerson").WithValues("identifierColumns", request.IdentifierColumns)
+
+	content := model.ErasureCertificateContent{
+		AccountUuid:       accountUuid,
+		IdentifierHash:    hashErasureIdentifier(b.config.ErasureConfig.IdentifierHashKey, request.Identifier),
+		IdentifierColumns: request.IdentifierColumns,
+		ErasedObjects:     make([]model.ErasedObject, 0),
+		Destinations:      make([]model.ErasureDestination, 0),
+		SkippedObjects:    make([]model.SkippedObject, 0),
+	}
+
+	// the unified profiles of the person are read from the output tables before they are erased
+	err := b.eraseIdentityResolutions(ctx, request, accountUuid, &content)
+	if err != nil {
+		logger.Error(err, "cannot erase identity resolutions")
+		return nil, err
+	}
+	erasedTableIds, err := b.eraseDataTables(ctx, request, accountUuid, &content)
+	if err != nil {
+		logger.Error(err, "cannot erase data tables")
+		return nil, err
+	}
+	erasedMasterSegmentIds, erasedSegmentIds, err := b.eraseMasterSegments(ctx, request, accountUuid, &content)
+	if err != nil {
+		logger.Error(err, "cannot erase master segments")
+		return nil, err
+	}
+	err = b.eraseEventBuffers(ctx, request, accountUuid, &content)
+	if err != nil {
+		logger.Error(err, "cannot erase buffered events")
+		return nil, err
+	}
+	err = b.eraseDataProfiles(ctx, request, &content)
+	if err != nil {
+		logger.Error(err, "cannot erase data profiles")
+		return nil, err
+	}
+	content.Destinations, err = b.erasureDestinations(ctx, accountUuid, erasedTableIds, erasedMasterSegmentIds, erasedSegmentIds)
+	if err != nil {
+		logger.Error(err, "cannot list destinations of the person")
+		return nil, err
+	}
+	content.ErasedAt = time.Now().UTC()
+
+	certificate, err := b.createErasureCertificate(ctx, accountUuid, &content)
+	if err != nil {
+		logger.Error(err, "cannot create erasure certificate")
+		return nil, err
+	}
+	logger.Info("erased person", "certificateId", certificate.Id, "erasedObjects", len(certificate.ErasedObjects))
+
+	return &api.ErasePersonResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		Certificate: certificate,
+	}, nil
+}
+
+func (b business) GetListErasureCertificates(ctx context.Context, request *api.GetListErasureCertificatesRequest, accountUuid string) (*api.GetListErasureCertificatesResponse, error) {
+	logger := b.log.WithName("GetListErasureCertificates").WithValues("request", request)
+
+	result, err := b.repository.ErasureCertificateRepository.ListErasureCertificates(ctx, &repository.ListErasureCertificatesParams{
+		AccountUuid: uuid.MustParse(accountUuid),
+		Page:        int(request.Page),
+		PageSize:    int(request.PageSize),
+	})
+	if err != nil {
+		logger.Error(err, "cannot list erasure certificates")
+		return nil, err
+	}
+	certificates := make([]*api.ErasureCertificate, 0, len(result.Certificates))
+	for idx := range result.Certificates {
+		certificate, err := parseErasureCertificate(&result.Certificates[idx])
+		if err != nil {
+			logger.Error(err, "cannot parse erasure certificate", "id", result.Certificates[idx].ID)
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return &api.GetListErasureCertificatesResponse{
+		Code:         int32(code.Code_OK),
+		Message:      "Success",
+		Count:        result.Count,
+		Certificates: certificates,
+	}, nil
+}
+
+func (b business) GetErasureCertificate(ctx context.Context, request *api.GetErasureCertificateRequest, accountUuid string) (*api.GetErasureCertificateResponse, error) {
+	logger := b.log.WithName("GetErasureCertificate").WithValues("request", request)
+
+	erasureCertificate, err := b.repository.ErasureCertificateRepository.GetErasureCertificate(ctx, uuid.MustParse(accountUuid), request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get erasure certificate")
+		return nil, status.Error(codes.NotFound, "Erasure certificate not found")
+	}
+	previous, err := b.repository.ErasureCertificateRepository.GetPreviousErasureCertificate(ctx, erasureCertificate.AccountUuid, erasureCertificate.ID)
+	if err != nil {
+		logger.Error(err, "cannot get previous erasure certificate")
+		return nil, err
+	}
+	previousHash := ""
+	if previous != nil {
+		previousHash = previous.Hash
+	}
+	certificate, err := parseErasureCertificate(erasureCertificate)
+	if err != nil {
+		logger.Error(err, "cannot parse erasure certificate")
+		return nil, err
+	}
+
+	return &api.GetErasureCertificateResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		Certificate: certificate,
+		Verified: erasureCertificate.PreviousHash == previousHash &&
+			erasureCertificate.Hash == hashErasureCertificate(erasureCertificate.PreviousHash, erasureCertificate.Content),
+	}, nil
+}
+
+// eraseDataTables returns the ids of the data tables the person was deleted from
+func (b business) eraseDataTables(ctx context.Context, request *api.ErasePersonRequest, accountUuid string, content *model.ErasureCertificateContent) ([]int64, error) {
+	erasedTableIds := make([]int64, 0)
+	for page := 1; ; page++ {
+		result, err := b.repository.DataTableRepository.ListDataTables(ctx, &repository.ListDataTablesFilters{
+			AccountUuid: accountUuid,
+			Page:        page,
+			PageSize:    100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result == nil || len(result.DataTables) == 0 {
+			break
+		}
+		for _, dataTable := range result.DataTables {
+			if dataTable.Status == model.DataTableStatus_DRAFT {
+				continue
+			}
+			if dataTable.Status != model.DataTableStatus_UP_TO_DATE {
+				content.SkippedObjects = append(content.SkippedObjects, model.SkippedObject{
+					ObjectType: model.ErasedObjectType_DataTable,
+					ObjectId:   dataTable.ID,
+					Name:       dataTable.Name,
+					Reason:     "the table is being written, erase the person again once it is up to date",
+				})
+				continue
+			}
+			schema, err := data_table.ParseSchema(dataTable.Schema)
+			if err != nil {
+				return nil, err
+			}
+			err = b.eraseDataTableStores(ctx, request, accountUuid, &dataTable, content)
+			if err != nil {
+				return nil, err
+			}
+			condition := identifierCondition(schema, request.IdentifierColumns, request.Identifier)
+			if condition == "" {
+				continue
+			}
+			path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaTablePath(accountUuid, dataTable.Name))
+			deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, path, condition)
+			if err != nil {
+				return nil, err
+			}
+			if deletedRows == 0 {
+				continue
+			}
+			erasedTableIds = append(erasedTableIds, dataTable.ID)
+			content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+				ObjectType:      model.ErasedObjectType_DataTable,
+				ObjectId:        dataTable.ID,
+				Name:            dataTable.Name,
+				DeletedRows:     deletedRows,
+				HistoryVacuumed: vacuumed,
+			})
+		}
+		if int64(page*100) >= result.Count {
+			break
+		}
+	}
+	return erasedTableIds, nil
+}
+
+// eraseDataTableStores deletes the person from the quarantine of the data table. The quality and deduplication reports
+// only keep row counts of each run, they are listed as skipped
+func (b business) eraseDataTableStores(ctx context.Context, request *api.ErasePersonRequest, accountUuid string, dataTable *model.DataTable, content *model.ErasureCertificateContent) error {
+	stores := []struct {
+		objectType model.ErasedObjectType
+		path       string
+	}{
+		{objectType: model.ErasedObjectType_Quarantine, path: utils.GenerateDeltaQuarantinePath(accountUuid, dataTable.Name)},
+		{objectType: model.ErasedObjectType_QualityReport, path: utils.GenerateDeltaQualityReportPath(accountUuid, dataTable.Name)},
+		{objectType: model.ErasedObjectType_DeduplicationReport, path: utils.GenerateDeltaDeduplicationReportPath(accountUuid, dataTable.Name)},
+	}
+	for _, store := range stores {
+		// the stores are written by the first dag run using them, there is none before
+		schemaResponse, err := b.queryAdapter.GetSchemaTable(ctx, &query.GetSchemaDataTableRequest{TablePath: store.path})
+		if err != nil || len(schemaResponse.Schema) == 0 {
+			continue
+		}
+		if store.objectType != model.ErasedObjectType_Quarantine {
+			content.SkippedObjects = append(content.SkippedObjects, model.SkippedObject{
+				ObjectType: store.objectType,
+				ObjectId:   dataTable.ID,
+				Name:       dataTable.Name,
+				Reason:     "only row counts of each run are kept, no row of the person",
+			})
+			continue
+		}
+
+		schema := utils.Map(schemaResponse.Schema, func(column query.FieldSchema) model.SchemaUnit {
+			return model.SchemaUnit{ColumnName: column.Name, DataType: column.Type}
+		})
+		condition := identifierCondition(schema, request.IdentifierColumns, request.Identifier)
+		if condition == "" {
+			continue
+		}
+		deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, store.path), condition)
+		if err != nil {
+			return err
+		}
+		if deletedRows > 0 {
+			content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+				ObjectType:      store.objectType,
+				ObjectId:        dataTable.ID,
+				Name:            dataTable.Name,
+				DeletedRows:     deletedRows,
+				HistoryVacuumed: vacuumed,
+			})
+		}
+	}
+	return nil
+}
+
+// eraseIdentityResolutions deletes the unified profiles of the person from the identity graphs and histories, the output
+// tables are erased with the other data tables
+func (b business) eraseIdentityResolutions(ctx context.Context, request *api.ErasePersonRequest, accountUuid string, content *model.ErasureCertificateContent) error {
+	for page := 1; ; page++ {
+		result, err := b.repository.IdentityResolutionRepository.ListIdentityResolutions(ctx, &repository.ListIdentityResolutionsParams{
+			AccountUuid: uuid.MustParse(accountUuid),
+			Page:        page,
+			PageSize:    100,
+		})
+		if err != nil {
+			return err
+		}
+		if len(result.IdentityResolutions) == 0 {
+			break
+		}
+		for _, identityResolution := range result.IdentityResolutions {
+			if identityResolution.Status == model.IdentityResolutionStatus_DRAFT {
+				continue
+			}
+			if identityResolution.Status != model.IdentityResolutionStatus_UP_TO_DATE {
+				content.SkippedObjects = append(content.SkippedObjects, model.SkippedObject{
+					ObjectType: model.ErasedObjectType_IdentityResolution,
+					ObjectId:   identityResolution.ID,
+					Name:       identityResolution.Name,
+					Reason:     "the identity graph is not up to date, erase the person again once it is resolved",
+				})
+				continue
+			}
+			outputTable, err := b.repository.DataTableRepository.GetDataTable(ctx, identityResolution.OutputTableId)
+			if err != nil {
+				return err
+			}
+			outputSchema, err := data_table.ParseSchema(outputTable.Schema)
+			if err != nil {
+				return err
+			}
+			condition := identifierCondition(outputSchema, request.IdentifierColumns, request.Identifier)
+			if condition == "" {
+				continue
+			}
+			response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+				Query: fmt.Sprintf("SELECT DISTINCT %s FROM delta.`s3a://%s/%s` WHERE %s", model.IdentityResolution_UnifiedProfileIdColumn,
+					b.config.S3StorageConfig.Bucket, utils.GenerateDeltaTablePath(accountUuid, outputTable.Name), condition),
+			})
+			if err != nil {
+				return err
+			}
+			profileIds := make([]string, 0, len(response.Data))
+			for _, row := range response.Data {
+				if profileId, ok := row[model.IdentityResolution_UnifiedProfileIdColumn].(string); ok {
+					profileIds = append(profileIds, utils.QuoteSparkString(profileId))
+				}
+			}
+			if len(profileIds) == 0 {
+				continue
+			}
+
+			profileIdList := strings.Join(profileIds, ", ")
+			stores := []struct {
+				objectType model.ErasedObjectType
+				path       string
+				condition  string
+			}{
+				{
+					objectType: model.ErasedObjectType_IdentityGraph,
+					path:       utils.GenerateDeltaIdentityGraphPath(accountUuid, identityResolution.ID),
+					condition:  fmt.Sprintf("%s IN (%s)", model.IdentityResolution_UnifiedProfileIdColumn, profileIdList),
+				},
+				{
+					objectType: model.ErasedObjectType_IdentityHistory,
+					path:       utils.GenerateDeltaIdentityHistoryPath(accountUuid, identityResolution.ID),
+					condition: fmt.Sprintf("%s IN (%s) OR arrays_overlap(related_profile_ids, array(%s))",
+						model.IdentityResolution_UnifiedProfileIdColumn, profileIdList, profileIdList),
+				},
+			}
+			for _, store := range stores {
+				deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, store.path), store.condition)
+				if err != nil {
+					return err
+				}
+				if deletedRows > 0 {
+					content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+						ObjectType:      store.objectType,
+						ObjectId:        identityResolution.ID,
+						Name:            identityResolution.Name,
+						DeletedRows:     deletedRows,
+						HistoryVacuumed: vacuumed,
+					})
+				}
+			}
+		}
+		if int64(page*100) >= result.Count {
+			break
+		}
+	}
+	return nil
+}
+
+// eraseEventBuffers deletes the events of the person not flushed yet into the event tables
+func (b business) eraseEventBuffers(ctx context.Context, request *api.ErasePersonRequest, accountUuid string, content *model.ErasureCertificateContent) error {
+	for page := 1; ; page++ {
+		result, err := b.repository.EventSourceRepository.ListEventSources(ctx, &repository.ListEventSourcesParams{
+			AccountUuid: uuid.MustParse(accountUuid),
+			Page:        page,
+			PageSize:    100,
+		})
+		if err != nil {
+			return err
+		}
+		if len(result.EventSources) == 0 {
+			break
+		}
+		for _, eventSource := range result.EventSources {
+			deletedRows, err := b.repository.EventBufferRepository.DeleteEventBuffersByValue(ctx, eventSource.ID, request.IdentifierColumns, strings.TrimSpace(request.Identifier))
+			if err != nil {
+				return err
+			}
+			if deletedRows > 0 {
+				content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+					ObjectType:  model.ErasedObjectType_EventBuffer,
+					ObjectId:    eventSource.ID,
+					Name:        eventSource.Name,
+					DeletedRows: deletedRows,
+				})
+			}
+		}
+		if int64(page*100) >= result.Count {
+			break
+		}
+	}
+	return nil
+}
+
+// eraseDataProfiles removes the identifier from the top values, min and max of the profiles of the erased tables
+func (b business) eraseDataProfiles(ctx context.Context, request *api.ErasePersonRequest, content *model.ErasureCertificateContent) error {
+	profileObjectTypes := map[model.ErasedObjectType]model.DataProfileObjectType{
+		model.ErasedObjectType_DataTable:     model.DataProfileObjectType_DataTable,
+		model.ErasedObjectType_Audience:      model.DataProfileObjectType_Audience,
+		model.ErasedObjectType_BehaviorTable: model.DataProfileObjectType_Behavior,
+	}
+	identifier := strings.TrimSpace(request.Identifier)
+	for _, object := range content.ErasedObjects {
+		objectType, ok := profileObjectTypes[object.ObjectType]
+		if !ok || object.DeletedRows == 0 {
+			continue
+		}
+		dataProfile, err := b.repository.DataProfileRepository.GetDataProfile(ctx, objectType, object.ObjectId)
+		if err != nil {
+			return err
+		}
+		profile, err := data_table.ParseDataProfile(dataProfile)
+		if err != nil {
+			return err
+		}
+		if profile == nil {
+			continue
+		}
+		removedValues := data_table.RemoveProfileValue(profile, identifier)
+		if removedValues == 0 {
+			continue
+		}
+		err = data_table.SaveDataProfile(ctx, b.repository, objectType, object.ObjectId, profile)
+		if err != nil {
+			return err
+		}
+		content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+			ObjectType:  model.ErasedObjectType_DataProfile,
+			ObjectId:    object.ObjectId,
+			Name:        object.Name,
+			DeletedRows: removedValues,
+		})
+	}
+	return nil
+}
+
+// eraseMasterSegments returns the ids of the master segments and segments the person was deleted from
+func (b business) eraseMasterSegments(ctx context.Context, request *api.ErasePersonRequest, accountUuid string, content *model.ErasureCertificateContent) ([]int64, []int64, error) {
+	erasedMasterSegmentIds := make([]int64, 0)
+	erasedSegmentIds := make([]int64, 0)
+	for page := 1; ; page++ {
+		result, err := b.repository.SegmentRepository.ListMasterSegments(ctx, &repository.ListMasterSegmentsFilter{
+			AccountUuid: uuid.MustParse(accountUuid),
+			Page:        page,
+			PageSize:    100,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if result == nil || len(result.MasterSegments) == 0 {
+			break
+		}
+		for _, masterSegment := range result.MasterSegments {
+			if masterSegment.Status == model.MasterSegmentStatus_DRAFT {
+				continue
+			}
+			if masterSegment.Status != model.MasterSegmentStatus_UP_TO_DATE {
+				content.SkippedObjects = append(content.SkippedObjects, model.SkippedObject{
+					ObjectType: model.ErasedObjectType_MasterSegment,
+					ObjectId:   masterSegment.ID,
+					Name:       masterSegment.Name,
+					Reason:     "the master segment is being built, erase the person again once it is up to date",
+				})
+				continue
+			}
+			segmentIds, erased, err := b.eraseMasterSegment(ctx, request, accountUuid, &masterSegment, content)
+			if err != nil {
+				return nil, nil, err
+			}
+			if erased {
+				erasedMasterSegmentIds = append(erasedMasterSegmentIds, masterSegment.ID)
+			}
+			erasedSegmentIds = append(erasedSegmentIds, segmentIds...)
+		}
+		if int64(page*100) >= result.Count {
+			break
+		}
+	}
+	return erasedMasterSegmentIds, erasedSegmentIds, nil
+}
+
+// eraseMasterSegment deletes the profiles of the audience matching the identifier with their behaviors, segment
+// memberships, prediction results and search index entries. Behavior rows holding the identifier are deleted even
+// without a matching profile.
+func (b business) eraseMasterSegment(ctx context.Context, request *api.ErasePersonRequest, accountUuid string, masterSegment *model.MasterSegment, content *model.ErasureCertificateContent) ([]int64, bool, error) {
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		return nil, false, err
+	}
+	audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		return nil, false, err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the identifier columns of the main table may be renamed in the audience
+	audienceColumns := append([]string{}, request.IdentifierColumns...)
+	for _, column := range configuration.SelectedColumns {
+		for _, identifierColumn := range request.IdentifierColumns {
+			if column.TableColumnName == identifierColumn {
+				audienceColumns = append(audienceColumns, column.NewTableColumnName)
+			}
+		}
+	}
+	audiencePath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegment.ID))
+	audienceCondition := identifierCondition(audienceSchema, audienceColumns, request.Identifier)
+	audienceRows := make([]map[string]any, 0)
+	if audienceCondition != "" {
+		response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+			Query: fmt.Sprintf("SELECT * FROM delta.`%s` WHERE %s", audiencePath, audienceCondition),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		audienceRows = response.Data
+	}
+	cdpSystemUuids := make([]string, 0, len(audienceRows))
+	for _, row := range audienceRows {
+		if cdpSystemUuid, ok := row[model.AudienceColumn_CdpSystemUuid].(string); ok {
+			cdpSystemUuids = append(cdpSystemUuids, cdpSystemUuid)
+		}
+	}
+	profileCondition := ""
+	if len(cdpSystemUuids) > 0 {
+		profileCondition = fmt.Sprintf("%s IN (%s)", model.AudienceColumn_CdpSystemUuid,
+			strings.Join(utils.Map(cdpSystemUuids, utils.QuoteSparkString), ", "))
+	}
+	erased := false
+
+	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, behaviorTable := range behaviorTables {
+		behaviorSchema, err := data_table.ParseSchema(behaviorTable.Schema)
+		if err != nil {
+			return nil, false, err
+		}
+		conditions := make([]string, 0)
+		if condition := identifierCondition(behaviorSchema, request.IdentifierColumns, request.Identifier); condition != "" {
+			conditions = append(conditions, condition)
+		}
+		joinColumn := behaviorJoinColumn(&configuration, behaviorTable.JoinKey)
+		for _, row := range audienceRows {
+			joinValue, ok := row[joinColumn]
+			if !ok || joinValue == nil {
+				continue
+			}
+			condition, err := data_table.RuleCondition(behaviorSchema, &api.Rule{
+				Field:    behaviorTable.ForeignKey,
+				Operator: string(model.RuleOperator_Equal),
+				Value:    customerValueString(joinValue),
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			conditions = append(conditions, condition)
+		}
+		if len(conditions) == 0 {
+			continue
+		}
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaBehaviorPath(masterSegment.ID, behaviorTable.Name))
+		deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, path, strings.Join(conditions, " OR "))
+		if err != nil {
+			return nil, false, err
+		}
+		if deletedRows > 0 {
+			erased = true
+			content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+				ObjectType:      model.ErasedObjectType_BehaviorTable,
+				ObjectId:        behaviorTable.ID,
+				Name:            behaviorTable.Name,
+				DeletedRows:     deletedRows,
+				HistoryVacuumed: vacuumed,
+			})
+		}
+	}
+	if profileCondition == "" {
+		return []int64{}, erased, nil
+	}
+
+	erasedSegmentIds := make([]int64, 0)
+	segments, err := b.repository.SegmentRepository.ListSegments(ctx, &repository.ListSegmentFilter{
+		AccountUuid:      accountUuid,
+		MasterSegmentIds: []int64{masterSegment.ID},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, segment := range segments {
+		if segment.Status == string(model.SegmentStatus_DRAFT) {
+			continue
+		}
+		if segment.Status != string(model.SegmentStatus_UP_TO_DATE) {
+			content.SkippedObjects = append(content.SkippedObjects, model.SkippedObject{
+				ObjectType: model.ErasedObjectType_Segment,
+				ObjectId:   segment.ID,
+				Name:       segment.Name,
+				Reason:     "the segment is being built, erase the person again once it is up to date",
+			})
+			continue
+		}
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(masterSegment.ID, segment.ID))
+		deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, path, profileCondition)
+		if err != nil {
+			return nil, false, err
+		}
+		if deletedRows > 0 {
+			erasedSegmentIds = append(erasedSegmentIds, segment.ID)
+			content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+				ObjectType:      model.ErasedObjectType_Segment,
+				ObjectId:        segment.ID,
+				Name:            segment.Name,
+				DeletedRows:     deletedRows,
+				HistoryVacuumed: vacuumed,
+			})
+		}
+		// the membership snapshots keep the profiles of the previous builds
+		snapshot, err := b.repository.SegmentSnapshotRepository.GetLatestSegmentSnapshot(ctx, segment.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		if snapshot != nil {
+			snapshotPath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentSnapshotPath(masterSegment.ID, segment.ID))
+			deletedRows, vacuumed, err = b.deleteDeltaRows(ctx, snapshotPath, profileCondition)
+			if err != nil {
+				return nil, false, err
+			}
+			if deletedRows > 0 {
+				content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+					ObjectType:      model.ErasedObjectType_SegmentSnapshot,
+					ObjectId:        segment.ID,
+					Name:            segment.Name,
+					DeletedRows:     deletedRows,
+					HistoryVacuumed: vacuumed,
+				})
+			}
+		}
+	}
+
+	// every predict model applied to a segment of the master segment writes its own prediction result
+	if len(segments) > 0 {
+		actions, err := b.repository.DataActionRepository.GetListDataActions(ctx, &repository.GetListDataActionsParams{
+			ActionTypes: []string{string(model.ActionType_ApplyPredictModel)},
+			Statuses:    []model.DataActionStatus{model.DataActionStatus_Success},
+			AccountUuid: uuid.MustParse(accountUuid),
+			TargetTable: model.TargetTable_Segment,
+			ObjectIds:   utils.Map(segments, func(segment repository.SegmentListItem) int64 { return segment.ID }),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for _, action := range actions.DataActions {
+			var config ApplyPredictModelConfig
+			err = json.Unmarshal(action.Payload.RawMessage, &config)
+			if err != nil {
+				return nil, false, err
+			}
+			path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, config.DagConfig.ResultPath)
+			deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, path, profileCondition)
+			if err != nil {
+				return nil, false, err
+			}
+			if deletedRows > 0 {
+				content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+					ObjectType:      model.ErasedObjectType_PredictionResult,
+					ObjectId:        masterSegment.ID,
+					Name:            masterSegment.Name,
+					DeletedRows:     deletedRows,
+					HistoryVacuumed: vacuumed,
+				})
+			}
+		}
+	}
+
+	deletedRows, vacuumed, err := b.deleteDeltaRows(ctx, audiencePath, profileCondition)
+	if err != nil {
+		return nil, false, err
+	}
+	content.ErasedObjects = append(content.ErasedObjects, model.ErasedObject{
+		ObjectType:      model.ErasedObjectType_Audience,
+		ObjectId:        masterSegment.ID,
+		Name:            masterSegment.Name,
+		DeletedRows:     deletedRows,
+		HistoryVacuumed: vacuumed,
+	})
+	err = b.repository.ProfileSearchIndexRepository.DeleteProfileSearchIndexEntries(ctx, masterSegment.ID, cdpSystemUuids)
+	if err != nil {
+		return nil, false, err
+	}
+	return erasedSegmentIds, true, nil
+}
+
+// erasureDestinations lists the destinations the erased data tables, master segments and segments were exported to
+func (b business) erasureDestinations(ctx context.Context, accountUuid string, dataTableIds []int64, masterSegmentIds []int64, segmentIds []int64) ([]model.ErasureDestination, error) {
+	destinations := make([]model.ErasureDestination, 0)
+	destinationTypes := make(map[int64]model.DataDestinationType)
+	for page := 1; ; page++ {
+		result, err := b.repository.DataDestinationRepository.ListDataDestinations(ctx, &repository.ListDataDestinationsParams{
+			AccountUuid: accountUuid,
+			Page:        page,
+			PageSize:    100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, destination := range result.Destinations {
+			destinationTypes[destination.ID] = destination.Type
+		}
+		if len(result.Destinations) == 0 || int64(page*100) >= result.Count {
+			break
+		}
+	}
+
+	if len(dataTableIds) > 0 {
+		dataTables, err := b.repository.DataTableRepository.ListDataTables(ctx, &repository.ListDataTablesFilters{DataTableIds: dataTableIds, PageSize: len(dataTableIds)})
+		if err != nil {
+			return nil, err
+		}
+		tableNames := make(map[int64]string, len(dataTableIds))
+		for _, dataTable := range dataTables.DataTables {
+			tableNames[dataTable.ID] = dataTable.Name
+		}
+		tableDestinations, err := b.repository.DataTableRepository.GetDestinationsOfDataTables(ctx, dataTableIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, dataTableId := range dataTableIds {
+			for _, destination := range tableDestinations[dataTableId] {
+				destinations = append(destinations, model.ErasureDestination{
+					DestinationId:   destination.ID,
+					DestinationName: destination.Name,
+					DestinationType: destination.Type,
+					ObjectType:      string(model.TargetTable_DataTable),
+					ObjectId:        dataTableId,
+					ObjectName:      tableNames[dataTableId],
+				})
+			}
+		}
+	}
+
+	if len(masterSegmentIds) > 0 {
+		masterSegmentMaps, err := b.repository.DestMasterSegmentMapRepository.ListDestinationMasterSegmentMaps(ctx, &repository.ListDestinationMasterSegmentMapsParams{
+			MasterSegmentIds: masterSegmentIds,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, mapping := range masterSegmentMaps {
+			if mapping.DataActionStatus != string(model.DataActionStatus_Success) {
+				continue
+			}
+			destinations = append(destinations, model.ErasureDestination{
+				DestinationId:   mapping.DestinationId,
+				DestinationName: mapping.DestinationName,
+				DestinationType: destinationTypes[mapping.DestinationId],
+				ObjectType:      string(model.TargetTable_MasterSegment),
+				ObjectId:        mapping.MasterSegmentId,
+				ObjectName:      mapping.MasterSegmentName,
+			})
+		}
+	}
+
+	if len(segmentIds) > 0 {
+		segmentMaps, err := b.repository.DestSegmentMapRepository.ListDestinationSegmentMaps(ctx, &repository.ListDestinationSegmentMapsParams{
+			SegmentIds: segmentIds,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, mapping := range segmentMaps {
+			if mapping.DataActionStatus != string(model.DataActionStatus_Success) {
+				continue
+			}
+			destinations = append(destinations, model.ErasureDestination{
+				DestinationId:   mapping.DestinationId,
+				DestinationName: mapping.DestinationName,
+				DestinationType: destinationTypes[mapping.DestinationId],
+				ObjectType:      string(model.TargetTable_Segment),
+				ObjectId:        mapping.SegmentId,
+				ObjectName:      mapping.SegmentName,
+			})
+		}
+	}
+	return destinations, nil
+}
+
+// createErasureCertificate chains the certificate to the latest certificate of the account. The rows are already
+// deleted, so when a concurrent erasure of the account chained to the same certificate first, the unique previous hash
+// rejects the insert and the certificate is chained again to the new latest one
+func (b business) createErasureCertificate(ctx context.Context, accountUuid string, content *model.ErasureCertificateContent) (*api.ErasureCertificate, error) {
+	jsonContent, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		latest, err := b.repository.ErasureCertificateRepository.GetLatestErasureCertificate(ctx, uuid.MustParse(accountUuid))
+		if err != nil {
+			return nil, err
+		}
+		previousHash := ""
+		if latest != nil {
+			previousHash = latest.Hash
+		}
+		certificate := &model.ErasureCertificate{
+			AccountUuid:    uuid.MustParse(accountUuid),
+			IdentifierHash: content.IdentifierHash,
+			Content:        string(jsonContent),
+			PreviousHash:   previousHash,
+			Hash:           hashErasureCertificate(previousHash, string(jsonContent)),
+		}
+		err = b.repository.ErasureCertificateRepository.CreateErasureCertificate(ctx, certificate)
+		if err == nil {
+			return parseErasureCertificate(certificate)
+		}
+		if attempt == model.ErasureCertificate_MaxAttempts {
+			return nil, err
+		}
+		b.log.WithName("createErasureCertificate").Error(err, "cannot chain erasure certificate, retrying", "attempt", attempt)
+	}
+}
+
+func parseErasureCertificate(certificate *model.ErasureCertificate) (*api.ErasureCertificate, error) {
+	var content model.ErasureCertificateContent
+	err := json.Unmarshal([]byte(certificate.Content), &content)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ErasureCertificate{
+		Id:                certificate.ID,
+		IdentifierHash:    certificate.IdentifierHash,
+		IdentifierColumns: content.IdentifierColumns,
+		ErasedObjects: utils.Map(content.ErasedObjects, func(object model.ErasedObject) *api.ErasedObject {
+			return &api.ErasedObject{
+				ObjectType:      string(object.ObjectType),
+				ObjectId:        object.ObjectId,
+				Name:            object.Name,
+				DeletedRows:     object.DeletedRows,
+				HistoryVacuumed: object.HistoryVacuumed,
+			}
+		}),
+		Destinations: utils.Map(content.Destinations, func(destination model.ErasureDestination) *api.ErasureDestination {
+			return &api.ErasureDestination{
+				DestinationId:   destination.DestinationId,
+				DestinationName: destination.DestinationName,
+				DestinationType: string(destination.DestinationType),
+				ObjectType:      destination.ObjectType,
+				ObjectId:        destination.ObjectId,
+				ObjectName:      destination.ObjectName,
+			}
+		}),
+		SkippedObjects: utils.Map(content.SkippedObjects, func(object model.SkippedObject) *api.ErasureSkippedObject {
+			return &api.ErasureSkippedObject{
+				ObjectType: string(object.ObjectType),
+				ObjectId:   object.ObjectId,
+				Name:       object.Name,
+				Reason:     object.Reason,
+			}
+		}),
+		ErasedAt:     content.ErasedAt.Format(time.RFC3339),
+		PreviousHash: certificate.PreviousHash,
+		Hash:         certificate.Hash,
+	}, nil
+}
+
+// deleteDeltaRows counts the rows matching condition before deleting them, delta returns no count to the query api.
+// It then vacuums the history of the table, it returns whether the vacuum succeeded, the rows stay readable by time
+// travel until the history is vacuumed
+func (b business) deleteDeltaRows(ctx context.Context, path string, condition string) (int64, bool, error) {
+	countResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT COUNT(*) AS count FROM delta.`%s` WHERE %s", path, condition),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	var count float64
+	if len(countResponse.Data) > 0 {
+		count, _ = countResponse.Data[0]["count"].(float64)
+	}
+	if count == 0 {
+		return 0, false, nil
+	}
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("DELETE FROM delta.`%s` WHERE %s", path, condition),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = b.vacuumDeltaHistory(ctx, path)
+	if err != nil {
+		// not return this error, the rows are deleted and the certificate records the history is not vacuumed
+		b.log.WithName("deleteDeltaRows").Error(err, "cannot vacuum delta history", "path", path)
+		return int64(count), false, nil
+	}
+	return int64(count), true, nil
+}
+
+// vacuumDeltaHistory removes the files of every version but the latest. Delta rejects a retention below the deleted
+// file retention of the table, it is lowered to zero for the vacuum then reset
+func (b business) vacuumDeltaHistory(ctx context.Context, path string) error {
+	_, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("ALTER TABLE delta.`%s` SET TBLPROPERTIES ('delta.deletedFileRetentionDuration' = 'interval 0 hours')", path),
+	})
+	if err != nil {
+		return err
+	}
+	_, vacuumErr := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("VACUUM delta.`%s` RETAIN 0 HOURS", path),
+	})
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("ALTER TABLE delta.`%s` UNSET TBLPROPERTIES ('delta.deletedFileRetentionDuration')", path),
+	})
+	return errors.Join(vacuumErr, err)
+}
+
+// identifierCondition matches the rows having one of the columns of schema equal to identifier, empty when no column
+// of schema can hold the identifier
+func identifierCondition(schema []model.SchemaUnit, columns []string, identifier string) string {
+	conditions := make([]string, 0, len(columns))
+	matched := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if _, ok := data_table.SchemaColumnType(schema, column); !ok || matched[column] {
+			continue
+		}
+		matched[column] = true
+		condition, err := data_table.RuleCondition(schema, &api.Rule{
+			Field:    column,
+			Operator: string(model.RuleOperator_Equal),
+			Value:    identifier,
+		})
+		if err != nil {
+			// the identifier cannot be a value of the column, as a text in a number column
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return strings.Join(conditions, " OR ")
+}
+
+// hashErasureIdentifier keys the hash with a server secret, an unkeyed hash of an email or a phone number is
+// reversed by hashing the likely values
+func hashErasureIdentifier(key string, identifier string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(strings.TrimSpace(identifier)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func hashErasureCertificate(previousHash string, content string) string {
+	hash := sha256.Sum256([]byte(previousHash + content))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,124 @@
+package segment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+func TestHashErasureIdentifier(t *testing.T) {
+	hash := hashErasureIdentifier("server key", " an@cdp.io ")
+	if hash != hashErasureIdentifier("server key", "an@cdp.io") {
+		t.Error("the spaces around the identifier change its hash")
+	}
+	// a guess of the identifier hashed without the key of the server does not find the hash
+	unkeyed := sha256.Sum256([]byte("an@cdp.io"))
+	if hash == hex.EncodeToString(unkeyed[:]) || hash == hashErasureIdentifier("other key", "an@cdp.io") {
+		t.Error("the identifier hash does not depend on the key")
+	}
+	if len(hash) != 64 || hash == hashErasureIdentifier("server key", "an@cdp.com") {
+		t.Errorf("identifier hash = %s", hash)
+	}
+}
+
+type identityResolutionRepository struct {
+	repository.IdentityResolutionRepository
+	identityResolutions []model.IdentityResolution
+}
+
+func (r identityResolutionRepository) ListIdentityResolutions(ctx context.Context, params *repository.ListIdentityResolutionsParams) (*repository.ListIdentityResolutionsResult, error) {
+	if params.Page > 1 {
+		return &repository.ListIdentityResolutionsResult{Count: int64(len(r.identityResolutions))}, nil
+	}
+	return &repository.ListIdentityResolutionsResult{IdentityResolutions: r.identityResolutions, Count: int64(len(r.identityResolutions))}, nil
+}
+
+type outputTableRepository struct {
+	repository.DataTableRepository
+	dataTable *model.DataTable
+}
+
+func (r outputTableRepository) GetDataTable(ctx context.Context, id int64) (*model.DataTable, error) {
+	return r.dataTable, nil
+}
+
+// scriptedQueryAdapter answers each raw sql query with the rows of the first script whose key is in the query
+type scriptedQueryAdapter struct {
+	query.QueryAdapter
+	scripts map[string][]map[string]any
+	queries *[]string
+}
+
+func (a scriptedQueryAdapter) QueryRawSQL(ctx context.Context, request *query.QueryRawSQLRequest) (*query.QueryRawSQLResponse, error) {
+	*a.queries = append(*a.queries, request.Query)
+	for key, rows := range a.scripts {
+		if strings.Contains(request.Query, key) {
+			return &query.QueryRawSQLResponse{Data: rows}, nil
+		}
+	}
+	return &query.QueryRawSQLResponse{}, nil
+}
+
+func TestEraseIdentityResolutions(t *testing.T) {
+	accountUuid := uuid.NewString()
+	queries := make([]string, 0)
+	b := business{
+		log: logr.Discard(),
+		repository: &repository.Repository{
+			IdentityResolutionRepository: identityResolutionRepository{identityResolutions: []model.IdentityResolution{
+				{ID: 1, Name: "draft", Status: model.IdentityResolutionStatus_DRAFT},
+				{ID: 2, Name: "crm merge", Status: model.IdentityResolutionStatus_FAILED},
+				{ID: 3, Name: "web and crm", Status: model.IdentityResolutionStatus_UP_TO_DATE, OutputTableId: 30},
+			}},
+			DataTableRepository: outputTableRepository{dataTable: &model.DataTable{
+				ID:     30,
+				Name:   "unified_profiles",
+				Schema: pqtype.NullRawMessage{RawMessage: []byte(`[{"column_name":"email","data_type":"string"},{"column_name":"unified_profile_id","data_type":"string"}]`), Valid: true},
+			}},
+		},
+		queryAdapter: scriptedQueryAdapter{queries: &queries, scripts: map[string][]map[string]any{
+			"SELECT DISTINCT unified_profile_id": {{"unified_profile_id": "u-1"}},
+			"/3/graph` WHERE":                    {{"count": float64(2)}},
+		}},
+		config: &config.Config{S3StorageConfig: config.S3StorageConfig{Bucket: "bucket"}},
+	}
+	content := model.ErasureCertificateContent{}
+	err := b.eraseIdentityResolutions(context.Background(), &api.ErasePersonRequest{Identifier: "an@cdp.io", IdentifierColumns: []string{"email"}}, accountUuid, &content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the failed resolution may still hold the person in its graph, the certificate tells it was not erased
+	if len(content.SkippedObjects) != 1 || content.SkippedObjects[0].ObjectType != model.ErasedObjectType_IdentityResolution || content.SkippedObjects[0].ObjectId != 2 {
+		t.Errorf("skipped objects = %+v", content.SkippedObjects)
+	}
+	if len(content.ErasedObjects) != 1 {
+		t.Fatalf("erased objects = %+v", content.ErasedObjects)
+	}
+	graph := content.ErasedObjects[0]
+	if graph.ObjectType != model.ErasedObjectType_IdentityGraph || graph.ObjectId != 3 || graph.DeletedRows != 2 || !graph.HistoryVacuumed {
+		t.Errorf("erased graph = %+v", graph)
+	}
+	deleteGraph := "DELETE FROM delta.`s3a://bucket/identity/" + accountUuid + "/3/graph` WHERE unified_profile_id IN ('u-1')"
+	var deleted bool
+	for _, sql := range queries {
+		deleted = deleted || sql == deleteGraph
+		if strings.Contains(sql, "/2/") {
+			t.Errorf("the failed resolution is queried: %s", sql)
+		}
+	}
+	if !deleted {
+		t.Errorf("the profile is not deleted from the graph, queries %q", queries)
+	}
+}
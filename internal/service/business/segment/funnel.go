@@ -0,0 +1,372 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (b business) CreateFunnel(ctx context.Context, request *api.CreateFunnelRequest, accountUuid string) (*api.CreateFunnelResponse, error) {
+	logger := b.log.WithName("CreateFunnel").WithValues("request", request)
+
+	_, _, configuration, err := b.getRefreshableMasterSegment(ctx, request.MasterSegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	// compiling the funnel checks its steps
+	_, err = b.funnelQuery(ctx, request.MasterSegmentId, configuration, request.Steps, "", "")
+	if err != nil {
+		logger.Error(err, "invalid funnel steps")
+		return nil, err
+	}
+	jsonSteps, err := json.Marshal(request.Steps)
+	if err != nil {
+		logger.Error(err, "cannot marshal funnel steps")
+		return nil, err
+	}
+
+	funnel := &model.Funnel{
+		AccountUuid:     uuid.MustParse(accountUuid),
+		MasterSegmentId: request.MasterSegmentId,
+		Name:            request.Name,
+		Description:     request.Description,
+		Steps:           pqtype.NullRawMessage{RawMessage: jsonSteps, Valid: true},
+	}
+	err = b.repository.FunnelRepository.CreateFunnel(ctx, funnel)
+	if err != nil {
+		logger.Error(err, "cannot create funnel")
+		return nil, err
+	}
+
+	return &api.CreateFunnelResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Funnel:  toApiFunnel(funnel, request.Steps),
+	}, nil
+}
+
+func (b business) GetListFunnels(ctx context.Context, request *api.GetListFunnelsRequest, accountUuid string) (*api.GetListFunnelsResponse, error) {
+	logger := b.log.WithName("GetListFunnels").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	funnels, err := b.repository.FunnelRepository.ListFunnels(ctx, request.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot list funnels")
+		return nil, err
+	}
+
+	apiFunnels := make([]*api.Funnel, 0, len(funnels))
+	for i := range funnels {
+		steps, err := funnelSteps(&funnels[i])
+		if err != nil {
+			logger.Error(err, "cannot unmarshal funnel steps", "id", funnels[i].ID)
+			return nil, err
+		}
+		apiFunnels = append(apiFunnels, toApiFunnel(&funnels[i], steps))
+	}
+	return &api.GetListFunnelsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Funnels: apiFunnels,
+	}, nil
+}
+
+func (b business) DeleteFunnel(ctx context.Context, request *api.DeleteFunnelRequest, accountUuid string) (*api.DeleteFunnelResponse, error) {
+	logger := b.log.WithName("DeleteFunnel").WithValues("request", request)
+
+	funnel, err := b.getOwnedFunnel(ctx, request.Id, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get funnel")
+		return nil, err
+	}
+	// the drop off segments keep their condition, they do not depend on the funnel row
+	err = b.repository.FunnelRepository.DeleteFunnel(ctx, funnel.ID)
+	if err != nil {
+		logger.Error(err, "cannot delete funnel")
+		return nil, err
+	}
+
+	return &api.DeleteFunnelResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+// ComputeFunnel counts the profiles reaching each step and the median times to convert, for the whole audience and
+// for the most frequent values of the breakdown column
+func (b business) ComputeFunnel(ctx context.Context, request *api.ComputeFunnelRequest, accountUuid string) (*api.ComputeFunnelResponse, error) {
+	logger := b.log.WithName("ComputeFunnel").WithValues("request", request)
+
+	funnel, err := b.getOwnedFunnel(ctx, request.Id, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get funnel")
+		return nil, err
+	}
+	steps, err := funnelSteps(funnel)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal funnel steps")
+		return nil, err
+	}
+	_, audienceTable, configuration, err := b.getRefreshableMasterSegment(ctx, funnel.MasterSegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	funnelSQL, err := b.funnelQuery(ctx, funnel.MasterSegmentId, configuration, steps, request.From, request.To)
+	if err != nil {
+		logger.Error(err, "cannot compile funnel")
+		return nil, err
+	}
+
+	aggregations := make([]string, 0, 3*len(steps))
+	for idx := range steps {
+		reachedAt := fmt.Sprintf("reached_at_%d", idx+1)
+		aggregations = append(aggregations, fmt.Sprintf("COUNT(%s) AS count_%d", reachedAt, idx+1))
+		if idx > 0 {
+			aggregations = append(aggregations,
+				fmt.Sprintf("percentile_approx(CAST(%s AS LONG) - CAST(reached_at_%d AS LONG), 0.5) AS median_previous_%d", reachedAt, idx, idx+1),
+				fmt.Sprintf("percentile_approx(CAST(%s AS LONG) - CAST(reached_at_1 AS LONG), 0.5) AS median_start_%d", reachedAt, idx+1))
+		}
+	}
+	totalResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT %s FROM (%s) AS funnel", strings.Join(aggregations, ", "), funnelSQL),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query funnel")
+		return nil, err
+	}
+	if len(totalResponse.Data) == 0 {
+		return nil, status.Error(codes.Internal, "funnel query returned no row")
+	}
+
+	breakdowns := make([]*api.FunnelBreakdown, 0)
+	if request.BreakdownColumn != "" {
+		audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+		if err != nil {
+			logger.Error(err, "cannot parse audience schema")
+			return nil, err
+		}
+		if _, ok := data_table.SchemaColumnType(audienceSchema, request.BreakdownColumn); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "breakdown column %s is not a column of the audience", request.BreakdownColumn)
+		}
+		masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, funnel.MasterSegmentId)
+		if err != nil {
+			logger.Error(err, "cannot get column masks")
+			return nil, err
+		}
+		breakdownResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+			Query: fmt.Sprintf("SELECT audience.value, %s FROM (%s) AS funnel JOIN (SELECT %s, CAST(%s AS STRING) AS value FROM delta.`s3a://%s/%s`) AS audience "+
+				"ON funnel.%s = audience.%s WHERE funnel.reached_at_1 IS NOT NULL GROUP BY audience.value ORDER BY count_1 DESC LIMIT %d",
+				strings.Join(aggregations, ", "), funnelSQL, model.AudienceColumn_CdpSystemUuid, utils.QuoteSparkIdentifier(request.BreakdownColumn),
+				b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(funnel.MasterSegmentId),
+				model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid, model.Funnel_BreakdownMaxSize),
+		})
+		if err != nil {
+			logger.Error(err, "cannot query funnel breakdown")
+			return nil, err
+		}
+		for _, row := range breakdownResponse.Data {
+			value, _ := row["value"].(string)
+			breakdowns = append(breakdowns, &api.FunnelBreakdown{
+				Value: masks.MaskString(request.BreakdownColumn, value),
+				Steps: funnelStepResults(steps, row),
+			})
+		}
+	}
+
+	return &api.ComputeFunnelResponse{
+		Code:       int32(code.Code_OK),
+		Message:    "Success",
+		Steps:      funnelStepResults(steps, totalResponse.Data[0]),
+		Breakdowns: breakdowns,
+	}, nil
+}
+
+// CreateFunnelDropOffSegment creates a segment of the profiles reaching the step without reaching the next one,
+// the funnel is compiled in the condition of the segment so each build of the segment computes it again
+func (b business) CreateFunnelDropOffSegment(ctx context.Context, request *api.CreateFunnelDropOffSegmentRequest, accountUuid string) (*api.CreateFunnelDropOffSegmentResponse, error) {
+	logger := b.log.WithName("CreateFunnelDropOffSegment").WithValues("request", request)
+
+	funnel, err := b.getOwnedFunnel(ctx, request.Id, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get funnel")
+		return nil, err
+	}
+	steps, err := funnelSteps(funnel)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal funnel steps")
+		return nil, err
+	}
+	if int(request.Step) >= len(steps) {
+		return nil, status.Errorf(codes.InvalidArgument, "step must be between 1 and %d, the profiles of the last step did not drop off", len(steps)-1)
+	}
+	_, _, configuration, err := b.getRefreshableMasterSegment(ctx, funnel.MasterSegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	funnelSQL, err := b.funnelQuery(ctx, funnel.MasterSegmentId, configuration, steps, "", "")
+	if err != nil {
+		logger.Error(err, "cannot compile funnel")
+		return nil, err
+	}
+
+	description := request.Description
+	if description == "" {
+		description = fmt.Sprintf("Profiles of the funnel %s reaching %s without reaching %s", funnel.Name, steps[request.Step-1].Name, steps[request.Step].Name)
+	}
+	segment, err := b.createSegment(ctx, &api.CreateSegmentRequest{
+		MasterSegmentId: funnel.MasterSegmentId,
+		Name:            request.Name,
+		Description:     description,
+		SqlCondition: fmt.Sprintf("%s IN (SELECT %s FROM (%s) AS funnel WHERE reached_at_%d IS NOT NULL AND reached_at_%d IS NULL)",
+			model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid, funnelSQL, request.Step, request.Step+1),
+	}, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot create drop off segment")
+		return nil, err
+	}
+
+	return &api.CreateFunnelDropOffSegmentResponse{
+		Code:      int32(code.Code_OK),
+		Message:   "Success",
+		SegmentId: segment.ID,
+	}, nil
+}
+
+// funnelQuery compiles the steps in a query of the profiles entering the funnel with the time they reached each step, reached_at_1 to reached_at_n,
+// null from the first step they did not reach. A step is reached by the first matching event after the step before, within its max interval.
+// from and to bound the events of the first step
+func (b business) funnelQuery(ctx context.Context, masterSegmentId int64, configuration *repository.AudienceBuildConfiguration, steps []*api.FunnelStep, from string, to string) (string, error) {
+	if len(steps) < 2 || len(steps) > model.Funnel_MaxSteps {
+		return "", status.Errorf(codes.InvalidArgument, "a funnel has 2 to %d steps", model.Funnel_MaxSteps)
+	}
+
+	var funnelSQL string
+	for idx, step := range steps {
+		stepEvents, err := b.behaviorEvents(ctx, masterSegmentId, configuration, step.BehaviorTableId, step.TimeColumn, "", step.EventFilter, step.Name)
+		if err != nil {
+			return "", err
+		}
+		if idx == 0 {
+			bounds := make([]string, 0, 2)
+			if from != "" {
+				bounds = append(bounds, fmt.Sprintf("event_time >= TIMESTAMP %s", utils.QuoteSparkString(from)))
+			}
+			if to != "" {
+				bounds = append(bounds, fmt.Sprintf("event_time < TIMESTAMP %s + INTERVAL 1 DAYS", utils.QuoteSparkString(to)))
+			}
+			if len(bounds) > 0 {
+				stepEvents = fmt.Sprintf("SELECT * FROM (%s) AS bounded_events WHERE %s", stepEvents, strings.Join(bounds, " AND "))
+			}
+			funnelSQL = fmt.Sprintf("SELECT %s, MIN(event_time) AS reached_at_1 FROM (%s) AS step_events GROUP BY %s",
+				model.AudienceColumn_CdpSystemUuid, stepEvents, model.AudienceColumn_CdpSystemUuid)
+			continue
+		}
+		previousColumns := make([]string, 0, idx+1)
+		previousColumns = append(previousColumns, "previous."+model.AudienceColumn_CdpSystemUuid)
+		for previousIdx := 1; previousIdx <= idx; previousIdx++ {
+			previousColumns = append(previousColumns, fmt.Sprintf("previous.reached_at_%d", previousIdx))
+		}
+		joinCondition := fmt.Sprintf("step_events.%s = previous.%s AND step_events.event_time > previous.reached_at_%d",
+			model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid, idx)
+		if step.MaxIntervalSeconds > 0 {
+			joinCondition += fmt.Sprintf(" AND step_events.event_time <= previous.reached_at_%d + INTERVAL %d SECONDS", idx, step.MaxIntervalSeconds)
+		}
+		funnelSQL = fmt.Sprintf("SELECT %s, MIN(step_events.event_time) AS reached_at_%d FROM (%s) AS previous LEFT JOIN (%s) AS step_events ON %s GROUP BY %s",
+			strings.Join(previousColumns, ", "), idx+1, funnelSQL, stepEvents, joinCondition, strings.Join(previousColumns, ", "))
+	}
+	return funnelSQL, nil
+}
+
+// funnelStepResults reads the counts and medians of the aggregations of ComputeFunnel
+func funnelStepResults(steps []*api.FunnelStep, row map[string]any) []*api.FunnelStepResult {
+	results := make([]*api.FunnelStepResult, 0, len(steps))
+	for idx, step := range steps {
+		count, _ := row[fmt.Sprintf("count_%d", idx+1)].(float64)
+		result := &api.FunnelStepResult{
+			Step:  int32(idx + 1),
+			Name:  step.Name,
+			Count: int64(count),
+		}
+		if idx > 0 {
+			result.MedianSecondsFromPrevious, _ = row[fmt.Sprintf("median_previous_%d", idx+1)].(float64)
+			result.MedianSecondsFromStart, _ = row[fmt.Sprintf("median_start_%d", idx+1)].(float64)
+		}
+		results = append(results, result)
+	}
+	for idx, result := range results {
+		if results[0].Count > 0 {
+			result.ConversionRate = float64(result.Count) / float64(results[0].Count)
+		}
+		result.StepConversionRate = 1
+		if idx > 0 {
+			result.StepConversionRate = 0
+			if results[idx-1].Count > 0 {
+				result.StepConversionRate = float64(result.Count) / float64(results[idx-1].Count)
+			}
+		}
+		if idx < len(results)-1 {
+			result.DropOffCount = result.Count - results[idx+1].Count
+		}
+	}
+	return results
+}
+
+func (b business) getOwnedFunnel(ctx context.Context, id int64, accountUuid string) (*model.Funnel, error) {
+	funnel, err := b.repository.FunnelRepository.GetFunnel(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "Not found funnel")
+		}
+		return nil, err
+	}
+	if funnel.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can access funnel")
+	}
+	return funnel, nil
+}
+
+func funnelSteps(funnel *model.Funnel) ([]*api.FunnelStep, error) {
+	var steps []*api.FunnelStep
+	err := json.Unmarshal(funnel.Steps.RawMessage, &steps)
+	if err != nil {
+		return nil, err
+	}
+	return steps, nil
+}
+
+func toApiFunnel(funnel *model.Funnel, steps []*api.FunnelStep) *api.Funnel {
+	return &api.Funnel{
+		Id:              funnel.ID,
+		MasterSegmentId: funnel.MasterSegmentId,
+		Name:            funnel.Name,
+		Description:     funnel.Description,
+		Steps:           steps,
+		CreatedAt:       funnel.CreatedAt.String(),
+		UpdatedAt:       funnel.UpdatedAt.String(),
+	}
+}
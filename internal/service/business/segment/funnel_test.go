@@ -0,0 +1,98 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func funnelRow(counts ...float64) map[string]any {
+	row := map[string]any{}
+	for idx, count := range counts {
+		row[fmt.Sprintf("count_%d", idx+1)] = count
+	}
+	return row
+}
+
+func TestFunnelStepResultsRates(t *testing.T) {
+	steps := []*api.FunnelStep{{Name: "visit"}, {Name: "view product"}, {Name: "cart"}, {Name: "purchase"}}
+	results := funnelStepResults(steps, funnelRow(400, 120, 30, 12))
+
+	var dropOffs int64
+	stepConversions := 1.0
+	for idx, result := range results {
+		if result.Step != int32(idx+1) || result.Name != steps[idx].Name {
+			t.Errorf("result %d is step %d %s, want step %d %s", idx, result.Step, result.Name, idx+1, steps[idx].Name)
+		}
+		dropOffs += result.DropOffCount
+		stepConversions *= result.StepConversionRate
+		// converting step by step gives the conversion of the whole funnel up to the step
+		if math.Abs(stepConversions-result.ConversionRate) > 1e-9 {
+			t.Errorf("step %d conversion rate = %v, want the product of the step conversion rates %v", result.Step, result.ConversionRate, stepConversions)
+		}
+	}
+	if first, last := results[0].Count, results[len(results)-1].Count; dropOffs != first-last {
+		t.Errorf("drop offs = %d, want the %d profiles not reaching the last step", dropOffs, first-last)
+	}
+	if results[len(results)-1].DropOffCount != 0 {
+		t.Errorf("the last step drops off %d profiles", results[len(results)-1].DropOffCount)
+	}
+	if results[2].ConversionRate != 0.075 || results[3].StepConversionRate != 0.4 {
+		t.Errorf("cart conversion = %v and purchase step conversion = %v, want 0.075 and 0.4", results[2].ConversionRate, results[3].StepConversionRate)
+	}
+}
+
+func TestFunnelStepResultsMedians(t *testing.T) {
+	steps := []*api.FunnelStep{{Name: "signup"}, {Name: "activate"}, {Name: "subscribe"}}
+	row := funnelRow(50, 20, 5)
+	row["median_previous_2"], row["median_start_2"] = float64(3600), float64(3600)
+	row["median_previous_3"], row["median_start_3"] = float64(7200), float64(10800)
+	// the first step has no step before it, a median read for it is ignored
+	row["median_previous_1"] = float64(99)
+
+	results := funnelStepResults(steps, row)
+	if results[0].MedianSecondsFromPrevious != 0 || results[0].MedianSecondsFromStart != 0 {
+		t.Errorf("first step medians = %v and %v, want none", results[0].MedianSecondsFromPrevious, results[0].MedianSecondsFromStart)
+	}
+	if results[2].MedianSecondsFromPrevious != 7200 || results[2].MedianSecondsFromStart != 10800 {
+		t.Errorf("last step medians = %v and %v, want 7200 and 10800", results[2].MedianSecondsFromPrevious, results[2].MedianSecondsFromStart)
+	}
+}
+
+func TestFunnelStepResultsWithoutProfiles(t *testing.T) {
+	steps := []*api.FunnelStep{{Name: "visit"}, {Name: "cart"}}
+	// nobody reached the cart, null medians are read as 0 and the rates of empty steps are 0 instead of NaN
+	for name, row := range map[string]map[string]any{
+		"no profile reached the second step": {"count_1": float64(8), "count_2": float64(0), "median_previous_2": nil},
+		"no profile entered the funnel":      {},
+	} {
+		results := funnelStepResults(steps, row)
+		for _, result := range results {
+			if math.IsNaN(result.ConversionRate) || math.IsNaN(result.StepConversionRate) {
+				t.Errorf("%s: step %d rates are NaN", name, result.Step)
+			}
+		}
+		if results[1].Count != 0 || results[1].ConversionRate != 0 || results[1].StepConversionRate != 0 {
+			t.Errorf("%s: cart = %v, want no conversion", name, results[1])
+		}
+		if results[0].StepConversionRate != 1 || results[0].DropOffCount != results[0].Count {
+			t.Errorf("%s: visit = %v, want every profile of the first step dropped off", name, results[0])
+		}
+	}
+}
+
+func TestFunnelQueryStepCount(t *testing.T) {
+	for _, count := range []int{0, 1, model.Funnel_MaxSteps + 1} {
+		steps := make([]*api.FunnelStep, count)
+		_, err := business{}.funnelQuery(context.Background(), 1, nil, steps, "", "")
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("funnelQuery(%d steps) = %v, want InvalidArgument", count, err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	insightKind_Categorical = "categorical"
+	insightKind_Numeric     = "numeric"
+
+	// insightColumn_InSegment flags the audience rows of the segment members
+	insightColumn_InSegment = "cdp_in_segment"
+)
+
+// GetSegmentInsights compares the distribution of audience columns in the segment with the whole audience,
+// the audience rows are flagged with the membership of the segment so each column takes one query over both
+func (b business) GetSegmentInsights(ctx context.Context, request *api.GetSegmentInsightsRequest, accountUuid string) (*api.GetSegmentInsightsResponse, error) {
+	logger := b.log.WithName("GetSegmentInsights").WithValues("request", request)
+
+	segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return nil, err
+	}
+	if segment.Status != model.SegmentStatus_UP_TO_DATE {
+		return nil, status.Error(codes.FailedPrecondition, "Segment is not up to date")
+	}
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: segment.MasterSegmentId})
+	if err != nil {
+		logger.Error(err, "cannot get audience table")
+		return nil, err
+	}
+	audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse audience schema")
+		return nil, err
+	}
+	dataTypes := make(map[string]string, len(request.Columns))
+	for _, column := range request.Columns {
+		dataType, ok := data_table.SchemaColumnType(audienceSchema, column)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "column %s is not a column of the audience", column)
+		}
+		dataTypes[column] = dataType
+	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, segment.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return nil, err
+	}
+
+	topN := int(request.TopN)
+	if topN <= 0 {
+		topN = model.DataProfile_TopValuesSize
+	}
+	bucketCount := int(request.BucketCount)
+	if bucketCount <= 0 {
+		bucketCount = model.DataProfile_HistogramBuckets
+	}
+
+	flagged := fmt.Sprintf("(SELECT audience.*, CASE WHEN members.%s IS NULL THEN 0 ELSE 1 END AS %s FROM delta.`s3a://%s/%s` AS audience "+
+		"LEFT JOIN (SELECT DISTINCT %s FROM delta.`s3a://%s/%s`) AS members ON audience.%s = members.%s) AS flagged",
+		model.AudienceColumn_CdpSystemUuid, insightColumn_InSegment,
+		b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(segment.MasterSegmentId),
+		model.AudienceColumn_CdpSystemUuid, b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(segment.MasterSegmentId, segment.ID),
+		model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid)
+
+	aggregations := []string{"COUNT(*) AS audience_size", fmt.Sprintf("SUM(%s) AS segment_size", insightColumn_InSegment)}
+	for idx, column := range request.Columns {
+		if isNumericInsight(dataTypes[column]) {
+			name := utils.QuoteSparkIdentifier(column)
+			aggregations = append(aggregations,
+				fmt.Sprintf("CAST(MIN(%s) AS DOUBLE) AS min_%d", name, idx),
+				fmt.Sprintf("CAST(MAX(%s) AS DOUBLE) AS max_%d", name, idx))
+		}
+	}
+	aggregateResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT %s FROM %s", strings.Join(aggregations, ", "), flagged),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query segment and audience sizes")
+		return nil, err
+	}
+	if len(aggregateResponse.Data) == 0 {
+		return nil, status.Error(codes.Internal, "insight query returned no row")
+	}
+	aggregate := aggregateResponse.Data[0]
+	audienceSize, _ := aggregate["audience_size"].(float64)
+	segmentSize, _ := aggregate["segment_size"].(float64)
+
+	insights := make([]*api.ColumnInsight, 0, len(request.Columns))
+	for idx, column := range request.Columns {
+		var insight *api.ColumnInsight
+		if isNumericInsight(dataTypes[column]) {
+			lower, lowerOk := aggregate[fmt.Sprintf("min_%d", idx)].(float64)
+			upper, upperOk := aggregate[fmt.Sprintf("max_%d", idx)].(float64)
+			insight = &api.ColumnInsight{Kind: insightKind_Numeric, Buckets: []*api.InsightBucket{}}
+			if lowerOk && upperOk {
+				insight.Buckets, err = b.numericInsightBuckets(ctx, flagged, column, lower, upper, bucketCount)
+			}
+		} else {
+			insight = &api.ColumnInsight{Kind: insightKind_Categorical}
+			insight.Buckets, err = b.categoricalInsightBuckets(ctx, flagged, column, topN)
+		}
+		if err != nil {
+			logger.Error(err, "cannot query column distribution", "column", column)
+			return nil, err
+		}
+		insight.ColumnName = column
+		insight.DataType = dataTypes[column]
+		maskInsightBuckets(masks, insight)
+		for _, bucket := range insight.Buckets {
+			if segmentSize > 0 {
+				bucket.SegmentRatio = float64(bucket.SegmentCount) / segmentSize
+			}
+			if audienceSize > 0 {
+				bucket.AudienceRatio = float64(bucket.AudienceCount) / audienceSize
+			}
+			if bucket.AudienceRatio > 0 {
+				bucket.Lift = bucket.SegmentRatio / bucket.AudienceRatio
+			}
+		}
+		insights = append(insights, insight)
+	}
+
+	return &api.GetSegmentInsightsResponse{
+		Code:         int32(code.Code_OK),
+		Message:      "Success",
+		SegmentSize:  int64(segmentSize),
+		AudienceSize: int64(audienceSize),
+		Insights:     insights,
+	}, nil
+}
+
+// categoricalInsightBuckets keeps the most frequent values of the segment
+func (b business) categoricalInsightBuckets(ctx context.Context, flagged string, column string, topN int) ([]*api.InsightBucket, error) {
+	name := utils.QuoteSparkIdentifier(column)
+	response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT CAST(%s AS STRING) AS value, COUNT(*) AS audience_count, SUM(%s) AS segment_count FROM %s WHERE %s IS NOT NULL "+
+			"GROUP BY 1 ORDER BY segment_count DESC, audience_count DESC LIMIT %d",
+			name, insightColumn_InSegment, flagged, name, topN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.Map(response.Data, func(row map[string]any) *api.InsightBucket {
+		value, _ := row["value"].(string)
+		audienceCount, _ := row["audience_count"].(float64)
+		segmentCount, _ := row["segment_count"].(float64)
+		return &api.InsightBucket{Label: value, AudienceCount: int64(audienceCount), SegmentCount: int64(segmentCount)}
+	}), nil
+}
+
+// numericInsightBuckets splits the audience range [lower, upper] in equal width buckets, upper falls in the last bucket
+func (b business) numericInsightBuckets(ctx context.Context, flagged string, column string, lower float64, upper float64, bucketCount int) ([]*api.InsightBucket, error) {
+	if upper <= lower {
+		bucketCount = 1
+	}
+	width := (upper - lower) / float64(bucketCount)
+	bucketExpression := "0"
+	if width > 0 {
+		bucketExpression = fmt.Sprintf("LEAST(CAST(FLOOR((%s - %v) / %v) AS INT), %d)", utils.QuoteSparkIdentifier(column), lower, width, bucketCount-1)
+	}
+	response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT %s AS bucket, COUNT(*) AS audience_count, SUM(%s) AS segment_count FROM %s WHERE %s IS NOT NULL GROUP BY 1",
+			bucketExpression, insightColumn_InSegment, flagged, utils.QuoteSparkIdentifier(column)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]*api.InsightBucket, 0, bucketCount)
+	for idx := 0; idx < bucketCount; idx++ {
+		bucket := &api.InsightBucket{
+			Lower: lower + float64(idx)*width,
+			Upper: lower + float64(idx+1)*width,
+		}
+		bucket.Label = fmt.Sprintf("[%s, %s)", strconv.FormatFloat(bucket.Lower, 'g', 6, 64), strconv.FormatFloat(bucket.Upper, 'g', 6, 64))
+		if idx == bucketCount-1 {
+			bucket.Label = strings.TrimSuffix(bucket.Label, ")") + "]"
+		}
+		buckets = append(buckets, bucket)
+	}
+	for _, row := range response.Data {
+		idx, _ := row["bucket"].(float64)
+		if int(idx) < 0 || int(idx) >= len(buckets) {
+			continue
+		}
+		audienceCount, _ := row["audience_count"].(float64)
+		segmentCount, _ := row["segment_count"].(float64)
+		buckets[int(idx)].AudienceCount = int64(audienceCount)
+		buckets[int(idx)].SegmentCount = int64(segmentCount)
+	}
+	return buckets, nil
+}
+
+// maskInsightBuckets masks the labels of the values of a masked column. The bounds of a masked numeric column give away
+// its min, max and values, only the order of its buckets is kept
+func maskInsightBuckets(masks data_table.ColumnMasks, insight *api.ColumnInsight) {
+	if _, masked := masks[insight.ColumnName]; !masked {
+		return
+	}
+	for idx, bucket := range insight.Buckets {
+		if insight.Kind == insightKind_Numeric {
+			bucket.Lower, bucket.Upper = 0, 0
+			bucket.Label = fmt.Sprintf("bucket %d", idx+1)
+			continue
+		}
+		bucket.Label = masks.MaskString(insight.ColumnName, bucket.Label)
+	}
+}
+
+func isNumericInsight(dataType string) bool {
+	return dataType == string(model.ColumnDataType_Int) || dataType == string(model.ColumnDataType_Float)
+}
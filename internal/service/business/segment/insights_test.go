@@ -0,0 +1,74 @@
+package segment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+)
+
+func TestMaskInsightBuckets(t *testing.T) {
+	masks := data_table.ColumnMasks{
+		"national_id": {Tag: model.PiiTag_NationalId, Method: model.MaskingMethod_Hashed},
+		"email":       {Tag: model.PiiTag_Email, Method: model.MaskingMethod_Partial},
+	}
+
+	nationalId := &api.ColumnInsight{ColumnName: "national_id", Kind: insightKind_Numeric, Buckets: []*api.InsightBucket{
+		{Lower: 1e8, Upper: 5e8, Label: "[1e+08, 5e+08)", SegmentCount: 3},
+		{Lower: 5e8, Upper: 9e8, Label: "[5e+08, 9e+08]", SegmentCount: 5},
+	}}
+	maskInsightBuckets(masks, nationalId)
+	for idx, bucket := range nationalId.Buckets {
+		if bucket.Lower != 0 || bucket.Upper != 0 {
+			t.Errorf("bucket %d of a masked column keeps its bounds [%v, %v]", idx, bucket.Lower, bucket.Upper)
+		}
+	}
+	if nationalId.Buckets[0].Label != "bucket 1" || nationalId.Buckets[1].Label != "bucket 2" || nationalId.Buckets[1].SegmentCount != 5 {
+		t.Errorf("masked numeric buckets = %v, want the counts in order of the buckets", nationalId.Buckets)
+	}
+
+	email := &api.ColumnInsight{ColumnName: "email", Kind: insightKind_Categorical, Buckets: []*api.InsightBucket{{Label: "an@cdp.io"}}}
+	maskInsightBuckets(masks, email)
+	if email.Buckets[0].Label != "a***@cdp.io" {
+		t.Errorf("masked categorical label = %q, want a***@cdp.io", email.Buckets[0].Label)
+	}
+
+	age := &api.ColumnInsight{ColumnName: "age", Kind: insightKind_Numeric, Buckets: []*api.InsightBucket{{Lower: 18, Upper: 30, Label: "[18, 30]"}}}
+	maskInsightBuckets(masks, age)
+	if bucket := age.Buckets[0]; bucket.Lower != 18 || bucket.Upper != 30 || bucket.Label != "[18, 30]" {
+		t.Errorf("bucket of a column read in clear = %v, want it unchanged", bucket)
+	}
+}
+
+func TestNumericInsightBuckets(t *testing.T) {
+	b := business{queryAdapter: audienceQueryAdapter{rows: []map[string]any{
+		{"bucket": float64(0), "audience_count": float64(10), "segment_count": float64(2)},
+		{"bucket": float64(3), "audience_count": float64(4), "segment_count": float64(4)},
+		{"bucket": float64(7), "audience_count": float64(100), "segment_count": float64(100)},
+	}}}
+	buckets, err := b.numericInsightBuckets(context.Background(), "flagged", "age", 20, 60, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLabels := []string{"[20, 30)", "[30, 40)", "[40, 50)", "[50, 60]"}
+	if len(buckets) != len(wantLabels) {
+		t.Fatalf("got %d buckets, want %d", len(buckets), len(wantLabels))
+	}
+	for idx, label := range wantLabels {
+		if buckets[idx].Label != label {
+			t.Errorf("bucket %d label = %q, want %q", idx, buckets[idx].Label, label)
+		}
+	}
+	// a bucket out of the range is dropped, a bucket without rows keeps zero counts
+	if buckets[0].AudienceCount != 10 || buckets[1].AudienceCount != 0 || buckets[3].SegmentCount != 4 {
+		t.Errorf("bucket counts = %v", buckets)
+	}
+
+	// a column with a single value takes one bucket
+	single, err := b.numericInsightBuckets(context.Background(), "flagged", "age", 42, 42, 4)
+	if err != nil || len(single) != 1 || single[0].Label != "[42, 42]" {
+		t.Errorf("numericInsightBuckets(single value) = %v, %v", single, err)
+	}
+}
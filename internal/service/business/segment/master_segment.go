@@ -9,11 +9,13 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
 	"github.com/APCS20-Thesis/Backend/utils"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func (b business) CreateMasterSegment(ctx context.Context, request *api.CreateMasterSegmentRequest, accountUuid string) error {
@@ -38,17 +40,51 @@ func (b business) CreateMasterSegment(ctx context.Context, request *api.CreateMa
 		})
 	}
 
-	err := b.repository.TransactionRepository.CreateMasterSegmentTransaction(ctx, &repository.CreateMasterSegmentTransactionParams{
-		MasterSegmentName: request.Name,
-		Description:       request.Description,
-		AccountUuid:       uuid.MustParse(accountUuid),
-		AudienceName:      "audience",
-		BuildConfiguration: repository.AudienceBuildConfiguration{
-			MainTableId:     request.MainTableId,
-			SelectedColumns: request.SelectedColumns,
-			AttributeTables: repoAttributeTables,
-		},
-		BehaviorTables: repoBehaviorTables,
+	buildConfiguration := repository.AudienceBuildConfiguration{
+		MainTableId:        request.MainTableId,
+		SelectedColumns:    request.SelectedColumns,
+		AttributeTables:    repoAttributeTables,
+		ComputedAttributes: request.ComputedAttributes,
+		SearchableColumns:  request.SearchableColumns,
+		ConsentColumns:     request.ConsentColumns,
+	}
+	sources := make(map[string]computedAttributeSource, len(request.BehaviorTables))
+	for _, behaviorTable := range request.BehaviorTables {
+		sources[behaviorTable.Name] = computedAttributeSource{
+			DataTableId: behaviorTable.TableId,
+			ForeignKey:  behaviorTable.ForeignKey,
+			JoinKey:     behaviorTable.JoinKey,
+		}
+	}
+	audienceColumns, err := b.audienceColumnNames(ctx, &buildConfiguration)
+	if err != nil {
+		b.log.WithName("CreateMasterSegment").Error(err, "cannot get audience columns")
+		return err
+	}
+	computedAttributes, err := b.buildComputedAttributes(ctx, accountUuid, request.ComputedAttributes, sources, audienceColumns)
+	if err != nil {
+		b.log.WithName("CreateMasterSegment").Error(err, "invalid computed attributes")
+		return err
+	}
+	err = validateSearchableColumns(request.SearchableColumns, audienceColumns, request.ComputedAttributes)
+	if err != nil {
+		b.log.WithName("CreateMasterSegment").Error(err, "invalid searchable columns")
+		return err
+	}
+	err = validateConsentColumns(request.ConsentColumns, audienceColumns, request.ComputedAttributes)
+	if err != nil {
+		b.log.WithName("CreateMasterSegment").Error(err, "invalid consent columns")
+		return err
+	}
+
+	err = b.repository.TransactionRepository.CreateMasterSegmentTransaction(ctx, &repository.CreateMasterSegmentTransactionParams{
+		MasterSegmentName:  request.Name,
+		Description:        request.Description,
+		AccountUuid:        uuid.MustParse(accountUuid),
+		AudienceName:       "audience",
+		BuildConfiguration: buildConfiguration,
+		BehaviorTables:     repoBehaviorTables,
+		ComputedAttributes: computedAttributes,
 	}, b.airflowAdapter)
 	if err != nil {
 		b.log.WithName("CreateMasterSegment").Error(err, "cannot create master segment")
@@ -119,6 +155,14 @@ func (b business) GetMasterSegmentDetail(ctx context.Context, request *api.GetMa
 			return nil, err
 		}
 	}
+	var schemaIssues []*api.SchemaIssue
+	if masterSegment.SchemaIssues.RawMessage != nil && masterSegment.SchemaIssues.Valid {
+		err = json.Unmarshal(masterSegment.SchemaIssues.RawMessage, &schemaIssues)
+		if err != nil {
+			b.log.WithName("GetMasterSegmentDetail").Error(err, "cannot parse schema issues")
+			return nil, err
+		}
+	}
 
 	// Get behavior tables
 	behaviorTables, err := b.repository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{
@@ -147,18 +191,28 @@ func (b business) GetMasterSegmentDetail(ctx context.Context, request *api.GetMa
 	for _, table := range listTablesResult.DataTables {
 		mapDataTableIdToName[table.ID] = table.Name
 	}
+	retentionPolicies, err := data_table.RetentionPoliciesOfObjects(ctx, b.repository, model.RetentionObjectType_BehaviorTable,
+		utils.Map(behaviorTables, func(table model.BehaviorTable) int64 { return table.ID }))
+	if err != nil {
+		b.log.WithName("GetMasterSegmentDetail").Error(err, "cannot get retention policies of behavior tables")
+		return nil, err
+	}
 
 	return &api.MasterSegmentDetail{
-		Id:               masterSegment.ID,
-		Name:             masterSegment.Name,
-		Description:      masterSegment.Description,
-		Status:           string(masterSegment.Status),
-		CreatedAt:        masterSegment.CreatedAt.String(),
-		UpdatedAt:        masterSegment.UpdatedAt.String(),
-		AudienceTableId:  audienceTable.ID,
-		MainRawTableId:   buildConfiguration.MainTableId,
-		MainRawTableName: mapDataTableIdToName[buildConfiguration.MainTableId],
-		AudienceSchema:   audienceSchema,
+		Id:                 masterSegment.ID,
+		Name:               masterSegment.Name,
+		Description:        masterSegment.Description,
+		Status:             string(masterSegment.Status),
+		CreatedAt:          masterSegment.CreatedAt.String(),
+		UpdatedAt:          masterSegment.UpdatedAt.String(),
+		AudienceTableId:    audienceTable.ID,
+		MainRawTableId:     buildConfiguration.MainTableId,
+		MainRawTableName:   mapDataTableIdToName[buildConfiguration.MainTableId],
+		AudienceSchema:     audienceSchema,
+		SchemaIssues:       schemaIssues,
+		ComputedAttributes: buildConfiguration.ComputedAttributes,
+		SearchableColumns:  buildConfiguration.SearchableColumns,
+		ConsentColumns:     buildConfiguration.ConsentColumns,
 		AttributeTables: utils.Map(buildConfiguration.AttributeTables, func(table *repository.AttributeTableInfo) *api.MasterSegmentDetail_AttributeTable {
 			return &api.MasterSegmentDetail_AttributeTable{
 				RawTableId:      table.TableId,
@@ -178,28 +232,72 @@ func (b business) GetMasterSegmentDetail(ctx context.Context, request *api.GetMa
 				}
 			}
 			return &api.MasterSegmentDetail_BehaviorTable{
-				Id:           table.ID,
-				Name:         table.Name,
-				RawTableId:   table.DataTableId,
-				RawTableName: mapDataTableIdToName[table.DataTableId],
-				ForeignKey:   table.ForeignKey,
-				JoinKey:      table.JoinKey,
-				Schema:       behaviorSchema,
+				Id:              table.ID,
+				Name:            table.Name,
+				RawTableId:      table.DataTableId,
+				RawTableName:    mapDataTableIdToName[table.DataTableId],
+				ForeignKey:      table.ForeignKey,
+				JoinKey:         table.JoinKey,
+				Schema:          behaviorSchema,
+				RetentionPolicy: retentionPolicies[table.ID],
 			}
 		}),
 	}, nil
 }
 
-func (b business) ListMasterSegmentProfiles(ctx context.Context, request *api.GetListMasterSegmentProfilesRequest, accountUuid string) (int64, []string, error) {
+type MasterSegmentProfileRecords struct {
+	Count      int64
+	Records    []string
+	NextCursor string
+}
+
+func (b business) ListMasterSegmentProfiles(ctx context.Context, request *api.GetListMasterSegmentProfilesRequest, accountUuid string) (*MasterSegmentProfileRecords, error) {
 	masterSegment, err := b.repository.GetMasterSegment(ctx, request.Id)
 	if err != nil {
 		b.log.WithName("GetMasterSegmentDetail").Error(err, "cannot get master segment data")
-		return 0, nil, err
+		return nil, err
 	}
 
 	if masterSegment.AccountUuid.String() != accountUuid {
 		b.log.WithName("ListMasterSegmentProfiles").WithValues("masterSegmentId", request.Id).Error(err, "No have permission with master segment")
-		return 0, nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, masterSegment.ID)
+	if err != nil {
+		b.log.WithName("ListMasterSegmentProfiles").Error(err, "cannot get column masks", "masterSegmentId", request.Id)
+		return nil, err
+	}
+	if request.Query != nil {
+		err = masks.CheckQuery(request.Query)
+		if err != nil {
+			return nil, err
+		}
+		audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+		if err != nil {
+			b.log.WithName("ListMasterSegmentProfiles").Error(err, "cannot get audience table", "masterSegmentId", request.Id)
+			return nil, err
+		}
+		schema, err := data_table.ParseSchema(audienceTable.Schema)
+		if err != nil {
+			b.log.WithName("ListMasterSegmentProfiles").Error(err, "cannot parse audience schema", "masterSegmentId", request.Id)
+			return nil, err
+		}
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegment.ID))
+		result, err := data_table.QueryTable(ctx, b.queryAdapter, fmt.Sprintf("delta.`%s`", path), schema, request.Query, model.AudienceColumn_CdpSystemUuid)
+		if err != nil {
+			b.log.WithName("ListMasterSegmentProfiles").Error(err, "cannot query audience table", "masterSegmentId", request.Id)
+			return nil, err
+		}
+		records, err := masks.MaskRecords(result.Data)
+		if err != nil {
+			b.log.WithName("ListMasterSegmentProfiles").Error(err, "cannot mask records", "masterSegmentId", request.Id)
+			return nil, err
+		}
+		return &MasterSegmentProfileRecords{
+			Count:      result.Count,
+			Records:    records,
+			NextCursor: result.NextCursor,
+		}, nil
 	}
 	path := utils.GenerateDeltaAudiencePath(request.Id)
 
@@ -207,6 +305,10 @@ func (b business) ListMasterSegmentProfiles(ctx context.Context, request *api.Ge
 	var fields = make([]string, 0, 1)
 	var values = make([]string, 0, 1)
 	if request.SearchField != "" {
+		err = masks.CheckColumn(request.SearchField)
+		if err != nil {
+			return nil, err
+		}
 		fields = append(fields, request.SearchField)
 		values = append(values, request.SearchValue)
 	}
@@ -218,11 +320,19 @@ func (b business) ListMasterSegmentProfiles(ctx context.Context, request *api.Ge
 		TablePath: path,
 	})
 	if err != nil {
-		return 0, nil, err
+		return nil, err
+	}
+	records, err := masks.MaskRecords(queryResponse.Data)
+	if err != nil {
+		b.log.WithName("ListMasterSegmentProfiles").Error(err, "cannot mask records", "masterSegmentId", request.Id)
+		return nil, err
 	}
 
 	//res := query.QueryV2Paginate(request.Page, request.PageSize, queryResponse.Data)
-	return int64(queryResponse.Count), queryResponse.Data, nil
+	return &MasterSegmentProfileRecords{
+		Count:   queryResponse.Count,
+		Records: records,
+	}, nil
 }
 
 func covertLimitOffsetFromPageAndPageSize(page int32, pageSize int32) (limit int32, offset int32) {
@@ -255,8 +365,18 @@ func (b business) GetMasterSegmentProfile(ctx context.Context, request *api.GetM
 	if err != nil {
 		return "", err
 	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, masterSegment.ID)
+	if err != nil {
+		b.log.WithName("GetMasterSegmentProfile").Error(err, "cannot get column masks")
+		return "", err
+	}
+	records, err := masks.MaskRecords(queryResponse.Data[:1])
+	if err != nil {
+		b.log.WithName("GetMasterSegmentProfile").Error(err, "cannot mask profile")
+		return "", err
+	}
 
-	return queryResponse.Data[0], nil
+	return records[0], nil
 }
 
 func (b business) SyncOnCreateMasterSegment(ctx context.Context, masterSegmentId int64, actionStatus model.DataActionStatus) error {
@@ -358,12 +478,31 @@ func (b business) SyncOnCreateMasterSegment(ctx context.Context, masterSegmentId
 	}
 
 	tx.Commit()
+
+	var buildConfiguration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &buildConfiguration)
+	if err != nil {
+		b.log.WithName("SyncOnCreateMasterSegment").Error(err, "cannot unmarshal audience build configuration", "masterSegmentId", masterSegmentId)
+		return err
+	}
+	err = b.rebuildProfileSearchIndex(ctx, masterSegmentId, buildConfiguration.SearchableColumns)
+	if err != nil {
+		b.log.WithName("SyncOnCreateMasterSegment").Error(err, "cannot rebuild profile search index", "masterSegmentId", masterSegmentId)
+		// not return this error, the master segment is up to date
+	}
+	// the build replaced the audience, its RFM attributes are computed again
+	err = b.applyRFMAnalyses(ctx, masterSegmentId)
+	if err != nil {
+		b.log.WithName("SyncOnCreateMasterSegment").Error(err, "cannot apply rfm analyses", "masterSegmentId", masterSegmentId)
+		// not return this error, the runs of the analyses record it
+	}
 	return nil
 }
 
 type BehaviorProfileRecords struct {
-	Count   int32
-	Records []string
+	Count      int32
+	Records    []string
+	NextCursor string
 }
 
 func (b business) GetBehaviorProfile(ctx context.Context, request *api.GetBehaviorProfileRequest, accountUuid string) (*BehaviorProfileRecords, error) {
@@ -382,17 +521,62 @@ func (b business) GetBehaviorProfile(ctx context.Context, request *api.GetBehavi
 		b.log.WithName("GetBehaviorProfile").Error(err, "No have permission with behavior table")
 		return nil, status.Error(codes.PermissionDenied, "No have permission with behavior")
 	}
+	masks, err := data_table.BehaviorColumnMasks(ctx, b.repository, accountUuid, &behaviorTable)
+	if err != nil {
+		b.log.WithName("GetBehaviorProfile").Error(err, "cannot get column masks", "behaviorTableId", behaviorTable.ID)
+		return nil, err
+	}
 	path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaBehaviorPath(masterSegment.ID, behaviorTable.Name))
+	if request.Query != nil {
+		err = masks.CheckQuery(request.Query)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := data_table.ParseSchema(behaviorTable.Schema)
+		if err != nil {
+			b.log.WithName("GetBehaviorProfile").Error(err, "cannot parse behavior schema", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		// The records of the profile are the rows matching its foreign key value, whatever the filter of the query
+		tableQuery := proto.Clone(request.Query).(*api.TableQuery)
+		tableQuery.Filter = &api.Rule{
+			Combinator: string(model.RuleCombinator_And),
+			Rules: []*api.Rule{
+				{Field: behaviorTable.ForeignKey, Operator: string(model.RuleOperator_Equal), Value: request.ForeignKeyValue},
+				request.Query.Filter,
+			},
+		}
+		result, err := data_table.QueryTable(ctx, b.queryAdapter, fmt.Sprintf("delta.`%s`", path), schema, tableQuery, "")
+		if err != nil {
+			b.log.WithName("GetBehaviorProfile").Error(err, "cannot query behavior table", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		records, err := masks.MaskRecords(result.Data)
+		if err != nil {
+			b.log.WithName("GetBehaviorProfile").Error(err, "cannot mask records", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		return &BehaviorProfileRecords{
+			Count:      int32(result.Count),
+			Records:    records,
+			NextCursor: result.NextCursor,
+		}, nil
+	}
 	queryResponse, err := b.queryAdapter.QueryRawSQLV2(ctx, &query.QueryRawSQLV2Request{
 		Query: fmt.Sprintf("SELECT * FROM delta.`%s` WHERE %s='%s';", path, behaviorTable.ForeignKey, request.ForeignKeyValue),
 	})
 	if err != nil {
 		return nil, err
 	}
+	records, err := masks.MaskRecords(queryResponse.Data)
+	if err != nil {
+		b.log.WithName("GetBehaviorProfile").Error(err, "cannot mask records", "behaviorTableId", behaviorTable.ID)
+		return nil, err
+	}
 
 	return &BehaviorProfileRecords{
 		Count:   int32(queryResponse.Count),
-		Records: queryResponse.Data,
+		Records: records,
 	}, nil
 }
 
@@ -0,0 +1,125 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AnalyzeSegmentOverlap counts the profiles of every combination of the segments in one query,
+// each profile gets a mask with the bit of every segment it is in and the counts per mask give the Venn, UpSet and Jaccard data
+func (b business) AnalyzeSegmentOverlap(ctx context.Context, request *api.AnalyzeSegmentOverlapRequest, accountUuid string) (*api.AnalyzeSegmentOverlapResponse, error) {
+	logger := b.log.WithName("AnalyzeSegmentOverlap").WithValues("request", request)
+
+	segments := make([]*model.Segment, 0, len(request.SegmentIds))
+	for _, segmentId := range request.SegmentIds {
+		segment, err := b.getOwnedSegment(ctx, segmentId, accountUuid)
+		if err != nil {
+			logger.Error(err, "cannot get segment", "segmentId", segmentId)
+			return nil, err
+		}
+		if len(segments) > 0 && segment.MasterSegmentId != segments[0].MasterSegmentId {
+			return nil, status.Error(codes.InvalidArgument, "segments must belong to the same master segment")
+		}
+		if segment.Status != model.SegmentStatus_UP_TO_DATE {
+			return nil, status.Errorf(codes.FailedPrecondition, "segment %s is not up to date", segment.Name)
+		}
+		segments = append(segments, segment)
+	}
+
+	memberships := make([]string, 0, len(segments))
+	for i, segment := range segments {
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(segment.MasterSegmentId, segment.ID))
+		memberships = append(memberships, fmt.Sprintf("SELECT DISTINCT %s, %d AS bit FROM delta.`%s` WHERE %s IS NOT NULL",
+			model.AudienceColumn_CdpSystemUuid, 1<<i, path, model.AudienceColumn_CdpSystemUuid))
+	}
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT mask, COUNT(*) AS count FROM (SELECT %s, SUM(bit) AS mask FROM (%s) AS memberships GROUP BY %s) AS masks GROUP BY mask",
+			model.AudienceColumn_CdpSystemUuid, strings.Join(memberships, " UNION ALL "), model.AudienceColumn_CdpSystemUuid),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query segment overlap")
+		return nil, err
+	}
+	// exclusiveCounts[mask] is the number of profiles in exactly the segments of mask
+	exclusiveCounts := make([]int64, 1<<len(segments))
+	for _, row := range queryResponse.Data {
+		mask, _ := row["mask"].(float64)
+		count, _ := row["count"].(float64)
+		if int(mask) > 0 && int(mask) < len(exclusiveCounts) {
+			exclusiveCounts[int(mask)] = int64(count)
+		}
+	}
+	// counts[mask] is the number of profiles in every segment of mask, whatever their other segments
+	counts := make([]int64, len(exclusiveCounts))
+	var unionCount int64
+	for mask := 1; mask < len(exclusiveCounts); mask++ {
+		unionCount += exclusiveCounts[mask]
+		for superset := 1; superset < len(exclusiveCounts); superset++ {
+			if superset&mask == mask {
+				counts[mask] += exclusiveCounts[superset]
+			}
+		}
+	}
+
+	overlapSegments := make([]*api.SegmentOverlapSegment, 0, len(segments))
+	for i, segment := range segments {
+		overlapSegments = append(overlapSegments, &api.SegmentOverlapSegment{
+			SegmentId: segment.ID,
+			Name:      segment.Name,
+			Size:      counts[1<<i],
+		})
+	}
+	intersections := make([]*api.SegmentIntersection, 0, len(counts)-1)
+	for mask := 1; mask < len(counts); mask++ {
+		segmentIds := make([]int64, 0, bits.OnesCount(uint(mask)))
+		for i, segment := range segments {
+			if mask&(1<<i) != 0 {
+				segmentIds = append(segmentIds, segment.ID)
+			}
+		}
+		intersections = append(intersections, &api.SegmentIntersection{
+			SegmentIds:     segmentIds,
+			Count:          counts[mask],
+			ExclusiveCount: exclusiveCounts[mask],
+		})
+	}
+	pairs := make([]*api.SegmentPairOverlap, 0)
+	for i := range segments {
+		for j := i + 1; j < len(segments); j++ {
+			intersectionCount := counts[1<<i|1<<j]
+			pairUnionCount := counts[1<<i] + counts[1<<j] - intersectionCount
+			var jaccard float64
+			if pairUnionCount > 0 {
+				jaccard = float64(intersectionCount) / float64(pairUnionCount)
+			}
+			pairs = append(pairs, &api.SegmentPairOverlap{
+				SegmentIdA:        segments[i].ID,
+				SegmentIdB:        segments[j].ID,
+				IntersectionCount: intersectionCount,
+				UnionCount:        pairUnionCount,
+				Jaccard:           jaccard,
+			})
+		}
+	}
+
+	return &api.AnalyzeSegmentOverlapResponse{
+		Code:            int32(code.Code_OK),
+		Message:         "Success",
+		MasterSegmentId: segments[0].MasterSegmentId,
+		Segments:        overlapSegments,
+		Intersections:   intersections,
+		Pairs:           pairs,
+		AllCount:        counts[len(counts)-1],
+		UnionCount:      unionCount,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package segment
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type overlapSegmentRepository struct {
+	repository.SegmentRepository
+	segments map[int64]model.Segment
+}
+
+func (r overlapSegmentRepository) GetSegment(ctx context.Context, segmentId int64) (model.Segment, error) {
+	return r.segments[segmentId], nil
+}
+
+func newOverlapBusiness(accountUuid uuid.UUID, rows []map[string]any, queries *[]string) business {
+	segments := map[int64]model.Segment{}
+	for id, name := range map[int64]string{11: "buyers", 12: "newsletter", 13: "churn risk"} {
+		segments[id] = model.Segment{ID: id, Name: name, AccountUuid: accountUuid, MasterSegmentId: 1, Status: model.SegmentStatus_UP_TO_DATE}
+	}
+	segments[21] = model.Segment{ID: 21, Name: "other master", AccountUuid: accountUuid, MasterSegmentId: 2, Status: model.SegmentStatus_UP_TO_DATE}
+	return business{
+		log:          logr.Discard(),
+		repository:   &repository.Repository{SegmentRepository: overlapSegmentRepository{segments: segments}},
+		queryAdapter: scriptedQueryAdapter{queries: queries, scripts: map[string][]map[string]any{"GROUP BY mask": rows}},
+		config:       &config.Config{S3StorageConfig: config.S3StorageConfig{Bucket: "bucket"}},
+	}
+}
+
+func TestAnalyzeSegmentOverlap(t *testing.T) {
+	accountUuid := uuid.New()
+	queries := make([]string, 0)
+	// masks of the profiles in exactly these segments: buyers is bit 1, newsletter bit 2, churn risk bit 4
+	b := newOverlapBusiness(accountUuid, []map[string]any{
+		{"mask": float64(1), "count": float64(10)},
+		{"mask": float64(2), "count": float64(5)},
+		{"mask": float64(3), "count": float64(4)},
+		{"mask": float64(4), "count": float64(7)},
+		{"mask": float64(5), "count": float64(2)},
+		{"mask": float64(7), "count": float64(1)},
+		{"mask": float64(9), "count": float64(100)},
+	}, &queries)
+	response, err := b.AnalyzeSegmentOverlap(context.Background(), &api.AnalyzeSegmentOverlapRequest{SegmentIds: []int64{11, 12, 13}}, accountUuid.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queries) != 1 {
+		t.Fatalf("got %d queries, want the overlap counted in one query", len(queries))
+	}
+	for i, bit := range []string{"1 AS bit FROM delta.`s3a://bucket/", "2 AS bit", "4 AS bit"} {
+		if !strings.Contains(queries[0], bit) {
+			t.Errorf("segment %d is not given its bit %q in %s", i, bit, queries[0])
+		}
+	}
+	// a mask outside of the segments is ignored
+	if response.UnionCount != 29 || response.AllCount != 1 {
+		t.Errorf("union = %d, all = %d, want 29 and 1", response.UnionCount, response.AllCount)
+	}
+	sizes := map[int64]int64{}
+	for _, segment := range response.Segments {
+		sizes[segment.SegmentId] = segment.Size
+	}
+	if sizes[11] != 17 || sizes[12] != 10 || sizes[13] != 10 {
+		t.Errorf("segment sizes = %v, want 17, 10 and 10", sizes)
+	}
+
+	if len(response.Intersections) != 7 {
+		t.Fatalf("got %d intersections, want one per non empty combination", len(response.Intersections))
+	}
+	newsletterAndChurn := response.Intersections[5]
+	if len(newsletterAndChurn.SegmentIds) != 2 || newsletterAndChurn.SegmentIds[0] != 12 || newsletterAndChurn.SegmentIds[1] != 13 {
+		t.Errorf("intersection of mask 6 = %v, want newsletter and churn risk", newsletterAndChurn.SegmentIds)
+	}
+	// nobody is in newsletter and churn risk only, but one profile is in every segment
+	if newsletterAndChurn.Count != 1 || newsletterAndChurn.ExclusiveCount != 0 {
+		t.Errorf("intersection of mask 6 = %d (exclusive %d), want 1 (exclusive 0)", newsletterAndChurn.Count, newsletterAndChurn.ExclusiveCount)
+	}
+
+	wantPairs := []struct {
+		intersection, union int64
+		jaccard             float64
+	}{
+		{5, 22, 5.0 / 22},
+		{3, 24, 0.125},
+		{1, 19, 1.0 / 19},
+	}
+	if len(response.Pairs) != len(wantPairs) {
+		t.Fatalf("got %d pairs, want %d", len(response.Pairs), len(wantPairs))
+	}
+	for idx, want := range wantPairs {
+		pair := response.Pairs[idx]
+		if pair.IntersectionCount != want.intersection || pair.UnionCount != want.union || pair.Jaccard != want.jaccard {
+			t.Errorf("pair %d-%d = %d/%d (%v), want %d/%d (%v)", pair.SegmentIdA, pair.SegmentIdB,
+				pair.IntersectionCount, pair.UnionCount, pair.Jaccard, want.intersection, want.union, want.jaccard)
+		}
+	}
+}
+
+func TestAnalyzeSegmentOverlapRejectsOtherMasterSegment(t *testing.T) {
+	accountUuid := uuid.New()
+	queries := make([]string, 0)
+	b := newOverlapBusiness(accountUuid, nil, &queries)
+	_, err := b.AnalyzeSegmentOverlap(context.Background(), &api.AnalyzeSegmentOverlapRequest{SegmentIds: []int64{11, 21}}, accountUuid.String())
+	if status.Code(err) != codes.InvalidArgument || len(queries) != 0 {
+		t.Errorf("AnalyzeSegmentOverlap() = %v after %d queries, want InvalidArgument before querying", err, len(queries))
+	}
+}
@@ -0,0 +1,180 @@
+package segment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProfileMasterSegment profiles the audience table and every behavior table of the master segment,
+// the audience profile is stored with the master segment id
+func (b business) ProfileMasterSegment(ctx context.Context, request *api.ProfileMasterSegmentRequest, accountUuid string) (*api.ProfileMasterSegmentResponse, error) {
+	logger := b.log.WithName("ProfileMasterSegment").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	if masterSegment.Status != model.MasterSegmentStatus_UP_TO_DATE {
+		return nil, status.Error(codes.FailedPrecondition, "Master segment is not up to date")
+	}
+
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		logger.Error(err, "cannot get audience table")
+		return nil, err
+	}
+	audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse audience schema")
+		return nil, err
+	}
+	audienceProfile, err := data_table.ProfileDeltaTable(ctx, b.queryAdapter,
+		fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegment.ID)), audienceSchema)
+	if err != nil {
+		logger.Error(err, "cannot profile audience table")
+		return nil, err
+	}
+	err = data_table.SaveDataProfile(ctx, b.repository, model.DataProfileObjectType_Audience, masterSegment.ID, audienceProfile)
+	if err != nil {
+		logger.Error(err, "cannot save audience profile")
+		return nil, err
+	}
+
+	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		logger.Error(err, "cannot get behavior tables")
+		return nil, err
+	}
+	behaviorProfiles := make([]*api.BehaviorTableProfile, 0, len(behaviorTables))
+	for _, behaviorTable := range behaviorTables {
+		behaviorSchema, err := data_table.ParseSchema(behaviorTable.Schema)
+		if err != nil {
+			logger.Error(err, "cannot parse behavior schema", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		behaviorProfile, err := data_table.ProfileDeltaTable(ctx, b.queryAdapter,
+			fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaBehaviorPath(masterSegment.ID, behaviorTable.Name)), behaviorSchema)
+		if err != nil {
+			logger.Error(err, "cannot profile behavior table", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		err = data_table.SaveDataProfile(ctx, b.repository, model.DataProfileObjectType_Behavior, behaviorTable.ID, behaviorProfile)
+		if err != nil {
+			logger.Error(err, "cannot save behavior profile", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		behaviorProfiles = append(behaviorProfiles, &api.BehaviorTableProfile{
+			BehaviorTableId: behaviorTable.ID,
+			Name:            behaviorTable.Name,
+			Profile:         behaviorProfile,
+		})
+	}
+	err = b.maskMasterSegmentProfiles(ctx, accountUuid, masterSegment.ID, audienceProfile, behaviorTables, behaviorProfiles)
+	if err != nil {
+		logger.Error(err, "cannot mask profiles")
+		return nil, err
+	}
+
+	return &api.ProfileMasterSegmentResponse{
+		Code:           int32(code.Code_OK),
+		Message:        "Success",
+		Audience:       audienceProfile,
+		BehaviorTables: behaviorProfiles,
+	}, nil
+}
+
+func (b business) GetMasterSegmentStatistics(ctx context.Context, request *api.GetMasterSegmentStatisticsRequest, accountUuid string) (*api.GetMasterSegmentStatisticsResponse, error) {
+	logger := b.log.WithName("GetMasterSegmentStatistics").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+
+	audienceDataProfile, err := b.repository.DataProfileRepository.GetDataProfile(ctx, model.DataProfileObjectType_Audience, masterSegment.ID)
+	if err != nil {
+		logger.Error(err, "cannot get audience profile")
+		return nil, err
+	}
+	audienceProfile, err := data_table.ParseDataProfile(audienceDataProfile)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal audience profile")
+		return nil, err
+	}
+
+	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		logger.Error(err, "cannot get behavior tables")
+		return nil, err
+	}
+	dataProfiles, err := b.repository.DataProfileRepository.ListDataProfiles(ctx, model.DataProfileObjectType_Behavior,
+		utils.Map(behaviorTables, func(behaviorTable model.BehaviorTable) int64 { return behaviorTable.ID }))
+	if err != nil {
+		logger.Error(err, "cannot list behavior profiles")
+		return nil, err
+	}
+	dataProfileByBehaviorTableId := make(map[int64]*model.DataProfile, len(dataProfiles))
+	for idx := range dataProfiles {
+		dataProfileByBehaviorTableId[dataProfiles[idx].ObjectId] = &dataProfiles[idx]
+	}
+
+	behaviorProfiles := make([]*api.BehaviorTableProfile, 0, len(behaviorTables))
+	for _, behaviorTable := range behaviorTables {
+		behaviorProfile, err := data_table.ParseDataProfile(dataProfileByBehaviorTableId[behaviorTable.ID])
+		if err != nil {
+			logger.Error(err, "cannot unmarshal behavior profile", "behaviorTableId", behaviorTable.ID)
+			return nil, err
+		}
+		behaviorProfiles = append(behaviorProfiles, &api.BehaviorTableProfile{
+			BehaviorTableId: behaviorTable.ID,
+			Name:            behaviorTable.Name,
+			Profile:         behaviorProfile,
+		})
+	}
+	err = b.maskMasterSegmentProfiles(ctx, accountUuid, masterSegment.ID, audienceProfile, behaviorTables, behaviorProfiles)
+	if err != nil {
+		logger.Error(err, "cannot mask profiles")
+		return nil, err
+	}
+
+	return &api.GetMasterSegmentStatisticsResponse{
+		Code:           int32(code.Code_OK),
+		Message:        "Success",
+		Audience:       audienceProfile,
+		BehaviorTables: behaviorProfiles,
+	}, nil
+}
+
+// maskMasterSegmentProfiles masks the returned profiles, the saved profiles keep the raw values
+func (b business) maskMasterSegmentProfiles(ctx context.Context, accountUuid string, masterSegmentId int64, audienceProfile *api.DataProfile, behaviorTables []model.BehaviorTable, behaviorProfiles []*api.BehaviorTableProfile) error {
+	audienceMasks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, masterSegmentId)
+	if err != nil {
+		return err
+	}
+	audienceMasks.MaskProfile(audienceProfile)
+	for idx := range behaviorTables {
+		behaviorMasks, err := data_table.BehaviorColumnMasks(ctx, b.repository, accountUuid, &behaviorTables[idx])
+		if err != nil {
+			return err
+		}
+		behaviorMasks.MaskProfile(behaviorProfiles[idx].Profile)
+	}
+	return nil
+}
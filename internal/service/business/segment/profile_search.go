@@ -0,0 +1,303 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (b business) UpdateMasterSegmentSearchableColumns(ctx context.Context, request *api.UpdateMasterSegmentSearchableColumnsRequest, accountUuid string) (*api.UpdateMasterSegmentSearchableColumnsResponse, error) {
+	logger := b.log.WithName("UpdateMasterSegmentSearchableColumns").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+	if err != nil {
+		logger.Error(err, "cannot get audience table")
+		return nil, err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal audience build configuration")
+		return nil, err
+	}
+
+	audienceColumns, err := b.audienceColumnNames(ctx, &configuration)
+	if err != nil {
+		logger.Error(err, "cannot get audience columns")
+		return nil, err
+	}
+	schema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse audience schema")
+		return nil, err
+	}
+	for _, column := range schema {
+		audienceColumns = append(audienceColumns, column.ColumnName)
+	}
+	err = validateSearchableColumns(request.SearchableColumns, audienceColumns, configuration.ComputedAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration.SearchableColumns = request.SearchableColumns
+	jsonConfiguration, err := json.Marshal(configuration)
+	if err != nil {
+		logger.Error(err, "cannot marshal audience build configuration")
+		return nil, err
+	}
+	err = b.repository.SegmentRepository.UpdateAudienceTable(ctx, &repository.UpdateAudienceTableParams{
+		Id:                 audienceTable.ID,
+		BuildConfiguration: pqtype.NullRawMessage{RawMessage: jsonConfiguration, Valid: true},
+	})
+	if err != nil {
+		logger.Error(err, "cannot update audience table")
+		return nil, err
+	}
+
+	// a master segment being built is indexed when its build succeeds
+	if masterSegment.Status == model.MasterSegmentStatus_UP_TO_DATE {
+		err = b.rebuildProfileSearchIndex(ctx, masterSegment.ID, configuration.SearchableColumns)
+		if err != nil {
+			logger.Error(err, "cannot rebuild profile search index")
+			return nil, err
+		}
+	}
+
+	return &api.UpdateMasterSegmentSearchableColumnsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+	}, nil
+}
+
+func (b business) SearchProfiles(ctx context.Context, request *api.SearchProfilesRequest, accountUuid string) (*api.SearchProfilesResponse, error) {
+	logger := b.log.WithName("SearchProfiles").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+
+	normalizedQuery := normalizeSearchValue(request.Query)
+	if normalizedQuery == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is blank")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = model.ProfileSearch_DefaultLimit
+	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, masterSegment.ID)
+	if err != nil {
+		logger.Error(err, "cannot get column masks")
+		return nil, err
+	}
+	// prefix and fuzzy matches would recover a masked value one character at a time, masked columns are not searched
+	columns := request.Columns
+	if len(columns) == 0 {
+		audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegment.ID})
+		if err != nil {
+			logger.Error(err, "cannot get audience table")
+			return nil, err
+		}
+		var configuration repository.AudienceBuildConfiguration
+		err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+		if err != nil {
+			logger.Error(err, "cannot unmarshal audience build configuration")
+			return nil, err
+		}
+		for _, column := range configuration.SearchableColumns {
+			if _, ok := masks[column]; !ok {
+				columns = append(columns, column)
+			}
+		}
+	}
+	for _, column := range columns {
+		err = masks.CheckColumn(column)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*api.ProfileSearchHit, 0, limit)
+	if len(columns) == 0 {
+		return &api.SearchProfilesResponse{
+			Code:    int32(code.Code_OK),
+			Message: "Success",
+			Results: results,
+		}, nil
+	}
+	matches, err := b.repository.ProfileSearchIndexRepository.SearchProfileSearchIndex(ctx, &repository.SearchProfileSearchIndexParams{
+		MasterSegmentId: masterSegment.ID,
+		NormalizedQuery: normalizedQuery,
+		Columns:         columns,
+		Fuzzy:           request.Fuzzy,
+		Limit:           limit,
+	})
+	if err != nil {
+		logger.Error(err, "cannot search profile search index")
+		return nil, err
+	}
+
+	for _, match := range matches {
+		if len(results) == 0 || results[len(results)-1].CdpSystemUuid != match.CdpSystemUuid {
+			results = append(results, &api.ProfileSearchHit{
+				CdpSystemUuid: match.CdpSystemUuid,
+				Score:         match.ProfileScore,
+			})
+		}
+		hit := results[len(results)-1]
+		hit.Matches = append(hit.Matches, &api.ProfileSearchMatch{
+			Column:    match.ColumnName,
+			Value:     match.Value,
+			Highlight: highlightSearchValue(match.Value, request.Query),
+			Score:     match.Score,
+		})
+	}
+
+	return &api.SearchProfilesResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Results: results,
+	}, nil
+}
+
+func validateSearchableColumns(searchableColumns []string, audienceColumns []string, computedAttributes []*api.ComputedAttribute) error {
+	for _, attribute := range computedAttributes {
+		audienceColumns = append(audienceColumns, attribute.Name)
+	}
+	for _, column := range searchableColumns {
+		if !slices.Contains(audienceColumns, column) {
+			return status.Errorf(codes.InvalidArgument, "searchable column %s is not a column of the audience", column)
+		}
+	}
+	return nil
+}
+
+// rebuildProfileSearchIndex reads the searchable columns of the whole audience once and replaces the index of the master segment
+func (b business) rebuildProfileSearchIndex(ctx context.Context, masterSegmentId int64, searchableColumns []string) error {
+	entries := make([]model.ProfileSearchIndex, 0)
+	if len(searchableColumns) > 0 {
+		projection := []string{model.AudienceColumn_CdpSystemUuid}
+		for idx, column := range searchableColumns {
+			projection = append(projection, fmt.Sprintf("CAST(%s AS STRING) AS value_%d", utils.QuoteSparkIdentifier(column), idx))
+		}
+		path := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegmentId))
+		response, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+			Query: fmt.Sprintf("SELECT %s FROM delta.`%s`", strings.Join(projection, ", "), path),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range response.Data {
+			cdpSystemUuid, _ := row[model.AudienceColumn_CdpSystemUuid].(string)
+			if cdpSystemUuid == "" {
+				continue
+			}
+			for idx, column := range searchableColumns {
+				value, _ := row[fmt.Sprintf("value_%d", idx)].(string)
+				value = strings.TrimSpace(value)
+				if len(value) > model.ProfileSearch_MaxValueLength {
+					value = strings.ToValidUTF8(value[:model.ProfileSearch_MaxValueLength], "")
+				}
+				normalizedValue := normalizeSearchValue(value)
+				if normalizedValue == "" {
+					continue
+				}
+				entries = append(entries, model.ProfileSearchIndex{
+					MasterSegmentId: masterSegmentId,
+					CdpSystemUuid:   cdpSystemUuid,
+					ColumnName:      column,
+					Value:           value,
+					NormalizedValue: normalizedValue,
+				})
+			}
+		}
+	}
+
+	return b.repository.ProfileSearchIndexRepository.ReplaceProfileSearchIndex(ctx, masterSegmentId, entries)
+}
+
+// normalizeSearchValue makes values and queries comparable: lower case with single spaces
+func normalizeSearchValue(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), " ")
+}
+
+// highlightSearchValue wraps the occurrences of the query in value with <em></em>, or the occurrences of its words
+// when the whole query does not occur, as for fuzzy matches
+func highlightSearchValue(value string, searchQuery string) string {
+	lowerValue := strings.ToLower(value)
+	if len(lowerValue) != len(value) {
+		// lower casing changed the byte offsets, nothing can be highlighted safely
+		return html.EscapeString(value)
+	}
+
+	terms := []string{normalizeSearchValue(searchQuery)}
+	if !strings.Contains(lowerValue, terms[0]) {
+		terms = strings.Fields(terms[0])
+	}
+	type span struct{ start, end int }
+	spans := make([]span, 0)
+	for _, term := range terms {
+		// an empty term matches everywhere without moving the offset
+		if term == "" {
+			continue
+		}
+		for offset := 0; offset < len(lowerValue); {
+			idx := strings.Index(lowerValue[offset:], term)
+			if idx < 0 {
+				break
+			}
+			spans = append(spans, span{offset + idx, offset + idx + len(term)})
+			offset += idx + len(term)
+		}
+	}
+	if len(spans) == 0 {
+		return html.EscapeString(value)
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	var builder strings.Builder
+	position := 0
+	for _, s := range spans {
+		if s.end <= position {
+			continue
+		}
+		if s.start < position {
+			s.start = position
+		}
+		builder.WriteString(html.EscapeString(value[position:s.start]))
+		builder.WriteString("<em>")
+		builder.WriteString(html.EscapeString(value[s.start:s.end]))
+		builder.WriteString("</em>")
+		position = s.end
+	}
+	builder.WriteString(html.EscapeString(value[position:]))
+	return builder.String()
+}
@@ -0,0 +1,100 @@
+package segment
+
+import (
+	"context"
+	"html"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+)
+
+func TestHighlightSearchValue(t *testing.T) {
+	highlighted := map[[2]string]string{
+		{"Nguyen Van An", "  VAN   an "}: "Nguyen <em>Van An</em>",
+		{"ana banana", "ana"}:            "<em>ana</em> b<em>ana</em>na",
+		{"Tran Thi Binh", "binh tran"}:   "<em>Tran</em> Thi <em>Binh</em>",
+		{"abcdef", "abc bcd"}:            "<em>abc</em><em>d</em>ef",
+		{"<b>Tom</b> & Jerry", "tom"}:    "&lt;b&gt;<em>Tom</em>&lt;/b&gt; &amp; Jerry",
+		{"İstanbul", "stan"}:             "İstanbul",
+		{"Le Minh", ""}:                  "Le Minh",
+		{"Le Minh", "hoa"}:               "Le Minh",
+		{"support@cdp.io", "@CDP"}:       "support<em>@cdp</em>.io",
+		// a non-breaking space is not the space of the query, its words are highlighted
+		{"a\u00a0b", "a b"}:                    "<em>a</em>\u00a0<em>b</em>",
+		{"Phạm Thị Lan", "thị"}:                "Phạm <em>Thị</em> Lan",
+		{"Jerry & Tom's", "& tom's"}:           "Jerry <em>&amp; Tom&#39;s</em>",
+		{"TOM tom Tom", "tom"}:                 "<em>TOM</em> <em>tom</em> <em>Tom</em>",
+		{"the words out of order", "order of"}: "the words out <em>of</em> <em>order</em>",
+	}
+	for input, want := range highlighted {
+		got := highlightSearchValue(input[0], input[1])
+		// the highlight only adds tags around the escaped value
+		if stripped := html.UnescapeString(strings.NewReplacer("<em>", "", "</em>", "").Replace(got)); stripped != input[0] {
+			t.Errorf("highlightSearchValue(%q, %q) = %q changed the value to %q", input[0], input[1], got, stripped)
+		}
+		if got != want {
+			t.Errorf("highlightSearchValue(%q, %q) = %q, want %q", input[0], input[1], got, want)
+		}
+	}
+}
+
+type searchIndexRepository struct {
+	repository.ProfileSearchIndexRepository
+	entries []model.ProfileSearchIndex
+}
+
+func (r *searchIndexRepository) ReplaceProfileSearchIndex(ctx context.Context, masterSegmentId int64, entries []model.ProfileSearchIndex) error {
+	r.entries = entries
+	return nil
+}
+
+type audienceQueryAdapter struct {
+	query.QueryAdapter
+	rows []map[string]any
+}
+
+func (a audienceQueryAdapter) QueryRawSQL(ctx context.Context, request *query.QueryRawSQLRequest) (*query.QueryRawSQLResponse, error) {
+	return &query.QueryRawSQLResponse{Data: a.rows}, nil
+}
+
+func TestRebuildProfileSearchIndex(t *testing.T) {
+	longName := strings.Repeat("a", model.ProfileSearch_MaxValueLength-1) + "ằ"
+	index := &searchIndexRepository{}
+	b := business{
+		repository: &repository.Repository{ProfileSearchIndexRepository: index},
+		queryAdapter: audienceQueryAdapter{rows: []map[string]any{
+			{model.AudienceColumn_CdpSystemUuid: "p1", "value_0": "  Nguyen  Van An ", "value_1": "AN@CDP.IO"},
+			{model.AudienceColumn_CdpSystemUuid: "p2", "value_0": "   ", "value_1": nil},
+			{model.AudienceColumn_CdpSystemUuid: "", "value_0": "Le Minh"},
+			{model.AudienceColumn_CdpSystemUuid: "p3", "value_0": longName},
+		}},
+		config: &config.Config{},
+	}
+	if err := b.rebuildProfileSearchIndex(context.Background(), 1, []string{"name", "email"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// blank values and rows without profile are not indexed, a long value is cut on a rune boundary
+	if len(index.entries) != 3 {
+		t.Fatalf("index = %v", index.entries)
+	}
+	name, email, long := index.entries[0], index.entries[1], index.entries[2]
+	if name.ColumnName != "name" || name.Value != "Nguyen  Van An" || name.NormalizedValue != "nguyen van an" {
+		t.Errorf("name entry = %+v", name)
+	}
+	if email.ColumnName != "email" || email.CdpSystemUuid != "p1" || email.NormalizedValue != "an@cdp.io" {
+		t.Errorf("email entry = %+v", email)
+	}
+	if long.CdpSystemUuid != "p3" || long.Value != longName[:model.ProfileSearch_MaxValueLength-1] {
+		t.Errorf("long entry has %d bytes", len(long.Value))
+	}
+
+	// no searchable column empties the index
+	if err := b.rebuildProfileSearchIndex(context.Background(), 1, nil); err != nil || len(index.entries) != 0 {
+		t.Errorf("index without searchable columns = %v, %v", index.entries, err)
+	}
+}
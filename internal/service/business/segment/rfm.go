@@ -0,0 +1,638 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/airflow"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// rfmLabelCase labels the profiles by their scores brought to a 1 to 5 scale, the first matching label wins
+var rfmLabelCase = []struct {
+	condition string
+	label     model.RFMSegmentLabel
+}{
+	{"r >= 4 AND f >= 4 AND m >= 4", model.RFMSegmentLabel_Champions},
+	{"r <= 2 AND f >= 4 AND m >= 4", model.RFMSegmentLabel_CannotLoseThem},
+	{"r >= 3 AND f >= 4", model.RFMSegmentLabel_LoyalCustomers},
+	{"r <= 2 AND f >= 3", model.RFMSegmentLabel_AtRisk},
+	{"r >= 4 AND f <= 1", model.RFMSegmentLabel_NewCustomers},
+	{"r >= 4", model.RFMSegmentLabel_PotentialLoyalists},
+	{"r = 3", model.RFMSegmentLabel_NeedAttention},
+	{"r = 2", model.RFMSegmentLabel_Hibernating},
+}
+
+// CreateRFMAnalysis scores the profiles of the master segment by the recency, frequency and monetary value of their transactions,
+// writes the scores as audience attributes and creates one segment per standard RFM label. The scores and the segments are
+// computed in the background, the last run of the analysis tells when they are ready
+func (b business) CreateRFMAnalysis(ctx context.Context, request *api.CreateRFMAnalysisRequest, accountUuid string) (*api.CreateRFMAnalysisResponse, error) {
+	logger := b.log.WithName("CreateRFMAnalysis").WithValues("request", request)
+
+	masterSegment, audienceTable, configuration, err := b.getRefreshableMasterSegment(ctx, request.MasterSegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.Status != model.MasterSegmentStatus_UP_TO_DATE {
+		return nil, status.Error(codes.FailedPrecondition, "Master segment is not up to date")
+	}
+	behaviorTable, err := b.repository.SegmentRepository.GetBehaviorTable(ctx, &repository.GetBehaviorTableParams{Id: request.BehaviorTableId})
+	if err != nil {
+		logger.Error(err, "cannot get behavior table")
+		return nil, err
+	}
+	if behaviorTable.ID == 0 || behaviorTable.MasterSegmentId != masterSegment.ID {
+		return nil, status.Error(codes.NotFound, "Behavior table not found in master segment")
+	}
+
+	behaviorSchema, err := data_table.ParseSchema(behaviorTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse behavior schema")
+		return nil, err
+	}
+	dateType, _ := data_table.SchemaColumnType(behaviorSchema, request.DateColumn)
+	if dateType != string(model.ColumnDataType_Date) && dateType != string(model.ColumnDataType_Timestamp) {
+		return nil, status.Errorf(codes.InvalidArgument, "date column %s must be a date or timestamp column of the behavior table", request.DateColumn)
+	}
+	amountType, _ := data_table.SchemaColumnType(behaviorSchema, request.AmountColumn)
+	if amountType != string(model.ColumnDataType_Int) && amountType != string(model.ColumnDataType_Float) {
+		return nil, status.Errorf(codes.InvalidArgument, "amount column %s must be a numeric column of the behavior table", request.AmountColumn)
+	}
+	customerKey := request.CustomerKey
+	if customerKey == "" {
+		customerKey = behaviorTable.ForeignKey
+	}
+	if _, ok := data_table.SchemaColumnType(behaviorSchema, customerKey); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "customer key %s is not a column of the behavior table", customerKey)
+	}
+	audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		logger.Error(err, "cannot parse audience schema")
+		return nil, err
+	}
+	if customerKey != behaviorTable.ForeignKey {
+		if _, ok := data_table.SchemaColumnType(audienceSchema, customerKey); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "customer key %s must also be a column of the audience", customerKey)
+		}
+	} else if behaviorJoinColumn(configuration, behaviorTable.JoinKey) == "" {
+		return nil, status.Error(codes.FailedPrecondition, "the join key of the behavior table is not a column of the audience")
+	}
+
+	bins := request.Bins
+	if bins == 0 {
+		bins = model.RFMAnalysis_DefaultBins
+	}
+	if bins < 2 {
+		return nil, status.Error(codes.InvalidArgument, "bins must be between 2 and 10")
+	}
+	attributePrefix := request.AttributePrefix
+	if attributePrefix == "" {
+		attributePrefix = model.RFMAnalysis_DefaultAttributePrefix
+	}
+	analyses, err := b.repository.RFMAnalysisRepository.ListRFMAnalyses(ctx, masterSegment.ID)
+	if err != nil {
+		logger.Error(err, "cannot list rfm analyses")
+		return nil, err
+	}
+	var retriedAnalysis *model.RFMAnalysis
+	for idx, analysis := range analyses {
+		if analysis.AttributePrefix != attributePrefix {
+			continue
+		}
+		dataAction, err := b.repository.DataActionRepository.GetDataAction(ctx, analysis.DataActionId)
+		if err != nil {
+			logger.Error(err, "cannot get data action of rfm analysis", "id", analysis.ID)
+			return nil, err
+		}
+		if dataAction == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "attribute prefix %s is used by the RFM analysis %s", attributePrefix, analysis.Name)
+		}
+		var lastRun *model.DataActionRun
+		if dataAction.Status == model.DataActionStatus_Processing {
+			lastRun, err = b.repository.DataActionRunRepository.GetLatestDataActionRun(ctx, dataAction.ID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				logger.Error(err, "cannot get rfm analysis run", "id", analysis.ID)
+				return nil, err
+			}
+		}
+		// a failed or lost analysis is run again with the request, it keeps the attribute columns and the segments it created
+		if dataAction.Status != model.DataActionStatus_Failed && !rfmAnalysisLost(dataAction, lastRun, time.Now()) {
+			return nil, status.Errorf(codes.AlreadyExists, "attribute prefix %s is used by the RFM analysis %s", attributePrefix, analysis.Name)
+		}
+		retriedAnalysis = &analyses[idx]
+	}
+	if retriedAnalysis == nil {
+		for _, column := range rfmAttributeColumns(attributePrefix) {
+			if _, ok := data_table.SchemaColumnType(audienceSchema, column.ColumnName); ok {
+				return nil, status.Errorf(codes.AlreadyExists, "audience column %s already exists, choose another attribute prefix", column.ColumnName)
+			}
+		}
+	}
+
+	analysis := &model.RFMAnalysis{
+		AccountUuid:     uuid.MustParse(accountUuid),
+		MasterSegmentId: masterSegment.ID,
+		Name:            request.Name,
+		BehaviorTableId: behaviorTable.ID,
+		CustomerKey:     customerKey,
+		DateColumn:      request.DateColumn,
+		AmountColumn:    request.AmountColumn,
+		Bins:            bins,
+		AttributePrefix: attributePrefix,
+	}
+	err = b.db.Transaction(func(tx *gorm.DB) error {
+		if retriedAnalysis != nil {
+			analysis.ID = retriedAnalysis.ID
+			analysis.DataActionId = retriedAnalysis.DataActionId
+			analysis.Segments = retriedAnalysis.Segments
+			analysis.CreatedAt = retriedAnalysis.CreatedAt
+			err := b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+				Tx:     tx,
+				ID:     analysis.DataActionId,
+				Status: model.DataActionStatus_Processing,
+			})
+			if err != nil {
+				return err
+			}
+			return b.repository.RFMAnalysisRepository.UpdateRFMAnalysis(ctx, analysis)
+		}
+		// the action runs in the backend, it has no dag and is never PENDING so the dag jobs skip it
+		dataAction, err := b.repository.DataActionRepository.CreateDataAction(ctx, &repository.CreateDataActionParams{
+			Tx:          tx,
+			TargetTable: model.TargetTable_RFMAnalysis,
+			ActionType:  model.ActionType_RFMAnalysis,
+			AccountUuid: analysis.AccountUuid,
+			Status:      model.DataActionStatus_Processing,
+			ObjectId:    masterSegment.ID,
+		})
+		if err != nil {
+			return err
+		}
+		analysis.DataActionId = dataAction.ID
+		return b.repository.RFMAnalysisRepository.CreateRFMAnalysis(ctx, tx, analysis)
+	})
+	if err != nil {
+		logger.Error(err, "cannot create rfm analysis")
+		return nil, err
+	}
+
+	// scoring the whole audience outlives the request, the run of the analysis records its outcome
+	go b.completeRFMAnalysis(context.Background(), *analysis)
+
+	rfmAnalysis, err := b.toApiRFMAnalysis(ctx, analysis)
+	if err != nil {
+		logger.Error(err, "cannot get rfm analysis run")
+		return nil, err
+	}
+	return &api.CreateRFMAnalysisResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		RfmAnalysis: rfmAnalysis,
+	}, nil
+}
+
+// completeRFMAnalysis scores the audience with a created analysis and creates its segments.
+// A failure leaves its data action FAILED, creating the analysis again with the same attribute prefix retries it
+func (b business) completeRFMAnalysis(ctx context.Context, analysis model.RFMAnalysis) {
+	logger := b.log.WithName("completeRFMAnalysis").WithValues("id", analysis.ID)
+
+	err := b.applyRFMAnalysis(ctx, &analysis)
+	if err != nil {
+		logger.Error(err, "cannot apply rfm analysis")
+		return
+	}
+	err = b.syncRFMSegments(ctx, &analysis)
+	if err != nil {
+		logger.Error(b.failRFMAnalysis(ctx, &analysis, 0, err), "cannot sync rfm segments")
+	}
+}
+
+// rfmAnalysisLost tells a PROCESSING analysis whose scoring stopped with the backend, neither its action nor its last run
+// is updated for model.RFMAnalysis_RunTimeout
+func rfmAnalysisLost(dataAction *model.DataAction, lastRun *model.DataActionRun, now time.Time) bool {
+	if dataAction.Status != model.DataActionStatus_Processing {
+		return false
+	}
+	updatedAt := dataAction.UpdatedAt
+	if lastRun != nil && lastRun.UpdatedAt.After(updatedAt) {
+		updatedAt = lastRun.UpdatedAt
+	}
+	return now.Sub(updatedAt) > model.RFMAnalysis_RunTimeout
+}
+
+// failRFMAnalysis records the cause on the run of the analysis when it has one and leaves its data action FAILED,
+// a PROCESSING action would keep the analysis from being created again
+func (b business) failRFMAnalysis(ctx context.Context, analysis *model.RFMAnalysis, dataActionRunId int64, cause error) error {
+	errs := []error{cause}
+	if dataActionRunId > 0 {
+		err := b.repository.DataActionRunRepository.UpdateDataActionRunError(ctx, dataActionRunId, cause.Error())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("update data action run error: %w", err))
+		}
+	}
+	err := b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+		ID:     analysis.DataActionId,
+		Status: model.DataActionStatus_Failed,
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("update data action status: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// syncRFMSegments creates the segments missing for the labels of the analysis and rebuilds the others with the current scores
+func (b business) syncRFMSegments(ctx context.Context, analysis *model.RFMAnalysis) error {
+	var rfmSegments []model.RFMSegment
+	if analysis.Segments.Valid {
+		err := json.Unmarshal(analysis.Segments.RawMessage, &rfmSegments)
+		if err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	segmentColumn := utils.QuoteSparkIdentifier(analysis.AttributePrefix + "_segment")
+	for _, label := range model.RFMSegmentLabels {
+		idx := slices.IndexFunc(rfmSegments, func(rfmSegment model.RFMSegment) bool { return rfmSegment.Label == label })
+		if idx >= 0 {
+			err := b.rebuildSegment(ctx, rfmSegments[idx].SegmentId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("rebuild segment %d: %w", rfmSegments[idx].SegmentId, err))
+			}
+			continue
+		}
+
+		segment, err := b.createSegment(ctx, &api.CreateSegmentRequest{
+			MasterSegmentId: analysis.MasterSegmentId,
+			Name:            fmt.Sprintf("%s - %s", analysis.Name, label),
+			Description:     fmt.Sprintf("Profiles labeled %s by the RFM analysis %s", label, analysis.Name),
+			Condition: &api.Rule{
+				Combinator: "and",
+				Rules: []*api.Rule{{
+					Field:    analysis.AttributePrefix + "_segment",
+					Operator: string(model.RuleOperator_Equal),
+					Value:    string(label),
+				}},
+			},
+			SqlCondition: fmt.Sprintf("%s = %s", segmentColumn, utils.QuoteSparkString(string(label))),
+		}, analysis.AccountUuid.String())
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("create segment %s: %w", label, err))...)
+		}
+		// the segments are saved one by one, a retry does not create them twice
+		rfmSegments = append(rfmSegments, model.RFMSegment{Label: label, SegmentId: segment.ID})
+		jsonSegments, err := json.Marshal(rfmSegments)
+		if err != nil {
+			return err
+		}
+		analysis.Segments = pqtype.NullRawMessage{RawMessage: jsonSegments, Valid: true}
+		err = b.repository.RFMAnalysisRepository.UpdateRFMAnalysisSegments(ctx, analysis.ID, analysis.Segments)
+		if err != nil {
+			return err
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// rebuildSegment runs the dag of the segment again, a segment waiting for its first build or building is left to that build
+func (b business) rebuildSegment(ctx context.Context, segmentId int64) error {
+	result, err := b.repository.DataActionRepository.GetListDataActions(ctx, &repository.GetListDataActionsParams{
+		ActionTypes: []string{string(model.ActionType_CreateSegment)},
+		TargetTable: model.TargetTable_Segment,
+		ObjectId:    segmentId,
+	})
+	if err != nil {
+		return err
+	}
+	if len(result.DataActions) == 0 {
+		return fmt.Errorf("segment %d has no build action", segmentId)
+	}
+	dataAction := result.DataActions[0]
+	if dataAction.Status == model.DataActionStatus_Pending || dataAction.Status == model.DataActionStatus_Processing {
+		return nil
+	}
+
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		dagRun, err := b.airflowAdapter.TriggerNewDagRun(ctx, dataAction.DagId, &airflow.TriggerNewDagRunRequest{})
+		if err != nil {
+			return err
+		}
+		_, err = b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+			Tx:          tx,
+			ActionId:    dataAction.ID,
+			RunId:       dataAction.RunCount + 1,
+			DagRunId:    dagRun.DagRunId,
+			Status:      model.DataActionRunStatus_Processing,
+			AccountUuid: dataAction.AccountUuid,
+		})
+		if err != nil {
+			return err
+		}
+		err = b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+			Tx:       tx,
+			ID:       dataAction.ID,
+			Status:   model.DataActionStatus_Processing,
+			RunCount: dataAction.RunCount + 1,
+		})
+		if err != nil {
+			return err
+		}
+		return b.repository.SegmentRepository.UpdateSegment(ctx, &repository.UpdateSegmentParams{
+			Tx:     tx,
+			Id:     segmentId,
+			Status: string(model.SegmentStatus_UPDATING),
+		})
+	})
+}
+
+func (b business) GetListRFMAnalyses(ctx context.Context, request *api.GetListRFMAnalysesRequest, accountUuid string) (*api.GetListRFMAnalysesResponse, error) {
+	logger := b.log.WithName("GetListRFMAnalyses").WithValues("request", request)
+
+	masterSegment, err := b.repository.SegmentRepository.GetMasterSegment(ctx, request.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	if masterSegment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "No have permission with master segment")
+	}
+	analyses, err := b.repository.RFMAnalysisRepository.ListRFMAnalyses(ctx, request.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot list rfm analyses")
+		return nil, err
+	}
+
+	rfmAnalyses := make([]*api.RFMAnalysis, 0, len(analyses))
+	for i := range analyses {
+		rfmAnalysis, err := b.toApiRFMAnalysis(ctx, &analyses[i])
+		if err != nil {
+			logger.Error(err, "cannot get rfm analysis run", "id", analyses[i].ID)
+			return nil, err
+		}
+		rfmAnalyses = append(rfmAnalyses, rfmAnalysis)
+	}
+	return &api.GetListRFMAnalysesResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		RfmAnalyses: rfmAnalyses,
+	}, nil
+}
+
+// applyRFMAnalyses scores the audience again with every analysis of the master segment, a build of the master segment replaces the audience.
+// The segments of an analysis are rebuilt with its new scores
+func (b business) applyRFMAnalyses(ctx context.Context, masterSegmentId int64) error {
+	analyses, err := b.repository.RFMAnalysisRepository.ListRFMAnalyses(ctx, masterSegmentId)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i := range analyses {
+		err = b.applyRFMAnalysis(ctx, &analyses[i])
+		if err == nil {
+			err = b.syncRFMSegments(ctx, &analyses[i])
+			if err != nil {
+				err = b.failRFMAnalysis(ctx, &analyses[i], 0, err)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rfm analysis %d: %w", analyses[i].ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyRFMAnalysis runs the action of the analysis once and records the run, every failure leaves the action FAILED
+func (b business) applyRFMAnalysis(ctx context.Context, analysis *model.RFMAnalysis) error {
+	dataAction, err := b.repository.DataActionRepository.GetDataAction(ctx, analysis.DataActionId)
+	if err != nil {
+		return b.failRFMAnalysis(ctx, analysis, 0, err)
+	}
+	if dataAction == nil {
+		return fmt.Errorf("rfm analysis %d has no data action", analysis.ID)
+	}
+
+	// CREATING keeps the run away from the job syncing the dag runs, it has no dag run
+	dataActionRun, err := b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
+		ActionId:    dataAction.ID,
+		RunId:       dataAction.RunCount + 1,
+		Status:      model.DataActionRunStatus_Creating,
+		AccountUuid: dataAction.AccountUuid,
+	})
+	if err != nil {
+		return b.failRFMAnalysis(ctx, analysis, 0, err)
+	}
+	err = b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+		ID:       dataAction.ID,
+		RunCount: dataAction.RunCount + 1,
+	})
+	if err != nil {
+		return b.failRFMAnalysis(ctx, analysis, dataActionRun.ID, err)
+	}
+
+	err = b.runRFMAnalysis(ctx, analysis)
+	if err != nil {
+		return b.failRFMAnalysis(ctx, analysis, dataActionRun.ID, err)
+	}
+
+	err = b.repository.DataActionRunRepository.UpdateDataActionRunStatus(ctx, dataActionRun.ID, model.DataActionRunStatus_Success)
+	if err != nil {
+		return b.failRFMAnalysis(ctx, analysis, dataActionRun.ID, err)
+	}
+	err = b.repository.DataActionRepository.UpdateDataAction(ctx, &repository.UpdateDataActionParams{
+		ID:     dataAction.ID,
+		Status: model.DataActionStatus_Success,
+	})
+	if err != nil {
+		return b.failRFMAnalysis(ctx, analysis, dataActionRun.ID, err)
+	}
+	return nil
+}
+
+// runRFMAnalysis adds the attribute columns missing in the audience, clears them and merges the scores of the customers with transactions,
+// then syncs the audience schema
+func (b business) runRFMAnalysis(ctx context.Context, analysis *model.RFMAnalysis) error {
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: analysis.MasterSegmentId})
+	if err != nil {
+		return err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		return err
+	}
+	behaviorTable, err := b.repository.SegmentRepository.GetBehaviorTable(ctx, &repository.GetBehaviorTableParams{Id: analysis.BehaviorTableId})
+	if err != nil {
+		return err
+	}
+	if behaviorTable.ID == 0 {
+		return fmt.Errorf("behavior table %d not found", analysis.BehaviorTableId)
+	}
+	audienceColumn := analysis.CustomerKey
+	if analysis.CustomerKey == behaviorTable.ForeignKey {
+		audienceColumn = behaviorJoinColumn(&configuration, behaviorTable.JoinKey)
+	}
+	if audienceColumn == "" {
+		return fmt.Errorf("the join key %s of the behavior table is not a column of the audience", behaviorTable.JoinKey)
+	}
+
+	audiencePath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(analysis.MasterSegmentId))
+	behaviorPath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaBehaviorPath(analysis.MasterSegmentId, behaviorTable.Name))
+	schemaResponse, err := b.queryAdapter.GetSchemaTable(ctx, &query.GetSchemaDataTableRequest{
+		TablePath: utils.GenerateDeltaAudiencePath(analysis.MasterSegmentId),
+	})
+	if err != nil {
+		return err
+	}
+	existingColumns := utils.Map(schemaResponse.Schema, func(unit query.FieldSchema) string { return unit.Name })
+
+	attributeColumns := rfmAttributeColumns(analysis.AttributePrefix)
+	missingColumns := make([]string, 0, len(attributeColumns))
+	clearColumns := make([]string, 0, len(attributeColumns))
+	setColumns := make([]string, 0, len(attributeColumns))
+	for idx, column := range attributeColumns {
+		name := utils.QuoteSparkIdentifier(column.ColumnName)
+		if !slices.Contains(existingColumns, column.ColumnName) {
+			missingColumns = append(missingColumns, fmt.Sprintf("%s %s", name, column.DataType))
+		}
+		clearColumns = append(clearColumns, fmt.Sprintf("%s = NULL", name))
+		setColumns = append(setColumns, fmt.Sprintf("target.%s = source.%s", name, rfmScoreColumns[idx]))
+	}
+	if len(missingColumns) > 0 {
+		_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+			Query: fmt.Sprintf("ALTER TABLE delta.`%s` ADD COLUMNS (%s)", audiencePath, strings.Join(missingColumns, ", ")),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// the customers without transactions anymore lose their scores
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("UPDATE delta.`%s` SET %s", audiencePath, strings.Join(clearColumns, ", ")),
+	})
+	if err != nil {
+		return err
+	}
+
+	customerKey := utils.QuoteSparkIdentifier(analysis.CustomerKey)
+	customers := fmt.Sprintf("SELECT CAST(%s AS STRING) AS customer_key, DATEDIFF(current_date(), MAX(CAST(%s AS DATE))) AS recency_days, "+
+		"COUNT(*) AS frequency, CAST(COALESCE(SUM(%s), 0) AS DOUBLE) AS monetary FROM delta.`%s` WHERE %s IS NOT NULL GROUP BY 1",
+		customerKey, utils.QuoteSparkIdentifier(analysis.DateColumn), utils.QuoteSparkIdentifier(analysis.AmountColumn), behaviorPath, customerKey)
+	scores := fmt.Sprintf("SELECT *, NTILE(%d) OVER (ORDER BY recency_days DESC) AS r_score, NTILE(%d) OVER (ORDER BY frequency) AS f_score, "+
+		"NTILE(%d) OVER (ORDER BY monetary) AS m_score FROM (%s) AS customers", analysis.Bins, analysis.Bins, analysis.Bins, customers)
+	scaled := fmt.Sprintf("SELECT *, CEIL(r_score * 5.0 / %d) AS r, CEIL(f_score * 5.0 / %d) AS f, CEIL(m_score * 5.0 / %d) AS m FROM (%s) AS scores",
+		analysis.Bins, analysis.Bins, analysis.Bins, scores)
+	labels := make([]string, 0, len(rfmLabelCase))
+	for _, each := range rfmLabelCase {
+		labels = append(labels, fmt.Sprintf("WHEN %s THEN %s", each.condition, utils.QuoteSparkString(string(each.label))))
+	}
+	source := fmt.Sprintf("SELECT *, CASE %s ELSE %s END AS segment FROM (%s) AS scaled",
+		strings.Join(labels, " "), utils.QuoteSparkString(string(model.RFMSegmentLabel_Lost)), scaled)
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("MERGE INTO delta.`%s` AS target USING (%s) AS source ON CAST(target.%s AS STRING) = source.customer_key WHEN MATCHED THEN UPDATE SET %s",
+			audiencePath, source, utils.QuoteSparkIdentifier(audienceColumn), strings.Join(setColumns, ", ")),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(missingColumns) == 0 {
+		return nil
+	}
+	audienceSchema, err := data_table.ParseSchema(audienceTable.Schema)
+	if err != nil {
+		return err
+	}
+	for _, column := range attributeColumns {
+		if _, ok := data_table.SchemaColumnType(audienceSchema, column.ColumnName); !ok {
+			audienceSchema = append(audienceSchema, column)
+		}
+	}
+	jsonSchema, err := json.Marshal(audienceSchema)
+	if err != nil {
+		return err
+	}
+	return b.repository.SegmentRepository.UpdateAudienceTable(ctx, &repository.UpdateAudienceTableParams{
+		Id:     audienceTable.ID,
+		Schema: pqtype.NullRawMessage{RawMessage: jsonSchema, Valid: true},
+	})
+}
+
+// rfmScoreColumns are the columns of the scores query in the order of rfmAttributeColumns
+var rfmScoreColumns = []string{"recency_days", "frequency", "monetary", "r_score", "f_score", "m_score", "segment"}
+
+// rfmAttributeColumns are the audience columns written by an analysis, named after its prefix
+func rfmAttributeColumns(prefix string) []model.SchemaUnit {
+	return []model.SchemaUnit{
+		{ColumnName: prefix + "_recency_days", DataType: "integer"},
+		{ColumnName: prefix + "_frequency", DataType: "bigint"},
+		{ColumnName: prefix + "_monetary", DataType: "double"},
+		{ColumnName: prefix + "_r_score", DataType: "integer"},
+		{ColumnName: prefix + "_f_score", DataType: "integer"},
+		{ColumnName: prefix + "_m_score", DataType: "integer"},
+		{ColumnName: prefix + "_segment", DataType: "string"},
+	}
+}
+
+func (b business) toApiRFMAnalysis(ctx context.Context, analysis *model.RFMAnalysis) (*api.RFMAnalysis, error) {
+	rfmAnalysis := &api.RFMAnalysis{
+		Id:              analysis.ID,
+		MasterSegmentId: analysis.MasterSegmentId,
+		Name:            analysis.Name,
+		BehaviorTableId: analysis.BehaviorTableId,
+		CustomerKey:     analysis.CustomerKey,
+		DateColumn:      analysis.DateColumn,
+		AmountColumn:    analysis.AmountColumn,
+		Bins:            analysis.Bins,
+		AttributeColumns: utils.Map(rfmAttributeColumns(analysis.AttributePrefix), func(column model.SchemaUnit) string {
+			return column.ColumnName
+		}),
+		Segments:     []*api.RFMSegment{},
+		DataActionId: analysis.DataActionId,
+		CreatedAt:    analysis.CreatedAt.String(),
+	}
+	if analysis.Segments.Valid {
+		var segments []model.RFMSegment
+		err := json.Unmarshal(analysis.Segments.RawMessage, &segments)
+		if err != nil {
+			return nil, err
+		}
+		rfmAnalysis.Segments = utils.Map(segments, func(segment model.RFMSegment) *api.RFMSegment {
+			return &api.RFMSegment{Label: string(segment.Label), SegmentId: segment.SegmentId}
+		})
+	}
+
+	lastRun, err := b.repository.DataActionRunRepository.GetLatestDataActionRun(ctx, analysis.DataActionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return rfmAnalysis, nil
+		}
+		return nil, err
+	}
+	rfmAnalysis.LastRun = &api.DataActionRun{
+		Id:         lastRun.ID,
+		ActionId:   lastRun.ActionId,
+		ActionType: string(model.ActionType_RFMAnalysis),
+		Status:     string(lastRun.Status),
+		CreatedAt:  lastRun.CreatedAt.String(),
+		UpdatedAt:  lastRun.UpdatedAt.String(),
+		Error:      lastRun.Error,
+	}
+	return rfmAnalysis, nil
+}
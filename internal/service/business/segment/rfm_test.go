@@ -0,0 +1,198 @@
+package segment
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+)
+
+// rfmLabel evaluates rfmLabelCase in go the way the CASE of the scores query does
+func rfmLabel(t *testing.T, r, f, m int) model.RFMSegmentLabel {
+	scores := map[string]int{"r": r, "f": f, "m": m}
+	for _, each := range rfmLabelCase {
+		matched := true
+		for _, comparison := range strings.Split(each.condition, " AND ") {
+			fields := strings.Fields(comparison)
+			if len(fields) != 3 {
+				t.Fatalf("condition %q of %s is not a comparison of a score", comparison, each.label)
+			}
+			value, err := strconv.Atoi(fields[2])
+			if err != nil {
+				t.Fatal(err)
+			}
+			score := scores[fields[0]]
+			switch fields[1] {
+			case ">=":
+				matched = matched && score >= value
+			case "<=":
+				matched = matched && score <= value
+			case "=":
+				matched = matched && score == value
+			default:
+				t.Fatalf("operator %s of %s is not evaluated", fields[1], each.label)
+			}
+		}
+		if matched {
+			return each.label
+		}
+	}
+	return model.RFMSegmentLabel_Lost
+}
+
+func TestRFMLabelCase(t *testing.T) {
+	for scores, want := range map[[3]int]model.RFMSegmentLabel{
+		{5, 5, 5}: model.RFMSegmentLabel_Champions,
+		{1, 5, 4}: model.RFMSegmentLabel_CannotLoseThem,
+		{3, 4, 1}: model.RFMSegmentLabel_LoyalCustomers,
+		{5, 4, 2}: model.RFMSegmentLabel_LoyalCustomers,
+		{2, 3, 1}: model.RFMSegmentLabel_AtRisk,
+		{5, 1, 5}: model.RFMSegmentLabel_NewCustomers,
+		{4, 2, 1}: model.RFMSegmentLabel_PotentialLoyalists,
+		{3, 2, 5}: model.RFMSegmentLabel_NeedAttention,
+		{2, 1, 1}: model.RFMSegmentLabel_Hibernating,
+		{1, 2, 5}: model.RFMSegmentLabel_Lost,
+	} {
+		if got := rfmLabel(t, scores[0], scores[1], scores[2]); got != want {
+			t.Errorf("label of r=%d f=%d m=%d = %s, want %s", scores[0], scores[1], scores[2], got, want)
+		}
+	}
+
+	// every standard label is given to some scores, a segment of the analysis is never empty by construction
+	given := map[model.RFMSegmentLabel]bool{}
+	for r := 1; r <= 5; r++ {
+		for f := 1; f <= 5; f++ {
+			for m := 1; m <= 5; m++ {
+				given[rfmLabel(t, r, f, m)] = true
+			}
+		}
+	}
+	for _, label := range model.RFMSegmentLabels {
+		if !given[label] {
+			t.Errorf("no scores are labeled %s", label)
+		}
+	}
+}
+
+func TestRFMAttributeColumnsFollowScoreColumns(t *testing.T) {
+	columns := rfmAttributeColumns("spring_rfm")
+	if len(columns) != len(rfmScoreColumns) {
+		t.Fatalf("%d attribute columns for %d score columns", len(columns), len(rfmScoreColumns))
+	}
+	// the merge sets each attribute column from the score column at the same index
+	for idx, column := range columns {
+		if want := "spring_rfm_" + rfmScoreColumns[idx]; column.ColumnName != want {
+			t.Errorf("attribute column %d = %s, want %s", idx, column.ColumnName, want)
+		}
+		if column.DataType == "" {
+			t.Errorf("attribute column %s has no data type", column.ColumnName)
+		}
+	}
+}
+
+func TestRFMAnalysisLost(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	stale := now.Add(-model.RFMAnalysis_RunTimeout - time.Minute)
+	recent := now.Add(-time.Minute)
+
+	processing := &model.DataAction{Status: model.DataActionStatus_Processing, UpdatedAt: stale}
+	if !rfmAnalysisLost(processing, nil, now) {
+		t.Error("a processing analysis without run and not updated since the timeout is not lost")
+	}
+	if rfmAnalysisLost(processing, &model.DataActionRun{UpdatedAt: recent}, now) {
+		t.Error("a processing analysis with a recent run is lost")
+	}
+	if rfmAnalysisLost(&model.DataAction{Status: model.DataActionStatus_Processing, UpdatedAt: recent}, nil, now) {
+		t.Error("an analysis just created is lost")
+	}
+	if rfmAnalysisLost(&model.DataAction{Status: model.DataActionStatus_Success, UpdatedAt: stale}, nil, now) {
+		t.Error("a successful analysis is lost")
+	}
+}
+
+type rfmActionRepository struct {
+	repository.DataActionRepository
+	dataAction *model.DataAction
+	statuses   *[]model.DataActionStatus
+}
+
+func (r rfmActionRepository) GetDataAction(ctx context.Context, id int64) (*model.DataAction, error) {
+	return r.dataAction, nil
+}
+
+func (r rfmActionRepository) UpdateDataAction(ctx context.Context, params *repository.UpdateDataActionParams) error {
+	if params.Status != "" {
+		*r.statuses = append(*r.statuses, params.Status)
+	}
+	return nil
+}
+
+type rfmRunRepository struct {
+	repository.DataActionRunRepository
+	createErr error
+	runErrors map[int64]string
+}
+
+func (r rfmRunRepository) CreateDataActionRun(ctx context.Context, params *repository.CreateDataActionRunParams) (*model.DataActionRun, error) {
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	return &model.DataActionRun{ID: 90, ActionId: params.ActionId, RunId: params.RunId, Status: params.Status}, nil
+}
+
+func (r rfmRunRepository) UpdateDataActionRunError(ctx context.Context, id int64, message string) error {
+	r.runErrors[id] = message
+	return nil
+}
+
+type rfmAudienceRepository struct {
+	repository.SegmentRepository
+}
+
+func (r rfmAudienceRepository) GetAudienceTable(ctx context.Context, params repository.GetAudienceTableParams) (model.AudienceTable, error) {
+	return model.AudienceTable{}, errors.New("audience table is locked")
+}
+
+func TestApplyRFMAnalysisFailsTheAction(t *testing.T) {
+	analysis := &model.RFMAnalysis{ID: 3, DataActionId: 30, MasterSegmentId: 1}
+
+	t.Run("run not created", func(t *testing.T) {
+		statuses := make([]model.DataActionStatus, 0)
+		runs := rfmRunRepository{createErr: errors.New("connection reset"), runErrors: map[int64]string{}}
+		b := business{repository: &repository.Repository{
+			DataActionRepository:    rfmActionRepository{dataAction: &model.DataAction{ID: 30, Status: model.DataActionStatus_Processing}, statuses: &statuses},
+			DataActionRunRepository: runs,
+		}}
+		err := b.applyRFMAnalysis(context.Background(), analysis)
+		if err == nil || !strings.Contains(err.Error(), "connection reset") {
+			t.Errorf("applyRFMAnalysis() = %v, want the error creating the run", err)
+		}
+		if len(statuses) != 1 || statuses[0] != model.DataActionStatus_Failed {
+			t.Errorf("action statuses = %v, want FAILED", statuses)
+		}
+	})
+
+	t.Run("scoring failed", func(t *testing.T) {
+		statuses := make([]model.DataActionStatus, 0)
+		runs := rfmRunRepository{runErrors: map[int64]string{}}
+		b := business{repository: &repository.Repository{
+			DataActionRepository:    rfmActionRepository{dataAction: &model.DataAction{ID: 30, RunCount: 2, Status: model.DataActionStatus_Processing}, statuses: &statuses},
+			DataActionRunRepository: runs,
+			SegmentRepository:       rfmAudienceRepository{},
+		}}
+		if err := b.applyRFMAnalysis(context.Background(), analysis); err == nil {
+			t.Fatal("applyRFMAnalysis() succeeded without an audience table")
+		}
+		if runs.runErrors[90] != "audience table is locked" {
+			t.Errorf("run errors = %v, want the scoring error on run 90", runs.runErrors)
+		}
+		if len(statuses) != 1 || statuses[0] != model.DataActionStatus_Failed {
+			t.Errorf("action statuses = %v, want FAILED", statuses)
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
 	"github.com/APCS20-Thesis/Backend/internal/model"
 	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
 	"github.com/APCS20-Thesis/Backend/utils"
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
@@ -31,15 +32,32 @@ func (b business) ParseHavingCondition(havingConditions []*api.BehaviorCondition
 }
 
 func (b business) CreateSegment(ctx context.Context, request *api.CreateSegmentRequest, accountUuid string) error {
+	_, err := b.createSegment(ctx, request, accountUuid)
+	return err
+}
+
+// createSegment saves the segment and triggers its build
+func (b business) createSegment(ctx context.Context, request *api.CreateSegmentRequest, accountUuid string) (*model.Segment, error) {
 	logger := b.log.WithName("CreateSegment").WithValues("request", request)
 	condition := model.SegmentBuildConditions{
 		AudienceCondition:  request.Condition,
 		BehaviorConditions: request.BehaviorConditions,
+		SequenceConditions: request.SequenceConditions,
 	}
 	jsonCondition, err := json.Marshal(condition)
 	if err != nil {
 		logger.Error(err, "cannot marshal condition")
-		return err
+		return nil, err
+	}
+
+	// the sequence conditions are compiled apart from the audience condition of the user, the build and the exports of the segment apply both
+	var sequenceSqlCondition string
+	if len(request.SequenceConditions) > 0 {
+		sequenceSqlCondition, err = b.sequenceConditionsSQL(ctx, request.MasterSegmentId, request.SequenceConditions)
+		if err != nil {
+			logger.Error(err, "cannot compile sequence conditions")
+			return nil, err
+		}
 	}
 
 	behaviorTables, err := b.repository.SegmentRepository.ListBehaviorTables(ctx, &repository.ListBehaviorTablesParams{
@@ -66,7 +84,7 @@ func (b business) CreateSegment(ctx context.Context, request *api.CreateSegmentR
 	behaviorsJson, err := json.Marshal(behaviorConfig)
 	if err != nil {
 		logger.Error(err, "cannot marshal behavior config", "behaviorConfig", behaviorConfig)
-		return err
+		return nil, err
 	}
 
 	// Prepare calling airflow
@@ -76,25 +94,26 @@ func (b business) CreateSegment(ctx context.Context, request *api.CreateSegmentR
 
 	// 1. Save Segment
 	segment, err := b.repository.SegmentRepository.CreateSegment(ctx, &repository.CreateSegmentParams{
-		Tx:              tx,
-		Name:            request.Name,
-		Description:     request.Description,
-		MasterSegmentId: request.MasterSegmentId,
-		Condition:       pqtype.NullRawMessage{RawMessage: jsonCondition, Valid: true},
-		SqlCondition:    request.SqlCondition,
-		AccountUuid:     uuid.MustParse(accountUuid),
+		Tx:                   tx,
+		Name:                 request.Name,
+		Description:          request.Description,
+		MasterSegmentId:      request.MasterSegmentId,
+		Condition:            pqtype.NullRawMessage{RawMessage: jsonCondition, Valid: true},
+		SqlCondition:         request.SqlCondition,
+		SequenceSqlCondition: sequenceSqlCondition,
+		AccountUuid:          uuid.MustParse(accountUuid),
 	})
 	if err != nil {
 		logger.Error(err, "cannot create segment")
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 
 	// 2. Airflow generate segment
 	payload := &airflow.TriggerGenerateDagCreateSegmentRequest{Config: airflow.CreateSegmentConfig{
 		DagId:                       dagId,
 		AudienceTableKey:            utils.GenerateDeltaAudiencePath(request.MasterSegmentId),
-		AudienceCondition:           request.SqlCondition,
+		AudienceCondition:           segment.BuildSqlCondition(),
 		SegmentTableKey:             utils.GenerateDeltaSegmentPath(request.MasterSegmentId, segment.ID),
 		SegmentTableName:            "segment",
 		BehaviorTableConfigurations: string(behaviorsJson),
@@ -103,13 +122,13 @@ func (b business) CreateSegment(ctx context.Context, request *api.CreateSegmentR
 	if err != nil {
 		logger.Error(err, "cannot marshal payload", "payload", payload)
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 	err = b.airflowAdapter.TriggerGenerateDagCreateSegment(ctx, payload)
 	if err != nil {
 		logger.Error(err, "cannot trigger dag generate create segment")
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 
 	// 3. Save data action
@@ -127,7 +146,7 @@ func (b business) CreateSegment(ctx context.Context, request *api.CreateSegmentR
 	if err != nil {
 		logger.Error(err, "cannot create data action")
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 	// 4. Create data action run
 	_, err = b.repository.DataActionRunRepository.CreateDataActionRun(ctx, &repository.CreateDataActionRunParams{
@@ -140,12 +159,12 @@ func (b business) CreateSegment(ctx context.Context, request *api.CreateSegmentR
 	if err != nil {
 		logger.Error(err, "cannot create data action run")
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 
 	tx.Commit()
 
-	return nil
+	return segment, nil
 }
 
 func (b business) ListSegments(ctx context.Context, request *api.GetListSegmentsRequest, accountUuid string) ([]*api.Segment, error) {
@@ -420,6 +439,16 @@ func (b business) ProcessGetResultPredictionActions(ctx context.Context, request
 	if err != nil {
 		return nil, err
 	}
+	masks, err := data_table.AudienceColumnMasks(ctx, b.repository, accountUuid, segment.MasterSegmentId)
+	if err != nil {
+		logger.Error(err, "cannot get column masks", "masterSegmentId", segment.MasterSegmentId)
+		return nil, err
+	}
+	data, err := masks.MaskRecords(queryResponse.Data)
+	if err != nil {
+		logger.Error(err, "cannot mask records", "actionId", action.ID)
+		return nil, err
+	}
 
 	return &api.GetResultPredictionActionsResponse{
 		Code:        0,
@@ -428,6 +457,6 @@ func (b business) ProcessGetResultPredictionActions(ctx context.Context, request
 		SegmentName: segment.Name,
 		Label_1:     trainConfig.Label1,
 		Label_2:     trainConfig.Label2,
-		Data:        queryResponse.Data,
+		Data:        data,
 	}, nil
 }
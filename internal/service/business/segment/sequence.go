@@ -0,0 +1,137 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	data_table "github.com/APCS20-Thesis/Backend/internal/service/business/data-table"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sequenceConditionsSQL compiles the sequence conditions in an audience condition, a profile must match all of them
+func (b business) sequenceConditionsSQL(ctx context.Context, masterSegmentId int64, conditions []*api.SequenceCondition) (string, error) {
+	audienceTable, err := b.repository.SegmentRepository.GetAudienceTable(ctx, repository.GetAudienceTableParams{MasterSegmentId: masterSegmentId})
+	if err != nil {
+		return "", err
+	}
+	var configuration repository.AudienceBuildConfiguration
+	err = json.Unmarshal(audienceTable.BuildConfiguration.RawMessage, &configuration)
+	if err != nil {
+		return "", err
+	}
+
+	clauses := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		sequence, err := b.sequenceQuery(ctx, masterSegmentId, &configuration, condition)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, fmt.Sprintf("%s IN (SELECT %s FROM (%s) AS sequence)",
+			model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid, sequence))
+	}
+	return strings.Join(clauses, " AND "), nil
+}
+
+// sequenceQuery selects the profiles matching the sequence with the time of each event matching its last positive step.
+// Every event of a step after an event of the step before is kept, not only the first one, so a later event can still
+// meet the max gap of the next step. A negated step keeps the events of the step before without any such event after them,
+// once its max gap is over since the event could still happen before
+func (b business) sequenceQuery(ctx context.Context, masterSegmentId int64, configuration *repository.AudienceBuildConfiguration, condition *api.SequenceCondition) (string, error) {
+	if len(condition.Steps) == 0 {
+		return "", status.Error(codes.InvalidArgument, "a sequence condition needs at least one step")
+	}
+
+	var sequenceSQL string
+	for idx, step := range condition.Steps {
+		if step.Negated && (idx == 0 || idx != len(condition.Steps)-1) {
+			return "", status.Error(codes.InvalidArgument, "only the last step of a sequence with several steps can be negated")
+		}
+		stepName := fmt.Sprintf("%d", idx+1)
+		events, err := b.behaviorEvents(ctx, masterSegmentId, configuration, step.BehaviorTableId, step.TimeColumn, "", step.EventFilter, stepName)
+		if err != nil {
+			return "", err
+		}
+
+		if idx == 0 {
+			sequenceSQL = fmt.Sprintf("SELECT DISTINCT %s, event_time FROM (%s) AS step_events", model.AudienceColumn_CdpSystemUuid, events)
+			if condition.WithinLastDays > 0 {
+				sequenceSQL += fmt.Sprintf(" WHERE event_time >= current_timestamp() - INTERVAL %d DAYS", condition.WithinLastDays)
+			}
+			continue
+		}
+		joinCondition := fmt.Sprintf("step_events.%s = previous.%s AND step_events.event_time > previous.event_time",
+			model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid)
+		if step.MaxGapSeconds > 0 {
+			joinCondition += fmt.Sprintf(" AND step_events.event_time <= previous.event_time + INTERVAL %d SECONDS", step.MaxGapSeconds)
+		}
+		if step.Negated {
+			sequenceSQL = fmt.Sprintf("SELECT previous.%s, previous.event_time FROM (%s) AS previous LEFT ANTI JOIN (%s) AS step_events ON %s",
+				model.AudienceColumn_CdpSystemUuid, sequenceSQL, events, joinCondition)
+			if step.MaxGapSeconds > 0 {
+				sequenceSQL += fmt.Sprintf(" WHERE previous.event_time <= current_timestamp() - INTERVAL %d SECONDS", step.MaxGapSeconds)
+			}
+			continue
+		}
+		sequenceSQL = fmt.Sprintf("SELECT DISTINCT step_events.%s, step_events.event_time FROM (%s) AS previous JOIN (%s) AS step_events ON %s",
+			model.AudienceColumn_CdpSystemUuid, sequenceSQL, events, joinCondition)
+	}
+	return sequenceSQL, nil
+}
+
+// behaviorEvents selects the events of the behavior table matching filter with the profile they belong to,
+// as cdp_system_uuid, event_time and event_value, the numeric valueColumn or null when empty. stepName names the step in the errors
+func (b business) behaviorEvents(ctx context.Context, masterSegmentId int64, configuration *repository.AudienceBuildConfiguration, behaviorTableId int64, timeColumn string, valueColumn string, filter *api.Rule, stepName string) (string, error) {
+	behaviorTable, err := b.repository.SegmentRepository.GetBehaviorTable(ctx, &repository.GetBehaviorTableParams{Id: behaviorTableId})
+	if err != nil {
+		return "", err
+	}
+	if behaviorTable.ID == 0 || behaviorTable.MasterSegmentId != masterSegmentId {
+		return "", status.Errorf(codes.InvalidArgument, "behavior table of step %s not found in master segment", stepName)
+	}
+	behaviorSchema, err := data_table.ParseSchema(behaviorTable.Schema)
+	if err != nil {
+		return "", err
+	}
+	if timeColumn == "" {
+		timeColumn = data_table.TimeColumn(behaviorSchema)
+	}
+	timeType, _ := data_table.SchemaColumnType(behaviorSchema, timeColumn)
+	if timeType != string(model.ColumnDataType_Date) && timeType != string(model.ColumnDataType_Timestamp) {
+		return "", status.Errorf(codes.InvalidArgument, "step %s needs a date or timestamp column of its behavior table", stepName)
+	}
+	eventValue := "CAST(NULL AS DOUBLE)"
+	if valueColumn != "" {
+		valueType, _ := data_table.SchemaColumnType(behaviorSchema, valueColumn)
+		if valueType != string(model.ColumnDataType_Int) && valueType != string(model.ColumnDataType_Float) {
+			return "", status.Errorf(codes.InvalidArgument, "value column %s of step %s must be a numeric column of its behavior table", valueColumn, stepName)
+		}
+		eventValue = fmt.Sprintf("CAST(%s AS DOUBLE)", utils.QuoteSparkIdentifier(valueColumn))
+	}
+	joinColumn := behaviorJoinColumn(configuration, behaviorTable.JoinKey)
+	if joinColumn == "" {
+		return "", status.Errorf(codes.FailedPrecondition, "the join key of the behavior table of step %s is not a column of the audience", stepName)
+	}
+
+	foreignKey := utils.QuoteSparkIdentifier(behaviorTable.ForeignKey)
+	eventTime := fmt.Sprintf("CAST(%s AS TIMESTAMP)", utils.QuoteSparkIdentifier(timeColumn))
+	conditions := []string{fmt.Sprintf("%s IS NOT NULL", foreignKey), fmt.Sprintf("%s IS NOT NULL", eventTime)}
+	eventFilter, err := data_table.RuleCondition(behaviorSchema, filter)
+	if err != nil {
+		return "", err
+	}
+	if eventFilter != "" {
+		conditions = append(conditions, eventFilter)
+	}
+	return fmt.Sprintf("SELECT audience.%s, events.event_time, events.event_value FROM (SELECT CAST(%s AS STRING) AS join_key, %s AS event_time, %s AS event_value FROM delta.`s3a://%s/%s` WHERE %s) AS events "+
+		"JOIN (SELECT %s, CAST(%s AS STRING) AS join_key FROM delta.`s3a://%s/%s`) AS audience ON events.join_key = audience.join_key",
+		model.AudienceColumn_CdpSystemUuid, foreignKey, eventTime, eventValue, b.config.S3StorageConfig.Bucket,
+		utils.GenerateDeltaBehaviorPath(masterSegmentId, behaviorTable.Name), strings.Join(conditions, " AND "),
+		model.AudienceColumn_CdpSystemUuid, utils.QuoteSparkIdentifier(joinColumn), b.config.S3StorageConfig.Bucket, utils.GenerateDeltaAudiencePath(masterSegmentId)), nil
+}
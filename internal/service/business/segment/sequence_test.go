@@ -0,0 +1,72 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/config"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/sqlc-dev/pqtype"
+)
+
+type ordersBehaviorRepository struct {
+	repository.SegmentRepository
+}
+
+func (r ordersBehaviorRepository) GetBehaviorTable(ctx context.Context, params *repository.GetBehaviorTableParams) (model.BehaviorTable, error) {
+	schema, err := json.Marshal(ordersSchema)
+	if err != nil {
+		return model.BehaviorTable{}, err
+	}
+	return model.BehaviorTable{
+		ID:              params.Id,
+		MasterSegmentId: 1,
+		Name:            "orders",
+		ForeignKey:      "customer_id",
+		JoinKey:         "customer_id",
+		Schema:          pqtype.NullRawMessage{RawMessage: schema, Valid: true},
+	}, nil
+}
+
+func compileSequence(t *testing.T, steps ...*api.SequenceCondition_SequenceStep) string {
+	t.Helper()
+	b := business{
+		repository: &repository.Repository{SegmentRepository: ordersBehaviorRepository{}},
+		config:     &config.Config{S3StorageConfig: config.S3StorageConfig{Bucket: "bucket"}},
+	}
+	sequence, err := b.sequenceQuery(context.Background(), 1, &repository.AudienceBuildConfiguration{}, &api.SequenceCondition{Steps: steps})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sequence
+}
+
+func TestSequenceQueryNegatedStepWaitsForItsGap(t *testing.T) {
+	placed := &api.SequenceCondition_SequenceStep{BehaviorTableId: 7, EventFilter: &api.Rule{Field: "status", Operator: string(model.RuleOperator_Equal), Value: "placed"}}
+	paid := &api.SequenceCondition_SequenceStep{BehaviorTableId: 7, EventFilter: &api.Rule{Field: "status", Operator: string(model.RuleOperator_Equal), Value: "paid"}, Negated: true, MaxGapSeconds: 86400}
+
+	// an order placed an hour ago is not unpaid yet, it can still be paid within the day
+	sequence := compileSequence(t, placed, paid)
+	if !strings.Contains(sequence, "LEFT ANTI JOIN") || !strings.Contains(sequence, "step_events.event_time <= previous.event_time + INTERVAL 86400 SECONDS") {
+		t.Errorf("sequenceQuery() = %s, want the paid events anti joined within the day", sequence)
+	}
+	if !strings.HasSuffix(sequence, "WHERE previous.event_time <= current_timestamp() - INTERVAL 86400 SECONDS") {
+		t.Errorf("sequenceQuery() = %s, want the placed orders of more than a day ago", sequence)
+	}
+
+	// without a gap the step can never happen later than now, every placed order without payment is kept
+	paid.MaxGapSeconds = 0
+	if sequence := compileSequence(t, placed, paid); strings.Contains(sequence, "current_timestamp()") {
+		t.Errorf("sequenceQuery() = %s, want no bound on the time of the placed orders", sequence)
+	}
+
+	// a positive step within a gap keeps the profiles as soon as its event happens
+	paid.Negated, paid.MaxGapSeconds = false, 86400
+	if sequence := compileSequence(t, placed, paid); strings.Contains(sequence, "current_timestamp()") {
+		t.Errorf("sequenceQuery() = %s, want no wait on a positive step", sequence)
+	}
+}
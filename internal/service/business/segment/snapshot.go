@@ -0,0 +1,356 @@
+package segment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// SyncOnBuildSegment snapshots the membership of the segment after a successful build and counts the profiles entered and exited since the previous snapshot
+func (b business) SyncOnBuildSegment(ctx context.Context, dataActionId int64) error {
+	logger := b.log.WithName("SyncOnBuildSegment").WithValues("dataActionId", dataActionId)
+
+	dataAction, err := b.repository.DataActionRepository.GetDataAction(ctx, dataActionId)
+	if err != nil {
+		logger.Error(err, "cannot get data action")
+		return err
+	}
+	segment, err := b.repository.SegmentRepository.GetSegment(ctx, dataAction.ObjectId)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return err
+	}
+	dataActionRun, err := b.repository.DataActionRunRepository.GetLatestDataActionRun(ctx, dataActionId)
+	if err != nil {
+		logger.Error(err, "cannot get latest data action run")
+		return err
+	}
+	previous, err := b.repository.SegmentSnapshotRepository.GetLatestSegmentSnapshot(ctx, segment.ID)
+	if err != nil {
+		logger.Error(err, "cannot get latest segment snapshot")
+		return err
+	}
+	if previous != nil && previous.DataActionRunId == dataActionRun.ID {
+		return nil
+	}
+	var previousRunId int64
+	if previous != nil {
+		previousRunId = previous.DataActionRunId
+	}
+
+	snapshotPath := b.segmentSnapshotLocation(&segment)
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("CREATE TABLE IF NOT EXISTS delta.`%s` (%s BIGINT, %s STRING) USING DELTA PARTITIONED BY (%s)",
+			snapshotPath, model.SegmentSnapshotColumn_DataActionRunId, model.AudienceColumn_CdpSystemUuid, model.SegmentSnapshotColumn_DataActionRunId),
+	})
+	if err != nil {
+		logger.Error(err, "cannot create segment snapshot table")
+		return err
+	}
+	// the rows of a sync failing before its snapshot record are inserted again by the next sync
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("DELETE FROM delta.`%s` WHERE %s = %d", snapshotPath, model.SegmentSnapshotColumn_DataActionRunId, dataActionRun.ID),
+	})
+	if err != nil {
+		logger.Error(err, "cannot delete segment snapshot rows of the run")
+		return err
+	}
+	segmentPath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(segment.MasterSegmentId, segment.ID))
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("INSERT INTO delta.`%s` SELECT DISTINCT %d AS %s, %s FROM delta.`%s` WHERE %s IS NOT NULL",
+			snapshotPath, dataActionRun.ID, model.SegmentSnapshotColumn_DataActionRunId, model.AudienceColumn_CdpSystemUuid,
+			segmentPath, model.AudienceColumn_CdpSystemUuid),
+	})
+	if err != nil {
+		logger.Error(err, "cannot snapshot segment membership")
+		return err
+	}
+
+	countResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT (SELECT COUNT(*) FROM (%s) AS members) AS size, (SELECT COUNT(*) FROM (%s) AS entered) AS entered_count, (SELECT COUNT(*) FROM (%s) AS exited) AS exited_count",
+			membersQuery(snapshotPath, strconv.FormatInt(dataActionRun.ID, 10)),
+			MembershipChangeQuery(snapshotPath, dataActionRun.ID, previousRunId, model.MembershipChange_Entered),
+			MembershipChangeQuery(snapshotPath, dataActionRun.ID, previousRunId, model.MembershipChange_Exited)),
+	})
+	if err != nil {
+		logger.Error(err, "cannot count membership changes")
+		return err
+	}
+	snapshot := &model.SegmentSnapshot{
+		AccountUuid:     segment.AccountUuid,
+		SegmentId:       segment.ID,
+		DataActionRunId: dataActionRun.ID,
+	}
+	if len(countResponse.Data) > 0 {
+		row := countResponse.Data[0]
+		size, _ := row["size"].(float64)
+		enteredCount, _ := row["entered_count"].(float64)
+		exitedCount, _ := row["exited_count"].(float64)
+		snapshot.Size, snapshot.EnteredCount, snapshot.ExitedCount = int64(size), int64(enteredCount), int64(exitedCount)
+	}
+
+	err = b.repository.SegmentSnapshotRepository.CreateSegmentSnapshot(ctx, snapshot)
+	if err != nil {
+		logger.Error(err, "cannot create segment snapshot")
+		return err
+	}
+
+	err = b.pruneSegmentSnapshots(ctx, segment.ID, snapshotPath)
+	if err != nil {
+		// not return this error, the snapshot is taken and the next build prunes the snapshot table again
+		logger.Error(err, "cannot prune segment snapshots")
+	}
+	return nil
+}
+
+// pruneSegmentSnapshots deletes the members of the snapshots older than the latest model.SegmentSnapshot_KeptMembershipChanges ones and the one before them,
+// then vacuums the files of the deleted rows once they are older than the delta safety interval
+func (b business) pruneSegmentSnapshots(ctx context.Context, segmentId int64, snapshotPath string) error {
+	snapshots, err := b.repository.SegmentSnapshotRepository.ListSegmentSnapshots(ctx, segmentId)
+	if err != nil {
+		return err
+	}
+	prunedRunId := prunedSnapshotRunId(snapshots)
+	if prunedRunId == 0 {
+		return nil
+	}
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("DELETE FROM delta.`%s` WHERE %s < %d", snapshotPath, model.SegmentSnapshotColumn_DataActionRunId, prunedRunId),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("VACUUM delta.`%s` RETAIN %d HOURS", snapshotPath, model.RetentionPolicy_MinVacuumRetainHours),
+	})
+	return err
+}
+
+// prunedSnapshotRunId returns the run id under which the members of the snapshots, ordered by id, are pruned, 0 when none is.
+// The changes of a snapshot compare it with the one before it, so the members of one more snapshot than the listed changes are kept
+func prunedSnapshotRunId(snapshots []model.SegmentSnapshot) int64 {
+	if len(snapshots) <= model.SegmentSnapshot_KeptMembershipChanges+1 {
+		return 0
+	}
+	return snapshots[len(snapshots)-model.SegmentSnapshot_KeptMembershipChanges-1].DataActionRunId
+}
+
+func (b business) GetListSegmentSnapshots(ctx context.Context, request *api.GetListSegmentSnapshotsRequest, accountUuid string) (*api.GetListSegmentSnapshotsResponse, error) {
+	logger := b.log.WithName("GetListSegmentSnapshots").WithValues("request", request)
+
+	segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return nil, err
+	}
+	snapshots, err := b.repository.SegmentSnapshotRepository.ListSegmentSnapshots(ctx, segment.ID)
+	if err != nil {
+		logger.Error(err, "cannot list segment snapshots")
+		return nil, err
+	}
+
+	return &api.GetListSegmentSnapshotsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Snapshots: utils.Map(snapshots, func(snapshot model.SegmentSnapshot) *api.SegmentSnapshot {
+			return &api.SegmentSnapshot{
+				Id:              snapshot.ID,
+				SegmentId:       snapshot.SegmentId,
+				DataActionRunId: snapshot.DataActionRunId,
+				Size:            snapshot.Size,
+				EnteredCount:    snapshot.EnteredCount,
+				ExitedCount:     snapshot.ExitedCount,
+				CreatedAt:       snapshot.CreatedAt.String(),
+			}
+		}),
+	}, nil
+}
+
+func (b business) GetSegmentMembershipChanges(ctx context.Context, request *api.GetSegmentMembershipChangesRequest, accountUuid string) (*api.GetSegmentMembershipChangesResponse, error) {
+	logger := b.log.WithName("GetSegmentMembershipChanges").WithValues("request", request)
+
+	segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return nil, err
+	}
+	var snapshot *model.SegmentSnapshot
+	if request.SnapshotId > 0 {
+		snapshot, err = b.repository.SegmentSnapshotRepository.GetSegmentSnapshot(ctx, segment.ID, request.SnapshotId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "Not found segment snapshot")
+		}
+	} else {
+		snapshot, err = b.repository.SegmentSnapshotRepository.GetLatestSegmentSnapshot(ctx, segment.ID)
+	}
+	if err != nil {
+		logger.Error(err, "cannot get segment snapshot")
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, status.Error(codes.FailedPrecondition, "segment has no membership snapshot, rebuild it to track its membership changes")
+	}
+	previous, err := b.repository.SegmentSnapshotRepository.GetPreviousSegmentSnapshot(ctx, snapshot)
+	if err != nil {
+		logger.Error(err, "cannot get previous segment snapshot")
+		return nil, err
+	}
+	var previousRunId int64
+	if previous != nil {
+		previousRunId = previous.DataActionRunId
+		snapshots, err := b.repository.SegmentSnapshotRepository.ListSegmentSnapshots(ctx, segment.ID)
+		if err != nil {
+			logger.Error(err, "cannot list segment snapshots")
+			return nil, err
+		}
+		if previousRunId < prunedSnapshotRunId(snapshots) {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("members of the snapshot are pruned, only the changes of the latest %d snapshots are kept", model.SegmentSnapshot_KeptMembershipChanges))
+		}
+	}
+
+	change := model.MembershipChange(request.Change)
+	count := snapshot.EnteredCount
+	if change == model.MembershipChange_Exited {
+		count = snapshot.ExitedCount
+	}
+	limit, offset := covertLimitOffsetFromPageAndPageSize(request.Page, request.PageSize)
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT %s FROM (SELECT %s, row_number() OVER (ORDER BY %s) AS row_number FROM (%s) AS changes) AS numbered WHERE row_number > %d AND row_number <= %d ORDER BY row_number",
+			model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid,
+			MembershipChangeQuery(b.segmentSnapshotLocation(segment), snapshot.DataActionRunId, previousRunId, change),
+			offset, offset+limit),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query membership changes")
+		return nil, err
+	}
+	cdpSystemUuids := make([]string, 0, len(queryResponse.Data))
+	for _, row := range queryResponse.Data {
+		if cdpSystemUuid, ok := row[model.AudienceColumn_CdpSystemUuid].(string); ok {
+			cdpSystemUuids = append(cdpSystemUuids, cdpSystemUuid)
+		}
+	}
+
+	return &api.GetSegmentMembershipChangesResponse{
+		Code:           int32(code.Code_OK),
+		Message:        "Success",
+		SnapshotId:     snapshot.ID,
+		Count:          count,
+		CdpSystemUuids: cdpSystemUuids,
+	}, nil
+}
+
+func (b business) GetSegmentSizeHistory(ctx context.Context, request *api.GetSegmentSizeHistoryRequest, accountUuid string) (*api.GetSegmentSizeHistoryResponse, error) {
+	logger := b.log.WithName("GetSegmentSizeHistory").WithValues("request", request)
+
+	segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return nil, err
+	}
+	snapshots, err := b.repository.SegmentSnapshotRepository.ListSegmentSnapshots(ctx, segment.ID)
+	if err != nil {
+		logger.Error(err, "cannot list segment snapshots")
+		return nil, err
+	}
+
+	points := make([]*api.SegmentSizePoint, 0)
+	for _, snapshot := range snapshots {
+		period := truncateToPeriod(snapshot.CreatedAt, request.Interval).Format(time.DateOnly)
+		if len(points) == 0 || points[len(points)-1].Period != period {
+			points = append(points, &api.SegmentSizePoint{Period: period})
+		}
+		point := points[len(points)-1]
+		point.Size = snapshot.Size
+		point.EnteredCount += snapshot.EnteredCount
+		point.ExitedCount += snapshot.ExitedCount
+	}
+
+	return &api.GetSegmentSizeHistoryResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Points:  points,
+	}, nil
+}
+
+// MembershipChangeCondition selects the audience rows of the profiles that entered or exited the segment at its latest snapshot.
+// The snapshots are resolved when the condition runs, every run of an export sends the changes of the latest build
+func MembershipChangeCondition(ctx context.Context, repo *repository.Repository, bucket string, segment *model.Segment, change model.MembershipChange) (string, error) {
+	snapshot, err := repo.SegmentSnapshotRepository.GetLatestSegmentSnapshot(ctx, segment.ID)
+	if err != nil {
+		return "", err
+	}
+	if snapshot == nil {
+		return "", status.Error(codes.FailedPrecondition, "segment has no membership snapshot, rebuild it to export its membership changes")
+	}
+
+	path := fmt.Sprintf("s3a://%s/%s", bucket, utils.GenerateDeltaSegmentSnapshotPath(segment.MasterSegmentId, segment.ID))
+	currentRunId := fmt.Sprintf("(SELECT max(%s) FROM delta.`%s`)", model.SegmentSnapshotColumn_DataActionRunId, path)
+	previousRunId := fmt.Sprintf("(SELECT coalesce(max(%s), 0) FROM delta.`%s` WHERE %s < %s)",
+		model.SegmentSnapshotColumn_DataActionRunId, path, model.SegmentSnapshotColumn_DataActionRunId, currentRunId)
+	return fmt.Sprintf("%s IN (%s)", model.AudienceColumn_CdpSystemUuid, membershipChangeQuery(path, currentRunId, previousRunId, change)), nil
+}
+
+// MembershipChangeQuery selects the profiles of the snapshot taken after currentRunId not in the one taken after previousRunId for entered, and the reverse for exited.
+// No snapshot is taken after run 0, every member of the first snapshot entered the segment
+func MembershipChangeQuery(snapshotPath string, currentRunId int64, previousRunId int64, change model.MembershipChange) string {
+	return membershipChangeQuery(snapshotPath, strconv.FormatInt(currentRunId, 10), strconv.FormatInt(previousRunId, 10), change)
+}
+
+// membershipChangeQuery takes the run ids as sql expressions
+func membershipChangeQuery(snapshotPath string, currentRunId string, previousRunId string, change model.MembershipChange) string {
+	from, other := currentRunId, previousRunId
+	if change == model.MembershipChange_Exited {
+		from, other = previousRunId, currentRunId
+	}
+	return fmt.Sprintf("SELECT snapshot.%s FROM (%s) AS snapshot LEFT ANTI JOIN (%s) AS other ON snapshot.%s = other.%s",
+		model.AudienceColumn_CdpSystemUuid, membersQuery(snapshotPath, from), membersQuery(snapshotPath, other),
+		model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid)
+}
+
+func membersQuery(snapshotPath string, dataActionRunId string) string {
+	return fmt.Sprintf("SELECT %s FROM delta.`%s` WHERE %s = %s",
+		model.AudienceColumn_CdpSystemUuid, snapshotPath, model.SegmentSnapshotColumn_DataActionRunId, dataActionRunId)
+}
+
+func (b business) segmentSnapshotLocation(segment *model.Segment) string {
+	return fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentSnapshotPath(segment.MasterSegmentId, segment.ID))
+}
+
+func (b business) getOwnedSegment(ctx context.Context, segmentId int64, accountUuid string) (*model.Segment, error) {
+	segment, err := b.repository.SegmentRepository.GetSegment(ctx, segmentId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "Not found segment")
+		}
+		return nil, err
+	}
+	if segment.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "Only user can access segment")
+	}
+	return &segment, nil
+}
+
+func truncateToPeriod(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	switch interval {
+	case "week":
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,107 @@
+package segment
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/internal/repository"
+)
+
+const testSnapshotPath = "s3a://bucket/snapshot"
+
+func TestMembershipChangeQueryEntered(t *testing.T) {
+	sql := MembershipChangeQuery(testSnapshotPath, 12, 7, model.MembershipChange_Entered)
+
+	// the profiles of the current run are kept when they are not in the previous run
+	snapshot, other, found := strings.Cut(sql, "LEFT ANTI JOIN")
+	if !found {
+		t.Fatalf("MembershipChangeQuery() = %s, want an anti join", sql)
+	}
+	if !strings.Contains(snapshot, "data_action_run_id = 12") || !strings.Contains(other, "data_action_run_id = 7") {
+		t.Errorf("MembershipChangeQuery() = %s, want run 12 anti joined with run 7", sql)
+	}
+	if !strings.HasSuffix(sql, "ON snapshot.cdp_system_uuid = other.cdp_system_uuid") {
+		t.Errorf("MembershipChangeQuery() = %s, want the runs joined on the profile", sql)
+	}
+}
+
+func TestMembershipChangeQueryExitedReversesTheRuns(t *testing.T) {
+	for _, runs := range [][2]int64{{12, 7}, {3, 0}, {1, 1}} {
+		exited := MembershipChangeQuery(testSnapshotPath, runs[0], runs[1], model.MembershipChange_Exited)
+		entered := MembershipChangeQuery(testSnapshotPath, runs[1], runs[0], model.MembershipChange_Entered)
+		if exited != entered {
+			t.Errorf("exited from run %d to %d = %s, want the profiles entering from run %d to %d %s", runs[1], runs[0], exited, runs[0], runs[1], entered)
+		}
+	}
+}
+
+func TestMembershipChangeQueryFirstSnapshot(t *testing.T) {
+	// no snapshot is taken after run 0, every member of the first snapshot entered and none exited
+	entered := MembershipChangeQuery(testSnapshotPath, 5, 0, model.MembershipChange_Entered)
+	if _, other, _ := strings.Cut(entered, "LEFT ANTI JOIN"); !strings.Contains(other, "data_action_run_id = 0") {
+		t.Errorf("MembershipChangeQuery() = %s, want the first snapshot compared with run 0", entered)
+	}
+	exited := MembershipChangeQuery(testSnapshotPath, 5, 0, model.MembershipChange_Exited)
+	if snapshot, _, _ := strings.Cut(exited, "LEFT ANTI JOIN"); !strings.Contains(snapshot, "data_action_run_id = 0") {
+		t.Errorf("MembershipChangeQuery() = %s, want the exited profiles selected from run 0", exited)
+	}
+}
+
+func testSnapshots(count int) []model.SegmentSnapshot {
+	snapshots := make([]model.SegmentSnapshot, 0, count)
+	for idx := 1; idx <= count; idx++ {
+		snapshots = append(snapshots, model.SegmentSnapshot{ID: int64(idx), SegmentId: 1, DataActionRunId: int64(idx * 10)})
+	}
+	return snapshots
+}
+
+func TestPrunedSnapshotRunId(t *testing.T) {
+	kept := model.SegmentSnapshot_KeptMembershipChanges
+	if got := prunedSnapshotRunId(nil); got != 0 {
+		t.Errorf("prunedSnapshotRunId(no snapshot) = %d, want 0", got)
+	}
+	if got := prunedSnapshotRunId(testSnapshots(kept + 1)); got != 0 {
+		t.Errorf("prunedSnapshotRunId(%d snapshots) = %d, want 0", kept+1, got)
+	}
+	// the oldest snapshot with listed changes is compared with the one before it, whose members are the oldest kept
+	snapshots := testSnapshots(kept + 5)
+	if got, want := prunedSnapshotRunId(snapshots), snapshots[4].DataActionRunId; got != want {
+		t.Errorf("prunedSnapshotRunId(%d snapshots) = %d, want %d", len(snapshots), got, want)
+	}
+}
+
+type listSnapshotRepository struct {
+	repository.SegmentSnapshotRepository
+	snapshots []model.SegmentSnapshot
+}
+
+func (r listSnapshotRepository) ListSegmentSnapshots(ctx context.Context, segmentId int64) ([]model.SegmentSnapshot, error) {
+	return r.snapshots, nil
+}
+
+func TestPruneSegmentSnapshots(t *testing.T) {
+	queries := make([]string, 0)
+	b := business{
+		repository:   &repository.Repository{SegmentSnapshotRepository: listSnapshotRepository{snapshots: testSnapshots(model.SegmentSnapshot_KeptMembershipChanges + 3)}},
+		queryAdapter: scriptedQueryAdapter{queries: &queries},
+	}
+	if err := b.pruneSegmentSnapshots(context.Background(), 1, testSnapshotPath); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"DELETE FROM delta.`s3a://bucket/snapshot` WHERE data_action_run_id < 30",
+		"VACUUM delta.`s3a://bucket/snapshot` RETAIN 168 HOURS",
+	}
+	if strings.Join(queries, "\n") != strings.Join(want, "\n") {
+		t.Errorf("queries =\n%s\nwant\n%s", strings.Join(queries, "\n"), strings.Join(want, "\n"))
+	}
+
+	// a segment with few builds keeps every member
+	queries = queries[:0]
+	b.repository.SegmentSnapshotRepository = listSnapshotRepository{snapshots: testSnapshots(2)}
+	if err := b.pruneSegmentSnapshots(context.Background(), 1, testSnapshotPath); err != nil || len(queries) != 0 {
+		t.Errorf("pruneSegmentSnapshots() = %v with queries %v, want nothing pruned", err, queries)
+	}
+}
@@ -0,0 +1,337 @@
+package segment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/adapter/query"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+	"github.com/APCS20-Thesis/Backend/utils"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// CreateSegmentSplit assigns the members of the segment to the arms by a stable hash of their profile id and the salt,
+// each arm is created as a child segment so it can be exported like any segment
+func (b business) CreateSegmentSplit(ctx context.Context, request *api.CreateSegmentSplitRequest, accountUuid string) (*api.CreateSegmentSplitResponse, error) {
+	logger := b.log.WithName("CreateSegmentSplit").WithValues("request", request)
+
+	segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return nil, err
+	}
+	if segment.Status != model.SegmentStatus_UP_TO_DATE {
+		return nil, status.Error(codes.FailedPrecondition, "Segment is not up to date")
+	}
+	var total int32
+	names := make(map[string]bool, len(request.Arms))
+	for _, arm := range request.Arms {
+		if names[arm.Name] {
+			return nil, status.Errorf(codes.InvalidArgument, "arm %s is defined twice", arm.Name)
+		}
+		names[arm.Name] = true
+		total += arm.Percentage
+	}
+	if total != 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "the percentages of the arms sum to %d instead of 100", total)
+	}
+	salt := request.Salt
+	if salt == "" {
+		salt = uuid.NewString()
+	}
+
+	// the split is saved before its arms so a failure on any arm can remove what was created
+	split := &model.SegmentSplit{
+		AccountUuid: uuid.MustParse(accountUuid),
+		SegmentId:   segment.ID,
+		Name:        request.Name,
+		Salt:        salt,
+		Arms:        pqtype.NullRawMessage{RawMessage: []byte("[]"), Valid: true},
+	}
+	err = b.repository.SegmentSplitRepository.CreateSegmentSplit(ctx, split)
+	if err != nil {
+		logger.Error(err, "cannot create segment split")
+		return nil, err
+	}
+
+	segmentPath := fmt.Sprintf("s3a://%s/%s", b.config.S3StorageConfig.Bucket, utils.GenerateDeltaSegmentPath(segment.MasterSegmentId, segment.ID))
+	arms := make([]model.SegmentSplitArm, 0, len(request.Arms))
+	var lower int32
+	for _, arm := range request.Arms {
+		upper := lower + arm.Percentage
+		// the parent membership keeps the arms inside the segment whatever its conditions, the hash bucket of a profile never changes
+		armSegment, err := b.createSegment(ctx, &api.CreateSegmentRequest{
+			MasterSegmentId: segment.MasterSegmentId,
+			Name:            fmt.Sprintf("%s - %s", request.Name, arm.Name),
+			Description:     fmt.Sprintf("Arm %s (%d%%) of the split %s of the segment %s", arm.Name, arm.Percentage, request.Name, segment.Name),
+			SqlCondition: fmt.Sprintf("%s IN (SELECT %s FROM delta.`%s`) AND pmod(xxhash64(%s, %s), 100) >= %d AND pmod(xxhash64(%s, %s), 100) < %d",
+				model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid, segmentPath,
+				utils.QuoteSparkString(salt), model.AudienceColumn_CdpSystemUuid, lower,
+				utils.QuoteSparkString(salt), model.AudienceColumn_CdpSystemUuid, upper),
+		}, accountUuid)
+		if err != nil {
+			logger.Error(err, "cannot create arm segment", "arm", arm.Name)
+			b.deleteSegmentSplit(ctx, split.ID, arms)
+			return nil, err
+		}
+		arms = append(arms, model.SegmentSplitArm{Name: arm.Name, Percentage: arm.Percentage, SegmentId: armSegment.ID})
+		lower = upper
+	}
+	jsonArms, err := json.Marshal(arms)
+	if err != nil {
+		logger.Error(err, "cannot marshal arms")
+		b.deleteSegmentSplit(ctx, split.ID, arms)
+		return nil, err
+	}
+	split.Arms = pqtype.NullRawMessage{RawMessage: jsonArms, Valid: true}
+	err = b.repository.SegmentSplitRepository.UpdateSegmentSplitArms(ctx, split.ID, split.Arms)
+	if err != nil {
+		logger.Error(err, "cannot update arms of segment split")
+		b.deleteSegmentSplit(ctx, split.ID, arms)
+		return nil, err
+	}
+
+	return &api.CreateSegmentSplitResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Split:   toApiSegmentSplit(split, arms),
+	}, nil
+}
+
+// deleteSegmentSplit removes a split that could not be created with the arm segments already created for it
+func (b business) deleteSegmentSplit(ctx context.Context, splitId int64, arms []model.SegmentSplitArm) {
+	logger := b.log.WithName("deleteSegmentSplit").WithValues("splitId", splitId)
+	for _, arm := range arms {
+		err := b.repository.SegmentRepository.DeleteSegment(ctx, arm.SegmentId)
+		if err != nil {
+			// not return this error, the error creating the split is the one to report
+			logger.Error(err, "cannot delete arm segment", "segmentId", arm.SegmentId)
+		}
+	}
+	err := b.repository.SegmentSplitRepository.DeleteSegmentSplit(ctx, splitId)
+	if err != nil {
+		// not return this error, the error creating the split is the one to report
+		logger.Error(err, "cannot delete segment split")
+	}
+}
+
+func (b business) GetListSegmentSplits(ctx context.Context, request *api.GetListSegmentSplitsRequest, accountUuid string) (*api.GetListSegmentSplitsResponse, error) {
+	logger := b.log.WithName("GetListSegmentSplits").WithValues("request", request)
+
+	segment, err := b.getOwnedSegment(ctx, request.SegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get segment")
+		return nil, err
+	}
+	splits, err := b.repository.SegmentSplitRepository.ListSegmentSplits(ctx, segment.ID)
+	if err != nil {
+		logger.Error(err, "cannot list segment splits")
+		return nil, err
+	}
+
+	apiSplits := make([]*api.SegmentSplit, 0, len(splits))
+	for i := range splits {
+		arms, err := segmentSplitArms(&splits[i])
+		if err != nil {
+			logger.Error(err, "cannot unmarshal arms", "id", splits[i].ID)
+			return nil, err
+		}
+		apiSplits = append(apiSplits, toApiSegmentSplit(&splits[i], arms))
+	}
+	return &api.GetListSegmentSplitsResponse{
+		Code:    int32(code.Code_OK),
+		Message: "Success",
+		Splits:  apiSplits,
+	}, nil
+}
+
+// CompareSegmentSplitArms measures the outcome events of the members of each arm since the split and tests the difference
+// of each arm with the control arm, a two-proportion z-test for conversion and a Welch test for mean.
+// The arms are large so both p-values use the normal distribution
+func (b business) CompareSegmentSplitArms(ctx context.Context, request *api.CompareSegmentSplitArmsRequest, accountUuid string) (*api.CompareSegmentSplitArmsResponse, error) {
+	logger := b.log.WithName("CompareSegmentSplitArms").WithValues("request", request)
+
+	split, err := b.repository.SegmentSplitRepository.GetSegmentSplit(ctx, request.Id)
+	if err != nil {
+		logger.Error(err, "cannot get segment split")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "Not found segment split")
+		}
+		return nil, err
+	}
+	if split.AccountUuid.String() != accountUuid {
+		return nil, status.Error(codes.PermissionDenied, "Only owner can access segment split")
+	}
+	arms, err := segmentSplitArms(split)
+	if err != nil {
+		logger.Error(err, "cannot unmarshal arms")
+		return nil, err
+	}
+	metric := model.SplitMetric(request.Metric)
+	if metric == "" {
+		metric = model.SplitMetric_Conversion
+	}
+	if metric == model.SplitMetric_Mean && request.ValueColumn == "" {
+		return nil, status.Error(codes.InvalidArgument, "value_column is required for the mean metric")
+	}
+	controlIdx := 0
+	if request.ControlArm != "" {
+		controlIdx = -1
+		for idx, arm := range arms {
+			if arm.Name == request.ControlArm {
+				controlIdx = idx
+			}
+		}
+		if controlIdx < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "control arm %s is not an arm of the split", request.ControlArm)
+		}
+	}
+
+	var masterSegmentId int64
+	members := make([]string, 0, len(arms))
+	for idx, arm := range arms {
+		armSegment, err := b.getOwnedSegment(ctx, arm.SegmentId, accountUuid)
+		if err != nil {
+			logger.Error(err, "cannot get arm segment", "arm", arm.Name)
+			return nil, err
+		}
+		if armSegment.Status != model.SegmentStatus_UP_TO_DATE {
+			return nil, status.Errorf(codes.FailedPrecondition, "segment of arm %s is not up to date", arm.Name)
+		}
+		masterSegmentId = armSegment.MasterSegmentId
+		members = append(members, fmt.Sprintf("SELECT DISTINCT %s, %d AS arm FROM delta.`s3a://%s/%s` WHERE %s IS NOT NULL",
+			model.AudienceColumn_CdpSystemUuid, idx, b.config.S3StorageConfig.Bucket,
+			utils.GenerateDeltaSegmentPath(armSegment.MasterSegmentId, armSegment.ID), model.AudienceColumn_CdpSystemUuid))
+	}
+	_, _, configuration, err := b.getRefreshableMasterSegment(ctx, masterSegmentId, accountUuid)
+	if err != nil {
+		logger.Error(err, "cannot get master segment")
+		return nil, err
+	}
+	valueColumn := ""
+	if metric == model.SplitMetric_Mean {
+		valueColumn = request.ValueColumn
+	}
+	events, err := b.behaviorEvents(ctx, masterSegmentId, configuration, request.BehaviorTableId, request.TimeColumn, valueColumn, request.EventFilter, "outcome")
+	if err != nil {
+		logger.Error(err, "cannot compile outcome events")
+		return nil, err
+	}
+	from := request.From
+	if from == "" {
+		from = split.CreatedAt.UTC().Format("2006-01-02")
+	}
+
+	queryResponse, err := b.queryAdapter.QueryRawSQL(ctx, &query.QueryRawSQLRequest{
+		Query: fmt.Sprintf("SELECT members.arm, COUNT(*) AS size, SUM(CASE WHEN outcomes.%s IS NULL THEN 0 ELSE 1 END) AS converted, "+
+			"AVG(COALESCE(outcomes.value, 0)) AS mean, STDDEV_SAMP(COALESCE(outcomes.value, 0)) AS stddev FROM (%s) AS members "+
+			"LEFT JOIN (SELECT %s, COALESCE(SUM(event_value), 0) AS value FROM (%s) AS outcome_events WHERE event_time >= TIMESTAMP %s GROUP BY %s) AS outcomes "+
+			"ON members.%s = outcomes.%s GROUP BY members.arm",
+			model.AudienceColumn_CdpSystemUuid, strings.Join(members, " UNION ALL "),
+			model.AudienceColumn_CdpSystemUuid, events, utils.QuoteSparkString(from), model.AudienceColumn_CdpSystemUuid,
+			model.AudienceColumn_CdpSystemUuid, model.AudienceColumn_CdpSystemUuid),
+	})
+	if err != nil {
+		logger.Error(err, "cannot query arm outcomes")
+		return nil, err
+	}
+
+	outcomes := make([]*api.SplitArmOutcome, 0, len(arms))
+	for _, arm := range arms {
+		outcomes = append(outcomes, &api.SplitArmOutcome{Name: arm.Name, SegmentId: arm.SegmentId})
+	}
+	for _, row := range queryResponse.Data {
+		idx, _ := row["arm"].(float64)
+		if int(idx) < 0 || int(idx) >= len(outcomes) {
+			continue
+		}
+		outcome := outcomes[int(idx)]
+		size, _ := row["size"].(float64)
+		converted, _ := row["converted"].(float64)
+		outcome.Size = int64(size)
+		outcome.Converted = int64(converted)
+		if metric == model.SplitMetric_Mean {
+			outcome.Value, _ = row["mean"].(float64)
+			outcome.Stddev, _ = row["stddev"].(float64)
+		} else if size > 0 {
+			outcome.Value = converted / size
+			outcome.Stddev = math.Sqrt(outcome.Value * (1 - outcome.Value))
+		}
+	}
+
+	control := outcomes[controlIdx]
+	comparisons := make([]*api.SplitArmComparison, 0, len(outcomes)-1)
+	for idx, outcome := range outcomes {
+		if idx == controlIdx {
+			continue
+		}
+		comparison := &api.SplitArmComparison{
+			Arm:        outcome.Name,
+			ControlArm: control.Name,
+			Difference: outcome.Value - control.Value,
+			PValue:     1,
+		}
+		if control.Value != 0 {
+			comparison.Lift = comparison.Difference / control.Value
+		}
+		standardError := splitStandardError(metric, outcome, control)
+		if standardError > 0 {
+			comparison.Statistic = comparison.Difference / standardError
+			comparison.PValue = math.Erfc(math.Abs(comparison.Statistic) / math.Sqrt2)
+		}
+		comparison.Significant = comparison.PValue < model.SegmentSplit_SignificanceLevel
+		comparisons = append(comparisons, comparison)
+	}
+
+	return &api.CompareSegmentSplitArmsResponse{
+		Code:        int32(code.Code_OK),
+		Message:     "Success",
+		Metric:      string(metric),
+		Arms:        outcomes,
+		Comparisons: comparisons,
+	}, nil
+}
+
+// splitStandardError is the standard error of the difference of the arms, pooled for conversion, 0 when an arm is empty
+func splitStandardError(metric model.SplitMetric, arm *api.SplitArmOutcome, control *api.SplitArmOutcome) float64 {
+	if arm.Size == 0 || control.Size == 0 {
+		return 0
+	}
+	if metric == model.SplitMetric_Mean {
+		return math.Sqrt(arm.Stddev*arm.Stddev/float64(arm.Size) + control.Stddev*control.Stddev/float64(control.Size))
+	}
+	pooled := float64(arm.Converted+control.Converted) / float64(arm.Size+control.Size)
+	return math.Sqrt(pooled * (1 - pooled) * (1/float64(arm.Size) + 1/float64(control.Size)))
+}
+
+func segmentSplitArms(split *model.SegmentSplit) ([]model.SegmentSplitArm, error) {
+	var arms []model.SegmentSplitArm
+	err := json.Unmarshal(split.Arms.RawMessage, &arms)
+	if err != nil {
+		return nil, err
+	}
+	return arms, nil
+}
+
+func toApiSegmentSplit(split *model.SegmentSplit, arms []model.SegmentSplitArm) *api.SegmentSplit {
+	return &api.SegmentSplit{
+		Id:        split.ID,
+		SegmentId: split.SegmentId,
+		Name:      split.Name,
+		Salt:      split.Salt,
+		Arms: utils.Map(arms, func(arm model.SegmentSplitArm) *api.SegmentSplitArm {
+			return &api.SegmentSplitArm{Name: arm.Name, Percentage: arm.Percentage, SegmentId: arm.SegmentId}
+		}),
+		CreatedAt: split.CreatedAt.String(),
+	}
+}
@@ -0,0 +1,56 @@
+package segment
+
+import (
+	"math"
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/api"
+	"github.com/APCS20-Thesis/Backend/internal/model"
+)
+
+func TestSplitStandardErrorConversion(t *testing.T) {
+	// 30% of the arm and 10% of the control converted, the pooled rate is 20%
+	arm := &api.SplitArmOutcome{Size: 100, Converted: 30}
+	control := &api.SplitArmOutcome{Size: 100, Converted: 10}
+	standardError := splitStandardError(model.SplitMetric_Conversion, arm, control)
+	if math.Abs(standardError-0.0565685424949238) > 1e-12 {
+		t.Errorf("splitStandardError() = %v, want 0.0565685424949238", standardError)
+	}
+	// the difference of 20 points is significant at the usual level, z is about 3.5
+	if z := 0.2 / standardError; z < 3.5 || z > 3.6 {
+		t.Errorf("z = %v, want about 3.54", z)
+	}
+
+	// the arms are pooled, not weighted by their own rates, so the error is the same either way
+	if swapped := splitStandardError(model.SplitMetric_Conversion, control, arm); swapped != standardError {
+		t.Errorf("splitStandardError(control, arm) = %v, want %v", swapped, standardError)
+	}
+	// the same rates on arms four times larger halve the error
+	larger := splitStandardError(model.SplitMetric_Conversion, &api.SplitArmOutcome{Size: 400, Converted: 120}, &api.SplitArmOutcome{Size: 400, Converted: 40})
+	if math.Abs(larger-standardError/2) > 1e-12 {
+		t.Errorf("splitStandardError(4x arms) = %v, want %v", larger, standardError/2)
+	}
+}
+
+func TestSplitStandardErrorMean(t *testing.T) {
+	// welch, the variances of the arms are not pooled: 100/50 + 400/200 = 4
+	arm := &api.SplitArmOutcome{Size: 50, Stddev: 10, Converted: 50}
+	control := &api.SplitArmOutcome{Size: 200, Stddev: 20, Converted: 1}
+	if got := splitStandardError(model.SplitMetric_Mean, arm, control); got != 2 {
+		t.Errorf("splitStandardError() = %v, want 2", got)
+	}
+}
+
+func TestSplitStandardErrorWithoutVariance(t *testing.T) {
+	for name, arms := range map[string][2]*api.SplitArmOutcome{
+		"empty arm":           {{Size: 0}, {Size: 200, Converted: 20}},
+		"empty control":       {{Size: 100, Converted: 30}, {}},
+		"nobody converted":    {{Size: 100}, {Size: 100}},
+		"everybody converted": {{Size: 80, Converted: 80}, {Size: 40, Converted: 40}},
+	} {
+		// no variance gives no statistic, the comparison keeps a p value of 1
+		if got := splitStandardError(model.SplitMetric_Conversion, arms[0], arms[1]); got != 0 {
+			t.Errorf("%s: splitStandardError() = %v, want 0", name, got)
+		}
+	}
+}
@@ -67,3 +67,39 @@ func (s *Service) GetListDestinationMap(ctx context.Context, request *api.GetLis
 	}
 	return s.business.DataDestinationBusiness.ProcessGetListDestinationMap(ctx, request, accountUuid)
 }
+
+func (s *Service) CreateSuppressionList(ctx context.Context, request *api.CreateSuppressionListRequest) (*api.CreateSuppressionListResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateSuppressionList").Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+	return s.business.DataDestinationBusiness.ProcessCreateSuppressionList(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListSuppressionLists(ctx context.Context, request *api.GetListSuppressionListsRequest) (*api.GetListSuppressionListsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListSuppressionLists").Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+	return s.business.DataDestinationBusiness.ProcessGetListSuppressionLists(ctx, request, accountUuid)
+}
+
+func (s *Service) UploadSuppressionEntries(ctx context.Context, request *api.UploadSuppressionEntriesRequest) (*api.UploadSuppressionEntriesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UploadSuppressionEntries").Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+	return s.business.DataDestinationBusiness.ProcessUploadSuppressionEntries(ctx, request, accountUuid)
+}
+
+func (s *Service) DeleteSuppressionList(ctx context.Context, request *api.DeleteSuppressionListRequest) (*api.DeleteSuppressionListResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("DeleteSuppressionList").Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+	return s.business.DataDestinationBusiness.ProcessDeleteSuppressionList(ctx, request, accountUuid)
+}
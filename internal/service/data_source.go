@@ -113,3 +113,15 @@ func (s *Service) ImportFromMySQLSource(ctx context.Context, request *api.Import
 func (s *Service) GetListSourceTableMap(ctx context.Context, request *api.GetListSourceTableMapRequest) (*api.GetListSourceTableMapResponse, error) {
 	return s.business.DataSourceBusiness.GetListSourceTableMappings(ctx, request)
 }
+
+func (s *Service) PreviewFile(ctx context.Context, request *api.PreviewFileRequest) (*api.PreviewFileResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("PreviewFile").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataSourceBusiness.ProcessPreviewFile(ctx, request, accountUuid)
+}
@@ -58,3 +58,207 @@ func (s *Service) GetQueryDataTable(ctx context.Context, request *api.GetQueryDa
 	}
 	return response, nil
 }
+
+func (s *Service) UpdateDataTableQualityRules(ctx context.Context, request *api.UpdateDataTableQualityRulesRequest) (*api.UpdateDataTableQualityRulesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateDataTableQualityRules").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.UpdateDataTableQualityRules(ctx, request, accountUuid)
+}
+
+func (s *Service) DeduplicateDataTable(ctx context.Context, request *api.DeduplicateDataTableRequest) (*api.DeduplicateDataTableResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("DeduplicateDataTable").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.DeduplicateDataTable(ctx, request, accountUuid)
+}
+
+func (s *Service) UpdateDataTableSchemaPolicy(ctx context.Context, request *api.UpdateDataTableSchemaPolicyRequest) (*api.UpdateDataTableSchemaPolicyResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateDataTableSchemaPolicy").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.UpdateDataTableSchemaPolicy(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListDataTableSchemaVersions(ctx context.Context, request *api.GetListDataTableSchemaVersionsRequest) (*api.GetListDataTableSchemaVersionsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListDataTableSchemaVersions").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.GetListDataTableSchemaVersions(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListDataTableVersions(ctx context.Context, request *api.GetListDataTableVersionsRequest) (*api.GetListDataTableVersionsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListDataTableVersions").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.GetListDataTableVersions(ctx, request, accountUuid)
+}
+
+func (s *Service) RestoreDataTable(ctx context.Context, request *api.RestoreDataTableRequest) (*api.RestoreDataTableResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("RestoreDataTable").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.RestoreDataTable(ctx, request, accountUuid)
+}
+
+func (s *Service) DiffDataTableVersions(ctx context.Context, request *api.DiffDataTableVersionsRequest) (*api.DiffDataTableVersionsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("DiffDataTableVersions").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.DiffDataTableVersions(ctx, request, accountUuid)
+}
+
+func (s *Service) ProfileDataTable(ctx context.Context, request *api.ProfileDataTableRequest) (*api.ProfileDataTableResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("ProfileDataTable").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.ProfileDataTable(ctx, request, accountUuid)
+}
+
+func (s *Service) GetDataTableStatistics(ctx context.Context, request *api.GetDataTableStatisticsRequest) (*api.GetDataTableStatisticsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetDataTableStatistics").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.GetDataTableStatistics(ctx, request, accountUuid)
+}
+
+func (s *Service) SuggestColumnTags(ctx context.Context, request *api.SuggestColumnTagsRequest) (*api.SuggestColumnTagsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("SuggestColumnTags").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.SuggestColumnTags(ctx, request, accountUuid)
+}
+
+func (s *Service) UpdateColumnTags(ctx context.Context, request *api.UpdateColumnTagsRequest) (*api.UpdateColumnTagsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateColumnTags").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.UpdateColumnTags(ctx, request, accountUuid)
+}
+
+func (s *Service) GetColumnTags(ctx context.Context, request *api.GetColumnTagsRequest) (*api.GetColumnTagsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetColumnTags").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.GetColumnTags(ctx, request, accountUuid)
+}
+
+func (s *Service) UpdateMaskingPolicies(ctx context.Context, request *api.UpdateMaskingPoliciesRequest) (*api.UpdateMaskingPoliciesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateMaskingPolicies").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.UpdateMaskingPolicies(ctx, request, accountUuid)
+}
+
+func (s *Service) GetMaskingPolicies(ctx context.Context, request *api.GetMaskingPoliciesRequest) (*api.GetMaskingPoliciesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetMaskingPolicies").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.GetMaskingPolicies(ctx, request, accountUuid)
+}
+
+func (s *Service) SetRetentionPolicy(ctx context.Context, request *api.SetRetentionPolicyRequest) (*api.SetRetentionPolicyResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("SetRetentionPolicy").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.SetRetentionPolicy(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListRetentionPolicies(ctx context.Context, request *api.GetListRetentionPoliciesRequest) (*api.GetListRetentionPoliciesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListRetentionPolicies").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.GetListRetentionPolicies(ctx, request, accountUuid)
+}
+
+func (s *Service) DeleteRetentionPolicy(ctx context.Context, request *api.DeleteRetentionPolicyRequest) (*api.DeleteRetentionPolicyResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("DeleteRetentionPolicy").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.DataTableBusiness.DeleteRetentionPolicy(ctx, request, accountUuid)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/api"
+)
+
+func (s *Service) CreateEventSource(ctx context.Context, request *api.CreateEventSourceRequest) (*api.CreateEventSourceResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateEventSource").Error(err, "cannot get account uuid from context")
+		return nil, err
+	}
+
+	return s.business.EventSourceBusiness.CreateEventSource(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListEventSources(ctx context.Context, request *api.GetListEventSourcesRequest) (*api.GetListEventSourcesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListEventSources").Error(err, "cannot get account uuid from context")
+		return nil, err
+	}
+
+	return s.business.EventSourceBusiness.GetListEventSources(ctx, request, accountUuid)
+}
+
+func (s *Service) Track(ctx context.Context, request *api.TrackRequest) (*api.IngestEventResponse, error) {
+	writeKey, err := GetWriteKeyFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.business.EventSourceBusiness.Track(ctx, request, writeKey)
+}
+
+func (s *Service) Identify(ctx context.Context, request *api.IdentifyRequest) (*api.IngestEventResponse, error) {
+	writeKey, err := GetWriteKeyFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.business.EventSourceBusiness.Identify(ctx, request, writeKey)
+}
+
+func (s *Service) BatchTrack(ctx context.Context, request *api.BatchTrackRequest) (*api.IngestEventResponse, error) {
+	writeKey, err := GetWriteKeyFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.business.EventSourceBusiness.BatchTrack(ctx, request, writeKey)
+}
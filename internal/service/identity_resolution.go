@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"github.com/APCS20-Thesis/Backend/api"
+)
+
+func (s *Service) CreateIdentityResolution(ctx context.Context, request *api.CreateIdentityResolutionRequest) (*api.CreateIdentityResolutionResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateIdentityResolution").Error(err, "cannot get account uuid from context")
+		return nil, err
+	}
+
+	return s.business.IdentityResolutionBusiness.CreateIdentityResolution(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListIdentityResolutions(ctx context.Context, request *api.GetListIdentityResolutionsRequest) (*api.GetListIdentityResolutionsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListIdentityResolutions").Error(err, "cannot get account uuid from context")
+		return nil, err
+	}
+
+	return s.business.IdentityResolutionBusiness.GetListIdentityResolutions(ctx, request, accountUuid)
+}
+
+func (s *Service) GetIdentityResolutionDetail(ctx context.Context, request *api.GetIdentityResolutionDetailRequest) (*api.GetIdentityResolutionDetailResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetIdentityResolutionDetail").Error(err, "cannot get account uuid from context")
+		return nil, err
+	}
+
+	return s.business.IdentityResolutionBusiness.GetIdentityResolutionDetail(ctx, request, accountUuid)
+}
+
+func (s *Service) GetIdentityProfileHistory(ctx context.Context, request *api.GetIdentityProfileHistoryRequest) (*api.GetIdentityProfileHistoryResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetIdentityProfileHistory").Error(err, "cannot get account uuid from context")
+		return nil, err
+	}
+
+	return s.business.IdentityResolutionBusiness.GetIdentityProfileHistory(ctx, request, accountUuid)
+}
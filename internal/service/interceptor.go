@@ -38,6 +38,8 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		}
 		if claims != nil {
 			md.Append(constants.KeyAccountUuid, claims.UUID)
+			// set, not appended, so a role sent by the client is never read
+			md.Set(constants.KeyRole, claims.Role)
 			ctx = metadata.NewIncomingContext(ctx, md)
 		}
 
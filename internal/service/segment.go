@@ -110,6 +110,7 @@ func (s *Service) GetMasterSegmentDetail(ctx context.Context, request *api.GetMa
 			}
 		}),
 		AudienceSchema: masterSegment.AudienceSchema,
+		SchemaIssues:   masterSegment.SchemaIssues,
 	}, nil
 }
 
@@ -149,13 +150,17 @@ func (s *Service) GetListMasterSegmentProfiles(ctx context.Context, request *api
 		return nil, err
 	}
 
-	count, res, err := s.business.SegmentBusiness.ListMasterSegmentProfiles(ctx, request, accountUuid)
+	profileRecords, err := s.business.SegmentBusiness.ListMasterSegmentProfiles(ctx, request, accountUuid)
+	if err != nil {
+		return nil, err
+	}
 
 	return &api.GetListMasterSegmentProfilesResponse{
-		Code:    int32(codes.OK),
-		Message: "Success",
-		Count:   count,
-		Results: res,
+		Code:       int32(codes.OK),
+		Message:    "Success",
+		Count:      profileRecords.Count,
+		Results:    profileRecords.Records,
+		NextCursor: profileRecords.NextCursor,
 	}, nil
 }
 
@@ -215,12 +220,16 @@ func (s *Service) GetBehaviorProfile(ctx context.Context, request *api.GetBehavi
 	}
 
 	behaviorRecords, err := s.business.SegmentBusiness.GetBehaviorProfile(ctx, request, accountUuid)
+	if err != nil {
+		return nil, err
+	}
 
 	return &api.GetBehaviorProfileResponse{
 		Code:            int32(codes.OK),
 		Message:         "Success",
 		Count:           int64(behaviorRecords.Count),
 		BehaviorRecords: behaviorRecords.Records,
+		NextCursor:      behaviorRecords.NextCursor,
 	}, nil
 }
 
@@ -239,3 +248,327 @@ func (s *Service) TotalProfilesMasterSegment(ctx context.Context, request *api.T
 		Count:   count,
 	}, nil
 }
+
+func (s *Service) ProfileMasterSegment(ctx context.Context, request *api.ProfileMasterSegmentRequest) (*api.ProfileMasterSegmentResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("ProfileMasterSegment").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.ProfileMasterSegment(ctx, request, accountUuid)
+}
+
+func (s *Service) GetMasterSegmentStatistics(ctx context.Context, request *api.GetMasterSegmentStatisticsRequest) (*api.GetMasterSegmentStatisticsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetMasterSegmentStatistics").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetMasterSegmentStatistics(ctx, request, accountUuid)
+}
+
+func (s *Service) UpdateMasterSegmentComputedAttributes(ctx context.Context, request *api.UpdateMasterSegmentComputedAttributesRequest) (*api.UpdateMasterSegmentComputedAttributesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateMasterSegmentComputedAttributes").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.UpdateMasterSegmentComputedAttributes(ctx, request, accountUuid)
+}
+
+func (s *Service) RefreshMasterSegment(ctx context.Context, request *api.RefreshMasterSegmentRequest) (*api.RefreshMasterSegmentResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("RefreshMasterSegment").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.RefreshMasterSegment(ctx, request, accountUuid)
+}
+
+func (s *Service) GetCustomerProfile(ctx context.Context, request *api.GetCustomerProfileRequest) (*api.GetCustomerProfileResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetCustomerProfile").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetCustomerProfile(ctx, request, accountUuid)
+}
+
+func (s *Service) UpdateMasterSegmentSearchableColumns(ctx context.Context, request *api.UpdateMasterSegmentSearchableColumnsRequest) (*api.UpdateMasterSegmentSearchableColumnsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateMasterSegmentSearchableColumns").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.UpdateMasterSegmentSearchableColumns(ctx, request, accountUuid)
+}
+
+func (s *Service) SearchProfiles(ctx context.Context, request *api.SearchProfilesRequest) (*api.SearchProfilesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("SearchProfiles").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.SearchProfiles(ctx, request, accountUuid)
+}
+
+func (s *Service) ErasePerson(ctx context.Context, request *api.ErasePersonRequest) (*api.ErasePersonResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("ErasePerson").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.ErasePerson(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListErasureCertificates(ctx context.Context, request *api.GetListErasureCertificatesRequest) (*api.GetListErasureCertificatesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListErasureCertificates").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetListErasureCertificates(ctx, request, accountUuid)
+}
+
+func (s *Service) GetErasureCertificate(ctx context.Context, request *api.GetErasureCertificateRequest) (*api.GetErasureCertificateResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetErasureCertificate").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetErasureCertificate(ctx, request, accountUuid)
+}
+
+func (s *Service) UpdateMasterSegmentConsentColumns(ctx context.Context, request *api.UpdateMasterSegmentConsentColumnsRequest) (*api.UpdateMasterSegmentConsentColumnsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("UpdateMasterSegmentConsentColumns").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.UpdateMasterSegmentConsentColumns(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListSegmentSnapshots(ctx context.Context, request *api.GetListSegmentSnapshotsRequest) (*api.GetListSegmentSnapshotsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListSegmentSnapshots").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetListSegmentSnapshots(ctx, request, accountUuid)
+}
+
+func (s *Service) GetSegmentMembershipChanges(ctx context.Context, request *api.GetSegmentMembershipChangesRequest) (*api.GetSegmentMembershipChangesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetSegmentMembershipChanges").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetSegmentMembershipChanges(ctx, request, accountUuid)
+}
+
+func (s *Service) GetSegmentSizeHistory(ctx context.Context, request *api.GetSegmentSizeHistoryRequest) (*api.GetSegmentSizeHistoryResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetSegmentSizeHistory").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetSegmentSizeHistory(ctx, request, accountUuid)
+}
+
+func (s *Service) AnalyzeSegmentOverlap(ctx context.Context, request *api.AnalyzeSegmentOverlapRequest) (*api.AnalyzeSegmentOverlapResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("AnalyzeSegmentOverlap").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.AnalyzeSegmentOverlap(ctx, request, accountUuid)
+}
+
+func (s *Service) GetSegmentInsights(ctx context.Context, request *api.GetSegmentInsightsRequest) (*api.GetSegmentInsightsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetSegmentInsights").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetSegmentInsights(ctx, request, accountUuid)
+}
+
+func (s *Service) CreateRFMAnalysis(ctx context.Context, request *api.CreateRFMAnalysisRequest) (*api.CreateRFMAnalysisResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateRFMAnalysis").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.CreateRFMAnalysis(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListRFMAnalyses(ctx context.Context, request *api.GetListRFMAnalysesRequest) (*api.GetListRFMAnalysesResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListRFMAnalyses").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetListRFMAnalyses(ctx, request, accountUuid)
+}
+
+func (s *Service) GetCohortRetention(ctx context.Context, request *api.GetCohortRetentionRequest) (*api.GetCohortRetentionResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetCohortRetention").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetCohortRetention(ctx, request, accountUuid)
+}
+
+func (s *Service) CreateFunnel(ctx context.Context, request *api.CreateFunnelRequest) (*api.CreateFunnelResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateFunnel").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.CreateFunnel(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListFunnels(ctx context.Context, request *api.GetListFunnelsRequest) (*api.GetListFunnelsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListFunnels").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetListFunnels(ctx, request, accountUuid)
+}
+
+func (s *Service) DeleteFunnel(ctx context.Context, request *api.DeleteFunnelRequest) (*api.DeleteFunnelResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("DeleteFunnel").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.DeleteFunnel(ctx, request, accountUuid)
+}
+
+func (s *Service) ComputeFunnel(ctx context.Context, request *api.ComputeFunnelRequest) (*api.ComputeFunnelResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("ComputeFunnel").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.ComputeFunnel(ctx, request, accountUuid)
+}
+
+func (s *Service) CreateFunnelDropOffSegment(ctx context.Context, request *api.CreateFunnelDropOffSegmentRequest) (*api.CreateFunnelDropOffSegmentResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateFunnelDropOffSegment").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.CreateFunnelDropOffSegment(ctx, request, accountUuid)
+}
+
+func (s *Service) CreateSegmentSplit(ctx context.Context, request *api.CreateSegmentSplitRequest) (*api.CreateSegmentSplitResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CreateSegmentSplit").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.CreateSegmentSplit(ctx, request, accountUuid)
+}
+
+func (s *Service) GetListSegmentSplits(ctx context.Context, request *api.GetListSegmentSplitsRequest) (*api.GetListSegmentSplitsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("GetListSegmentSplits").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.GetListSegmentSplits(ctx, request, accountUuid)
+}
+
+func (s *Service) CompareSegmentSplitArms(ctx context.Context, request *api.CompareSegmentSplitArmsRequest) (*api.CompareSegmentSplitArmsResponse, error) {
+	accountUuid, err := GetAccountUuidFromCtx(ctx)
+	if err != nil {
+		s.log.WithName("CompareSegmentSplitArms").
+			WithValues("Context", ctx).
+			Error(err, "Cannot get account_uuid from context")
+		return nil, err
+	}
+
+	return s.business.SegmentBusiness.CompareSegmentSplitArms(ctx, request, accountUuid)
+}
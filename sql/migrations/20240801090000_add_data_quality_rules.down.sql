@@ -0,0 +1,3 @@
+ALTER TABLE data_action_run DROP COLUMN failed_count;
+ALTER TABLE data_action_run DROP COLUMN passed_count;
+ALTER TABLE data_table DROP COLUMN quality_configuration;
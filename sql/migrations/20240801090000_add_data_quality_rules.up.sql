@@ -0,0 +1,3 @@
+ALTER TABLE data_table ADD COLUMN quality_configuration JSONB;
+ALTER TABLE data_action_run ADD COLUMN passed_count BIGINT DEFAULT 0;
+ALTER TABLE data_action_run ADD COLUMN failed_count BIGINT DEFAULT 0;
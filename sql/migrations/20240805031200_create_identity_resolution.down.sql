@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_identity_resolution on "identity_resolution";
+
+DROP TABLE IF EXISTS identity_resolution;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS identity_resolution (
+    id bigserial not null constraint identity_resolution_pk primary key,
+    name varchar(255) not null,
+    description varchar(255),
+    configurations jsonb,
+    output_table_id bigint not null,
+    status varchar(255) not null,
+    account_uuid uuid not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+DROP TRIGGER IF EXISTS set_timestamp_identity_resolution on "identity_resolution";
+CREATE TRIGGER set_timestamp_identity_resolution
+    BEFORE UPDATE ON "identity_resolution"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
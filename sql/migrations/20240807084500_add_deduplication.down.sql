@@ -0,0 +1,3 @@
+ALTER TABLE data_action_run DROP COLUMN row_count_after;
+ALTER TABLE data_action_run DROP COLUMN row_count_before;
+ALTER TABLE source_table_map DROP COLUMN dedupe_configuration;
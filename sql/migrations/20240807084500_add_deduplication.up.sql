@@ -0,0 +1,3 @@
+ALTER TABLE source_table_map ADD COLUMN dedupe_configuration JSONB;
+ALTER TABLE data_action_run ADD COLUMN row_count_before BIGINT DEFAULT 0;
+ALTER TABLE data_action_run ADD COLUMN row_count_after BIGINT DEFAULT 0;
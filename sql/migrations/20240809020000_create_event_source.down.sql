@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS event_buffer;
+
+DROP TRIGGER IF EXISTS set_timestamp_event_source on "event_source";
+
+DROP TABLE IF EXISTS event_source;
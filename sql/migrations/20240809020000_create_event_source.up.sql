@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS event_source (
+    id bigserial not null constraint event_source_pk primary key,
+    name varchar(255) not null,
+    write_key varchar(255) not null constraint event_source_write_key_uk unique,
+    table_id bigint not null,
+    configurations jsonb,
+    account_uuid uuid not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+DROP TRIGGER IF EXISTS set_timestamp_event_source on "event_source";
+CREATE TRIGGER set_timestamp_event_source
+    BEFORE UPDATE ON "event_source"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS event_buffer (
+    id bigserial not null constraint event_buffer_pk primary key,
+    event_source_id bigint not null,
+    record jsonb not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+CREATE INDEX IF NOT EXISTS event_buffer_event_source_id_idx ON event_buffer (event_source_id, id);
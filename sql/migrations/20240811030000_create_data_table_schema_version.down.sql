@@ -0,0 +1,4 @@
+ALTER TABLE master_segment DROP COLUMN schema_issues;
+ALTER TABLE data_table DROP COLUMN schema_policy;
+
+DROP TABLE IF EXISTS data_table_schema_version;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS data_table_schema_version (
+    id bigserial not null constraint data_table_schema_version_pk primary key,
+    table_id bigint not null,
+    version int not null,
+    schema jsonb,
+    changes jsonb,
+    is_breaking boolean default false not null,
+    data_action_id bigint default 0 not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint data_table_schema_version_uk unique (table_id, version)
+);
+
+ALTER TABLE data_table ADD COLUMN schema_policy VARCHAR(50) DEFAULT 'allow_additive' NOT NULL;
+ALTER TABLE master_segment ADD COLUMN schema_issues JSONB;
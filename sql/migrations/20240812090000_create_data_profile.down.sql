@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_data_profile on "data_profile";
+
+DROP TABLE IF EXISTS data_profile;
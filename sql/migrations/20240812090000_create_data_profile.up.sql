@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS data_profile (
+    id bigserial not null constraint data_profile_pk primary key,
+    object_type varchar(50) not null,
+    object_id bigint not null,
+    row_count bigint default 0 not null,
+    columns jsonb,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint data_profile_object_uk unique (object_type, object_id)
+);
+
+DROP TRIGGER IF EXISTS set_timestamp_data_profile on "data_profile";
+CREATE TRIGGER set_timestamp_data_profile
+    BEFORE UPDATE ON "data_profile"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS profile_search_index (
+    id bigserial not null constraint profile_search_index_pk primary key,
+    master_segment_id bigint not null,
+    cdp_system_uuid varchar(255) not null,
+    column_name varchar(255) not null,
+    value text not null,
+    normalized_value text not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+CREATE INDEX IF NOT EXISTS profile_search_index_prefix_idx ON profile_search_index (master_segment_id, normalized_value text_pattern_ops);
+CREATE INDEX IF NOT EXISTS profile_search_index_trgm_idx ON profile_search_index USING gin (normalized_value gin_trgm_ops);
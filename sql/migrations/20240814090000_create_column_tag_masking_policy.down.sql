@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS set_timestamp_masking_policy on "masking_policy";
+DROP TRIGGER IF EXISTS set_timestamp_column_tag on "column_tag";
+
+DROP TABLE IF EXISTS masking_policy;
+DROP TABLE IF EXISTS column_tag;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS column_tag (
+    id bigserial not null constraint column_tag_pk primary key,
+    data_table_id bigint not null,
+    column_name varchar(255) not null,
+    tag varchar(50) not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint column_tag_uk unique (data_table_id, column_name)
+);
+
+DROP TRIGGER IF EXISTS set_timestamp_column_tag on "column_tag";
+CREATE TRIGGER set_timestamp_column_tag
+    BEFORE UPDATE ON "column_tag"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS masking_policy (
+    id bigserial not null constraint masking_policy_pk primary key,
+    account_uuid uuid not null,
+    role varchar(50) not null,
+    tag varchar(50) not null,
+    method varchar(50) not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint masking_policy_uk unique (account_uuid, role, tag)
+);
+
+DROP TRIGGER IF EXISTS set_timestamp_masking_policy on "masking_policy";
+CREATE TRIGGER set_timestamp_masking_policy
+    BEFORE UPDATE ON "masking_policy"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS erasure_certificate (
+    id bigserial not null constraint erasure_certificate_pk primary key,
+    account_uuid uuid not null,
+    identifier_hash varchar(64) not null,
+    content text not null,
+    previous_hash varchar(64) not null,
+    hash varchar(64) not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    -- a certificate has at most one successor, concurrent erasures cannot fork the chain of the account
+    constraint erasure_certificate_uk unique (account_uuid, previous_hash)
+);
+
+CREATE INDEX IF NOT EXISTS erasure_certificate_identifier_hash_idx ON erasure_certificate (account_uuid, identifier_hash);
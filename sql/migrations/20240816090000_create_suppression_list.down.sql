@@ -0,0 +1,9 @@
+ALTER TABLE dest_ms_segment_map DROP COLUMN suppressed_count;
+ALTER TABLE dest_segment_map DROP COLUMN suppressed_count;
+ALTER TABLE dest_table_map DROP COLUMN suppressed_count;
+ALTER TABLE data_destination DROP COLUMN channel;
+
+DROP TABLE IF EXISTS suppression_entry;
+
+DROP TRIGGER IF EXISTS set_timestamp_suppression_list on "suppression_list";
+DROP TABLE IF EXISTS suppression_list;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS suppression_list (
+    id bigserial not null constraint suppression_list_pk primary key,
+    account_uuid uuid not null,
+    name varchar(255) not null,
+    channel varchar(50) default '' not null,
+    identifier_column varchar(255) not null,
+    source_data_table_id bigint default 0 not null,
+    source_column varchar(255) default '' not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+CREATE INDEX IF NOT EXISTS suppression_list_account_uuid_idx ON suppression_list (account_uuid);
+
+DROP TRIGGER IF EXISTS set_timestamp_suppression_list on "suppression_list";
+CREATE TRIGGER set_timestamp_suppression_list
+    BEFORE UPDATE ON "suppression_list"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS suppression_entry (
+    id bigserial not null constraint suppression_entry_pk primary key,
+    suppression_list_id bigint not null,
+    value varchar(255) not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint suppression_entry_uk unique (suppression_list_id, value)
+);
+
+ALTER TABLE data_destination ADD COLUMN channel VARCHAR(50) DEFAULT '' NOT NULL;
+ALTER TABLE dest_table_map ADD COLUMN suppressed_count BIGINT DEFAULT 0 NOT NULL;
+ALTER TABLE dest_segment_map ADD COLUMN suppressed_count BIGINT DEFAULT 0 NOT NULL;
+ALTER TABLE dest_ms_segment_map ADD COLUMN suppressed_count BIGINT DEFAULT 0 NOT NULL;
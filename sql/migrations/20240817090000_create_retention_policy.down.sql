@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_retention_policy on "retention_policy";
+
+DROP TABLE IF EXISTS retention_policy;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS retention_policy (
+    id bigserial not null constraint retention_policy_pk primary key,
+    account_uuid uuid not null,
+    object_type varchar(50) not null,
+    object_id bigint not null,
+    timestamp_column varchar(255) default '' not null,
+    max_age_days int default 0 not null,
+    max_versions int default 0 not null,
+    clickstream boolean default false not null,
+    data_action_id bigint default 0 not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint retention_policy_uk unique (object_type, object_id)
+);
+
+CREATE INDEX IF NOT EXISTS retention_policy_account_uuid_idx ON retention_policy (account_uuid);
+
+DROP TRIGGER IF EXISTS set_timestamp_retention_policy on "retention_policy";
+CREATE TRIGGER set_timestamp_retention_policy
+    BEFORE UPDATE ON "retention_policy"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_segment_snapshot on "segment_snapshot";
+
+DROP TABLE IF EXISTS segment_snapshot;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS segment_snapshot (
+    id bigserial not null constraint segment_snapshot_pk primary key,
+    account_uuid uuid not null,
+    segment_id bigint not null,
+    data_action_run_id bigint not null,
+    size bigint default 0 not null,
+    entered_count bigint default 0 not null,
+    exited_count bigint default 0 not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint segment_snapshot_uk unique (data_action_run_id)
+);
+
+CREATE INDEX IF NOT EXISTS segment_snapshot_segment_id_idx ON segment_snapshot (segment_id);
+
+DROP TRIGGER IF EXISTS set_timestamp_segment_snapshot on "segment_snapshot";
+CREATE TRIGGER set_timestamp_segment_snapshot
+    BEFORE UPDATE ON "segment_snapshot"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_rfm_analysis on "rfm_analysis";
+
+DROP TABLE IF EXISTS rfm_analysis;
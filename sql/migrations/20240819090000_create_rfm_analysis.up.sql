@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS rfm_analysis (
+    id bigserial not null constraint rfm_analysis_pk primary key,
+    account_uuid uuid not null,
+    master_segment_id bigint not null,
+    name varchar(255) not null,
+    behavior_table_id bigint not null,
+    customer_key varchar(255) not null,
+    date_column varchar(255) not null,
+    amount_column varchar(255) not null,
+    bins int default 5 not null,
+    attribute_prefix varchar(64) not null,
+    data_action_id bigint default 0 not null,
+    segments jsonb,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    constraint rfm_analysis_uk unique (master_segment_id, attribute_prefix)
+);
+
+CREATE INDEX IF NOT EXISTS rfm_analysis_master_segment_id_idx ON rfm_analysis (master_segment_id);
+
+DROP TRIGGER IF EXISTS set_timestamp_rfm_analysis on "rfm_analysis";
+CREATE TRIGGER set_timestamp_rfm_analysis
+    BEFORE UPDATE ON "rfm_analysis"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_funnel on "funnel";
+
+DROP TABLE IF EXISTS funnel;
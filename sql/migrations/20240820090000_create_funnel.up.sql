@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS funnel (
+    id bigserial not null constraint funnel_pk primary key,
+    account_uuid uuid not null,
+    master_segment_id bigint not null,
+    name varchar(255) not null,
+    description text,
+    steps jsonb not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+CREATE INDEX IF NOT EXISTS funnel_master_segment_id_idx ON funnel (master_segment_id);
+
+DROP TRIGGER IF EXISTS set_timestamp_funnel on "funnel";
+CREATE TRIGGER set_timestamp_funnel
+    BEFORE UPDATE ON "funnel"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
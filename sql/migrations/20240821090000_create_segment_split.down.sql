@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_timestamp_segment_split on "segment_split";
+
+DROP TABLE IF EXISTS segment_split;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS segment_split (
+    id bigserial not null constraint segment_split_pk primary key,
+    account_uuid uuid not null,
+    segment_id bigint not null,
+    name varchar(255) not null,
+    salt varchar(255) not null,
+    arms jsonb not null,
+    created_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null,
+    updated_at timestamptz default (NOW () AT TIME ZONE 'UTC') not null
+);
+
+CREATE INDEX IF NOT EXISTS segment_split_segment_id_idx ON segment_split (segment_id);
+
+DROP TRIGGER IF EXISTS set_timestamp_segment_split on "segment_split";
+CREATE TRIGGER set_timestamp_segment_split
+    BEFORE UPDATE ON "segment_split"
+    FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1 @@
+ALTER TABLE segment DROP COLUMN sequence_sql_condition;
@@ -0,0 +1 @@
+ALTER TABLE segment ADD COLUMN sequence_sql_condition TEXT DEFAULT '' NOT NULL;
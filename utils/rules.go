@@ -56,6 +56,21 @@ func GenerateDeltaTablePath(accountUuid string, tableName string) string {
 	return path
 }
 
+// GenerateDeltaQuarantinePath is where rows rejected by data quality rules are written, next to the table
+func GenerateDeltaQuarantinePath(accountUuid string, tableName string) string {
+	return GenerateDeltaTablePath(accountUuid, tableName) + "_quarantine"
+}
+
+// GenerateDeltaQualityReportPath keeps one passed/failed count record per dag run of the table
+func GenerateDeltaQualityReportPath(accountUuid string, tableName string) string {
+	return GenerateDeltaTablePath(accountUuid, tableName) + "_quality_report"
+}
+
+// GenerateDeltaDeduplicationReportPath keeps one before/after row count record per dag run deduplicating the table
+func GenerateDeltaDeduplicationReportPath(accountUuid string, tableName string) string {
+	return GenerateDeltaTablePath(accountUuid, tableName) + "_dedupe_report"
+}
+
 func GenerateDeltaAudiencePath(masterSegmentId int64) string {
 	return strings.Replace(DeltaAudience_Path, DeltaAudience_MasterSegmentId,
 		strconv.FormatInt(masterSegmentId, 10), 2)
@@ -88,6 +103,12 @@ func GenerateDagId(accountUuid string, dataActionType model.ActionType) string {
 		prefix = "train_predict_model"
 	case model.ActionType_ApplyPredictModel:
 		prefix = "apply_predict_model"
+	case model.ActionType_IdentityResolution:
+		prefix = "identity_resolution"
+	case model.ActionType_DeduplicateDataTable:
+		prefix = "deduplicate_data_table"
+	case model.ActionType_RestoreDataTable:
+		prefix = "restore_data_table"
 	default:
 		return ""
 	}
@@ -98,6 +119,10 @@ func GenerateDeltaSegmentPath(masterSegmentId int64, segmentId int64) string {
 	return fmt.Sprintf("segments/%d/%d_segment", masterSegmentId, segmentId)
 }
 
+func GenerateDeltaSegmentSnapshotPath(masterSegmentId int64, segmentId int64) string {
+	return fmt.Sprintf("segments/%d/%d_snapshot", masterSegmentId, segmentId)
+}
+
 func GenerateDeltaPredictModelFilePath(masterSegmentId int64, predictModelId int64) string {
 	return fmt.Sprintf("segments/%d/%d_model", masterSegmentId, predictModelId)
 }
@@ -111,3 +136,16 @@ func GenerateDeltaPredictResult(masterSegmentId int64) string {
 	version := uuid.New()
 	return fmt.Sprintf("segments/%d/predict-output/%s", masterSegmentId, version)
 }
+
+func GenerateDeltaIdentityGraphPath(accountUuid string, identityResolutionId int64) string {
+	return fmt.Sprintf("identity/%s/%d/graph", accountUuid, identityResolutionId)
+}
+
+func GenerateDeltaIdentityHistoryPath(accountUuid string, identityResolutionId int64) string {
+	return fmt.Sprintf("identity/%s/%d/history", accountUuid, identityResolutionId)
+}
+
+// GenerateDeltaSuppressionPath keeps the uploaded suppression entries of every list of the account
+func GenerateDeltaSuppressionPath(accountUuid string) string {
+	return fmt.Sprintf("suppression/%s/entries", accountUuid)
+}
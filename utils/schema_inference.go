@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+)
+
+// InferTypeThreshold is the minimum ratio of non-empty values that must match a type for it to be detected.
+const InferTypeThreshold = 0.9
+
+// dateTimeLayout pairs a Go layout used for detection with the Spark pattern sent to the DAG.
+type dateTimeLayout struct {
+	GoLayout     string
+	SparkPattern string
+}
+
+var (
+	dateLayouts = []dateTimeLayout{
+		{GoLayout: "2006-01-02", SparkPattern: "yyyy-MM-dd"},
+		{GoLayout: "2006/01/02", SparkPattern: "yyyy/MM/dd"},
+		{GoLayout: "02/01/2006", SparkPattern: "dd/MM/yyyy"},
+		{GoLayout: "01/02/2006", SparkPattern: "MM/dd/yyyy"},
+		{GoLayout: "02-01-2006", SparkPattern: "dd-MM-yyyy"},
+	}
+	timestampLayouts = []dateTimeLayout{
+		{GoLayout: time.RFC3339, SparkPattern: "yyyy-MM-dd'T'HH:mm:ssXXX"},
+		{GoLayout: "2006-01-02T15:04:05", SparkPattern: "yyyy-MM-dd'T'HH:mm:ss"},
+		{GoLayout: "2006-01-02 15:04:05", SparkPattern: "yyyy-MM-dd HH:mm:ss"},
+		{GoLayout: "2006-01-02 15:04:05.000", SparkPattern: "yyyy-MM-dd HH:mm:ss.SSS"},
+		{GoLayout: "2006/01/02 15:04:05", SparkPattern: "yyyy/MM/dd HH:mm:ss"},
+		{GoLayout: "02/01/2006 15:04:05", SparkPattern: "dd/MM/yyyy HH:mm:ss"},
+		{GoLayout: "01/02/2006 15:04:05", SparkPattern: "MM/dd/yyyy HH:mm:ss"},
+	}
+	// compactDateLayout is also an int, it is only detected when every value is a date of 8 digits
+	compactDateLayout = dateTimeLayout{GoLayout: "20060102", SparkPattern: "yyyyMMdd"}
+)
+
+type InferredColumnType struct {
+	DataType   model.ColumnDataType
+	Format     string
+	Confidence float64
+	NullCount  int64
+}
+
+// InferColumnType detects the most specific type that matches the sampled values of a column.
+// Candidates are tried in the order bool, int, float, date, timestamp and fall back to string,
+// except for the dates of 8 digits which would otherwise be detected as int.
+func InferColumnType(values []string) InferredColumnType {
+	var nonEmpty []string
+	var nullCount int64
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || strings.EqualFold(value, "null") {
+			nullCount++
+			continue
+		}
+		nonEmpty = append(nonEmpty, value)
+	}
+	if len(nonEmpty) == 0 {
+		return InferredColumnType{DataType: model.ColumnDataType_String, Confidence: 0, NullCount: nullCount}
+	}
+
+	ratio := func(match func(string) bool) float64 {
+		matched := 0
+		for _, value := range nonEmpty {
+			if match(value) {
+				matched++
+			}
+		}
+		return float64(matched) / float64(len(nonEmpty))
+	}
+
+	if confidence := ratio(isBool); confidence >= InferTypeThreshold {
+		return InferredColumnType{DataType: model.ColumnDataType_Bool, Confidence: confidence, NullCount: nullCount}
+	}
+	if ratio(isCompactDate) == 1 {
+		return InferredColumnType{DataType: model.ColumnDataType_Date, Format: compactDateLayout.SparkPattern, Confidence: 1, NullCount: nullCount}
+	}
+	if confidence := ratio(isInt); confidence >= InferTypeThreshold {
+		return InferredColumnType{DataType: model.ColumnDataType_Int, Confidence: confidence, NullCount: nullCount}
+	}
+	if confidence := ratio(isFloat); confidence >= InferTypeThreshold {
+		return InferredColumnType{DataType: model.ColumnDataType_Float, Confidence: confidence, NullCount: nullCount}
+	}
+	for _, layout := range dateLayouts {
+		if confidence := ratio(matchLayout(layout.GoLayout)); confidence >= InferTypeThreshold {
+			return InferredColumnType{DataType: model.ColumnDataType_Date, Format: layout.SparkPattern, Confidence: confidence, NullCount: nullCount}
+		}
+	}
+	for _, layout := range timestampLayouts {
+		if confidence := ratio(matchLayout(layout.GoLayout)); confidence >= InferTypeThreshold {
+			return InferredColumnType{DataType: model.ColumnDataType_Timestamp, Format: layout.SparkPattern, Confidence: confidence, NullCount: nullCount}
+		}
+	}
+
+	return InferredColumnType{DataType: model.ColumnDataType_String, Confidence: 1, NullCount: nullCount}
+}
+
+func isBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "false":
+		return true
+	}
+	return false
+}
+
+func isInt(value string) bool {
+	_, err := strconv.ParseInt(value, 10, 64)
+	return err == nil
+}
+
+func isCompactDate(value string) bool {
+	return len(value) == len(compactDateLayout.GoLayout) && matchLayout(compactDateLayout.GoLayout)(value)
+}
+
+func isFloat(value string) bool {
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+func matchLayout(layout string) func(string) bool {
+	return func(value string) bool {
+		_, err := time.Parse(layout, value)
+		return err == nil
+	}
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/APCS20-Thesis/Backend/internal/model"
+)
+
+func TestInferColumnType(t *testing.T) {
+	tests := []struct {
+		name          string
+		values        []string
+		wantType      model.ColumnDataType
+		wantFormat    string
+		wantNullCount int64
+	}{
+		{name: "no value", values: nil, wantType: model.ColumnDataType_String},
+		{name: "only nulls", values: []string{"", " ", "NULL", "null"}, wantType: model.ColumnDataType_String, wantNullCount: 4},
+		{name: "bool", values: []string{"true", "FALSE", "True"}, wantType: model.ColumnDataType_Bool},
+		{name: "int", values: []string{"1", "-20", " 300 "}, wantType: model.ColumnDataType_Int},
+		{name: "int with nulls", values: []string{"1", "", "2", "null"}, wantType: model.ColumnDataType_Int, wantNullCount: 2},
+		{name: "float", values: []string{"1.5", "2", "-0.25"}, wantType: model.ColumnDataType_Float},
+		{name: "date", values: []string{"2024-01-31", "2024-02-29"}, wantType: model.ColumnDataType_Date, wantFormat: "yyyy-MM-dd"},
+		{name: "day first date", values: []string{"31/01/2024", "29/02/2024"}, wantType: model.ColumnDataType_Date, wantFormat: "dd/MM/yyyy"},
+		{name: "month first date", values: []string{"01/31/2024", "02/29/2024"}, wantType: model.ColumnDataType_Date, wantFormat: "MM/dd/yyyy"},
+		{name: "timestamp", values: []string{"2024-01-31 10:00:00", "2024-02-29 23:59:59"}, wantType: model.ColumnDataType_Timestamp, wantFormat: "yyyy-MM-dd HH:mm:ss"},
+		{name: "rfc3339 timestamp", values: []string{"2024-01-31T10:00:00Z", "2024-02-29T23:59:59+07:00"}, wantType: model.ColumnDataType_Timestamp, wantFormat: "yyyy-MM-dd'T'HH:mm:ssXXX"},
+		{
+			name:     "int under the threshold",
+			values:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "a", "b"},
+			wantType: model.ColumnDataType_String,
+		},
+		{
+			name:     "int at the threshold",
+			values:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "b"},
+			wantType: model.ColumnDataType_Int,
+		},
+		{name: "text", values: []string{"alice", "bob"}, wantType: model.ColumnDataType_String},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InferColumnType(tt.values)
+			if got.DataType != tt.wantType || got.Format != tt.wantFormat || got.NullCount != tt.wantNullCount {
+				t.Errorf("InferColumnType(%q) = %+v, want type %s, format %q, null count %d",
+					tt.values, got, tt.wantType, tt.wantFormat, tt.wantNullCount)
+			}
+		})
+	}
+}
+
+// TestInferColumnTypeCompactDate checks the dates of 8 digits are told apart from ints, which they also parse as
+func TestInferColumnTypeCompactDate(t *testing.T) {
+	inferred := InferColumnType([]string{"20240131", "20240229", "", "20231231"})
+	if inferred.DataType != model.ColumnDataType_Date || inferred.Format != "yyyyMMdd" {
+		t.Fatalf("dates of 8 digits are inferred as %s %q, want date yyyyMMdd", inferred.DataType, inferred.Format)
+	}
+	if inferred.Confidence != 1 || inferred.NullCount != 1 {
+		t.Errorf("confidence %v and null count %d, want 1 and 1", inferred.Confidence, inferred.NullCount)
+	}
+
+	// one id of 8 digits that is not a date keeps the column an int, a date needs every value
+	inferred = InferColumnType([]string{"20240131", "20240229", "20241301"})
+	if inferred.DataType != model.ColumnDataType_Int {
+		t.Errorf("ids of 8 digits with an invalid date are inferred as %s, want int", inferred.DataType)
+	}
+	inferred = InferColumnType([]string{"1024", "20240229"})
+	if inferred.DataType != model.ColumnDataType_Int {
+		t.Errorf("ints of other lengths are inferred as %s, want int", inferred.DataType)
+	}
+}